@@ -25,11 +25,18 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/approval"
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/auth"
 	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/connect"
 	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/credential"
 	"github.com/redpanda-data/console/backend/pkg/embed"
 	"github.com/redpanda-data/console/backend/pkg/git"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
 	"github.com/redpanda-data/console/backend/pkg/redpanda"
 	"github.com/redpanda-data/console/backend/pkg/version"
 )
@@ -46,6 +53,28 @@ type API struct {
 	GitSvc      *git.Service
 	RedpandaSvc *redpanda.Service
 
+	// AuthSvc authenticates users if the built-in authentication is enabled.
+	// It is nil otherwise.
+	AuthSvc *auth.Service
+
+	// RBACSvc authorizes users with the RBAC policy file if enabled. It is nil otherwise.
+	RBACSvc *rbac.Service
+
+	// APITokenSvc authenticates service accounts with API tokens if enabled. It is
+	// nil otherwise.
+	APITokenSvc *apitoken.Service
+
+	// AuditSvc records all mutating requests if the audit log is enabled. It is nil otherwise.
+	AuditSvc *audit.Service
+
+	// ApprovalSvc creates change requests for dangerous operations that have to be
+	// approved by a second user, if approvals are enabled. It is nil otherwise.
+	ApprovalSvc *approval.Service
+
+	// CredentialSvc creates and rotates SCRAM credentials and tracks their
+	// lifecycle, if credential management is enabled. It is nil otherwise.
+	CredentialSvc *credential.Service
+
 	// FrontendResources is an in-memory Filesystem with all go:embedded frontend resources.
 	// The index.html is expected to be at the root of the filesystem. This prop will only be accessed
 	// if the config property serveFrontend is set to true.
@@ -76,11 +105,43 @@ func New(cfg *config.Config, opts ...Option) *API {
 		logger.Fatal("failed to create Redpanda service", zap.Error(err))
 	}
 
-	connectSvc, err := connect.NewService(cfg.Connect, logger)
+	var connectOpts []connect.Option
+	if cfg.Connect.Discovery.Enabled || (cfg.Connect.Registry.Enabled && cfg.Connect.Registry.Storage == config.ConnectRegistryStorageKafka) {
+		kgoOpts, err := kafka.NewKgoConfig(&cfg.Kafka, logger.Named("connect_kafka_client"), nil)
+		if err != nil {
+			logger.Fatal("failed to create Kafka client config for Kafka connect service", zap.Error(err))
+		}
+		connectOpts = append(connectOpts, connect.WithKafkaClientOpts(kgoOpts...))
+	}
+	connectSvc, err := connect.NewService(cfg.Connect, logger, connectOpts...)
 	if err != nil {
 		logger.Fatal("failed to create Kafka connect service", zap.Error(err))
 	}
 
+	var authSvc *auth.Service
+	if cfg.Auth.Enabled {
+		authSvc, err = auth.NewService(cfg.Auth, logger.Named("auth"))
+		if err != nil {
+			logger.Fatal("failed to create auth service", zap.Error(err))
+		}
+	}
+	var rbacSvc *rbac.Service
+	if cfg.Auth.RBAC.Enabled {
+		rbacSvc, err = rbac.NewService(cfg.Auth.RBAC, logger.Named("rbac"))
+		if err != nil {
+			logger.Fatal("failed to create rbac service", zap.Error(err))
+		}
+	}
+
+	var apiTokenSvc *apitoken.Service
+	if cfg.Auth.APITokens.Enabled {
+		store, err := apitoken.NewStore(cfg.Auth.APITokens)
+		if err != nil {
+			logger.Fatal("failed to create api token store", zap.Error(err))
+		}
+		apiTokenSvc = apitoken.NewService(cfg.Auth.APITokens, logger.Named("api_tokens"), store, rbacSvc)
+	}
+
 	var consoleSvc console.Servicer
 	if cfg.Console.Enabled {
 		consoleSvc, err = console.NewService(cfg, logger, redpandaSvc, connectSvc)
@@ -89,6 +150,37 @@ func New(cfg *config.Config, opts ...Option) *API {
 		}
 	}
 
+	var auditSvc *audit.Service
+	if cfg.Audit.Enabled {
+		// The Kafka sink shares the clients of the console service rather than
+		// opening additional connections to the cluster
+		var auditKafkaSvc *kafka.Service
+		if cfg.Audit.Kafka.Enabled {
+			svc, ok := consoleSvc.(*console.Service)
+			if !ok {
+				logger.Fatal("the Kafka sink of the audit log requires the console service to be enabled")
+			}
+			auditKafkaSvc = svc.KafkaService()
+		}
+		auditSvc, err = audit.NewService(cfg.Audit, logger.Named("audit"), auditKafkaSvc)
+		if err != nil {
+			logger.Fatal("failed to create audit service", zap.Error(err))
+		}
+	}
+
+	var approvalSvc *approval.Service
+	if cfg.Auth.Approvals.Enabled {
+		store, err := approval.NewStore(cfg.Auth.Approvals)
+		if err != nil {
+			logger.Fatal("failed to create change request store", zap.Error(err))
+		}
+		var recorder approval.Recorder
+		if auditSvc != nil {
+			recorder = auditSvc
+		}
+		approvalSvc = approval.NewService(cfg.Auth.Approvals, logger.Named("approvals"), store, recorder)
+	}
+
 	// Use default frontend resources from embeds. They may be overridden via functional options.
 	// We don't use hooks here because we may want to use the API struct without providing all hooks.
 	fsys, err := fs.Sub(embed.FrontendFiles, "frontend")
@@ -102,6 +194,11 @@ func New(cfg *config.Config, opts ...Option) *API {
 		ConsoleSvc:        consoleSvc,
 		ConnectSvc:        connectSvc,
 		RedpandaSvc:       redpandaSvc,
+		AuthSvc:           authSvc,
+		RBACSvc:           rbacSvc,
+		APITokenSvc:       apiTokenSvc,
+		AuditSvc:          auditSvc,
+		ApprovalSvc:       approvalSvc,
 		Hooks:             newDefaultHooks(),
 		FrontendResources: fsys,
 		License: redpanda.License{
@@ -110,9 +207,22 @@ func New(cfg *config.Config, opts ...Option) *API {
 			ExpiresAt: math.MaxInt32,
 		},
 	}
+	if rbacSvc != nil || apiTokenSvc != nil {
+		a.Hooks.Authorization = newRBACHooks(rbacSvc)
+	}
 	for _, opt := range opts {
 		opt(a)
 	}
+	if a.ApprovalSvc != nil {
+		a.registerApprovalExecutors()
+	}
+	if cfg.Kafka.Credentials.Enabled {
+		store, err := credential.NewStore(cfg.Kafka.Credentials)
+		if err != nil {
+			logger.Fatal("failed to create credential metadata store", zap.Error(err))
+		}
+		a.CredentialSvc = credential.NewService(cfg.Kafka.Credentials, logger.Named("credentials"), store, &credentialClient{api: a})
+	}
 
 	return a
 }
@@ -124,6 +234,13 @@ func (api *API) Start() {
 		api.Logger.Fatal("failed to start console service", zap.Error(err))
 	}
 
+	if api.RBACSvc != nil {
+		api.RBACSvc.Start()
+	}
+	if api.CredentialSvc != nil {
+		api.CredentialSvc.Start()
+	}
+
 	mux := api.routes()
 
 	// Server
@@ -147,5 +264,13 @@ func (api *API) Stop(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to shutdown HTTP server: %w", err)
 	}
+	if api.ConnectSvc != nil {
+		api.ConnectSvc.Stop()
+	}
+	if api.AuditSvc != nil {
+		if err := api.AuditSvc.Close(); err != nil {
+			return fmt.Errorf("failed to close audit log: %w", err)
+		}
+	}
 	return nil
 }
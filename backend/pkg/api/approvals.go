@@ -0,0 +1,369 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/approval"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+// deleteTopicPayload is the payload of the deleteTopic operation.
+type deleteTopicPayload struct {
+	TopicName string `json:"topicName"`
+}
+
+// OK validates the payload.
+func (p *deleteTopicPayload) OK() error {
+	if p.TopicName == "" {
+		return errors.New("topic name must be set")
+	}
+	return nil
+}
+
+// deleteTopicRecordsPayload is the payload of the deleteTopicRecords operation.
+type deleteTopicRecordsPayload struct {
+	TopicName string `json:"topicName"`
+	deleteTopicRecordsRequest
+}
+
+// OK validates the payload.
+func (p *deleteTopicRecordsPayload) OK() error {
+	if p.TopicName == "" {
+		return errors.New("topic name must be set")
+	}
+	return p.deleteTopicRecordsRequest.OK()
+}
+
+// resetConsumerGroupOffsetsPayload is the payload of the resetConsumerGroupOffsets
+// operation.
+type resetConsumerGroupOffsetsPayload struct {
+	patchConsumerGroupRequest
+}
+
+// OK validates the payload.
+func (p *resetConsumerGroupOffsetsPayload) OK() error {
+	if p.GroupID == "" {
+		return errors.New("group id must be set")
+	}
+	return p.patchConsumerGroupRequest.OK()
+}
+
+// deleteSchemaSubjectPayload is the payload of the deleteSchemaSubject operation.
+type deleteSchemaSubjectPayload struct {
+	Subject   string `json:"subject"`
+	Permanent bool   `json:"permanent"`
+}
+
+// OK validates the payload.
+func (p *deleteSchemaSubjectPayload) OK() error {
+	if p.Subject == "" {
+		return errors.New("subject must be set")
+	}
+	return nil
+}
+
+// deleteSchemaSubjectVersionPayload is the payload of the deleteSchemaSubjectVersion
+// operation.
+type deleteSchemaSubjectVersionPayload struct {
+	Subject   string `json:"subject"`
+	Version   string `json:"version"`
+	Permanent bool   `json:"permanent"`
+}
+
+// OK validates the payload.
+func (p *deleteSchemaSubjectVersionPayload) OK() error {
+	if p.Subject == "" {
+		return errors.New("subject must be set")
+	}
+	if p.Version != console.SchemaVersionsLatest {
+		if _, err := strconv.Atoi(p.Version); err != nil {
+			return fmt.Errorf("version %q is not valid. Must be %q or a positive integer", p.Version, console.SchemaVersionsLatest)
+		}
+	}
+	return nil
+}
+
+// deleteConsumerGroupOffsetsPayload is the payload of the deleteConsumerGroupOffsets
+// operation.
+type deleteConsumerGroupOffsetsPayload struct {
+	deleteConsumerGroupRequest
+}
+
+// OK validates the payload.
+func (p *deleteConsumerGroupOffsetsPayload) OK() error {
+	if p.GroupID == "" {
+		return errors.New("group id must be set")
+	}
+	return p.deleteConsumerGroupRequest.OK()
+}
+
+// applyTranslatedConsumerGroupOffsetsPayload is the payload of the
+// applyTranslatedConsumerGroupOffsets operation.
+type applyTranslatedConsumerGroupOffsetsPayload struct {
+	SourceClusterAlias string   `json:"sourceClusterAlias"`
+	GroupID            string   `json:"groupId"`
+	TopicNames         []string `json:"topicNames,omitempty"`
+}
+
+// OK validates the payload.
+func (p *applyTranslatedConsumerGroupOffsetsPayload) OK() error {
+	if p.SourceClusterAlias == "" {
+		return errors.New("source cluster alias must be set")
+	}
+	if p.GroupID == "" {
+		return errors.New("group id must be set")
+	}
+	return nil
+}
+
+// payload is implemented by the pointers to all operation payloads.
+type payload[T any] interface {
+	*T
+	OK() error
+}
+
+// approvalExecutor implements approval.Executor for an operation with the payload
+// T, reusing the authorization hooks and console service calls of the REST
+// handler of the operation.
+type approvalExecutor[T any, P payload[T]] struct {
+	target    func(p P) map[string]string
+	authorize func(ctx context.Context, p P) *rest.Error
+	execute   func(ctx context.Context, p P) (any, *rest.Error)
+}
+
+var _ approval.Executor = (*approvalExecutor[deleteTopicPayload, *deleteTopicPayload])(nil)
+
+func (e *approvalExecutor[T, P]) decode(encoded json.RawMessage) (P, error) {
+	p := P(new(T))
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(p); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	if err := p.OK(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Target returns the resource names of the payload.
+func (e *approvalExecutor[T, P]) Target(encoded json.RawMessage) (map[string]string, error) {
+	p, err := e.decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return e.target(p), nil
+}
+
+// Authorize runs the authorization hook of the operation for the user of the context.
+func (e *approvalExecutor[T, P]) Authorize(ctx context.Context, encoded json.RawMessage) error {
+	p, err := e.decode(encoded)
+	if err != nil {
+		return err
+	}
+	if restErr := e.authorize(ctx, p); restErr != nil {
+		return errors.New(restErr.Message)
+	}
+	return nil
+}
+
+// Execute performs the operation.
+func (e *approvalExecutor[T, P]) Execute(ctx context.Context, encoded json.RawMessage) (any, error) {
+	p, err := e.decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	res, restErr := e.execute(ctx, p)
+	if restErr != nil {
+		return nil, errors.New(restErr.Message)
+	}
+	return res, nil
+}
+
+// checkHook turns the result of an authorization hook into a REST error.
+func checkHook(allowed bool, restErr *rest.Error, message string) *rest.Error {
+	if restErr != nil {
+		return restErr
+	}
+	if !allowed {
+		return &rest.Error{
+			Err:      errors.New(message),
+			Status:   http.StatusForbidden,
+			Message:  message,
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+// checkSchemaRegistryEnabled returns an error if the schema registry is not configured.
+func (api *API) checkSchemaRegistryEnabled() *rest.Error {
+	if !api.Cfg.Kafka.Schema.Enabled {
+		return &rest.Error{
+			Err:      errors.New("schema registry is not configured"),
+			Status:   http.StatusNotImplemented,
+			Message:  "Schema Registry is not configured",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+// registerApprovalExecutors registers the executors of all operations that can
+// require an approval.
+func (api *API) registerApprovalExecutors() {
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationDeleteTopic, &approvalExecutor[deleteTopicPayload, *deleteTopicPayload]{
+		target: func(p *deleteTopicPayload) map[string]string {
+			return map[string]string{"topicName": p.TopicName}
+		},
+		authorize: func(ctx context.Context, p *deleteTopicPayload) *rest.Error {
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteTopic(ctx, p.TopicName)
+			return checkHook(canDelete, restErr, "You don't have permissions to delete this topic")
+		},
+		execute: func(ctx context.Context, p *deleteTopicPayload) (any, *rest.Error) {
+			return nil, api.ConsoleSvc.DeleteTopic(ctx, p.TopicName)
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationDeleteTopicRecords, &approvalExecutor[deleteTopicRecordsPayload, *deleteTopicRecordsPayload]{
+		target: func(p *deleteTopicRecordsPayload) map[string]string {
+			return map[string]string{"topicName": p.TopicName}
+		},
+		authorize: func(ctx context.Context, p *deleteTopicRecordsPayload) *rest.Error {
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteTopicRecords(ctx, p.TopicName)
+			return checkHook(canDelete, restErr, "You don't have permissions to delete records in this topic")
+		},
+		execute: func(ctx context.Context, p *deleteTopicRecordsPayload) (any, *rest.Error) {
+			return api.ConsoleSvc.DeleteTopicRecords(ctx, p.toKmsg(p.TopicName))
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationResetConsumerGroupOffsets, &approvalExecutor[resetConsumerGroupOffsetsPayload, *resetConsumerGroupOffsetsPayload]{
+		target: func(p *resetConsumerGroupOffsetsPayload) map[string]string {
+			return map[string]string{"groupId": p.GroupID}
+		},
+		authorize: func(ctx context.Context, p *resetConsumerGroupOffsetsPayload) *rest.Error {
+			canEdit, restErr := api.Hooks.Authorization.CanEditConsumerGroup(ctx, p.GroupID)
+			return checkHook(canEdit, restErr, "You don't have permissions to edit this consumer group")
+		},
+		execute: func(ctx context.Context, p *resetConsumerGroupOffsetsPayload) (any, *rest.Error) {
+			return api.ConsoleSvc.EditConsumerGroupOffsets(ctx, p.GroupID, p.toKmsg())
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationDeleteSchemaSubject, &approvalExecutor[deleteSchemaSubjectPayload, *deleteSchemaSubjectPayload]{
+		target: func(p *deleteSchemaSubjectPayload) map[string]string {
+			return map[string]string{"subject": p.Subject, "permanent": strconv.FormatBool(p.Permanent)}
+		},
+		authorize: func(ctx context.Context, p *deleteSchemaSubjectPayload) *rest.Error {
+			if restErr := api.checkSchemaRegistryEnabled(); restErr != nil {
+				return restErr
+			}
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteSchemas(ctx)
+			if restErr := checkHook(canDelete, restErr, "You don't have permissions to delete a subject."); restErr != nil {
+				return restErr
+			}
+			return api.checkSchemaSubjectPermission(ctx, p.Subject, "deleteSchemas")
+		},
+		execute: func(ctx context.Context, p *deleteSchemaSubjectPayload) (any, *rest.Error) {
+			return api.deleteSchemaSubject(ctx, p.Subject, p.Permanent)
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationDeleteSchemaSubjectVersion, &approvalExecutor[deleteSchemaSubjectVersionPayload, *deleteSchemaSubjectVersionPayload]{
+		target: func(p *deleteSchemaSubjectVersionPayload) map[string]string {
+			return map[string]string{"subject": p.Subject, "version": p.Version, "permanent": strconv.FormatBool(p.Permanent)}
+		},
+		authorize: func(ctx context.Context, p *deleteSchemaSubjectVersionPayload) *rest.Error {
+			if restErr := api.checkSchemaRegistryEnabled(); restErr != nil {
+				return restErr
+			}
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteSchemas(ctx)
+			if restErr := checkHook(canDelete, restErr, "You don't have permissions to delete a subject version."); restErr != nil {
+				return restErr
+			}
+			return api.checkSchemaSubjectPermission(ctx, p.Subject, "deleteSchemas")
+		},
+		execute: func(ctx context.Context, p *deleteSchemaSubjectVersionPayload) (any, *rest.Error) {
+			return api.deleteSchemaSubjectVersion(ctx, p.Subject, p.Version, p.Permanent)
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationDeleteConsumerGroupOffsets, &approvalExecutor[deleteConsumerGroupOffsetsPayload, *deleteConsumerGroupOffsetsPayload]{
+		target: func(p *deleteConsumerGroupOffsetsPayload) map[string]string {
+			return map[string]string{"groupId": p.GroupID}
+		},
+		authorize: func(ctx context.Context, p *deleteConsumerGroupOffsetsPayload) *rest.Error {
+			canDelete, restErr := api.Hooks.Authorization.CanDeleteConsumerGroup(ctx, p.GroupID)
+			return checkHook(canDelete, restErr, "You don't have permissions to edit this consumer group")
+		},
+		execute: func(ctx context.Context, p *deleteConsumerGroupOffsetsPayload) (any, *rest.Error) {
+			return api.deleteConsumerGroupOffsets(ctx, &p.deleteConsumerGroupRequest)
+		},
+	})
+
+	api.ApprovalSvc.RegisterExecutor(config.AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets, &approvalExecutor[applyTranslatedConsumerGroupOffsetsPayload, *applyTranslatedConsumerGroupOffsetsPayload]{
+		target: func(p *applyTranslatedConsumerGroupOffsetsPayload) map[string]string {
+			return map[string]string{"groupId": p.GroupID, "sourceClusterAlias": p.SourceClusterAlias}
+		},
+		authorize: func(ctx context.Context, p *applyTranslatedConsumerGroupOffsetsPayload) *rest.Error {
+			canEdit, restErr := api.Hooks.Authorization.CanEditConsumerGroup(ctx, p.GroupID)
+			return checkHook(canEdit, restErr, "You don't have permissions to edit this consumer group")
+		},
+		execute: func(ctx context.Context, p *applyTranslatedConsumerGroupOffsetsPayload) (any, *rest.Error) {
+			return api.ConsoleSvc.ApplyTranslatedConsumerGroupOffsets(ctx, console.TranslateConsumerGroupOffsetsRequest{
+				SourceClusterAlias: p.SourceClusterAlias,
+				GroupID:            p.GroupID,
+				TopicNames:         p.TopicNames,
+			})
+		},
+	})
+}
+
+// requiresApproval returns true if approvals are enabled and the operation has
+// to be approved before it is executed.
+func (api *API) requiresApproval(operation string) bool {
+	return api.ApprovalSvc != nil && api.ApprovalSvc.IsRequired(operation)
+}
+
+// submitChangeRequest creates a change request for the operation instead of
+// executing it and responds with 202 Accepted. The optional "comment" query
+// parameter is stored as justification.
+func (api *API) submitChangeRequest(w http.ResponseWriter, r *http.Request, operation string, payload any) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:      fmt.Errorf("failed to encode change request payload: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  "Failed to encode change request payload",
+			IsSilent: false,
+		})
+		return
+	}
+
+	changeRequest, err := api.ApprovalSvc.Submit(r.Context(), operation, encoded, r.URL.Query().Get("comment"))
+	if err != nil {
+		rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, "Failed to create change request"))
+		return
+	}
+
+	rest.SendResponse(w, r, api.Logger, http.StatusAccepted, changeRequestResponse{ChangeRequest: changeRequest})
+}
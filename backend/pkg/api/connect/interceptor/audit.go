@@ -0,0 +1,111 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+// readOnlyMethodPrefixes are prefixes of RPC method names that do not modify any
+// resources. All other methods are recorded in the audit log.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Describe", "Validate", "Search", "Lint"}
+
+// AuditInterceptor records all mutating calls in the audit log.
+type AuditInterceptor struct {
+	auditSvc *audit.Service
+}
+
+// NewAuditInterceptor creates a new AuditInterceptor.
+func NewAuditInterceptor(auditSvc *audit.Service) *AuditInterceptor {
+	return &AuditInterceptor{auditSvc: auditSvc}
+}
+
+// WrapUnary creates an interceptor that records mutating Connect requests.
+func (in *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if procedure == "" {
+			// For HTTP paths invoked via gRPC gateway the procedure is not set on the spec
+			procedure, _ = runtime.RPCMethod(ctx)
+		}
+		if isReadOnlyProcedure(procedure) {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := next(ctx, req)
+
+		entry := &audit.Entry{
+			Timestamp:  start,
+			RemoteAddr: req.Peer().Addr,
+			Protocol:   audit.ProtocolConnect,
+			Operation:  procedure,
+			Result: audit.Result{
+				Success: err == nil,
+				Status:  connect.CodeOf(err).String(),
+			},
+			Duration: time.Since(start).Milliseconds(),
+		}
+		if err == nil {
+			entry.Result.Status = "ok"
+		} else {
+			entry.Result.Error = err.Error()
+		}
+		if principal := auth.PrincipalFromContext(ctx); principal != nil {
+			entry.Principal = principal.Name
+			entry.Provider = principal.Provider
+		}
+		if msg, ok := req.Any().(proto.Message); ok {
+			if encoded, marshalErr := protojson.Marshal(msg); marshalErr == nil {
+				entry.Request, entry.RequestTruncated = audit.RedactRequest(encoded, in.auditSvc.MaxRequestSize())
+				entry.Target = audit.TargetFromRequest(entry.Request)
+			}
+		}
+		in.auditSvc.Record(entry)
+
+		return res, err
+	}
+}
+
+// WrapStreamingClient is the middleware handler for bidirectional requests from
+// the client perspective.
+func (*AuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler is the middleware handler for bidirectional requests from
+// the server handling perspective. Streaming procedures only read data and are
+// therefore not recorded.
+func (*AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// isReadOnlyProcedure returns true if the method name of the procedure, e.g.
+// "/redpanda.api.dataplane.v1alpha1.TopicService/ListTopics", starts with a
+// read-only prefix.
+func isReadOnlyProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
+)
+
+// AuthenticationInterceptor rejects requests that are not authenticated. It puts
+// the principal on the context, so that it's available to all subsequent handlers.
+type AuthenticationInterceptor struct {
+	authSvc *auth.Service
+	// apiTokenSvc authenticates bearer tokens. It is nil if API tokens are disabled.
+	apiTokenSvc *apitoken.Service
+}
+
+// NewAuthenticationInterceptor creates a new AuthenticationInterceptor. The API
+// token service may be nil if API tokens are disabled.
+func NewAuthenticationInterceptor(authSvc *auth.Service, apiTokenSvc *apitoken.Service) *AuthenticationInterceptor {
+	return &AuthenticationInterceptor{authSvc: authSvc, apiTokenSvc: apiTokenSvc}
+}
+
+// WrapUnary creates an interceptor to authenticate Connect requests.
+func (in *AuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := in.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient is the middleware handler for bidirectional requests from
+// the client perspective.
+func (*AuthenticationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler is the middleware handler for bidirectional requests from
+// the server handling perspective.
+func (in *AuthenticationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := in.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate returns a context that carries the principal and, if impersonation is
+// enabled, the principal's Kafka credentials. The principal may already have been put
+// on the context by an HTTP middleware, otherwise the request headers are used.
+func (in *AuthenticationInterceptor) authenticate(ctx context.Context, header http.Header) (context.Context, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		var err error
+		ctx, principal, err = in.authenticateHeader(ctx, header)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx, err := in.authSvc.ImpersonationContext(ctx, principal)
+	if err != nil {
+		code := connect.CodePermissionDenied
+		if errors.Is(err, auth.ErrImpersonationTokenExpired) {
+			code = connect.CodeUnauthenticated
+		}
+		return ctx, apierrors.NewConnectError(code, err,
+			apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()))
+	}
+
+	return ctx, nil
+}
+
+// authenticateHeader authenticates the bearer token of the request if API tokens are
+// enabled, and the session cookie or basic auth credentials otherwise.
+func (in *AuthenticationInterceptor) authenticateHeader(ctx context.Context, header http.Header) (context.Context, *auth.Principal, error) {
+	if in.apiTokenSvc != nil {
+		tokenCtx, err := in.apiTokenSvc.AuthenticateRequest(ctx, header)
+		switch {
+		case err == nil:
+			return tokenCtx, auth.PrincipalFromContext(tokenCtx), nil
+		case errors.Is(err, apitoken.ErrInvalidToken), errors.Is(err, apitoken.ErrTokenExpired):
+			return ctx, nil, apierrors.NewConnectError(
+				connect.CodeUnauthenticated,
+				err,
+				apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()))
+		case !errors.Is(err, apitoken.ErrNoBearerToken):
+			return ctx, nil, apierrors.NewConnectError(
+				connect.CodeInternal,
+				err,
+				apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()))
+		}
+	}
+
+	principal, err := in.authSvc.Authenticate(header)
+	if err != nil {
+		return ctx, nil, apierrors.NewConnectError(
+			connect.CodeUnauthenticated,
+			errors.New("you must be logged in to access this resource"),
+			apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()))
+	}
+	return auth.ContextWithPrincipal(ctx, principal), principal, nil
+}
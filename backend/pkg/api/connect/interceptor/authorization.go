@@ -0,0 +1,313 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
+	"connectrpc.com/connect"
+	"github.com/cloudhut/common/rest"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/api/hooks"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1/consolev1alpha1connect"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1/dataplanev1alpha1connect"
+)
+
+// ClusterPermissionFunc checks a cluster action of the RBAC policy. It returns a
+// rest.Error with status 403 if the requester is not allowed to perform it.
+type ClusterPermissionFunc func(ctx context.Context, action string) *rest.Error
+
+// authorizeFunc returns whether the requester of the context may call a
+// procedure with the given request message.
+type authorizeFunc func(ctx context.Context, msg any) (bool, *rest.Error)
+
+// filterFunc removes the resources from a response message that the requester
+// of the context is not allowed to see.
+type filterFunc func(ctx context.Context, msg any) *rest.Error
+
+// AuthorizationInterceptor checks the authorization hooks for all procedures of
+// the dataplane and console services, using the same actions as the equivalent
+// REST endpoints. Procedures of these services that have no rule are denied, so
+// that new procedures can not be called without authorization. Requests that are
+// authenticated with an API token are authorized with the scopes of the token by
+// the hooks.
+type AuthorizationInterceptor struct {
+	authorizers map[string]authorizeFunc
+	filters     map[string]filterFunc
+}
+
+// authorizedServices are the services whose procedures must all have a rule.
+var authorizedServices = []string{
+	dataplanev1alpha1connect.UserServiceName,
+	dataplanev1alpha1connect.ACLServiceName,
+	dataplanev1alpha1connect.TopicServiceName,
+	dataplanev1alpha1connect.KafkaConnectServiceName,
+	dataplanev1alpha1connect.TransformServiceName,
+	consolev1alpha1connect.TransformServiceName,
+	consolev1alpha1connect.ConsoleServiceName,
+	consolev1alpha1connect.RedpandaConnectServiceName,
+	consolev1alpha1connect.SecurityServiceName,
+	consolev1alpha1connect.ApprovalServiceName,
+}
+
+type topicNameGetter interface{ GetTopicName() string }
+
+type nameGetter interface{ GetName() string }
+
+type clusterNameGetter interface{ GetClusterName() string }
+
+// NewAuthorizationInterceptor creates a new AuthorizationInterceptor. Actions
+// that are not covered by the authorization hooks, such as managing transforms,
+// are checked with the given cluster permission func.
+func NewAuthorizationInterceptor(authHooks hooks.AuthorizationHooks, clusterPermission ClusterPermissionFunc) *AuthorizationInterceptor {
+	cluster := func(check func(context.Context) (bool, *rest.Error)) authorizeFunc {
+		return func(ctx context.Context, _ any) (bool, *rest.Error) { return check(ctx) }
+	}
+	topic := func(check func(context.Context, string) (bool, *rest.Error)) authorizeFunc {
+		return func(ctx context.Context, msg any) (bool, *rest.Error) {
+			switch req := msg.(type) {
+			case *v1alpha1.CreateTopicRequest:
+				return check(ctx, req.GetTopic().GetName())
+			case topicNameGetter:
+				return check(ctx, req.GetTopicName())
+			case nameGetter:
+				return check(ctx, req.GetName())
+			}
+			return false, nil
+		}
+	}
+	connectCluster := func(check func(context.Context, string) (bool, *rest.Error)) authorizeFunc {
+		return func(ctx context.Context, msg any) (bool, *rest.Error) {
+			req, ok := msg.(clusterNameGetter)
+			if !ok {
+				return false, nil
+			}
+			return check(ctx, req.GetClusterName())
+		}
+	}
+	clusterAction := func(action string) authorizeFunc {
+		return func(ctx context.Context, _ any) (bool, *rest.Error) {
+			restErr := clusterPermission(ctx, action)
+			if restErr != nil && restErr.Status == http.StatusForbidden {
+				return false, nil
+			}
+			return restErr == nil, restErr
+		}
+	}
+	// Listing works for everyone, but the results are filtered below
+	allowed := func(context.Context, any) (bool, *rest.Error) { return true, nil }
+	// The handlers of these procedures check the authorization hooks themselves,
+	// as the resources depend on the streamed messages or on the change request
+	checkedByHandler := allowed
+
+	authorizers := map[string]authorizeFunc{
+		dataplanev1alpha1connect.UserServiceListUsersProcedure:  cluster(authHooks.CanListKafkaUsers),
+		dataplanev1alpha1connect.UserServiceCreateUserProcedure: cluster(authHooks.CanCreateKafkaUsers),
+		dataplanev1alpha1connect.UserServiceUpdateUserProcedure: cluster(authHooks.CanCreateKafkaUsers),
+		dataplanev1alpha1connect.UserServiceDeleteUserProcedure: cluster(authHooks.CanDeleteKafkaUsers),
+
+		dataplanev1alpha1connect.ACLServiceListACLsProcedure:   cluster(authHooks.CanListACLs),
+		dataplanev1alpha1connect.ACLServiceCreateACLProcedure:  cluster(authHooks.CanCreateACL),
+		dataplanev1alpha1connect.ACLServiceDeleteACLsProcedure: cluster(authHooks.CanDeleteACL),
+
+		dataplanev1alpha1connect.TopicServiceListTopicsProcedure:                allowed,
+		dataplanev1alpha1connect.TopicServiceCreateTopicProcedure:               topic(authHooks.CanCreateTopic),
+		dataplanev1alpha1connect.TopicServiceDeleteTopicProcedure:               topic(authHooks.CanDeleteTopic),
+		dataplanev1alpha1connect.TopicServiceGetTopicConfigurationsProcedure:    topic(authHooks.CanViewTopicConfig),
+		dataplanev1alpha1connect.TopicServiceUpdateTopicConfigurationsProcedure: topic(authHooks.CanEditTopicConfig),
+		dataplanev1alpha1connect.TopicServiceSetTopicConfigurationsProcedure:    topic(authHooks.CanEditTopicConfig),
+
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectClustersProcedure:     allowed,
+		dataplanev1alpha1connect.KafkaConnectServiceGetConnectClusterProcedure:       connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectorsProcedure:          connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceGetConnectorProcedure:            connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceGetConnectorStatusProcedure:      connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceGetConnectorConfigProcedure:      connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectorTopicsProcedure:     connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceGetConnectorRemediationProcedure: connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectorOffsetsProcedure:    connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectWorkersProcedure:      connectCluster(authHooks.CanViewConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceCreateConnectorProcedure:         connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceUpsertConnectorProcedure:         connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceRestartConnectorProcedure:        connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServicePauseConnectorProcedure:          connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceResumeConnectorProcedure:         connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceStopConnectorProcedure:           connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceResetConnectorTopicsProcedure:    connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceAlterConnectorOffsetsProcedure:   connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceResetConnectorOffsetsProcedure:   connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceRestartWorkerTasksProcedure:      connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceRestartFailedTasksProcedure:      connectCluster(authHooks.CanEditConnectCluster),
+		dataplanev1alpha1connect.KafkaConnectServiceDeleteConnectorProcedure:         connectCluster(authHooks.CanDeleteConnectCluster),
+
+		dataplanev1alpha1connect.TransformServiceListTransformsProcedure:  clusterAction("viewTransforms"),
+		dataplanev1alpha1connect.TransformServiceGetTransformProcedure:    clusterAction("viewTransforms"),
+		dataplanev1alpha1connect.TransformServiceDeleteTransformProcedure: clusterAction("manageTransforms"),
+		consolev1alpha1connect.TransformServiceListTransformsProcedure:    clusterAction("viewTransforms"),
+		consolev1alpha1connect.TransformServiceGetTransformProcedure:      clusterAction("viewTransforms"),
+		consolev1alpha1connect.TransformServiceDeleteTransformProcedure:   clusterAction("manageTransforms"),
+
+		consolev1alpha1connect.ConsoleServiceListMessagesProcedure:          checkedByHandler,
+		consolev1alpha1connect.ConsoleServicePublishMessageProcedure:        checkedByHandler,
+		consolev1alpha1connect.RedpandaConnectServiceLintConfigProcedure:    checkedByHandler,
+		consolev1alpha1connect.SecurityServiceListRolesProcedure:            cluster(authHooks.CanListRedpandaRoles),
+		consolev1alpha1connect.SecurityServiceGetRoleProcedure:              cluster(authHooks.CanListRedpandaRoles),
+		consolev1alpha1connect.SecurityServiceListRoleMembersProcedure:      cluster(authHooks.CanListRedpandaRoles),
+		consolev1alpha1connect.SecurityServiceCreateRoleProcedure:           cluster(authHooks.CanCreateRedpandaRoles),
+		consolev1alpha1connect.SecurityServiceUpdateRoleMembershipProcedure: cluster(authHooks.CanCreateRedpandaRoles),
+		consolev1alpha1connect.SecurityServiceDeleteRoleProcedure:           cluster(authHooks.CanDeleteRedpandaRoles),
+		consolev1alpha1connect.ApprovalServiceListChangeRequestsProcedure:   checkedByHandler,
+		consolev1alpha1connect.ApprovalServiceGetChangeRequestProcedure:     checkedByHandler,
+		consolev1alpha1connect.ApprovalServiceCreateChangeRequestProcedure:  checkedByHandler,
+		consolev1alpha1connect.ApprovalServiceRejectChangeRequestProcedure:  checkedByHandler,
+		consolev1alpha1connect.ApprovalServiceApproveChangeRequestProcedure: clusterAction("approveChanges"),
+	}
+
+	filters := map[string]filterFunc{
+		dataplanev1alpha1connect.TopicServiceListTopicsProcedure: func(ctx context.Context, msg any) *rest.Error {
+			res, ok := msg.(*v1alpha1.ListTopicsResponse)
+			if !ok {
+				return nil
+			}
+			visible := make([]*v1alpha1.ListTopicsResponse_Topic, 0, len(res.Topics))
+			for _, topic := range res.Topics {
+				canSee, restErr := authHooks.CanSeeTopic(ctx, topic.GetName())
+				if restErr != nil {
+					return restErr
+				}
+				if canSee {
+					visible = append(visible, topic)
+				}
+			}
+			res.Topics = visible
+			return nil
+		},
+		dataplanev1alpha1connect.KafkaConnectServiceListConnectClustersProcedure: func(ctx context.Context, msg any) *rest.Error {
+			res, ok := msg.(*v1alpha1.ListConnectClustersResponse)
+			if !ok {
+				return nil
+			}
+			visible := make([]*v1alpha1.ConnectCluster, 0, len(res.Clusters))
+			for _, cluster := range res.Clusters {
+				canSee, restErr := authHooks.CanViewConnectCluster(ctx, cluster.GetName())
+				if restErr != nil {
+					return restErr
+				}
+				if canSee {
+					visible = append(visible, cluster)
+				}
+			}
+			res.Clusters = visible
+			return nil
+		},
+	}
+
+	return &AuthorizationInterceptor{authorizers: authorizers, filters: filters}
+}
+
+// WrapUnary creates an interceptor that authorizes Connect requests.
+func (in *AuthorizationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if procedure == "" {
+			// For HTTP paths invoked via gRPC gateway the procedure is not set on the spec
+			procedure, _ = runtime.RPCMethod(ctx)
+		}
+		if err := in.authorize(ctx, procedure, req.Any()); err != nil {
+			return nil, err
+		}
+
+		res, err := next(ctx, req)
+		if err != nil {
+			return res, err
+		}
+		if filter, exists := in.filters[procedure]; exists {
+			if restErr := filter(ctx, res.Any()); restErr != nil {
+				return nil, authorizationError(restErr)
+			}
+		}
+		return res, nil
+	}
+}
+
+// WrapStreamingClient is the middleware handler for bidirectional requests from
+// the client perspective.
+func (*AuthorizationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler is the middleware handler for bidirectional requests from
+// the server handling perspective. The request message is not known before the
+// handler receives it, so that only rules that do not depend on it can allow
+// streaming procedures.
+func (in *AuthorizationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := in.authorize(ctx, conn.Spec().Procedure, nil); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (in *AuthorizationInterceptor) authorize(ctx context.Context, procedure string, msg any) error {
+	authorize, exists := in.authorizers[procedure]
+	if !exists {
+		if isAuthorizedService(procedure) {
+			return permissionDenied(procedure)
+		}
+		return nil
+	}
+
+	allowed, restErr := authorize(ctx, msg)
+	if restErr != nil {
+		return authorizationError(restErr)
+	}
+	if !allowed {
+		return permissionDenied(procedure)
+	}
+	return nil
+}
+
+func isAuthorizedService(procedure string) bool {
+	for _, service := range authorizedServices {
+		if strings.HasPrefix(procedure, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func permissionDenied(procedure string) *connect.Error {
+	return apierrors.NewConnectError(
+		connect.CodePermissionDenied,
+		fmt.Errorf("you are not allowed to call %s", procedure),
+		apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_PERMISSION_DENIED.String()),
+	)
+}
+
+func authorizationError(restErr *rest.Error) *connect.Error {
+	err := restErr.Err
+	if err == nil {
+		err = errors.New(restErr.Message)
+	}
+	return apierrors.NewConnectError(
+		apierrors.CodeFromHTTPStatus(restErr.Status),
+		err,
+		apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()),
+	)
+}
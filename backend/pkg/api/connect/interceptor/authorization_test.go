@@ -0,0 +1,40 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/redpanda-data/console/backend/pkg/api/mocks"
+)
+
+func TestAuthorizationInterceptorCoversAllProcedures(t *testing.T) {
+	allowAll := func(context.Context, string) *rest.Error { return nil }
+	in := NewAuthorizationInterceptor(mocks.NewMockAuthorizationHooks(gomock.NewController(t)), allowAll)
+
+	for _, serviceName := range authorizedServices {
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+		require.NoError(t, err)
+		methods := descriptor.(protoreflect.ServiceDescriptor).Methods()
+		for i := 0; i < methods.Len(); i++ {
+			procedure := fmt.Sprintf("/%s/%s", serviceName, methods.Get(i).Name())
+			assert.Contains(t, in.authorizers, procedure, "procedure has no authorization rule")
+		}
+	}
+}
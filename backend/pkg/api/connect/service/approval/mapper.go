@@ -0,0 +1,131 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package approval
+
+import (
+	"errors"
+	"fmt"
+
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/approval"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1"
+)
+
+type mapper struct{}
+
+func (*mapper) statusToProto(status approval.Status) v1alpha1.ChangeRequestStatus {
+	switch status {
+	case approval.StatusPending:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING
+	case approval.StatusApproved:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED
+	case approval.StatusExecuted:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_EXECUTED
+	case approval.StatusFailed:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_FAILED
+	case approval.StatusRejected:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED
+	case approval.StatusExpired:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_EXPIRED
+	default:
+		return v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func (*mapper) statusFromProto(status v1alpha1.ChangeRequestStatus) approval.Status {
+	switch status {
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_PENDING:
+		return approval.StatusPending
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_APPROVED:
+		return approval.StatusApproved
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_EXECUTED:
+		return approval.StatusExecuted
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_FAILED:
+		return approval.StatusFailed
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_REJECTED:
+		return approval.StatusRejected
+	case v1alpha1.ChangeRequestStatus_CHANGE_REQUEST_STATUS_EXPIRED:
+		return approval.StatusExpired
+	default:
+		return ""
+	}
+}
+
+func (m *mapper) changeRequestToProto(changeRequest approval.ChangeRequest) *v1alpha1.ChangeRequest {
+	events := make([]*v1alpha1.ChangeRequestEvent, len(changeRequest.Events))
+	for i, event := range changeRequest.Events {
+		events[i] = &v1alpha1.ChangeRequestEvent{
+			Time:    timestamppb.New(event.Time),
+			Actor:   event.Actor,
+			Action:  event.Action,
+			Comment: event.Comment,
+		}
+	}
+
+	res := &v1alpha1.ChangeRequest{
+		Id:            changeRequest.ID,
+		Operation:     changeRequest.Operation,
+		Target:        changeRequest.Target,
+		Payload:       string(changeRequest.Payload),
+		Comment:       changeRequest.Comment,
+		RequestedBy:   changeRequest.RequestedBy,
+		RequestedAt:   timestamppb.New(changeRequest.RequestedAt),
+		ExpiresAt:     timestamppb.New(changeRequest.ExpiresAt),
+		Status:        m.statusToProto(changeRequest.Status),
+		ReviewedBy:    changeRequest.ReviewedBy,
+		ReviewComment: changeRequest.ReviewComment,
+		Result:        string(changeRequest.Result),
+		Error:         changeRequest.Error,
+		Events:        events,
+	}
+	if changeRequest.ReviewedAt != nil {
+		res.ReviewedAt = timestamppb.New(*changeRequest.ReviewedAt)
+	}
+	return res
+}
+
+func (m *mapper) changeRequestsToProto(changeRequests []approval.ChangeRequest) []*v1alpha1.ChangeRequest {
+	result := make([]*v1alpha1.ChangeRequest, len(changeRequests))
+	for i, changeRequest := range changeRequests {
+		result[i] = m.changeRequestToProto(changeRequest)
+	}
+
+	return result
+}
+
+// errorToConnect maps the errors of the approval service to connect errors.
+func (*mapper) errorToConnect(err error, message string) *connect.Error {
+	code := connect.CodeInternal
+	reason := commonv1alpha1.Reason_REASON_SERVER_ERROR
+	switch {
+	case errors.Is(err, approval.ErrNotFound):
+		code = connect.CodeNotFound
+		reason = commonv1alpha1.Reason_REASON_RESOURCE_NOT_FOUND
+	case errors.Is(err, approval.ErrInvalidRequest):
+		code = connect.CodeInvalidArgument
+		reason = commonv1alpha1.Reason_REASON_INVALID_INPUT
+	case errors.Is(err, approval.ErrNotAuthorized), errors.Is(err, approval.ErrSelfApproval):
+		code = connect.CodePermissionDenied
+		reason = commonv1alpha1.Reason_REASON_PERMISSION_DENIED
+	case errors.Is(err, approval.ErrNotPending):
+		code = connect.CodeFailedPrecondition
+		reason = commonv1alpha1.Reason_REASON_INVALID_INPUT
+	}
+
+	return apierrors.NewConnectError(
+		code,
+		fmt.Errorf("%v: %w", message, err),
+		apierrors.NewErrorInfo(reason.String()),
+	)
+}
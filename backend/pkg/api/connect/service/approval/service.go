@@ -0,0 +1,171 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package approval contains the implementation of all change request endpoints
+// of the four-eyes approval workflow.
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/approval"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1/consolev1alpha1connect"
+)
+
+var _ consolev1alpha1connect.ApprovalServiceHandler = (*Service)(nil)
+
+// Service that implements the ApprovalServiceHandler interface.
+type Service struct {
+	logger      *zap.Logger
+	approvalSvc *approval.Service
+	// isReviewer returns true if the user of the context has the "approveChanges"
+	// permission, which is required to see and review the change requests of
+	// other users.
+	isReviewer func(ctx context.Context) bool
+	mapper     *mapper
+}
+
+// NewService creates a new approval service handler.
+func NewService(logger *zap.Logger, approvalSvc *approval.Service, isReviewer func(ctx context.Context) bool) *Service {
+	return &Service{
+		logger:      logger,
+		approvalSvc: approvalSvc,
+		isReviewer:  isReviewer,
+		mapper:      &mapper{},
+	}
+}
+
+// ListChangeRequests lists change requests. Users who can not review change
+// requests only see their own.
+func (s *Service) ListChangeRequests(ctx context.Context, req *connect.Request[v1alpha1.ListChangeRequestsRequest]) (*connect.Response[v1alpha1.ListChangeRequestsResponse], error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter approval.ListFilter
+	if req.Msg.Filter != nil {
+		filter.Status = s.mapper.statusFromProto(req.Msg.Filter.Status)
+		filter.RequestedBy = req.Msg.Filter.RequestedBy
+	}
+	if !s.isReviewer(ctx) {
+		filter.RequestedBy = principal.Name
+	}
+
+	changeRequests, err := s.approvalSvc.List(ctx, filter)
+	if err != nil {
+		return nil, s.mapper.errorToConnect(err, "failed to list change requests")
+	}
+
+	return connect.NewResponse(&v1alpha1.ListChangeRequestsResponse{
+		ChangeRequests: s.mapper.changeRequestsToProto(changeRequests),
+	}), nil
+}
+
+// GetChangeRequest retrieves a change request of the user or, for reviewers, of
+// any user.
+func (s *Service) GetChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.GetChangeRequestRequest]) (*connect.Response[v1alpha1.GetChangeRequestResponse], error) {
+	changeRequest, err := s.getVisibleChangeRequest(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1alpha1.GetChangeRequestResponse{
+		ChangeRequest: s.mapper.changeRequestToProto(changeRequest),
+	}), nil
+}
+
+// CreateChangeRequest requests an operation that requires an approval.
+func (s *Service) CreateChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.CreateChangeRequestRequest]) (*connect.Response[v1alpha1.CreateChangeRequestResponse], error) {
+	changeRequest, err := s.approvalSvc.Submit(ctx, req.Msg.Operation, []byte(req.Msg.Payload), req.Msg.Comment)
+	if err != nil {
+		return nil, s.mapper.errorToConnect(err, "failed to create change request")
+	}
+
+	return connect.NewResponse(&v1alpha1.CreateChangeRequestResponse{
+		ChangeRequest: s.mapper.changeRequestToProto(changeRequest),
+	}), nil
+}
+
+// ApproveChangeRequest approves a pending change request of another user and
+// executes its operation.
+func (s *Service) ApproveChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.ApproveChangeRequestRequest]) (*connect.Response[v1alpha1.ApproveChangeRequestResponse], error) {
+	if !s.isReviewer(ctx) {
+		return nil, apierrors.NewConnectError(
+			connect.CodePermissionDenied,
+			errors.New("you don't have permissions to approve change requests"),
+			apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_PERMISSION_DENIED.String()),
+		)
+	}
+
+	changeRequest, err := s.approvalSvc.Approve(ctx, req.Msg.Id, req.Msg.Comment)
+	if err != nil {
+		return nil, s.mapper.errorToConnect(err, fmt.Sprintf("failed to approve change request %q", req.Msg.Id))
+	}
+
+	return connect.NewResponse(&v1alpha1.ApproveChangeRequestResponse{
+		ChangeRequest: s.mapper.changeRequestToProto(changeRequest),
+	}), nil
+}
+
+// RejectChangeRequest rejects a pending change request. Requesters can reject
+// their own change requests to withdraw them.
+func (s *Service) RejectChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.RejectChangeRequestRequest]) (*connect.Response[v1alpha1.RejectChangeRequestResponse], error) {
+	if _, err := s.getVisibleChangeRequest(ctx, req.Msg.Id); err != nil {
+		return nil, err
+	}
+
+	changeRequest, err := s.approvalSvc.Reject(ctx, req.Msg.Id, req.Msg.Comment)
+	if err != nil {
+		return nil, s.mapper.errorToConnect(err, fmt.Sprintf("failed to reject change request %q", req.Msg.Id))
+	}
+
+	return connect.NewResponse(&v1alpha1.RejectChangeRequestResponse{
+		ChangeRequest: s.mapper.changeRequestToProto(changeRequest),
+	}), nil
+}
+
+// getVisibleChangeRequest returns the change request if the user of the context
+// is a reviewer or has requested it. Otherwise, it returns a not found error.
+func (s *Service) getVisibleChangeRequest(ctx context.Context, id string) (approval.ChangeRequest, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return approval.ChangeRequest{}, err
+	}
+
+	changeRequest, err := s.approvalSvc.Get(ctx, id)
+	if err == nil && changeRequest.RequestedBy != principal.Name && !s.isReviewer(ctx) {
+		err = approval.ErrNotFound
+	}
+	if err != nil {
+		return approval.ChangeRequest{}, s.mapper.errorToConnect(err, fmt.Sprintf("failed to get change request %q", id))
+	}
+	return changeRequest, nil
+}
+
+func principalFromContext(ctx context.Context) (*auth.Principal, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeUnauthenticated,
+			errors.New("change requests require an authenticated user"),
+			apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_NO_AUTHENTICATION_TOKEN.String()),
+		)
+	}
+	return principal, nil
+}
@@ -42,6 +42,10 @@ func rpcPublishMessagePayloadOptionsToSerializeInput(po *v1alpha.PublishMessageP
 		encoding = serde.PayloadEncodingMsgPack
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_SMILE:
 		encoding = serde.PayloadEncodingSmile
+	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_CBOR:
+		encoding = serde.PayloadEncodingCBOR
+	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_BSON:
+		encoding = serde.PayloadEncodingBSON
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_UINT:
 		encoding = serde.PayloadEncodingUint
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_UNSPECIFIED,
@@ -109,6 +113,10 @@ func toProtoEncoding(serdeEncoding serde.PayloadEncoding) v1alpha.PayloadEncodin
 		encoding = v1alpha.PayloadEncoding_PAYLOAD_ENCODING_MESSAGE_PACK
 	case serde.PayloadEncodingSmile:
 		encoding = v1alpha.PayloadEncoding_PAYLOAD_ENCODING_SMILE
+	case serde.PayloadEncodingCBOR:
+		encoding = v1alpha.PayloadEncoding_PAYLOAD_ENCODING_CBOR
+	case serde.PayloadEncodingBSON:
+		encoding = v1alpha.PayloadEncoding_PAYLOAD_ENCODING_BSON
 	case serde.PayloadEncodingUint:
 		encoding = v1alpha.PayloadEncoding_PAYLOAD_ENCODING_UINT
 	case serde.PayloadEncodingBinary:
@@ -148,6 +156,10 @@ func fromProtoEncoding(protoEncoding v1alpha.PayloadEncoding) serde.PayloadEncod
 		encoding = serde.PayloadEncodingMsgPack
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_SMILE:
 		encoding = serde.PayloadEncodingSmile
+	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_CBOR:
+		encoding = serde.PayloadEncodingCBOR
+	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_BSON:
+		encoding = serde.PayloadEncodingBSON
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_UINT:
 		encoding = serde.PayloadEncodingUint
 	case v1alpha.PayloadEncoding_PAYLOAD_ENCODING_BINARY:
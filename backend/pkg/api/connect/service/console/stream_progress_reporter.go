@@ -127,12 +127,32 @@ func (p *streamProgressReporter) OnMessage(message *kafka.TopicMessage) {
 
 	for _, mh := range message.Headers {
 		mh := mh
-		headers = append(
-			headers, &v1alpha.KafkaRecordHeader{
-				Key:   mh.Key,
-				Value: mh.Value,
-			},
-		)
+		header := &v1alpha.KafkaRecordHeader{
+			Key:   mh.Key,
+			Value: mh.Value,
+		}
+
+		if mh.DecodedValue != nil {
+			header.DecodedValue = mh.DecodedValue.NormalizedPayload
+			header.DecodedEncoding = toProtoEncoding(mh.DecodedValue.Encoding)
+
+			if mh.DecodedValue.SchemaID != nil {
+				schemaID := int32(*mh.DecodedValue.SchemaID)
+				header.SchemaId = &schemaID
+			}
+
+			header.TroubleshootReport = make([]*v1alpha.TroubleshootReport, 0, len(mh.DecodedValue.Troubleshooting))
+			for _, ts := range mh.DecodedValue.Troubleshooting {
+				header.TroubleshootReport = append(
+					header.TroubleshootReport, &v1alpha.TroubleshootReport{
+						SerdeName: ts.SerdeName,
+						Message:   ts.Message,
+					},
+				)
+			}
+		}
+
+		headers = append(headers, header)
 	}
 
 	compression := v1alpha.CompressionType_COMPRESSION_TYPE_UNSPECIFIED
@@ -15,6 +15,9 @@ import (
 	"fmt"
 
 	con "github.com/cloudhut/connect-client"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	kafkaconnect "github.com/redpanda-data/console/backend/pkg/connect"
 	dataplanev1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
@@ -257,3 +260,147 @@ func convertStringMapToInterfaceMap(stringMap map[string]string) map[string]any
 	}
 	return interfaceMap
 }
+
+func (m mapper) connectorRemediationToProto(remediation kafkaconnect.ConnectorRemediation) *dataplanev1alpha1.GetConnectorRemediationResponse {
+	tasks := make([]*dataplanev1alpha1.ConnectorRemediationTaskState, len(remediation.Tasks))
+	for i, task := range remediation.Tasks {
+		tasks[i] = &dataplanev1alpha1.ConnectorRemediationTaskState{
+			TaskId:   int32(task.TaskID),
+			Attempts: int32(task.Attempts),
+			GaveUp:   task.GaveUp,
+		}
+		if !task.NextAttempt.IsZero() {
+			tasks[i].NextAttemptTime = timestamppb.New(task.NextAttempt)
+		}
+	}
+
+	history := make([]*dataplanev1alpha1.ConnectorRemediationEvent, len(remediation.History))
+	for i, event := range remediation.History {
+		history[i] = &dataplanev1alpha1.ConnectorRemediationEvent{
+			Time:     timestamppb.New(event.Timestamp),
+			TaskId:   int32(event.TaskID),
+			WorkerId: event.WorkerID,
+			Action:   m.remediationActionToProto(event.Action),
+			Attempt:  int32(event.Attempt),
+			Trace:    event.Trace,
+			Error:    event.Error,
+		}
+	}
+
+	return &dataplanev1alpha1.GetConnectorRemediationResponse{
+		Policy: &dataplanev1alpha1.ConnectorRemediationPolicy{
+			Enabled:      remediation.Enabled,
+			MaxAttempts:  int32(remediation.Policy.MaxAttempts),
+			BaseInterval: durationpb.New(remediation.Policy.BaseInterval),
+			MaxInterval:  durationpb.New(remediation.Policy.MaxInterval),
+			Multiplier:   remediation.Policy.Multiplier,
+		},
+		Tasks:   tasks,
+		History: history,
+	}
+}
+
+func (mapper) remediationActionToProto(action kafkaconnect.RemediationAction) dataplanev1alpha1.ConnectorRemediationEvent_Action {
+	switch action {
+	case kafkaconnect.RemediationActionRestarted:
+		return dataplanev1alpha1.ConnectorRemediationEvent_ACTION_RESTARTED
+	case kafkaconnect.RemediationActionRestartFailed:
+		return dataplanev1alpha1.ConnectorRemediationEvent_ACTION_RESTART_FAILED
+	case kafkaconnect.RemediationActionGaveUp:
+		return dataplanev1alpha1.ConnectorRemediationEvent_ACTION_GAVE_UP
+	case kafkaconnect.RemediationActionRecovered:
+		return dataplanev1alpha1.ConnectorRemediationEvent_ACTION_RECOVERED
+	default:
+		return dataplanev1alpha1.ConnectorRemediationEvent_ACTION_UNSPECIFIED
+	}
+}
+
+func (mapper) connectorOffsetsToProto(offsets kafkaconnect.ConnectorOffsets) (*dataplanev1alpha1.ListConnectorOffsetsResponse, error) {
+	offsetsProto := make([]*dataplanev1alpha1.ConnectorOffset, len(offsets.Offsets))
+	for i, offset := range offsets.Offsets {
+		partition, err := structpb.NewStruct(offset.Partition)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map partition of connector offset: %w", err)
+		}
+		var offsetStruct *structpb.Struct
+		if offset.Offset != nil {
+			offsetStruct, err = structpb.NewStruct(offset.Offset)
+			if err != nil {
+				return nil, fmt.Errorf("failed to map offset of connector offset: %w", err)
+			}
+		}
+		offsetsProto[i] = &dataplanev1alpha1.ConnectorOffset{
+			Partition: partition,
+			Offset:    offsetStruct,
+		}
+	}
+
+	return &dataplanev1alpha1.ListConnectorOffsetsResponse{
+		Type:    offsets.Type,
+		Offsets: offsetsProto,
+	}, nil
+}
+
+func (mapper) connectorOffsetsFromProto(offsetsProto []*dataplanev1alpha1.ConnectorOffset) []kafkaconnect.ConnectorOffset {
+	offsets := make([]kafkaconnect.ConnectorOffset, len(offsetsProto))
+	for i, offset := range offsetsProto {
+		offsets[i] = kafkaconnect.ConnectorOffset{
+			Partition: offset.GetPartition().AsMap(),
+		}
+		// A missing offset resets the partition's offset
+		if offset.GetOffset() != nil {
+			offsets[i].Offset = offset.GetOffset().AsMap()
+		}
+	}
+	return offsets
+}
+
+func (m mapper) clusterWorkersToProto(clusterWorkers kafkaconnect.ClusterWorkers) *dataplanev1alpha1.ListConnectWorkersResponse {
+	workers := make([]*dataplanev1alpha1.ConnectWorker, len(clusterWorkers.Workers))
+	for i, worker := range clusterWorkers.Workers {
+		connectors := make([]*dataplanev1alpha1.ConnectWorkerConnector, len(worker.Connectors))
+		for j, connector := range worker.Connectors {
+			connectors[j] = &dataplanev1alpha1.ConnectWorkerConnector{
+				Name:  connector.Name,
+				State: connector.State,
+			}
+		}
+		workers[i] = &dataplanev1alpha1.ConnectWorker{
+			WorkerId:     worker.WorkerID,
+			Connectors:   connectors,
+			Tasks:        m.workerTasksToProto(worker.Tasks),
+			RunningTasks: int32(worker.RunningTasks),
+			FailedTasks:  int32(worker.FailedTasks),
+		}
+	}
+
+	return &dataplanev1alpha1.ListConnectWorkersResponse{
+		Workers:         workers,
+		UnassignedTasks: m.workerTasksToProto(clusterWorkers.UnassignedTasks),
+		TaskSkew:        clusterWorkers.TaskSkew,
+	}
+}
+
+func (mapper) workerTasksToProto(tasks []kafkaconnect.WorkerTask) []*dataplanev1alpha1.ConnectWorkerTask {
+	tasksProto := make([]*dataplanev1alpha1.ConnectWorkerTask, len(tasks))
+	for i, task := range tasks {
+		tasksProto[i] = &dataplanev1alpha1.ConnectWorkerTask{
+			Connector: task.Connector,
+			TaskId:    int32(task.TaskID),
+			State:     task.State,
+		}
+	}
+	return tasksProto
+}
+
+func (mapper) taskRestartsToProto(restarts []kafkaconnect.TaskRestart) []*dataplanev1alpha1.ConnectWorkerTaskRestart {
+	restartsProto := make([]*dataplanev1alpha1.ConnectWorkerTaskRestart, len(restarts))
+	for i, restart := range restarts {
+		restartsProto[i] = &dataplanev1alpha1.ConnectWorkerTaskRestart{
+			Connector: restart.Connector,
+			TaskId:    int32(restart.TaskID),
+			Error:     restart.Error,
+		}
+	}
+	return restartsProto
+}
@@ -336,6 +336,94 @@ func (s *Service) ResetConnectorTopics(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+// GetConnectorRemediation implements the handler for the get connector remediation
+// operation. It returns the auto remediation policy along with the restart history
+// that is kept in memory by the connect supervisor.
+func (s *Service) GetConnectorRemediation(_ context.Context, req *connect.Request[v1alpha1.GetConnectorRemediationRequest]) (*connect.Response[v1alpha1.GetConnectorRemediationResponse], error) {
+	remediation := s.connectSvc.Supervisor.GetConnectorRemediation(req.Msg.ClusterName, req.Msg.Name)
+
+	return connect.NewResponse(s.mapper.connectorRemediationToProto(remediation)), nil
+}
+
+// ListConnectorOffsets implements the handler for the list connector offsets
+// operation.
+func (s *Service) ListConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	offsets, restErr := s.connectSvc.ListConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name)
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	res, err := s.mapper.connectorOffsetsToProto(offsets)
+	if err != nil {
+		return nil, apierrors.NewConnectError(
+			connect.CodeInternal,
+			err,
+			apierrors.NewErrorInfo(v1alpha1.Reason_REASON_CONSOLE_ERROR.String()),
+		)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// AlterConnectorOffsets implements the handler for the alter connector offsets
+// operation. The connector must be stopped, or stop_connector must be set to
+// stop it first.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	offsets := s.mapper.connectorOffsetsFromProto(req.Msg.Offsets)
+	msg, restErr := s.connectSvc.AlterConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name, offsets, req.Msg.StopConnector)
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	return connect.NewResponse(&v1alpha1.AlterConnectorOffsetsResponse{Message: msg}), nil
+}
+
+// ResetConnectorOffsets implements the handler for the reset connector offsets
+// operation. The connector must be stopped, or stop_connector must be set to
+// stop it first.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	msg, restErr := s.connectSvc.ResetConnectorOffsets(ctx, req.Msg.ClusterName, req.Msg.Name, req.Msg.StopConnector)
+	if restErr != nil {
+		return nil, s.matchError(restErr)
+	}
+
+	return connect.NewResponse(&v1alpha1.ResetConnectorOffsetsResponse{Message: msg}), nil
+}
+
+// ListConnectWorkers implements the handler for the list connect workers
+// operation. It groups the connectors and tasks by the worker they are
+// assigned to.
+func (s *Service) ListConnectWorkers(ctx context.Context, req *connect.Request[v1alpha1.ListConnectWorkersRequest]) (*connect.Response[v1alpha1.ListConnectWorkersResponse], error) {
+	workers, err := s.connectSvc.GetClusterWorkers(ctx, req.Msg.ClusterName)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(s.mapper.clusterWorkersToProto(workers)), nil
+}
+
+// RestartWorkerTasks implements the handler for the restart worker tasks
+// operation.
+func (s *Service) RestartWorkerTasks(ctx context.Context, req *connect.Request[v1alpha1.RestartWorkerTasksRequest]) (*connect.Response[v1alpha1.RestartWorkerTasksResponse], error) {
+	restarts, err := s.connectSvc.RestartWorkerTasks(ctx, req.Msg.ClusterName, req.Msg.WorkerId)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.RestartWorkerTasksResponse{Restarts: s.mapper.taskRestartsToProto(restarts)}), nil
+}
+
+// RestartFailedTasks implements the handler for the restart failed tasks
+// operation.
+func (s *Service) RestartFailedTasks(ctx context.Context, req *connect.Request[v1alpha1.RestartFailedTasksRequest]) (*connect.Response[v1alpha1.RestartFailedTasksResponse], error) {
+	restarts, err := s.connectSvc.RestartFailedTasks(ctx, req.Msg.ClusterName)
+	if err != nil {
+		return nil, s.matchError(err)
+	}
+
+	return connect.NewResponse(&v1alpha1.RestartFailedTasksResponse{Restarts: s.mapper.taskRestartsToProto(restarts)}), nil
+}
+
 func (*Service) matchError(err *rest.Error) *connect.Error {
 	switch err.Status {
 	case http.StatusNotFound:
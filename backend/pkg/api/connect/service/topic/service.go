@@ -19,12 +19,14 @@ import (
 	"strconv"
 	"strings"
 
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
 	"connectrpc.com/connect"
 	"github.com/redpanda-data/common-go/api/pagination"
 	"github.com/twmb/franz-go/pkg/kmsg"
 	"go.uber.org/zap"
 
 	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/approval"
 	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/console"
 	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
@@ -36,11 +38,12 @@ var _ dataplanev1alpha1connect.TopicServiceHandler = (*Service)(nil)
 // Service that implements the UserServiceHandler interface. This includes all
 // RPCs to manage Redpanda or Kafka users.
 type Service struct {
-	cfg        *config.Config
-	logger     *zap.Logger
-	consoleSvc console.Servicer
-	mapper     kafkaClientMapper
-	defaulter  defaulter
+	cfg         *config.Config
+	logger      *zap.Logger
+	consoleSvc  console.Servicer
+	approvalSvc *approval.Service
+	mapper      kafkaClientMapper
+	defaulter   defaulter
 }
 
 // ListTopics lists all Kafka topics with their most important metadata.
@@ -94,6 +97,14 @@ func (s *Service) ListTopics(ctx context.Context, req *connect.Request[v1alpha1.
 
 // DeleteTopic deletes a Kafka topic.
 func (s *Service) DeleteTopic(ctx context.Context, req *connect.Request[v1alpha1.DeleteTopicRequest]) (*connect.Response[v1alpha1.DeleteTopicResponse], error) {
+	if s.approvalSvc != nil && s.approvalSvc.IsRequired(config.AuthApprovalsOperationDeleteTopic) {
+		return nil, apierrors.NewConnectError(
+			connect.CodeFailedPrecondition,
+			errors.New("deleting topics requires an approval, create a change request with the ApprovalService instead"),
+			apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_INVALID_INPUT.String()),
+		)
+	}
+
 	kafkaReq := s.mapper.deleteTopicToKmsg(req.Msg)
 	kafkaRes, err := s.consoleSvc.DeleteTopics(ctx, &kafkaReq)
 	if err != nil {
@@ -335,13 +346,15 @@ func (s *Service) SetTopicConfigurations(ctx context.Context, req *connect.Reque
 func NewService(cfg *config.Config,
 	logger *zap.Logger,
 	consoleSvc console.Servicer,
+	approvalSvc *approval.Service,
 ) *Service {
 	return &Service{
-		cfg:        cfg,
-		logger:     logger,
-		consoleSvc: consoleSvc,
-		mapper:     kafkaClientMapper{},
-		defaulter:  defaulter{},
+		cfg:         cfg,
+		logger:      logger,
+		consoleSvc:  consoleSvc,
+		approvalSvc: approvalSvc,
+		mapper:      kafkaClientMapper{},
+		defaulter:   defaulter{},
 	}
 }
 
@@ -0,0 +1,147 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/redpanda-data/console/backend/pkg/credential"
+)
+
+// redpandaRolePrincipalPrefix is the prefix of ACL principals that refer to a
+// Redpanda role instead of a user.
+const redpandaRolePrincipalPrefix = "RedpandaRole:"
+
+// credentialClient manages SCRAM credentials via the Kafka API of the console
+// service. It implements credential.Client.
+type credentialClient struct {
+	api *API
+}
+
+var _ credential.Client = (*credentialClient)(nil)
+
+// DescribeCredentials returns the SCRAM mechanisms of all users.
+func (c *credentialClient) DescribeCredentials(ctx context.Context) (map[string][]string, error) {
+	described, err := c.api.ConsoleSvc.DescribeUserSCRAMs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mechanismsByUser := make(map[string][]string, len(described))
+	for _, user := range described.Sorted() {
+		if user.Err != nil {
+			return nil, fmt.Errorf("failed to describe scram credentials of user %q: %w: %v", user.User, user.Err, user.ErrMessage)
+		}
+		mechanisms := make([]string, 0, len(user.CredInfos))
+		for _, info := range user.CredInfos {
+			mechanisms = append(mechanisms, info.Mechanism.String())
+		}
+		mechanismsByUser[user.User] = mechanisms
+	}
+	return mechanismsByUser, nil
+}
+
+// UpsertCredential creates or replaces the SCRAM credential of the user for the mechanism.
+func (c *credentialClient) UpsertCredential(ctx context.Context, username, mechanism, password string) error {
+	scramMechanism, err := scramMechanismFromString(mechanism)
+	if err != nil {
+		return err
+	}
+	altered, err := c.api.ConsoleSvc.AlterUserSCRAMs(ctx, nil, []kadm.UpsertSCRAM{{
+		User:       username,
+		Mechanism:  scramMechanism,
+		Iterations: c.api.Cfg.Kafka.Credentials.Iterations,
+		Password:   password,
+	}})
+	if err != nil {
+		return err
+	}
+	return alteredUserSCRAMsError(altered)
+}
+
+// DeleteCredential deletes the SCRAM credential of the user for the mechanism.
+func (c *credentialClient) DeleteCredential(ctx context.Context, username, mechanism string) error {
+	scramMechanism, err := scramMechanismFromString(mechanism)
+	if err != nil {
+		return err
+	}
+	altered, err := c.api.ConsoleSvc.AlterUserSCRAMs(ctx, []kadm.DeleteSCRAM{{User: username, Mechanism: scramMechanism}}, nil)
+	if err != nil {
+		return err
+	}
+	return alteredUserSCRAMsError(altered)
+}
+
+// ListACLPrincipals returns the principals of all ACLs. If the Redpanda Admin API
+// is configured, the members of Redpanda roles with ACLs are added as users.
+func (c *credentialClient) ListACLPrincipals(ctx context.Context) (map[string]struct{}, error) {
+	aclOverview, err := c.api.ConsoleSvc.ListAllACLs(ctx, kmsg.DescribeACLsRequest{
+		ResourceType:        kmsg.ACLResourceTypeAny,
+		ResourcePatternType: kmsg.ACLResourcePatternTypeAny,
+		Operation:           kmsg.ACLOperationAny,
+		PermissionType:      kmsg.ACLPermissionTypeAny,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !aclOverview.IsAuthorizerEnabled || aclOverview.KafkaResponse == nil {
+		return nil, errors.New("authorizer is not enabled")
+	}
+
+	principals := make(map[string]struct{})
+	roles := make(map[string]struct{})
+	for _, resource := range aclOverview.KafkaResponse.Resources {
+		for _, acl := range resource.ACLs {
+			principals[acl.Principal] = struct{}{}
+			if role, isRole := strings.CutPrefix(acl.Principal, redpandaRolePrincipalPrefix); isRole {
+				roles[role] = struct{}{}
+			}
+		}
+	}
+
+	if len(roles) == 0 || c.api.RedpandaSvc == nil {
+		return principals, nil
+	}
+	for role := range roles {
+		res, err := c.api.RedpandaSvc.RoleMembers(ctx, role)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members of role %q: %w", role, err)
+		}
+		for _, member := range res.Members {
+			principals["User:"+member.Name] = struct{}{}
+		}
+	}
+	return principals, nil
+}
+
+func scramMechanismFromString(mechanism string) (kadm.ScramMechanism, error) {
+	switch mechanism {
+	case credential.MechanismScramSha256:
+		return kadm.ScramSha256, nil
+	case credential.MechanismScramSha512:
+		return kadm.ScramSha512, nil
+	default:
+		return 0, fmt.Errorf("unsupported scram mechanism %q", mechanism)
+	}
+}
+
+func alteredUserSCRAMsError(altered kadm.AlteredUserSCRAMs) error {
+	for _, user := range altered.Sorted() {
+		if user.Err != nil {
+			return fmt.Errorf("failed to alter scram credentials of user %q: %w: %v", user.User, user.Err, user.ErrMessage)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+func (api *API) handleAnalyzeACLs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.canListACLs(w, r) {
+			return
+		}
+
+		// ACLs for resources that do not exist (yet) are only proposed for
+		// deletion if explicitly requested
+		includeUnused := false
+		if value := r.URL.Query().Get("includeUnused"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      err,
+					Status:   http.StatusBadRequest,
+					Message:  "includeUnused must be true or false",
+					IsSilent: false,
+				})
+				return
+			}
+			includeUnused = parsed
+		}
+
+		analysis, restErr := api.ConsoleSvc.AnalyzeACLs(r.Context(), includeUnused)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, analysis)
+	}
+}
+
+// executeACLDeletePlanRequest contains the filters of an ACL delete plan, as returned
+// by the ACL analysis. Clients may remove filters of ACLs that shall be kept.
+type executeACLDeletePlanRequest struct {
+	Filters []console.ACLDeleteFilter `json:"filters"`
+}
+
+// OK validates that each filter matches exactly one ACL, so that a modified plan
+// can not delete more ACLs than intended.
+func (e *executeACLDeletePlanRequest) OK() error {
+	if len(e.Filters) == 0 {
+		return errors.New("at least one filter must be given")
+	}
+	for i, filter := range e.Filters {
+		switch {
+		case filter.ResourceType == kmsg.ACLResourceTypeAny || filter.ResourceType == kmsg.ACLResourceTypeUnknown:
+			return fmt.Errorf("filter at index %d: resource type must not be any", i)
+		case filter.ResourcePatternType != kmsg.ACLResourcePatternTypeLiteral && filter.ResourcePatternType != kmsg.ACLResourcePatternTypePrefixed:
+			return fmt.Errorf("filter at index %d: resource pattern type must be LITERAL or PREFIXED", i)
+		case filter.Operation == kmsg.ACLOperationAny || filter.Operation == kmsg.ACLOperationUnknown:
+			return fmt.Errorf("filter at index %d: operation must not be any", i)
+		case filter.PermissionType != kmsg.ACLPermissionTypeAllow && filter.PermissionType != kmsg.ACLPermissionTypeDeny:
+			return fmt.Errorf("filter at index %d: permission type must be ALLOW or DENY", i)
+		case filter.ResourceName == "" || filter.Principal == "" || filter.Host == "":
+			return fmt.Errorf("filter at index %d: resource name, principal and host must be set", i)
+		default:
+		}
+	}
+	return nil
+}
+
+func (api *API) handleExecuteACLDeletePlan() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse request from body & validate it as part of Decode()
+		var req executeACLDeletePlanRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Check if logged-in user is allowed to delete ACLs
+		isAllowed, restErr := api.Hooks.Authorization.CanDeleteACL(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !isAllowed {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("requester is not allowed to delete ACLs"),
+				Status:   http.StatusForbidden,
+				Message:  "You are not allowed to delete ACLs",
+				IsSilent: true,
+			})
+			return
+		}
+
+		filters := make([]kmsg.DeleteACLsRequestFilter, len(req.Filters))
+		for i, filter := range req.Filters {
+			// Check if targeted user is a protected Kafka user
+			if api.Hooks.Authorization.IsProtectedKafkaUser(filter.Principal) {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("requester targets a protected Kafka principal to delete ACLs"),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You are not allowed to delete ACLs for the protected principal %q", filter.Principal),
+					IsSilent: false,
+				})
+				return
+			}
+
+			kFilter := kmsg.NewDeleteACLsRequestFilter()
+			kFilter.ResourceType = filter.ResourceType
+			kFilter.ResourceName = kmsg.StringPtr(filter.ResourceName)
+			kFilter.ResourcePatternType = filter.ResourcePatternType
+			kFilter.Principal = kmsg.StringPtr(filter.Principal)
+			kFilter.Host = kmsg.StringPtr(filter.Host)
+			kFilter.Operation = filter.Operation
+			kFilter.PermissionType = filter.PermissionType
+			filters[i] = kFilter
+		}
+
+		aclDeleteRes, restErr := api.ConsoleSvc.DeleteACLsWithFilters(r.Context(), filters)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, aclDeleteRes)
+	}
+}
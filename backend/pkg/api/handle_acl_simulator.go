@@ -0,0 +1,123 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kmsg"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+type simulateACLAccessRequest struct {
+	console.SimulateACLAccessRequest
+}
+
+// OK validates the user input for the simulate ACL access request.
+func (s *simulateACLAccessRequest) OK() error {
+	if s.Principal == "" {
+		return errors.New("principal must be set")
+	}
+	if len(s.Checks) == 0 {
+		return errors.New("at least one check must be given")
+	}
+	for i, check := range s.Checks {
+		switch check.ResourceType {
+		case kmsg.ACLResourceTypeUnknown, kmsg.ACLResourceTypeAny:
+			return fmt.Errorf("check at index %d: resource type must be a specific resource type", i)
+		case kmsg.ACLResourceTypeCluster:
+		default:
+			if check.ResourceName == "" {
+				return fmt.Errorf("check at index %d: resource name must be set", i)
+			}
+		}
+		switch check.Operation {
+		case kmsg.ACLOperationUnknown, kmsg.ACLOperationAny, kmsg.ACLOperationAll:
+			return fmt.Errorf("check at index %d: operation must be a specific operation", i)
+		default:
+		}
+	}
+	return nil
+}
+
+func (api *API) handleSimulateACLAccess() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse request from body & validate it as part of Decode()
+		var req simulateACLAccessRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if !api.canListACLs(w, r) {
+			return
+		}
+
+		res, restErr := api.ConsoleSvc.SimulateACLAccess(r.Context(), req.SimulateACLAccessRequest)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func (api *API) handleGetPrincipalAccessReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal := r.URL.Query().Get("principal")
+		if principal == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("principal must be set"),
+				Status:   http.StatusBadRequest,
+				Message:  "The principal query parameter must be set",
+				IsSilent: false,
+			})
+			return
+		}
+
+		if !api.canListACLs(w, r) {
+			return
+		}
+
+		report, restErr := api.ConsoleSvc.GetPrincipalAccessReport(r.Context(), principal, r.URL.Query().Get("host"))
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, report)
+	}
+}
+
+// canListACLs checks whether the requester is allowed to list ACLs and sends an
+// error response if not.
+func (api *API) canListACLs(w http.ResponseWriter, r *http.Request) bool {
+	isAllowed, restErr := api.Hooks.Authorization.CanListACLs(r.Context())
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	if !isAllowed {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:      fmt.Errorf("requester is not allowed to list ACLs"),
+			Status:   http.StatusForbidden,
+			Message:  "You are not allowed to list ACLs",
+			IsSilent: true,
+		})
+		return false
+	}
+	return true
+}
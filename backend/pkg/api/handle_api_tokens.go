@@ -0,0 +1,166 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+// apiTokenResponse describes a token without its secret.
+type apiTokenResponse struct {
+	apitoken.Token
+	IsExpired bool `json:"isExpired"`
+}
+
+// checkCanManageAPITokens requires the "manageApiTokens" cluster permission. API
+// tokens can not manage tokens themselves, so that a leaked token can not be used
+// to issue tokens with more scopes.
+func (api *API) checkCanManageAPITokens(r *http.Request) *rest.Error {
+	if _, isToken := apitoken.TokenFromContext(r.Context()); isToken {
+		return &rest.Error{
+			Err:      errors.New("api tokens can not be managed with an api token"),
+			Status:   http.StatusForbidden,
+			Message:  "API tokens can not be managed by requests that are authenticated with an API token.",
+			IsSilent: false,
+		}
+	}
+	return api.checkClusterPermission(r, "manageApiTokens")
+}
+
+func (api *API) handleListAPITokens() http.HandlerFunc {
+	type response struct {
+		Tokens []apiTokenResponse `json:"tokens"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageAPITokens(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		tokens, err := api.APITokenSvc.List(r.Context(), r.URL.Query().Get("serviceAccount"))
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to list API tokens: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		res := response{Tokens: make([]apiTokenResponse, len(tokens))}
+		for i, token := range tokens {
+			res.Tokens[i] = apiTokenResponse{Token: token, IsExpired: token.IsExpired()}
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+type createAPITokenRequest struct {
+	apitoken.CreateTokenRequest
+}
+
+// OK validates the user input for the create API token request. Scopes and expiry
+// are validated by the API token service.
+func (r *createAPITokenRequest) OK() error {
+	if r.ServiceAccount == "" {
+		return errors.New("service account must be set")
+	}
+	if len(r.Scopes) == 0 {
+		return errors.New("at least one scope must be set")
+	}
+	return nil
+}
+
+func (api *API) handleCreateAPIToken() http.HandlerFunc {
+	type response struct {
+		apiTokenResponse
+		// Token is the bearer token. It is only returned once.
+		Token string `json:"token"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageAPITokens(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var req createAPITokenRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		principal := auth.PrincipalFromContext(r.Context())
+		if principal == nil {
+			rest.SendRESTError(w, r, api.Logger, errNotAuthenticated)
+			return
+		}
+
+		// Scopes that the creator does not have are rejected, so that tokens can
+		// not be used to escalate permissions
+		token, secret, err := api.APITokenSvc.Create(r.Context(), principal, req.CreateTokenRequest)
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, apitoken.ErrInvalidRequest):
+				status = http.StatusBadRequest
+			case errors.Is(err, apitoken.ErrScopeNotGranted):
+				status = http.StatusForbidden
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   status,
+				Message:  fmt.Sprintf("Failed to create API token: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, response{
+			apiTokenResponse: apiTokenResponse{Token: token, IsExpired: false},
+			Token:            secret,
+		})
+	}
+}
+
+func (api *API) handleRevokeAPIToken() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkCanManageAPITokens(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		tokenID := rest.GetURLParam(r, "tokenId")
+		if err := api.APITokenSvc.Revoke(r.Context(), tokenID); err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, apitoken.ErrNotFound) {
+				status = http.StatusNotFound
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   status,
+				Message:  fmt.Sprintf("Failed to revoke API token %q: %v", tokenID, err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+const (
+	auditQueryDefaultLimit = 100
+	auditQueryMaxLimit     = 1000
+
+	// auditMaxErrorResponseSize is the maximum number of bytes of an error response
+	// that are read to extract the error message.
+	auditMaxErrorResponseSize = 4096
+)
+
+// limitedBuffer keeps the first bytes that are written to it and discards the rest.
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.buf.Len(); remaining > 0 {
+		b.buf.Write(p[:min(len(p), remaining)])
+	}
+	return len(p), nil
+}
+
+// auditMiddleware records all mutating requests in the audit log. It must be used
+// after the authentication middleware, so that the principal is known.
+func (api *API) auditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		entry := &audit.Entry{
+			Timestamp:  time.Now(),
+			RemoteAddr: r.RemoteAddr,
+			Protocol:   audit.ProtocolREST,
+		}
+		if principal := auth.PrincipalFromContext(r.Context()); principal != nil {
+			entry.Principal = principal.Name
+			entry.Provider = principal.Provider
+		}
+
+		// Read the request body up to the maximum recorded size and restore it for the handler
+		maxRequestSize := api.AuditSvc.MaxRequestSize()
+		if r.Body != nil && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			head, err := io.ReadAll(io.LimitReader(r.Body, int64(maxRequestSize)+1))
+			if err == nil {
+				entry.Request, entry.RequestTruncated = audit.RedactRequest(head, maxRequestSize)
+			}
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+		}
+
+		ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		responseHead := &limitedBuffer{limit: auditMaxErrorResponseSize}
+		ww.Tee(responseHead)
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		entry.Duration = time.Since(entry.Timestamp).Milliseconds()
+		entry.Result = audit.Result{
+			Success: status < http.StatusBadRequest,
+			Status:  strconv.Itoa(status),
+		}
+		if !entry.Result.Success {
+			entry.Result.Error = restErrorMessage(responseHead.buf.Bytes())
+		}
+
+		entry.Target = make(map[string]string)
+		if rctx := chi.RouteContext(r.Context()); rctx != nil {
+			entry.Operation = r.Method + " " + rctx.RoutePattern()
+			for i, key := range rctx.URLParams.Keys {
+				if key != "*" && i < len(rctx.URLParams.Values) {
+					entry.Target[key] = rctx.URLParams.Values[i]
+				}
+			}
+		} else {
+			entry.Operation = r.Method + " " + r.URL.Path
+		}
+
+		api.AuditSvc.Record(entry)
+	})
+}
+
+// restErrorMessage extracts the message of a REST error response.
+func restErrorMessage(body []byte) string {
+	var restErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &restErr); err == nil && restErr.Message != "" {
+		return restErr.Message
+	}
+	return strings.TrimSpace(string(body))
+}
+
+func (api *API) handleQueryAuditLog() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkClusterPermission(r, "viewAuditLog"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		query, err := parseAuditQuery(r)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  err.Error(),
+				IsSilent: false,
+			})
+			return
+		}
+
+		res, err := api.AuditSvc.Query(r.Context(), query)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, audit.ErrQueryNotSupported) {
+				status = http.StatusNotImplemented
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   status,
+				Message:  fmt.Sprintf("Failed to query audit log: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+func parseAuditQuery(r *http.Request) (audit.Query, error) {
+	params := r.URL.Query()
+	query := audit.Query{
+		Principal: params.Get("principal"),
+		Operation: params.Get("operation"),
+		Target:    params.Get("target"),
+		Limit:     auditQueryDefaultLimit,
+	}
+
+	if limit := params.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed <= 0 || parsed > auditQueryMaxLimit {
+			return query, fmt.Errorf("limit must be a number between 1 and %d", auditQueryMaxLimit)
+		}
+		query.Limit = parsed
+	}
+	if success := params.Get("success"); success != "" {
+		parsed, err := strconv.ParseBool(success)
+		if err != nil {
+			return query, errors.New("success must be true or false")
+		}
+		query.Success = &parsed
+	}
+	for param, target := range map[string]*time.Time{"from": &query.From, "to": &query.To} {
+		if value := params.Get(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return query, fmt.Errorf("%v must be an RFC 3339 timestamp", param)
+			}
+			*target = parsed
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+// authenticationMiddleware rejects requests that are not authenticated and puts
+// the principal of authenticated requests on the request context.
+func (api *API) authenticationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, principal, err := api.authenticate(r.Context(), r.Header)
+		if err != nil {
+			message := "You must be logged in to access this resource"
+			if errors.Is(err, apitoken.ErrInvalidToken) || errors.Is(err, apitoken.ErrTokenExpired) {
+				message = fmt.Sprintf("Failed to authenticate with API token: %v", err.Error())
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusUnauthorized,
+				Message:  message,
+				IsSilent: true,
+			})
+			return
+		}
+
+		ctx, err = api.AuthSvc.ImpersonationContext(ctx, principal)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, impersonationRESTError(err))
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authenticate returns a copy of ctx that carries the principal of the request. API
+// tokens are accepted as bearer tokens if enabled, otherwise the session cookie or
+// basic auth credentials are used.
+func (api *API) authenticate(ctx context.Context, header http.Header) (context.Context, *auth.Principal, error) {
+	if api.APITokenSvc != nil {
+		tokenCtx, err := api.APITokenSvc.AuthenticateRequest(ctx, header)
+		if err == nil {
+			return tokenCtx, auth.PrincipalFromContext(tokenCtx), nil
+		}
+		if !errors.Is(err, apitoken.ErrNoBearerToken) {
+			return ctx, nil, err
+		}
+	}
+
+	principal, err := api.AuthSvc.Authenticate(header)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return auth.ContextWithPrincipal(ctx, principal), principal, nil
+}
+
+// impersonationRESTError returns the error for a user that can not be impersonated
+// towards Kafka. Expired tokens require a new login, whereas basic auth users are
+// not allowed to access Kafka at all.
+func impersonationRESTError(err error) *rest.Error {
+	status := http.StatusForbidden
+	if errors.Is(err, auth.ErrImpersonationTokenExpired) {
+		status = http.StatusUnauthorized
+	}
+	return &rest.Error{
+		Err:      err,
+		Status:   status,
+		Message:  fmt.Sprintf("Failed to authenticate towards Kafka: %v", err.Error()),
+		IsSilent: true,
+	}
+}
+
+// principalContextMiddleware puts the principal of authenticated requests on the
+// request context, but lets unauthenticated requests pass. This is used for the
+// ConnectRPC router, whose interceptors reject unauthenticated requests.
+func (api *API) principalContextMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ctx, _, err := api.authenticate(r.Context(), r.Header); err == nil {
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (api *API) handleGetLoginMethods() http.HandlerFunc {
+	type response struct {
+		Methods []auth.LoginMethod `json:"methods"`
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Methods: api.AuthSvc.LoginMethods()})
+	}
+}
+
+type loginBasicRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// OK validates the user input for the basic login request.
+func (r *loginBasicRequest) OK() error {
+	if r.Username == "" || r.Password == "" {
+		return errors.New("username and password must be set")
+	}
+	return nil
+}
+
+func (api *API) handleLoginBasic() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req loginBasicRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		principal, err := api.AuthSvc.LoginBasic(w, req.Username, req.Password)
+		if err != nil {
+			status := http.StatusInternalServerError
+			message := fmt.Sprintf("Failed to log in: %v", err.Error())
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				status = http.StatusUnauthorized
+				message = "Invalid username or password"
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   status,
+				Message:  message,
+				IsSilent: false,
+			})
+			return
+		}
+		api.Logger.Info("user logged in", zap.String("principal", principal.Name), zap.String("provider", principal.Provider))
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, principal)
+	}
+}
+
+func (api *API) handleLoginOIDC() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authURL, err := api.AuthSvc.StartOIDCLogin(r.Context(), w, r.URL.Query().Get("redirect"))
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusServiceUnavailable,
+				Message:  fmt.Sprintf("Failed to start login at the identity provider: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+	}
+}
+
+func (api *API) handleOIDCCallback() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal, redirectPath, err := api.AuthSvc.FinishOIDCLogin(r, w)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusUnauthorized,
+				Message:  fmt.Sprintf("Login failed: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		api.Logger.Info("user logged in", zap.String("principal", principal.Name), zap.String("provider", principal.Provider))
+
+		if basePath, ok := r.Context().Value(BasePathCtxKey).(string); ok && basePath != "" {
+			redirectPath = basePath + redirectPath[1:]
+		}
+		http.Redirect(w, r, redirectPath, http.StatusFound)
+	}
+}
+
+func (api *API) handleLogout() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		api.AuthSvc.Logout(w)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (api *API) handleGetCurrentPrincipal() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, auth.PrincipalFromContext(r.Context()))
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/approval"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+type changeRequestResponse struct {
+	ChangeRequest approval.ChangeRequest `json:"changeRequest"`
+}
+
+// changeRequestRESTError maps the errors of the approval service to REST errors.
+func changeRequestRESTError(err error, message string) *rest.Error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, approval.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, approval.ErrInvalidRequest):
+		status = http.StatusBadRequest
+	case errors.Is(err, approval.ErrNotAuthorized), errors.Is(err, approval.ErrSelfApproval):
+		status = http.StatusForbidden
+	case errors.Is(err, approval.ErrNotPending):
+		status = http.StatusConflict
+	}
+	return &rest.Error{
+		Err:      err,
+		Status:   status,
+		Message:  fmt.Sprintf("%v: %v", message, err.Error()),
+		IsSilent: false,
+	}
+}
+
+// isChangeRequestReviewer returns true if the requester has the "approveChanges"
+// permission, which is required to see and review the change requests of other users.
+func (api *API) isChangeRequestReviewer(r *http.Request) bool {
+	return api.checkClusterPermission(r, "approveChanges") == nil
+}
+
+// getVisibleChangeRequest returns the change request if the requester is a
+// reviewer or has requested it. Otherwise, it responds with not found.
+func (api *API) getVisibleChangeRequest(w http.ResponseWriter, r *http.Request) (approval.ChangeRequest, bool) {
+	principal := auth.PrincipalFromContext(r.Context())
+	if principal == nil {
+		rest.SendRESTError(w, r, api.Logger, errNotAuthenticated)
+		return approval.ChangeRequest{}, false
+	}
+
+	changeRequestID := rest.GetURLParam(r, "changeRequestId")
+	changeRequest, err := api.ApprovalSvc.Get(r.Context(), changeRequestID)
+	if err == nil && changeRequest.RequestedBy != principal.Name && !api.isChangeRequestReviewer(r) {
+		err = approval.ErrNotFound
+	}
+	if err != nil {
+		rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, fmt.Sprintf("Failed to get change request %q", changeRequestID)))
+		return approval.ChangeRequest{}, false
+	}
+	return changeRequest, true
+}
+
+func (api *API) handleListChangeRequests() http.HandlerFunc {
+	type response struct {
+		ChangeRequests []approval.ChangeRequest `json:"changeRequests"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal := auth.PrincipalFromContext(r.Context())
+		if principal == nil {
+			rest.SendRESTError(w, r, api.Logger, errNotAuthenticated)
+			return
+		}
+
+		filter := approval.ListFilter{
+			Status:      approval.Status(r.URL.Query().Get("status")),
+			RequestedBy: r.URL.Query().Get("requestedBy"),
+		}
+		// Users who can not review change requests only see their own
+		if !api.isChangeRequestReviewer(r) {
+			filter.RequestedBy = principal.Name
+		}
+
+		changeRequests, err := api.ApprovalSvc.List(r.Context(), filter)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, "Failed to list change requests"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{ChangeRequests: changeRequests})
+	}
+}
+
+type createChangeRequestRequest struct {
+	Operation string          `json:"operation"`
+	Payload   json.RawMessage `json:"payload"`
+	Comment   string          `json:"comment"`
+}
+
+// OK validates the user input for the create change request request. The payload
+// is validated by the executor of the operation.
+func (c *createChangeRequestRequest) OK() error {
+	if c.Operation == "" {
+		return errors.New("operation must be set")
+	}
+	if len(c.Payload) == 0 {
+		return errors.New("payload must be set")
+	}
+	return nil
+}
+
+func (api *API) handleCreateChangeRequest() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createChangeRequestRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		changeRequest, err := api.ApprovalSvc.Submit(r.Context(), req.Operation, req.Payload, req.Comment)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, "Failed to create change request"))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, changeRequestResponse{ChangeRequest: changeRequest})
+	}
+}
+
+func (api *API) handleGetChangeRequest() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		changeRequest, ok := api.getVisibleChangeRequest(w, r)
+		if !ok {
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, changeRequestResponse{ChangeRequest: changeRequest})
+	}
+}
+
+type reviewChangeRequestRequest struct {
+	Comment string `json:"comment"`
+}
+
+// OK validates the user input for the review change request request.
+func (*reviewChangeRequestRequest) OK() error {
+	return nil
+}
+
+func (api *API) handleApproveChangeRequest() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkClusterPermission(r, "approveChanges"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var req reviewChangeRequestRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		changeRequestID := rest.GetURLParam(r, "changeRequestId")
+		changeRequest, err := api.ApprovalSvc.Approve(r.Context(), changeRequestID, req.Comment)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, fmt.Sprintf("Failed to approve change request %q", changeRequestID)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, changeRequestResponse{ChangeRequest: changeRequest})
+	}
+}
+
+func (api *API) handleRejectChangeRequest() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req reviewChangeRequestRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Reviewers can reject all change requests, requesters can withdraw their own
+		if _, ok := api.getVisibleChangeRequest(w, r); !ok {
+			return
+		}
+
+		changeRequestID := rest.GetURLParam(r, "changeRequestId")
+		changeRequest, err := api.ApprovalSvc.Reject(r.Context(), changeRequestID, req.Comment)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, changeRequestRESTError(err, fmt.Sprintf("Failed to reject change request %q", changeRequestID)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, changeRequestResponse{ChangeRequest: changeRequest})
+	}
+}
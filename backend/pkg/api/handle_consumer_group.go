@@ -10,6 +10,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -18,6 +19,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/console"
 )
 
@@ -132,6 +134,25 @@ func (p *patchConsumerGroupRequest) OK() error {
 	return nil
 }
 
+// toKmsg builds the offset commit request topics.
+func (p *patchConsumerGroupRequest) toKmsg() []kmsg.OffsetCommitRequestTopic {
+	kmsgReq := make([]kmsg.OffsetCommitRequestTopic, len(p.Topics))
+	for i, topic := range p.Topics {
+		partitions := make([]kmsg.OffsetCommitRequestTopicPartition, len(topic.Partitions))
+		for j, partition := range topic.Partitions {
+			partitionReq := kmsg.NewOffsetCommitRequestTopicPartition()
+			partitionReq.Partition = partition.ID
+			partitionReq.Offset = partition.Offset
+			partitions[j] = partitionReq
+		}
+		topicReq := kmsg.NewOffsetCommitRequestTopic()
+		topicReq.Topic = topic.TopicName
+		topicReq.Partitions = partitions
+		kmsgReq[i] = topicReq
+	}
+	return kmsgReq
+}
+
 func (api *API) handlePatchConsumerGroup() http.HandlerFunc {
 	type response struct {
 		*console.EditConsumerGroupOffsetsResponse
@@ -163,24 +184,13 @@ func (api *API) handlePatchConsumerGroup() http.HandlerFunc {
 			return
 		}
 
-		// 3. Submit edit offset request
-		kmsgReq := make([]kmsg.OffsetCommitRequestTopic, len(req.Topics))
-		for i, topic := range req.Topics {
-			partitions := make([]kmsg.OffsetCommitRequestTopicPartition, len(topic.Partitions))
-			for j, partition := range topic.Partitions {
-				partitionReq := kmsg.NewOffsetCommitRequestTopicPartition()
-				partitionReq.Partition = partition.ID
-				partitionReq.Offset = partition.Offset
-				partitions[j] = partitionReq
-			}
-			topicReq := kmsg.NewOffsetCommitRequestTopic()
-			topicReq.Topic = topic.TopicName
-			topicReq.Partitions = partitions
-			kmsgReq[i] = topicReq
+		if api.requiresApproval(config.AuthApprovalsOperationResetConsumerGroupOffsets) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationResetConsumerGroupOffsets, resetConsumerGroupOffsetsPayload{req})
+			return
 		}
 
-		// 4. Check response and pass it to the frontend
-		res, restErr := api.ConsoleSvc.EditConsumerGroupOffsets(r.Context(), req.GroupID, kmsgReq)
+		// 3. Submit edit offset request and pass the response to the frontend
+		res, restErr := api.ConsoleSvc.EditConsumerGroupOffsets(r.Context(), req.GroupID, req.toKmsg())
 		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
 			return
@@ -216,6 +226,24 @@ func (p *deleteConsumerGroupRequest) OK() error {
 	return nil
 }
 
+// toKmsg builds the offset delete request topics.
+func (p *deleteConsumerGroupRequest) toKmsg() []kmsg.OffsetDeleteRequestTopic {
+	kmsgReq := make([]kmsg.OffsetDeleteRequestTopic, len(p.Topics))
+	for i, topic := range p.Topics {
+		partitions := make([]kmsg.OffsetDeleteRequestTopicPartition, len(topic.Partitions))
+		for j, partition := range topic.Partitions {
+			partitionReq := kmsg.NewOffsetDeleteRequestTopicPartition()
+			partitionReq.Partition = partition.ID
+			partitions[j] = partitionReq
+		}
+		topicReq := kmsg.NewOffsetDeleteRequestTopic()
+		topicReq.Topic = topic.TopicName
+		topicReq.Partitions = partitions
+		kmsgReq[i] = topicReq
+	}
+	return kmsgReq
+}
+
 func (api *API) handleDeleteConsumerGroupOffsets() http.HandlerFunc {
 	type response struct {
 		Topics []console.DeleteConsumerGroupOffsetsResponseTopic `json:"topics"`
@@ -247,30 +275,14 @@ func (api *API) handleDeleteConsumerGroupOffsets() http.HandlerFunc {
 			return
 		}
 
-		// 3. Submit delete offset request
-		kmsgReq := make([]kmsg.OffsetDeleteRequestTopic, len(req.Topics))
-		for i, topic := range req.Topics {
-			partitions := make([]kmsg.OffsetDeleteRequestTopicPartition, len(topic.Partitions))
-			for j, partition := range topic.Partitions {
-				partitionReq := kmsg.NewOffsetDeleteRequestTopicPartition()
-				partitionReq.Partition = partition.ID
-				partitions[j] = partitionReq
-			}
-			topicReq := kmsg.NewOffsetDeleteRequestTopic()
-			topicReq.Topic = topic.TopicName
-			topicReq.Partitions = partitions
-			kmsgReq[i] = topicReq
+		if api.requiresApproval(config.AuthApprovalsOperationDeleteConsumerGroupOffsets) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationDeleteConsumerGroupOffsets, deleteConsumerGroupOffsetsPayload{req})
+			return
 		}
 
-		// 4. Check response and pass it to the frontend
-		deletedTopics, err := api.ConsoleSvc.DeleteConsumerGroupOffsets(r.Context(), req.GroupID, kmsgReq)
-		if err != nil {
-			restErr := &rest.Error{
-				Err:      err,
-				Status:   http.StatusServiceUnavailable,
-				Message:  fmt.Sprintf("Delete consumer group offset request has failed: %v", err.Error()),
-				IsSilent: false,
-			}
+		// 3. Submit delete offset request and pass the response to the frontend
+		deletedTopics, restErr := api.deleteConsumerGroupOffsets(r.Context(), &req)
+		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
 			return
 		}
@@ -280,6 +292,19 @@ func (api *API) handleDeleteConsumerGroupOffsets() http.HandlerFunc {
 	}
 }
 
+func (api *API) deleteConsumerGroupOffsets(ctx context.Context, req *deleteConsumerGroupRequest) ([]console.DeleteConsumerGroupOffsetsResponseTopic, *rest.Error) {
+	deletedTopics, err := api.ConsoleSvc.DeleteConsumerGroupOffsets(ctx, req.GroupID, req.toKmsg())
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Delete consumer group offset request has failed: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	return deletedTopics, nil
+}
+
 func (api *API) handleDeleteConsumerGroup() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		groupID := rest.GetURLParam(r, "groupId")
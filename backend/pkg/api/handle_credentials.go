@@ -0,0 +1,197 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/credential"
+)
+
+// credentialRESTError maps the errors of the credential service to REST errors.
+func credentialRESTError(err error, message string) *rest.Error {
+	status := http.StatusServiceUnavailable
+	switch {
+	case errors.Is(err, credential.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, credential.ErrInvalidRequest):
+		status = http.StatusBadRequest
+	case errors.Is(err, credential.ErrAlreadyExists),
+		errors.Is(err, credential.ErrRotationPending),
+		errors.Is(err, credential.ErrNoRotationPending):
+		status = http.StatusConflict
+	}
+	return &rest.Error{
+		Err:      err,
+		Status:   status,
+		Message:  fmt.Sprintf("%v: %v", message, err.Error()),
+		IsSilent: false,
+	}
+}
+
+// checkKafkaUserPermission returns an error if the requester is not allowed to
+// perform the action on the given Kafka user. An empty username only checks the
+// permission.
+func (api *API) checkKafkaUserPermission(ctx context.Context, isAllowed func(context.Context) (bool, *rest.Error), action, username string) *rest.Error {
+	allowed, restErr := isAllowed(ctx)
+	if restErr != nil {
+		return restErr
+	}
+	if !allowed {
+		return &rest.Error{
+			Err:      fmt.Errorf("requester has no permissions to %v Kafka users", action),
+			Status:   http.StatusForbidden,
+			Message:  fmt.Sprintf("You don't have permissions to %v Kafka users.", action),
+			IsSilent: false,
+		}
+	}
+	if username != "" && api.Hooks.Authorization.IsProtectedKafkaUser(username) {
+		return &rest.Error{
+			Err:      fmt.Errorf("requester tried to %v a protected Kafka user", action),
+			Status:   http.StatusForbidden,
+			Message:  "You are not allowed to manage this protected Kafka user",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+func (api *API) handleGetCredentialReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if restErr := api.checkKafkaUserPermission(r.Context(), api.Hooks.Authorization.CanListKafkaUsers, "list", ""); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		report, err := api.CredentialSvc.Report(r.Context())
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, credentialRESTError(err, "Failed to create credential report"))
+			return
+		}
+		users := make([]credential.UserReport, 0, len(report.Users))
+		for _, user := range report.Users {
+			if api.Hooks.Authorization.IsProtectedKafkaUser(user.Username) {
+				continue
+			}
+			users = append(users, user)
+		}
+		report.Users = users
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, report)
+	}
+}
+
+type createCredentialRequest struct {
+	credential.CreateUserRequest
+}
+
+// OK validates the user input for the create credential request. The mechanism
+// is validated by the credential service.
+func (c *createCredentialRequest) OK() error {
+	if c.Username == "" {
+		return errors.New("username must be set")
+	}
+	return nil
+}
+
+func (api *API) handleCreateCredential() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createCredentialRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if restErr := api.checkKafkaUserPermission(r.Context(), api.Hooks.Authorization.CanCreateKafkaUsers, "create", req.Username); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		issued, err := api.CredentialSvc.CreateUser(r.Context(), req.CreateUserRequest)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, credentialRESTError(err, fmt.Sprintf("Failed to create user %q", req.Username)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, issued)
+	}
+}
+
+type rotateCredentialRequest struct {
+	credential.RotateRequest
+}
+
+// OK validates the user input for the rotate credential request. All fields are
+// optional.
+func (*rotateCredentialRequest) OK() error {
+	return nil
+}
+
+func (api *API) handleRotateCredential() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req rotateCredentialRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		username := rest.GetURLParam(r, "username")
+		if restErr := api.checkKafkaUserPermission(r.Context(), api.Hooks.Authorization.CanCreateKafkaUsers, "rotate", username); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		issued, err := api.CredentialSvc.RotatePassword(r.Context(), username, req.RotateRequest)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, credentialRESTError(err, fmt.Sprintf("Failed to rotate password of user %q", username)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, issued)
+	}
+}
+
+func (api *API) handleCompleteCredentialRotation() http.HandlerFunc {
+	type response struct {
+		Metadata credential.Metadata `json:"metadata"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		username := rest.GetURLParam(r, "username")
+		if restErr := api.checkKafkaUserPermission(r.Context(), api.Hooks.Authorization.CanCreateKafkaUsers, "rotate", username); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		metadata, err := api.CredentialSvc.CompleteRotation(r.Context(), username)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, credentialRESTError(err, fmt.Sprintf("Failed to complete password rotation of user %q", username)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Metadata: metadata})
+	}
+}
+
+func (api *API) handleDeleteCredential() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		username := rest.GetURLParam(r, "username")
+		if restErr := api.checkKafkaUserPermission(r.Context(), api.Hooks.Authorization.CanDeleteKafkaUsers, "delete", username); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if err := api.CredentialSvc.DeleteUser(r.Context(), username); err != nil {
+			rest.SendRESTError(w, r, api.Logger, credentialRESTError(err, fmt.Sprintf("Failed to delete user %q", username)))
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/gorilla/schema"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+type listDeadLetterRecordsRequest struct {
+	// MaxResults is the maximum number of records. Defaults to 100.
+	MaxResults int `schema:"maxResults"`
+
+	Troubleshoot       bool                  `schema:"troubleshoot"`
+	IgnoreMaxSizeLimit bool                  `schema:"ignoreMaxSizeLimit"`
+	KeyDeserializer    serde.PayloadEncoding `schema:"keyDeserializer"`
+	ValueDeserializer  serde.PayloadEncoding `schema:"valueDeserializer"`
+}
+
+func (api *API) handleListConnectorDeadLetterRecords() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		// 1. Parse request from url parameters
+		decoder := schema.NewDecoder()
+		decoder.IgnoreUnknownKeys(true)
+		req := &listDeadLetterRecordsRequest{}
+		if err := decoder.Decode(req, r.URL.Query()); err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse request parameters: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		if req.MaxResults == 0 {
+			req.MaxResults = 100
+		}
+		if req.MaxResults < 0 || req.MaxResults > 1000 {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("max results must be between 1 and 1000"),
+				Status:   http.StatusBadRequest,
+				Message:  "Max results must be between 1 and 1000",
+				IsSilent: false,
+			})
+			return
+		}
+
+		// 2. Resolve the connector's dead letter queue topic and check permissions
+		if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+		topicName, restErr := api.ConnectSvc.GetConnectorDeadLetterQueueTopic(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.checkCanViewTopicMessages(w, r, topicName, req.MaxResults) {
+			return
+		}
+		api.Hooks.Authorization.PrintListMessagesAuditLog(r.Context(), r, &console.ListMessageRequest{
+			TopicName:         topicName,
+			PartitionID:       -1,
+			StartOffset:       console.StartOffsetRecent,
+			MessageCount:      req.MaxResults,
+			KeyDeserializer:   req.KeyDeserializer,
+			ValueDeserializer: req.ValueDeserializer,
+		})
+
+		// 3. Read and decode the dead letter queue
+		res, restErr := api.ConsoleSvc.ListDeadLetterRecords(r.Context(), console.ListDeadLetterRecordsRequest{
+			TopicName:  topicName,
+			MaxResults: req.MaxResults,
+			Deserialization: kafka.RecordDeserializationRequest{
+				Troubleshoot:       req.Troubleshoot,
+				IgnoreMaxSizeLimit: req.IgnoreMaxSizeLimit,
+				KeyDeserializer:    req.KeyDeserializer,
+				ValueDeserializer:  req.ValueDeserializer,
+			},
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+type replayDeadLetterRecordsRequest struct {
+	Records         []console.DeadLetterRecordID `json:"records"`
+	UseTransactions bool                         `json:"useTransactions"`
+}
+
+// OK validates the user input for the replay dead letter records request.
+func (r *replayDeadLetterRecordsRequest) OK() error {
+	if len(r.Records) == 0 {
+		return errors.New("at least one record must be selected")
+	}
+	if len(r.Records) > 1000 {
+		return errors.New("at most 1000 records can be replayed at once")
+	}
+	return nil
+}
+
+func (api *API) handleReplayConnectorDeadLetterRecords() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		// 1. Parse and validate request
+		var req replayDeadLetterRecordsRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Resolve the connector's dead letter queue topic and check permissions
+		if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+		topicName, restErr := api.ConnectSvc.GetConnectorDeadLetterQueueTopic(ctx, clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.checkCanViewTopicMessages(w, r, topicName, len(req.Records)) {
+			return
+		}
+
+		// 3. Read the selected records and check that the requester may publish
+		// to their original topics
+		records, restErr := api.ConsoleSvc.GetDeadLetterReplayRecords(r.Context(), console.DeadLetterReplayRequest{
+			TopicName: topicName,
+			Records:   req.Records,
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		checkedTopics := make(map[string]struct{})
+		for _, record := range records {
+			if _, checked := checkedTopics[record.Topic]; checked {
+				continue
+			}
+			checkedTopics[record.Topic] = struct{}{}
+
+			canPublish, restErr := api.Hooks.Authorization.CanPublishTopicRecords(r.Context(), record.Topic)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canPublish {
+				rest.SendRESTError(w, r, api.Logger, &rest.Error{
+					Err:      fmt.Errorf("requester has no permissions to publish records in topic '%v'", record.Topic),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You don't have permissions to publish records in topic '%v'", record.Topic),
+					IsSilent: false,
+				})
+				return
+			}
+		}
+
+		// 4. Produce the records with their original key and value
+		res := api.ConsoleSvc.ProduceRecords(r.Context(), records, req.UseTransactions, []kgo.CompressionCodec{kgo.NoCompression()})
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
+)
+
+func (api *API) checkCanViewConnectCluster(r *http.Request, clusterName string) *rest.Error {
+	canSee, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), clusterName)
+	if restErr != nil {
+		return restErr
+	}
+	if !canSee {
+		return &rest.Error{
+			Err:          fmt.Errorf("requester has no permissions to view this connect cluster"),
+			Status:       http.StatusForbidden,
+			Message:      "You don't have permissions to view connectors in this Kafka connect cluster",
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	return nil
+}
+
+func parseRevision(value string, name string) (int, *rest.Error) {
+	revision, err := strconv.Atoi(value)
+	if err != nil || revision < 1 {
+		return 0, &rest.Error{
+			Err:      fmt.Errorf("failed to parse %v revision %q as positive number", name, value),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Invalid %v revision given. Revision must be a positive number.", name),
+			IsSilent: false,
+		}
+	}
+	return revision, nil
+}
+
+func (api *API) handleListConnectorConfigRevisions() http.HandlerFunc {
+	type response struct {
+		Revisions []history.Revision `json:"revisions"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		revisions, restErr := api.ConnectSvc.ListConnectorConfigRevisions(clusterName, connector)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Revisions: revisions})
+	}
+}
+
+func (api *API) handleCompareConnectorConfigRevisions() http.HandlerFunc {
+	type response struct {
+		FromRevision int                    `json:"fromRevision"`
+		ToRevision   int                    `json:"toRevision"`
+		Changes      []history.ConfigChange `json:"changes"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		fromRevision, restErr := parseRevision(r.URL.Query().Get("from"), "from")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		toRevision, restErr := parseRevision(r.URL.Query().Get("to"), "to")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		changes, restErr := api.ConnectSvc.CompareConnectorConfigRevisions(clusterName, connector, fromRevision, toRevision)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{
+			FromRevision: fromRevision,
+			ToRevision:   toRevision,
+			Changes:      changes,
+		})
+	}
+}
+
+func (api *API) handleRollbackConnectorConfig() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		connector := rest.GetURLParam(r, "connector")
+
+		revision, restErr := parseRevision(rest.GetURLParam(r, "revision"), "target")
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canEdit {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("requester has no permissions to edit in this connect cluster"),
+				Status:       http.StatusForbidden,
+				Message:      "You don't have permissions to edit connectors in this Kafka connect cluster",
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		cInfo, restErr := api.ConnectSvc.RollbackConnectorConfig(ctx, clusterName, connector, revision)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// restart the instance and all the tasks, same as when the config is updated
+		restErr = api.ConnectSvc.RestartConnector(ctx, clusterName, connector, true, false)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, cInfo)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleGetPluginCatalog() http.HandlerFunc {
+	type response struct {
+		IsConfigured bool `json:"isConfigured"`
+		connect.PluginCatalog
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		// Only aggregate the clusters which the requester is allowed to see
+		allClusterNames := api.ConnectSvc.ClusterNames()
+		clusterNames := make([]string, 0, len(allClusterNames))
+		for _, clusterName := range allClusterNames {
+			canSee, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), clusterName)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				continue
+			}
+			if canSee {
+				clusterNames = append(clusterNames, clusterName)
+			}
+		}
+
+		catalog, err := api.ConnectSvc.GetPluginCatalog(ctx, clusterNames)
+		if err != nil {
+			if errors.Is(err, connect.ErrKafkaConnectNotConfigured) {
+				rest.SendResponse(w, r, api.Logger, http.StatusOK, response{IsConfigured: false})
+				return
+			}
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("failed to get connector plugin catalog: %w", err),
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to get connector plugin catalog: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{IsConfigured: true, PluginCatalog: catalog})
+	}
+}
+
+func (api *API) handleGetPluginConfigDefinitions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+		pluginClassName := rest.GetURLParam(r, "pluginClassName")
+
+		if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		definitions, restErr := api.ConnectSvc.GetPluginConfigDefinitions(ctx, clusterName, pluginClassName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, definitions)
+	}
+}
+
+func (api *API) handleComparePluginConfigDefinitions() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pluginClassName := rest.GetURLParam(r, "pluginClassName")
+		fromClusterName := r.URL.Query().Get("fromCluster")
+		toClusterName := r.URL.Query().Get("toCluster")
+		if fromClusterName == "" || toClusterName == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("fromCluster and toCluster query parameters must be set"),
+				Status:   http.StatusBadRequest,
+				Message:  "The query parameters fromCluster and toCluster must be set",
+				IsSilent: false,
+			})
+			return
+		}
+
+		for _, clusterName := range []string{fromClusterName, toClusterName} {
+			if restErr := api.checkCanViewConnectCluster(r, clusterName); restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), api.ConnectSvc.Cfg.RequestTimeout)
+		defer cancel()
+
+		diff, restErr := api.ConnectSvc.ComparePluginConfigDefinitions(ctx, pluginClassName, fromClusterName, toClusterName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, diff)
+	}
+}
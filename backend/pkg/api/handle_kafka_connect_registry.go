@@ -0,0 +1,176 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect"
+)
+
+func (api *API) handleListConnectClusterRegistrations() http.HandlerFunc {
+	type response struct {
+		Clusters []connect.ClusterRegistration `json:"clusters"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		registrations, restErr := api.ConnectSvc.ListClusterRegistrations()
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Only return the clusters which the requester is allowed to see
+		visible := make([]connect.ClusterRegistration, 0, len(registrations))
+		for _, registration := range registrations {
+			canSee, restErr := api.Hooks.Authorization.CanViewConnectCluster(r.Context(), registration.Name)
+			if restErr != nil {
+				api.Logger.Error("failed to check view connect cluster permissions", zap.Error(restErr.Err))
+				continue
+			}
+			if canSee {
+				visible = append(visible, registration)
+			}
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Clusters: visible})
+	}
+}
+
+// connectClusterRegistrationTLS contains the PEM encoded certificates and key.
+// File paths are not accepted, so that requesters can not make Console read
+// arbitrary local files.
+type connectClusterRegistrationTLS struct {
+	Enabled               bool   `json:"enabled"`
+	CaPEM                 string `json:"caPem"`
+	CertPEM               string `json:"certPem"`
+	KeyPEM                string `json:"keyPem"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTlsVerify"`
+}
+
+type connectClusterRegistrationRequest struct {
+	Name     string                        `json:"name"`
+	URL      string                        `json:"url"`
+	TLS      connectClusterRegistrationTLS `json:"tls"`
+	Username string                        `json:"username"`
+	Password string                        `json:"password"`
+	Token    string                        `json:"token"`
+}
+
+// OK validates the user input for the connect cluster registration request.
+// The cluster config itself is validated by the connect service.
+func (r *connectClusterRegistrationRequest) OK() error {
+	if r.Password != "" && r.Username == "" {
+		return errors.New("a username must be set along with the password")
+	}
+	if r.TLS.KeyPEM != "" && r.TLS.CertPEM == "" {
+		return errors.New("a client certificate must be set along with the key")
+	}
+	return nil
+}
+
+func (r *connectClusterRegistrationRequest) toClusterConfig() config.ConnectCluster {
+	return config.ConnectCluster{
+		Name: r.Name,
+		URL:  r.URL,
+		TLS: config.ConnectClusterTLS{
+			Enabled:               r.TLS.Enabled,
+			CaPEM:                 r.TLS.CaPEM,
+			CertPEM:               r.TLS.CertPEM,
+			KeyPEM:                r.TLS.KeyPEM,
+			InsecureSkipTLSVerify: r.TLS.InsecureSkipTLSVerify,
+		},
+		Username: r.Username,
+		Password: r.Password,
+		Token:    r.Token,
+	}
+}
+
+// checkCanManageConnectClusters requires the "manageConnectClusters" cluster
+// permission in addition to the edit permission on the connect cluster itself.
+// Registered clusters make Console send requests to arbitrary URLs, so that
+// registering them must be granted separately.
+func (api *API) checkCanManageConnectClusters(r *http.Request, clusterName string) *rest.Error {
+	if restErr := api.checkClusterPermission(r, "manageConnectClusters"); restErr != nil {
+		return restErr
+	}
+	return api.checkCanManageConnectClusters(r, clusterName)
+}
+
+func (api *API) handleRegisterConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req connectClusterRegistrationRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if restErr := api.checkCanManageConnectClusters(r, req.Name); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if restErr := api.ConnectSvc.RegisterCluster(req.toClusterConfig()); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, nil)
+	}
+}
+
+func (api *API) handleUpdateConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+
+		if restErr := api.checkCanManageConnectClusters(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var req connectClusterRegistrationRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		// The cluster can not be renamed, because its name is the identifier
+		req.Name = clusterName
+
+		if restErr := api.ConnectSvc.UpdateCluster(req.toClusterConfig()); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
+
+func (api *API) handleRemoveConnectCluster() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clusterName := rest.GetURLParam(r, "clusterName")
+
+		if restErr := api.checkCanManageConnectClusters(r, clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if restErr := api.ConnectSvc.RemoveCluster(clusterName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
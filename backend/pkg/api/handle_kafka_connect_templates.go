@@ -0,0 +1,243 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect"
+	"github.com/redpanda-data/console/backend/pkg/connect/template"
+)
+
+// maxTemplateTargets limits the number of connectors that can be created or
+// updated with a single apply request.
+const maxTemplateTargets = 1000
+
+func (api *API) checkCanEditConnectCluster(r *http.Request, clusterName string) *rest.Error {
+	canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+	if restErr != nil {
+		return restErr
+	}
+	if !canEdit {
+		return &rest.Error{
+			Err:          fmt.Errorf("requester has no permissions to edit in this connect cluster"),
+			Status:       http.StatusForbidden,
+			Message:      fmt.Sprintf("You don't have permissions to edit connectors in the Kafka connect cluster %q", clusterName),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	return nil
+}
+
+// checkCanEditAnyConnectCluster permits managing templates, which are not bound
+// to a single cluster, to everyone who can edit connectors in at least one
+// cluster. Applying a template is authorized per target cluster.
+func (api *API) checkCanEditAnyConnectCluster(r *http.Request) *rest.Error {
+	for _, clusterName := range api.ConnectSvc.ClusterNames() {
+		canEdit, restErr := api.Hooks.Authorization.CanEditConnectCluster(r.Context(), clusterName)
+		if restErr != nil {
+			return restErr
+		}
+		if canEdit {
+			return nil
+		}
+	}
+	return &rest.Error{
+		Err:      fmt.Errorf("requester has no permissions to edit in any connect cluster"),
+		Status:   http.StatusForbidden,
+		Message:  "You don't have permissions to manage connector templates",
+		IsSilent: false,
+	}
+}
+
+func (api *API) handleListConnectorTemplates() http.HandlerFunc {
+	type response struct {
+		Templates []template.Template `json:"templates"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		templates, restErr := api.ConnectSvc.ListConnectorTemplates()
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Templates: templates})
+	}
+}
+
+func (api *API) handleGetConnectorTemplate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		templateName := rest.GetURLParam(r, "templateName")
+
+		tpl, restErr := api.ConnectSvc.GetConnectorTemplate(templateName)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, tpl)
+	}
+}
+
+type putConnectorTemplateRequest struct {
+	Description      string                       `json:"description"`
+	ConnectorName    string                       `json:"connectorName"`
+	Config           map[string]string            `json:"config"`
+	Variables        []template.Variable          `json:"variables"`
+	ClusterOverrides map[string]map[string]string `json:"clusterOverrides"`
+}
+
+// OK validates the user input for the put connector template request. The
+// template itself is validated by the connect service.
+func (*putConnectorTemplateRequest) OK() error {
+	return nil
+}
+
+func (api *API) handlePutConnectorTemplate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		templateName := rest.GetURLParam(r, "templateName")
+
+		if restErr := api.checkCanEditAnyConnectCluster(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		var req putConnectorTemplateRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		tpl, restErr := api.ConnectSvc.PutConnectorTemplate(template.Template{
+			Name:             templateName,
+			Description:      req.Description,
+			ConnectorName:    req.ConnectorName,
+			Config:           req.Config,
+			Variables:        req.Variables,
+			ClusterOverrides: req.ClusterOverrides,
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, tpl)
+	}
+}
+
+func (api *API) handleDeleteConnectorTemplate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		templateName := rest.GetURLParam(r, "templateName")
+
+		if restErr := api.checkCanEditAnyConnectCluster(r); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		if restErr := api.ConnectSvc.DeleteConnectorTemplate(templateName); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, nil)
+	}
+}
+
+type applyConnectorTemplateRequest struct {
+	// Clusters and Topics are expanded into one target per combination. If no
+	// topics are given, there is one target per cluster.
+	Clusters []string `json:"clusters"`
+	Topics   []string `json:"topics"`
+	// Targets are applied in addition to the expanded clusters and topics.
+	Targets   []connect.TemplateTarget `json:"targets"`
+	Variables map[string]string        `json:"variables"`
+	DryRun    bool                     `json:"dryRun"`
+}
+
+// OK validates the user input for the apply connector template request.
+func (r *applyConnectorTemplateRequest) OK() error {
+	if len(r.Topics) > 0 && len(r.Clusters) == 0 {
+		return errors.New("clusters must be given along with topics")
+	}
+	targets := len(r.Targets) + len(r.Clusters)*max(len(r.Topics), 1)
+	if targets == 0 {
+		return errors.New("at least one cluster or target must be given")
+	}
+	if targets > maxTemplateTargets {
+		return fmt.Errorf("at most %d connectors can be applied at once", maxTemplateTargets)
+	}
+	return nil
+}
+
+// toTargets expands the clusters and topics into targets.
+func (r *applyConnectorTemplateRequest) toTargets() []connect.TemplateTarget {
+	targets := make([]connect.TemplateTarget, 0, len(r.Targets)+len(r.Clusters)*max(len(r.Topics), 1))
+	for _, clusterName := range r.Clusters {
+		if len(r.Topics) == 0 {
+			targets = append(targets, connect.TemplateTarget{ClusterName: clusterName})
+			continue
+		}
+		for _, topic := range r.Topics {
+			targets = append(targets, connect.TemplateTarget{ClusterName: clusterName, Topic: topic})
+		}
+	}
+	return append(targets, r.Targets...)
+}
+
+func (api *API) handleApplyConnectorTemplate() http.HandlerFunc {
+	type response struct {
+		Results []connect.TemplateApplyResult `json:"results"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		templateName := rest.GetURLParam(r, "templateName")
+
+		var req applyConnectorTemplateRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		targets := req.toTargets()
+
+		// Either all targets are permitted or nothing is applied
+		checked := make(map[string]struct{})
+		for _, target := range targets {
+			if _, exists := checked[target.ClusterName]; exists {
+				continue
+			}
+			checked[target.ClusterName] = struct{}{}
+			if restErr := api.checkCanEditConnectCluster(r, target.ClusterName); restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+		}
+
+		results, restErr := api.ConnectSvc.ApplyConnectorTemplate(r.Context(), connect.ApplyConnectorTemplateRequest{
+			TemplateName: templateName,
+			Targets:      targets,
+			Variables:    req.Variables,
+			DryRun:       req.DryRun,
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Results: results})
+	}
+}
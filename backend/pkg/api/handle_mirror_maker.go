@@ -0,0 +1,154 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+func (api *API) handleGetMirrorMakerOverview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		overview, restErr := api.ConsoleSvc.GetMirrorMakerOverview(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// Only return the topic mappings of topics the requester is allowed to see
+		visibleMappings := make([]console.MirrorMakerTopicMapping, 0, len(overview.TopicMappings))
+		for _, mapping := range overview.TopicMappings {
+			canSee, restErr := api.Hooks.Authorization.CanSeeTopic(r.Context(), mapping.TargetTopicName)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if canSee {
+				visibleMappings = append(visibleMappings, mapping)
+			}
+		}
+		overview.TopicMappings = visibleMappings
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, overview)
+	}
+}
+
+func (api *API) handleTranslateConsumerGroupOffsets() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := console.TranslateConsumerGroupOffsetsRequest{
+			SourceClusterAlias: rest.GetURLParam(r, "sourceClusterAlias"),
+			GroupID:            rest.GetURLParam(r, "groupId"),
+		}
+		if topics := r.URL.Query().Get("topics"); topics != "" {
+			req.TopicNames = strings.Split(topics, ",")
+		}
+
+		// Check if logged-in user is allowed to see the consumer group
+		canSee, restErr := api.Hooks.Authorization.CanSeeConsumerGroup(r.Context(), req.GroupID)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canSee {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("requester has no permissions to view consumer group"),
+				Status:       http.StatusForbidden,
+				Message:      "You don't have permissions to view this consumer group",
+				InternalLogs: []zapcore.Field{zap.String("group_id", req.GroupID)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		res, restErr := api.ConsoleSvc.TranslateConsumerGroupOffsets(r.Context(), req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+type applyTranslatedConsumerGroupOffsetsRequest struct {
+	// TopicNames restricts the applied offsets to the given replicated topics. The
+	// offsets of all checkpointed topics are applied if empty.
+	TopicNames []string `json:"topicNames"`
+}
+
+// OK validates the user input for the apply translated offsets request.
+func (*applyTranslatedConsumerGroupOffsetsRequest) OK() error {
+	return nil
+}
+
+func (api *API) handleApplyTranslatedConsumerGroupOffsets() http.HandlerFunc {
+	type response struct {
+		*console.EditConsumerGroupOffsetsResponse
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		sourceClusterAlias := rest.GetURLParam(r, "sourceClusterAlias")
+		groupID := rest.GetURLParam(r, "groupId")
+
+		// 1. Parse request
+		var req applyTranslatedConsumerGroupOffsetsRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Check if logged-in user is allowed to edit the consumer group
+		canEdit, restErr := api.Hooks.Authorization.CanEditConsumerGroup(r.Context(), groupID)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !canEdit {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:          fmt.Errorf("requester has no permissions to edit consumer group"),
+				Status:       http.StatusForbidden,
+				Message:      "You don't have permissions to edit this consumer group",
+				InternalLogs: []zapcore.Field{zap.String("group_id", groupID)},
+				IsSilent:     false,
+			})
+			return
+		}
+
+		translateReq := console.TranslateConsumerGroupOffsetsRequest{
+			SourceClusterAlias: sourceClusterAlias,
+			GroupID:            groupID,
+			TopicNames:         req.TopicNames,
+		}
+		if api.requiresApproval(config.AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets, applyTranslatedConsumerGroupOffsetsPayload{
+				SourceClusterAlias: sourceClusterAlias,
+				GroupID:            groupID,
+				TopicNames:         req.TopicNames,
+			})
+			return
+		}
+
+		// 3. Commit the translated offsets
+		res, restErr := api.ConsoleSvc.ApplyTranslatedConsumerGroupOffsets(r.Context(), translateReq)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{res})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
+)
+
+// handleExplainAuthorization explains why a request is allowed or denied by the RBAC
+// policy. By default the requester's own permissions are explained. Explaining the
+// permissions of other users requires the cluster action "debugAuthorization".
+func (api *API) handleExplainAuthorization() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principal := auth.PrincipalFromContext(r.Context())
+		if principal == nil {
+			rest.SendRESTError(w, r, api.Logger, errNotAuthenticated)
+			return
+		}
+
+		query := r.URL.Query()
+		resourceType := rbac.ResourceType(query.Get("resourceType"))
+		resourceName := query.Get("resourceName")
+		action := query.Get("action")
+		validActions := rbac.Actions(resourceType)
+		if len(validActions) == 0 {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("unknown resource type %q", resourceType),
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Unknown resource type %q", resourceType),
+				IsSilent: false,
+			})
+			return
+		}
+		if !slices.Contains(validActions, action) {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("unknown action %q for resource type %q", action, resourceType),
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Unknown action %q for resource type %q. Valid actions are: %v", action, resourceType, strings.Join(validActions, ", ")),
+				IsSilent: false,
+			})
+			return
+		}
+
+		subject := principal
+		if user := query.Get("user"); user != "" {
+			if restErr := api.checkClusterPermission(r, "debugAuthorization"); restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			subject = &auth.Principal{Name: user, Groups: make([]string, 0)}
+			if groups := query.Get("groups"); groups != "" {
+				subject.Groups = strings.Split(groups, ",")
+			}
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, api.RBACSvc.Explain(subject, resourceType, resourceName, action))
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/console"
+)
+
+// maxRoleDefinitionsImportSize is the maximum size of an imported YAML document.
+const maxRoleDefinitionsImportSize = 4 << 20
+
+// canAccessRoleDefinitions checks the role and the ACL permissions of the
+// requester, because role definitions bundle both. Creating roles requires the
+// create permissions, reading them the list permissions.
+func (api *API) canAccessRoleDefinitions(w http.ResponseWriter, r *http.Request, create bool) bool {
+	type check struct {
+		isAllowed func(context.Context) (bool, *rest.Error)
+		action    string
+	}
+	checks := []check{
+		{api.Hooks.Authorization.CanListRedpandaRoles, "list Redpanda roles"},
+		{api.Hooks.Authorization.CanListACLs, "list ACLs"},
+	}
+	if create {
+		checks = append(checks,
+			check{api.Hooks.Authorization.CanCreateRedpandaRoles, "create Redpanda roles"},
+			check{api.Hooks.Authorization.CanCreateACL, "create ACLs"})
+	}
+
+	for _, c := range checks {
+		isAllowed, restErr := c.isAllowed(r.Context())
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return false
+		}
+		if !isAllowed {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      fmt.Errorf("requester is not allowed to %v", c.action),
+				Status:   http.StatusForbidden,
+				Message:  fmt.Sprintf("You are not allowed to %v", c.action),
+				IsSilent: true,
+			})
+			return false
+		}
+	}
+	return true
+}
+
+// checkRoleMembers returns an error if a protected Kafka user would be assigned to
+// a role, which would grant it the permissions of the role.
+func (api *API) checkRoleMembers(definitions ...console.RoleDefinition) *rest.Error {
+	for _, definition := range definitions {
+		for _, member := range definition.Members {
+			if api.Hooks.Authorization.IsProtectedKafkaUser(member) {
+				return &rest.Error{
+					Err:      fmt.Errorf("requester tried to assign the protected Kafka user %q to role %q", member, definition.Name),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You are not allowed to assign the protected Kafka user %q to a role", member),
+					IsSilent: false,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (api *API) handleGetRoleDefinition() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.canAccessRoleDefinitions(w, r, false) {
+			return
+		}
+
+		definition, restErr := api.ConsoleSvc.GetRoleDefinition(r.Context(), rest.GetURLParam(r, "roleName"))
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, definition)
+	}
+}
+
+type createRoleWithACLsRequest struct {
+	console.RoleDefinition
+}
+
+// OK validates the user input for the create role request. The ACLs are
+// validated by the console service.
+func (c *createRoleWithACLsRequest) OK() error {
+	if c.Name == "" {
+		return errors.New("role name must be set")
+	}
+	return nil
+}
+
+func (api *API) handleCreateRoleWithACLs() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createRoleWithACLsRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.canAccessRoleDefinitions(w, r, true) {
+			return
+		}
+		if restErr := api.checkRoleMembers(req.RoleDefinition); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		definition, restErr := api.ConsoleSvc.CreateRoleWithACLs(r.Context(), req.RoleDefinition)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, definition)
+	}
+}
+
+type cloneRoleRequest struct {
+	// Name of the new role.
+	Name string `json:"name"`
+	// IncludeMembers assigns the members of the source role to the new role.
+	IncludeMembers bool `json:"includeMembers"`
+}
+
+// OK validates the user input for the clone role request.
+func (c *cloneRoleRequest) OK() error {
+	if c.Name == "" {
+		return errors.New("name of the new role must be set")
+	}
+	return nil
+}
+
+func (api *API) handleCloneRole() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cloneRoleRequest
+		if restErr := rest.Decode(w, r, &req); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if !api.canAccessRoleDefinitions(w, r, true) {
+			return
+		}
+
+		definition, restErr := api.ConsoleSvc.CloneRole(r.Context(), rest.GetURLParam(r, "roleName"), req.Name, req.IncludeMembers)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusCreated, definition)
+	}
+}
+
+func (api *API) handleDiffRoles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		roleA := r.URL.Query().Get("roleA")
+		roleB := r.URL.Query().Get("roleB")
+		if roleA == "" || roleB == "" {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("roleA and roleB must be set"),
+				Status:   http.StatusBadRequest,
+				Message:  "The query parameters roleA and roleB must be set",
+				IsSilent: false,
+			})
+			return
+		}
+		if !api.canAccessRoleDefinitions(w, r, false) {
+			return
+		}
+
+		diff, restErr := api.ConsoleSvc.DiffRoles(r.Context(), roleA, roleB)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, diff)
+	}
+}
+
+// handleExportRoles responds with the YAML definitions of the roles given by the
+// repeatable roleName query parameter, or of all roles.
+func (api *API) handleExportRoles() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.canAccessRoleDefinitions(w, r, false) {
+			return
+		}
+
+		definitions, restErr := api.ConsoleSvc.ExportRoleDefinitions(r.Context(), r.URL.Query()["roleName"])
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		encoded, err := console.MarshalRoleDefinitionsYAML(definitions)
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to encode role definitions: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Content-Disposition", `attachment; filename="roles.yaml"`)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(encoded); err != nil {
+			api.Logger.Debug("failed to write role definitions response")
+		}
+	}
+}
+
+// handleImportRoles creates the roles of a YAML document that has been created by
+// the export. Existing roles are skipped.
+func (api *API) handleImportRoles() http.HandlerFunc {
+	type response struct {
+		Results []console.RoleImportResult `json:"results"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !api.canAccessRoleDefinitions(w, r, true) {
+			return
+		}
+
+		definitions, err := console.ParseRoleDefinitionsYAML(http.MaxBytesReader(w, r.Body, maxRoleDefinitionsImportSize))
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse role definitions: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		if restErr := api.checkRoleMembers(definitions...); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		results, restErr := api.ConsoleSvc.ImportRoleDefinitions(r.Context(), definitions)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, response{Results: results})
+	}
+}
@@ -10,6 +10,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -21,6 +22,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/console"
 	"github.com/redpanda-data/console/backend/pkg/schema"
 )
@@ -385,7 +387,14 @@ func (api *API) handleGetSchemaSubjects() http.HandlerFunc {
 			})
 			return
 		}
-		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+
+		visibleSubjects := make([]console.SchemaRegistrySubject, 0, len(res))
+		for _, subject := range res {
+			if api.checkSchemaSubjectPermission(r.Context(), subject.Name, "viewSchemas") == nil {
+				visibleSubjects = append(visibleSubjects, subject)
+			}
+		}
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, visibleSubjects)
 	}
 }
 
@@ -413,6 +422,10 @@ func (api *API) handleGetSchemaSubjectDetails() http.HandlerFunc {
 
 		// 1. Parse request params
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "viewSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		// 2. Parse and validate version input
 		version := rest.GetURLParam(r, "version")
@@ -483,6 +496,10 @@ func (api *API) handleGetSchemaReferencedBy() http.HandlerFunc {
 
 		// 1. Parse request params
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "viewSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		// 2. Parse and validate version input
 		version := rest.GetURLParam(r, "version")
@@ -553,6 +570,10 @@ func (api *API) handleDeleteSubject() http.HandlerFunc {
 
 		// 1. Parse request parameters
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "deleteSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		deletePermanentlyStr := rest.GetQueryParam(r, "permanent")
 		if deletePermanentlyStr == "" {
@@ -569,34 +590,49 @@ func (api *API) handleDeleteSubject() http.HandlerFunc {
 			return
 		}
 
-		// 2. Send delete request
-		res, err := api.ConsoleSvc.DeleteSchemaRegistrySubject(r.Context(), subjectName, deletePermanently)
-		if err != nil {
-			var schemaError *schema.RestError
-			if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeSubjectNotFound {
-				rest.SendRESTError(w, r, api.Logger, &rest.Error{
-					Err:      err,
-					Status:   http.StatusNotFound,
-					Message:  "Requested subject does not exist",
-					IsSilent: false,
-				})
-				return
-			}
-
-			rest.SendRESTError(w, r, api.Logger, &rest.Error{
-				Err:          err,
-				Status:       http.StatusServiceUnavailable,
-				Message:      fmt.Sprintf("Failed to delete schema registry subject: %v", err.Error()),
-				InternalLogs: []zapcore.Field{zap.String("subject_name", subjectName)},
-				IsSilent:     false,
+		if api.requiresApproval(config.AuthApprovalsOperationDeleteSchemaSubject) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationDeleteSchemaSubject, deleteSchemaSubjectPayload{
+				Subject:   subjectName,
+				Permanent: deletePermanently,
 			})
 			return
 		}
 
+		// 2. Send delete request
+		res, restErr := api.deleteSchemaSubject(r.Context(), subjectName, deletePermanently)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
 		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
 	}
 }
 
+func (api *API) deleteSchemaSubject(ctx context.Context, subjectName string, deletePermanently bool) (*console.SchemaRegistryDeleteSubjectResponse, *rest.Error) {
+	res, err := api.ConsoleSvc.DeleteSchemaRegistrySubject(ctx, subjectName, deletePermanently)
+	if err != nil {
+		var schemaError *schema.RestError
+		if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeSubjectNotFound {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotFound,
+				Message:  "Requested subject does not exist",
+				IsSilent: false,
+			}
+		}
+
+		return nil, &rest.Error{
+			Err:          err,
+			Status:       http.StatusServiceUnavailable,
+			Message:      fmt.Sprintf("Failed to delete schema registry subject: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("subject_name", subjectName)},
+			IsSilent:     false,
+		}
+	}
+	return res, nil
+}
+
 func (api *API) handleDeleteSubjectVersion() http.HandlerFunc {
 	if !api.Cfg.Kafka.Schema.Enabled {
 		return api.handleSchemaRegistryNotConfigured()
@@ -621,6 +657,10 @@ func (api *API) handleDeleteSubjectVersion() http.HandlerFunc {
 
 		// 1. Parse request parameters
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "deleteSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		version := rest.GetURLParam(r, "version")
 		switch version {
@@ -655,46 +695,61 @@ func (api *API) handleDeleteSubjectVersion() http.HandlerFunc {
 			return
 		}
 
-		// 2. Send delete request
-		res, err := api.ConsoleSvc.DeleteSchemaRegistrySubjectVersion(r.Context(), subjectName, version, deletePermanently)
-		if err != nil {
-			var schemaError *schema.RestError
-			if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeSubjectNotFound {
-				rest.SendRESTError(w, r, api.Logger, &rest.Error{
-					Err:      err,
-					Status:   http.StatusNotFound,
-					Message:  "Requested subject does not exist",
-					IsSilent: false,
-				})
-				return
-			}
-			if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeVersionNotFound {
-				rest.SendRESTError(w, r, api.Logger, &rest.Error{
-					Err:      err,
-					Status:   http.StatusNotFound,
-					Message:  "Requested version does not exist on the given subject",
-					IsSilent: false,
-				})
-				return
-			}
-
-			rest.SendRESTError(w, r, api.Logger, &rest.Error{
-				Err:     err,
-				Status:  http.StatusServiceUnavailable,
-				Message: fmt.Sprintf("Failed to delete schema registry subject version: %v", err.Error()),
-				InternalLogs: []zapcore.Field{
-					zap.String("subject_name", subjectName),
-					zap.String("version", version),
-				},
-				IsSilent: false,
+		if api.requiresApproval(config.AuthApprovalsOperationDeleteSchemaSubjectVersion) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationDeleteSchemaSubjectVersion, deleteSchemaSubjectVersionPayload{
+				Subject:   subjectName,
+				Version:   version,
+				Permanent: deletePermanently,
 			})
 			return
 		}
 
+		// 2. Send delete request
+		res, restErr := api.deleteSchemaSubjectVersion(r.Context(), subjectName, version, deletePermanently)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
 		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
 	}
 }
 
+func (api *API) deleteSchemaSubjectVersion(ctx context.Context, subjectName, version string, deletePermanently bool) (*console.SchemaRegistryDeleteSubjectVersionResponse, *rest.Error) {
+	res, err := api.ConsoleSvc.DeleteSchemaRegistrySubjectVersion(ctx, subjectName, version, deletePermanently)
+	if err != nil {
+		var schemaError *schema.RestError
+		if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeSubjectNotFound {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotFound,
+				Message:  "Requested subject does not exist",
+				IsSilent: false,
+			}
+		}
+		if errors.As(err, &schemaError) && schemaError.ErrorCode == schema.CodeVersionNotFound {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusNotFound,
+				Message:  "Requested version does not exist on the given subject",
+				IsSilent: false,
+			}
+		}
+
+		return nil, &rest.Error{
+			Err:     err,
+			Status:  http.StatusServiceUnavailable,
+			Message: fmt.Sprintf("Failed to delete schema registry subject version: %v", err.Error()),
+			InternalLogs: []zapcore.Field{
+				zap.String("subject_name", subjectName),
+				zap.String("version", version),
+			},
+			IsSilent: false,
+		}
+	}
+	return res, nil
+}
+
 func (api *API) handleCreateSchema() http.HandlerFunc {
 	if !api.Cfg.Kafka.Schema.Enabled {
 		return api.handleSchemaRegistryNotConfigured()
@@ -719,6 +774,10 @@ func (api *API) handleCreateSchema() http.HandlerFunc {
 
 		// 1. Parse request parameters
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "createSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		var payload schema.Schema
 		restErr = rest.Decode(w, r, &payload)
@@ -778,6 +837,10 @@ func (api *API) handleValidateSchema() http.HandlerFunc {
 
 		// 1. Parse request parameters
 		subjectName := getSubjectFromRequestPath(r)
+		if restErr := api.checkSchemaSubjectPermission(r.Context(), subjectName, "viewSchemas"); restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
 
 		version := rest.GetURLParam(r, "version")
 		switch version {
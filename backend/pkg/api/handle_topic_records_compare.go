@@ -0,0 +1,120 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+
+	"github.com/redpanda-data/console/backend/pkg/api/httptypes"
+	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+type compareRecordsRequest struct {
+	Left  console.RecordReference `json:"left"`
+	Right console.RecordReference `json:"right"`
+
+	// KeyDeserializer and ValueDeserializer enforce the encoding that is used to deserialize
+	// both records. If empty, the encoding is detected automatically.
+	KeyDeserializer   serde.PayloadEncoding `json:"keyDeserializer"`
+	ValueDeserializer serde.PayloadEncoding `json:"valueDeserializer"`
+
+	// IgnoredFields are the field paths that shall be excluded from the comparison,
+	// for example "value.metadata.updatedAt". If not set, the configured defaults are used.
+	IgnoredFields []string `json:"ignoredFields"`
+}
+
+// OK validates the user input for the compare records request.
+func (c *compareRecordsRequest) OK() error {
+	for _, ref := range []console.RecordReference{c.Left, c.Right} {
+		if ref.TopicName == "" {
+			return errors.New("topic name is required for both records")
+		}
+		if ref.PartitionID < 0 {
+			return fmt.Errorf("partition id must not be negative")
+		}
+		if ref.Offset < 0 {
+			return fmt.Errorf("offset must not be negative")
+		}
+	}
+
+	for _, field := range c.IgnoredFields {
+		if field == "" {
+			return errors.New("ignored fields must not be empty")
+		}
+	}
+
+	return nil
+}
+
+func (api *API) handleCompareTopicsRecords() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. Parse and validate request
+		var req compareRecordsRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		// 2. Check if logged-in user is allowed to view the messages of both records
+		for _, ref := range []console.RecordReference{req.Left, req.Right} {
+			lmq := httptypes.ListMessagesRequest{
+				TopicName:   ref.TopicName,
+				StartOffset: ref.Offset,
+				PartitionID: ref.PartitionID,
+				MaxResults:  1,
+			}
+			canViewMessages, restErr := api.Hooks.Authorization.CanViewTopicMessages(r.Context(), &lmq)
+			if restErr != nil {
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+			if !canViewMessages {
+				restErr := &rest.Error{
+					Err:      fmt.Errorf("requester has no permissions to view messages in topic '%v'", ref.TopicName),
+					Status:   http.StatusForbidden,
+					Message:  fmt.Sprintf("You don't have permissions to view messages in topic '%v'", ref.TopicName),
+					IsSilent: false,
+				}
+				rest.SendRESTError(w, r, api.Logger, restErr)
+				return
+			}
+
+			api.Hooks.Authorization.PrintListMessagesAuditLog(r.Context(), r, &console.ListMessageRequest{
+				TopicName:         ref.TopicName,
+				PartitionID:       ref.PartitionID,
+				StartOffset:       ref.Offset,
+				MessageCount:      1,
+				KeyDeserializer:   req.KeyDeserializer,
+				ValueDeserializer: req.ValueDeserializer,
+			})
+		}
+
+		// 3. Fetch and compare both records
+		res, restErr := api.ConsoleSvc.CompareRecords(r.Context(), console.CompareRecordsRequest{
+			Left:              req.Left,
+			Right:             req.Right,
+			KeyDeserializer:   req.KeyDeserializer,
+			ValueDeserializer: req.ValueDeserializer,
+			IgnoredFields:     req.IgnoredFields,
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/gorilla/schema"
+
+	"github.com/redpanda-data/console/backend/pkg/api/httptypes"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/console"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+type lookupRecordsKey struct {
+	// Encoding that shall be used to serialize the key. Defaults to "text".
+	Encoding serde.PayloadEncoding `json:"encoding"`
+
+	// Data is the key that shall be serialized. For the binary encoding this
+	// must be base64 encoded.
+	Data string `json:"data"`
+
+	// SchemaID is the schema registry schema id that shall be used to serialize
+	// the key if a schema registry encoding is used.
+	SchemaID uint32 `json:"schemaId"`
+
+	// Index is the index of the message type in the protobuf schema.
+	Index []int `json:"index"`
+}
+
+type lookupRecordsByKeyRequest struct {
+	Key lookupRecordsKey `json:"key"`
+
+	// Partitioner that has been used to produce the records. If not set, the
+	// configured partitioner for the topic is used.
+	Partitioner string `json:"partitioner"`
+
+	// MaxResults is the maximum number of records (latest value plus history). Defaults to 20.
+	MaxResults int `json:"maxResults"`
+
+	Troubleshoot       bool                  `json:"troubleshoot"`
+	IncludeRawPayload  bool                  `json:"includeRawPayload"`
+	IgnoreMaxSizeLimit bool                  `json:"ignoreMaxSizeLimit"`
+	KeyDeserializer    serde.PayloadEncoding `json:"keyDeserializer"`
+	ValueDeserializer  serde.PayloadEncoding `json:"valueDeserializer"`
+}
+
+// OK validates the user input for the lookup records by key request.
+func (l *lookupRecordsByKeyRequest) OK() error {
+	if l.Key.Encoding == serde.PayloadEncodingNull {
+		return errors.New("records with a null key can not be looked up by key")
+	}
+	if l.Partitioner != "" && !slices.Contains(config.KeyPartitioners, l.Partitioner) {
+		return fmt.Errorf("partitioner %q is not supported, must be one of: %v", l.Partitioner, config.KeyPartitioners)
+	}
+	if l.MaxResults < 0 || l.MaxResults > 500 {
+		return errors.New("max results must be between 1 and 500")
+	}
+
+	return nil
+}
+
+// PayloadInput returns the key as input for the serde service.
+func (l *lookupRecordsKey) PayloadInput() (serde.RecordPayloadInput, error) {
+	encoding := l.Encoding
+	if encoding == "" {
+		encoding = serde.PayloadEncodingText
+	}
+
+	input := serde.RecordPayloadInput{
+		Payload:  l.Data,
+		Encoding: encoding,
+	}
+	if encoding == serde.PayloadEncodingBinary {
+		data, err := base64.StdEncoding.DecodeString(l.Data)
+		if err != nil {
+			return input, fmt.Errorf("failed to decode base64 encoded binary key: %w", err)
+		}
+		input.Payload = data
+	}
+	if l.SchemaID != 0 {
+		input.Options = append(input.Options, serde.WithSchemaID(l.SchemaID))
+	}
+	if len(l.Index) > 0 {
+		input.Options = append(input.Options, serde.WithIndex(l.Index...))
+	}
+
+	return input, nil
+}
+
+func (api *API) handleLookupTopicRecordsByKey() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		topicName := rest.GetURLParam(r, "topicName")
+
+		// 1. Parse and validate request
+		var req lookupRecordsByKeyRequest
+		restErr := rest.Decode(w, r, &req)
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+		if req.MaxResults == 0 {
+			req.MaxResults = 20
+		}
+		keyInput, err := req.Key.PayloadInput()
+		if err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  err.Error(),
+				IsSilent: false,
+			})
+			return
+		}
+
+		// 2. Check if logged-in user is allowed to view messages of the topic
+		if !api.checkCanViewTopicMessages(w, r, topicName, req.MaxResults) {
+			return
+		}
+		api.Hooks.Authorization.PrintListMessagesAuditLog(r.Context(), r, &console.ListMessageRequest{
+			TopicName:         topicName,
+			PartitionID:       -1,
+			StartOffset:       console.StartOffsetRecent,
+			MessageCount:      req.MaxResults,
+			KeyDeserializer:   req.KeyDeserializer,
+			ValueDeserializer: req.ValueDeserializer,
+		})
+
+		// 3. Look up records
+		res, restErr := api.ConsoleSvc.LookupRecordsByKey(r.Context(), console.LookupRecordsByKeyRequest{
+			TopicName:   topicName,
+			Key:         keyInput,
+			Partitioner: req.Partitioner,
+			MaxResults:  req.MaxResults,
+			Deserialization: kafka.RecordDeserializationRequest{
+				Troubleshoot:       req.Troubleshoot,
+				IncludeRawPayload:  req.IncludeRawPayload,
+				IgnoreMaxSizeLimit: req.IgnoreMaxSizeLimit,
+				KeyDeserializer:    req.KeyDeserializer,
+				ValueDeserializer:  req.ValueDeserializer,
+			},
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+type listLatestValuesRequest struct {
+	// PageSize is the maximum number of keys per page. Defaults to 50.
+	PageSize int `schema:"pageSize"`
+
+	// PageToken is the nextPageToken of the previous response.
+	PageToken string `schema:"pageToken"`
+
+	Troubleshoot       bool                  `schema:"troubleshoot"`
+	IgnoreMaxSizeLimit bool                  `schema:"ignoreMaxSizeLimit"`
+	KeyDeserializer    serde.PayloadEncoding `schema:"keyDeserializer"`
+	ValueDeserializer  serde.PayloadEncoding `schema:"valueDeserializer"`
+}
+
+func (api *API) handleListTopicLatestValues() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		topicName := rest.GetURLParam(r, "topicName")
+
+		// 1. Parse request from url parameters
+		decoder := schema.NewDecoder()
+		decoder.IgnoreUnknownKeys(true)
+		req := &listLatestValuesRequest{}
+		if err := decoder.Decode(req, r.URL.Query()); err != nil {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Failed to parse request parameters: %v", err.Error()),
+				IsSilent: false,
+			})
+			return
+		}
+		if req.PageSize == 0 {
+			req.PageSize = 50
+		}
+		if req.PageSize < 0 || req.PageSize > 500 {
+			rest.SendRESTError(w, r, api.Logger, &rest.Error{
+				Err:      errors.New("page size must be between 1 and 500"),
+				Status:   http.StatusBadRequest,
+				Message:  "Page size must be between 1 and 500",
+				IsSilent: false,
+			})
+			return
+		}
+
+		// 2. Check if logged-in user is allowed to view messages of the topic
+		if !api.checkCanViewTopicMessages(w, r, topicName, req.PageSize) {
+			return
+		}
+		api.Hooks.Authorization.PrintListMessagesAuditLog(r.Context(), r, &console.ListMessageRequest{
+			TopicName:         topicName,
+			PartitionID:       -1,
+			StartOffset:       console.StartOffsetOldest,
+			MessageCount:      req.PageSize,
+			KeyDeserializer:   req.KeyDeserializer,
+			ValueDeserializer: req.ValueDeserializer,
+		})
+
+		// 3. Materialize latest values
+		res, restErr := api.ConsoleSvc.ListLatestValues(r.Context(), console.ListLatestValuesRequest{
+			TopicName: topicName,
+			PageSize:  req.PageSize,
+			PageToken: req.PageToken,
+			Deserialization: kafka.RecordDeserializationRequest{
+				Troubleshoot:       req.Troubleshoot,
+				IgnoreMaxSizeLimit: req.IgnoreMaxSizeLimit,
+				KeyDeserializer:    req.KeyDeserializer,
+				ValueDeserializer:  req.ValueDeserializer,
+			},
+		})
+		if restErr != nil {
+			rest.SendRESTError(w, r, api.Logger, restErr)
+			return
+		}
+
+		rest.SendResponse(w, r, api.Logger, http.StatusOK, res)
+	}
+}
+
+// checkCanViewTopicMessages checks whether the requester is allowed to view the messages
+// of the given topic. If not, an error response is sent and false is returned.
+func (api *API) checkCanViewTopicMessages(w http.ResponseWriter, r *http.Request, topicName string, maxResults int) bool {
+	lmq := httptypes.ListMessagesRequest{
+		TopicName:   topicName,
+		StartOffset: console.StartOffsetRecent,
+		PartitionID: -1,
+		MaxResults:  maxResults,
+	}
+	canViewMessages, restErr := api.Hooks.Authorization.CanViewTopicMessages(r.Context(), &lmq)
+	if restErr != nil {
+		rest.SendRESTError(w, r, api.Logger, restErr)
+		return false
+	}
+	if !canViewMessages {
+		rest.SendRESTError(w, r, api.Logger, &rest.Error{
+			Err:      fmt.Errorf("requester has no permissions to view messages in topic '%v'", topicName),
+			Status:   http.StatusForbidden,
+			Message:  fmt.Sprintf("You don't have permissions to view messages in topic '%v'", topicName),
+			IsSilent: false,
+		})
+		return false
+	}
+
+	return true
+}
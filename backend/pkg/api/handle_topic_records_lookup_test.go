@@ -0,0 +1,58 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+func TestLookupRecordsKey_PayloadInput(t *testing.T) {
+	t.Run("defaults to text", func(t *testing.T) {
+		key := lookupRecordsKey{Data: "order-1"}
+		input, err := key.PayloadInput()
+		require.NoError(t, err)
+		assert.Equal(t, serde.PayloadEncodingText, input.Encoding)
+		assert.Equal(t, "order-1", input.Payload)
+		assert.Empty(t, input.Options)
+	})
+
+	t.Run("binary keys are base64 decoded", func(t *testing.T) {
+		key := lookupRecordsKey{Encoding: serde.PayloadEncodingBinary, Data: "AAAAKg=="}
+		input, err := key.PayloadInput()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0, 42}, input.Payload)
+	})
+
+	t.Run("invalid base64", func(t *testing.T) {
+		key := lookupRecordsKey{Encoding: serde.PayloadEncodingBinary, Data: "not base64!"}
+		_, err := key.PayloadInput()
+		assert.Error(t, err)
+	})
+
+	t.Run("schema options", func(t *testing.T) {
+		key := lookupRecordsKey{Encoding: serde.PayloadEncodingProtobufSchema, Data: `{"id":1}`, SchemaID: 3, Index: []int{0, 1}}
+		input, err := key.PayloadInput()
+		require.NoError(t, err)
+		assert.Len(t, input.Options, 2)
+	})
+}
+
+func TestLookupRecordsByKeyRequest_OK(t *testing.T) {
+	assert.NoError(t, (&lookupRecordsByKeyRequest{Key: lookupRecordsKey{Data: "a"}}).OK())
+	assert.NoError(t, (&lookupRecordsByKeyRequest{Key: lookupRecordsKey{Data: "a"}, Partitioner: "fnv1a", MaxResults: 10}).OK())
+	assert.Error(t, (&lookupRecordsByKeyRequest{Key: lookupRecordsKey{Encoding: serde.PayloadEncodingNull}}).OK())
+	assert.Error(t, (&lookupRecordsByKeyRequest{Key: lookupRecordsKey{Data: "a"}, Partitioner: "random"}).OK())
+	assert.Error(t, (&lookupRecordsByKeyRequest{Key: lookupRecordsKey{Data: "a"}, MaxResults: 1000}).OK())
+}
@@ -20,6 +20,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/redpanda-data/console/backend/pkg/config"
 	"github.com/redpanda-data/console/backend/pkg/console"
 )
 
@@ -187,6 +188,11 @@ func (api *API) handleDeleteTopic() http.HandlerFunc {
 			return
 		}
 
+		if api.requiresApproval(config.AuthApprovalsOperationDeleteTopic) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationDeleteTopic, deleteTopicPayload{TopicName: topicName})
+			return
+		}
+
 		restErr = api.ConsoleSvc.DeleteTopic(r.Context(), topicName)
 		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
@@ -227,6 +233,20 @@ func (d *deleteTopicRecordsRequest) OK() error {
 	return nil
 }
 
+// toKmsg builds the delete records request for the given topic.
+func (d *deleteTopicRecordsRequest) toKmsg(topicName string) kmsg.DeleteRecordsRequestTopic {
+	deleteReq := kmsg.NewDeleteRecordsRequestTopic()
+	deleteReq.Topic = topicName
+	deleteReq.Partitions = make([]kmsg.DeleteRecordsRequestTopicPartition, len(d.Partitions))
+	for i, partition := range d.Partitions {
+		pReq := kmsg.NewDeleteRecordsRequestTopicPartition()
+		pReq.Partition = partition.Partition
+		pReq.Offset = partition.Offset
+		deleteReq.Partitions[i] = pReq
+	}
+	return deleteReq
+}
+
 func (api *API) handleDeleteTopicRecords() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		topicName := rest.GetURLParam(r, "topicName")
@@ -256,18 +276,16 @@ func (api *API) handleDeleteTopicRecords() http.HandlerFunc {
 			return
 		}
 
-		// 3. Submit delete topic records request
-		deleteReq := kmsg.NewDeleteRecordsRequestTopic()
-		deleteReq.Topic = topicName
-		deleteReq.Partitions = make([]kmsg.DeleteRecordsRequestTopicPartition, len(req.Partitions))
-		for i, partition := range req.Partitions {
-			pReq := kmsg.NewDeleteRecordsRequestTopicPartition()
-			pReq.Partition = partition.Partition
-			pReq.Offset = partition.Offset
-			deleteReq.Partitions[i] = pReq
+		if api.requiresApproval(config.AuthApprovalsOperationDeleteTopicRecords) {
+			api.submitChangeRequest(w, r, config.AuthApprovalsOperationDeleteTopicRecords, deleteTopicRecordsPayload{
+				TopicName:                 topicName,
+				deleteTopicRecordsRequest: req,
+			})
+			return
 		}
 
-		deleteRes, restErr := api.ConsoleSvc.DeleteTopicRecords(r.Context(), deleteReq)
+		// 3. Submit delete topic records request
+		deleteRes, restErr := api.ConsoleSvc.DeleteTopicRecords(r.Context(), req.toKmsg(topicName))
 		if restErr != nil {
 			rest.SendRESTError(w, r, api.Logger, restErr)
 			return
@@ -0,0 +1,330 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	commonv1alpha1 "buf.build/gen/go/redpandadata/common/protocolbuffers/go/redpanda/api/common/v1alpha1"
+	"github.com/cloudhut/common/rest"
+
+	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
+	"github.com/redpanda-data/console/backend/pkg/api/httptypes"
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
+)
+
+// rbacHooks implements the AuthorizationHooks with the role bindings of the RBAC
+// policy file and the scopes of API tokens. The principal is taken from the request
+// context, which is set by the built-in authentication.
+type rbacHooks struct {
+	// defaultHooks provides the hooks that are not related to permissions,
+	// such as the audit log and protected Kafka users.
+	defaultHooks
+
+	// rbacSvc is nil if RBAC is disabled, in which case all users except those
+	// authenticated with an API token are allowed to perform all actions.
+	rbacSvc *rbac.Service
+}
+
+func newRBACHooks(rbacSvc *rbac.Service) *rbacHooks {
+	return &rbacHooks{rbacSvc: rbacSvc}
+}
+
+var errNotAuthenticated = &rest.Error{
+	Err:      errors.New("no principal in request context"),
+	Status:   http.StatusUnauthorized,
+	Message:  "You must be logged in to access this resource",
+	IsSilent: true,
+}
+
+// isAllowed authorizes requests that are authenticated with an API token with the
+// token's scopes only, and all other requests with the RBAC policy.
+func isAllowed(ctx context.Context, rbacSvc *rbac.Service, resourceType rbac.ResourceType, resourceName, action string) (bool, *rest.Error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return false, errNotAuthenticated
+	}
+	if token, ok := apitoken.TokenFromContext(ctx); ok {
+		return token.Scopes.IsAllowed(resourceType, resourceName, action), nil
+	}
+	if rbacSvc == nil {
+		return true, nil
+	}
+	return rbacSvc.IsAllowed(principal, resourceType, resourceName, action), nil
+}
+
+func (h *rbacHooks) isAllowed(ctx context.Context, resourceType rbac.ResourceType, resourceName, action string) (bool, *rest.Error) {
+	return isAllowed(ctx, h.rbacSvc, resourceType, resourceName, action)
+}
+
+func (h *rbacHooks) isAllowedOnAny(ctx context.Context, resourceType rbac.ResourceType, action string) (bool, *rest.Error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return false, errNotAuthenticated
+	}
+	if token, ok := apitoken.TokenFromContext(ctx); ok {
+		return token.Scopes.IsAllowedOnAny(resourceType, action), nil
+	}
+	if h.rbacSvc == nil {
+		return true, nil
+	}
+	return h.rbacSvc.IsAllowedOnAny(principal, resourceType, action), nil
+}
+
+// allowedActions returns the allowed actions, or "all" if every action of the
+// resource type is allowed.
+func (h *rbacHooks) allowedActions(ctx context.Context, resourceType rbac.ResourceType, resourceName string) ([]string, *rest.Error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return nil, errNotAuthenticated
+	}
+	var allowed []string
+	switch token, ok := apitoken.TokenFromContext(ctx); {
+	case ok:
+		allowed = token.Scopes.AllowedActions(resourceType, resourceName)
+	case h.rbacSvc == nil:
+		return []string{"all"}, nil
+	default:
+		allowed = h.rbacSvc.AllowedActions(principal, resourceType, resourceName)
+	}
+	if len(allowed) == len(rbac.Actions(resourceType)) {
+		return []string{"all"}, nil
+	}
+	return allowed, nil
+}
+
+// Topic Hooks
+func (h *rbacHooks) CanSeeTopic(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "seeTopic")
+}
+
+func (h *rbacHooks) CanCreateTopic(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "createTopic")
+}
+
+func (h *rbacHooks) CanEditTopicConfig(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "editConfig")
+}
+
+func (h *rbacHooks) CanDeleteTopic(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "deleteTopic")
+}
+
+func (h *rbacHooks) CanPublishTopicRecords(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "publishRecords")
+}
+
+func (h *rbacHooks) CanDeleteTopicRecords(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "deleteTopicRecords")
+}
+
+func (h *rbacHooks) CanViewTopicPartitions(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "viewPartitions")
+}
+
+func (h *rbacHooks) CanViewTopicConfig(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "viewConfig")
+}
+
+func (h *rbacHooks) CanViewTopicMessages(ctx context.Context, req *httptypes.ListMessagesRequest) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, req.TopicName, "viewMessages")
+}
+
+func (h *rbacHooks) CanUseMessageSearchFilters(ctx context.Context, req *httptypes.ListMessagesRequest) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, req.TopicName, "useSearchFilter")
+}
+
+func (h *rbacHooks) CanViewTopicConsumers(ctx context.Context, topicName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceTopic, topicName, "viewConsumers")
+}
+
+func (h *rbacHooks) AllowedTopicActions(ctx context.Context, topicName string) ([]string, *rest.Error) {
+	return h.allowedActions(ctx, rbac.ResourceTopic, topicName)
+}
+
+// ACL Hooks
+func (h *rbacHooks) CanListACLs(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "listAcls")
+}
+
+func (h *rbacHooks) CanCreateACL(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "createAcls")
+}
+
+func (h *rbacHooks) CanDeleteACL(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "deleteAcls")
+}
+
+// Quotas Hooks
+func (h *rbacHooks) CanListQuotas(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "listQuotas")
+}
+
+// ConsumerGroup Hooks
+func (h *rbacHooks) CanSeeConsumerGroup(ctx context.Context, groupName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConsumerGroup, groupName, "seeConsumerGroup")
+}
+
+func (h *rbacHooks) CanEditConsumerGroup(ctx context.Context, groupName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConsumerGroup, groupName, "editConsumerGroup")
+}
+
+func (h *rbacHooks) CanDeleteConsumerGroup(ctx context.Context, groupName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConsumerGroup, groupName, "deleteConsumerGroup")
+}
+
+func (h *rbacHooks) AllowedConsumerGroupActions(ctx context.Context, groupName string) ([]string, *rest.Error) {
+	return h.allowedActions(ctx, rbac.ResourceConsumerGroup, groupName)
+}
+
+// Operations Hooks
+func (h *rbacHooks) CanPatchPartitionReassignments(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "patchPartitionReassignments")
+}
+
+func (h *rbacHooks) CanPatchConfigs(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "patchConfigs")
+}
+
+// Kafka Connect Hooks
+func (h *rbacHooks) CanViewConnectCluster(ctx context.Context, clusterName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConnectCluster, clusterName, "viewConnectCluster")
+}
+
+func (h *rbacHooks) CanEditConnectCluster(ctx context.Context, clusterName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConnectCluster, clusterName, "editConnectCluster")
+}
+
+func (h *rbacHooks) CanDeleteConnectCluster(ctx context.Context, clusterName string) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceConnectCluster, clusterName, "deleteConnectCluster")
+}
+
+func (h *rbacHooks) AllowedConnectClusterActions(ctx context.Context, clusterName string) ([]string, *rest.Error) {
+	return h.allowedActions(ctx, rbac.ResourceConnectCluster, clusterName)
+}
+
+// Kafka User Hooks
+func (h *rbacHooks) CanListKafkaUsers(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "listKafkaUsers")
+}
+
+func (h *rbacHooks) CanCreateKafkaUsers(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "createKafkaUsers")
+}
+
+func (h *rbacHooks) CanDeleteKafkaUsers(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "deleteKafkaUsers")
+}
+
+// Schema Registry Hooks. The hooks are not scoped to a subject, so they pass if the
+// action is granted on any subject.
+func (h *rbacHooks) CanViewSchemas(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowedOnAny(ctx, rbac.ResourceSubject, "viewSchemas")
+}
+
+func (h *rbacHooks) CanCreateSchemas(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowedOnAny(ctx, rbac.ResourceSubject, "createSchemas")
+}
+
+func (h *rbacHooks) CanDeleteSchemas(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowedOnAny(ctx, rbac.ResourceSubject, "deleteSchemas")
+}
+
+func (h *rbacHooks) CanManageSchemaRegistry(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "manageSchemaRegistry")
+}
+
+// Kafka Role Hooks
+func (h *rbacHooks) CanListRedpandaRoles(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "listRedpandaRoles")
+}
+
+func (h *rbacHooks) CanCreateRedpandaRoles(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "createRedpandaRoles")
+}
+
+func (h *rbacHooks) CanDeleteRedpandaRoles(ctx context.Context) (bool, *rest.Error) {
+	return h.isAllowed(ctx, rbac.ResourceCluster, "", "deleteRedpandaRoles")
+}
+
+// checkSchemaSubjectPermission applies the subject patterns of the RBAC policy or
+// API token scopes. This is required in addition to the schema registry hooks, which
+// are not scoped to a subject. It always passes if neither RBAC nor API tokens are
+// enabled.
+func (api *API) checkSchemaSubjectPermission(ctx context.Context, subjectName, action string) *rest.Error {
+	if api.RBACSvc == nil && api.APITokenSvc == nil {
+		return nil
+	}
+	allowed, restErr := isAllowed(ctx, api.RBACSvc, rbac.ResourceSubject, subjectName, action)
+	if restErr != nil {
+		return restErr
+	}
+	if !allowed {
+		return &rest.Error{
+			Err:      fmt.Errorf("requester has no permission %q on subject %q", action, subjectName),
+			Status:   http.StatusForbidden,
+			Message:  fmt.Sprintf("You don't have permissions to access the subject %q.", subjectName),
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+// checkClusterPermission checks a cluster action of the RBAC policy or API token
+// scopes for requests that are not covered by the authorization hooks. It always
+// passes if neither RBAC nor API tokens are enabled.
+func (api *API) checkClusterPermission(r *http.Request, action string) *rest.Error {
+	return api.checkClusterPermissionContext(r.Context(), action)
+}
+
+// checkClusterPermissionContext is like checkClusterPermission, for callers that
+// have no HTTP request, such as ConnectRPC services.
+func (api *API) checkClusterPermissionContext(ctx context.Context, action string) *rest.Error {
+	if api.RBACSvc == nil && api.APITokenSvc == nil {
+		return nil
+	}
+	allowed, restErr := isAllowed(ctx, api.RBACSvc, rbac.ResourceCluster, "", action)
+	if restErr != nil {
+		return restErr
+	}
+	if !allowed {
+		return &rest.Error{
+			Err:      fmt.Errorf("requester has no permission %q", action),
+			Status:   http.StatusForbidden,
+			Message:  fmt.Sprintf("You don't have the %q permission.", action),
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+// requireClusterPermission is a middleware for HTTP handlers of the Connect API
+// that are not served by ConnectRPC and therefore not covered by the
+// authorization interceptor.
+func (api *API) requireClusterPermission(action string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if restErr := api.checkClusterPermission(r, action); restErr != nil {
+				apierrors.HandleHTTPError(r.Context(), w, r, apierrors.NewConnectError(
+					apierrors.CodeFromHTTPStatus(restErr.Status),
+					restErr.Err,
+					apierrors.NewErrorInfo(commonv1alpha1.Reason_REASON_PERMISSION_DENIED.String()),
+				))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
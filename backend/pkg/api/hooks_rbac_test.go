@@ -0,0 +1,202 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/api/connect/interceptor"
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
+	"github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1/dataplanev1alpha1connect"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
+)
+
+const readOnlyPolicy = `
+roles:
+  - name: viewer
+    permissions:
+      - resource: topic
+        pattern: "orders-.*"
+        actions: ["seeTopic", "viewConfig"]
+      - resource: cluster
+        actions: ["listAcls"]
+roleBindings:
+  - role: viewer
+    users: ["bob"]
+`
+
+type testTopicService struct {
+	dataplanev1alpha1connect.UnimplementedTopicServiceHandler
+}
+
+func (testTopicService) ListTopics(context.Context, *connect.Request[v1alpha1.ListTopicsRequest]) (*connect.Response[v1alpha1.ListTopicsResponse], error) {
+	return connect.NewResponse(&v1alpha1.ListTopicsResponse{Topics: []*v1alpha1.ListTopicsResponse_Topic{
+		{Name: "orders-v1"},
+		{Name: "payments"},
+	}}), nil
+}
+
+func (testTopicService) DeleteTopic(context.Context, *connect.Request[v1alpha1.DeleteTopicRequest]) (*connect.Response[v1alpha1.DeleteTopicResponse], error) {
+	return connect.NewResponse(&v1alpha1.DeleteTopicResponse{}), nil
+}
+
+// newTestAuthorizedServer serves the topic and ACL services with the
+// authorization interceptor for the principal "bob" and the read-only policy.
+func newTestAuthorizedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	policyFilepath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFilepath, []byte(readOnlyPolicy), 0o600))
+	rbacSvc, err := rbac.NewService(config.AuthRBAC{Enabled: true, PolicyFilepath: policyFilepath, RefreshInterval: time.Minute}, zap.NewNop())
+	require.NoError(t, err)
+
+	api := &API{RBACSvc: rbacSvc}
+	return newTestConnectServer(t, api, func(ctx context.Context) context.Context {
+		return auth.ContextWithPrincipal(ctx, &auth.Principal{Name: "bob"})
+	})
+}
+
+// newTestConnectServer serves the topic and ACL services with the authorization
+// interceptor of the API. The context of each request is prepared by withAuth.
+func newTestConnectServer(t *testing.T, api *API, withAuth func(context.Context) context.Context) *httptest.Server {
+	t.Helper()
+
+	in := interceptor.NewAuthorizationInterceptor(newRBACHooks(api.RBACSvc), api.checkClusterPermissionContext)
+	mux := http.NewServeMux()
+	mux.Handle(dataplanev1alpha1connect.NewTopicServiceHandler(testTopicService{}, connect.WithInterceptors(in)))
+	mux.Handle(dataplanev1alpha1connect.NewACLServiceHandler(dataplanev1alpha1connect.UnimplementedACLServiceHandler{}, connect.WithInterceptors(in)))
+	withPrincipal := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r.WithContext(withAuth(r.Context())))
+	})
+
+	server := httptest.NewServer(withPrincipal)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestConnectRPCAuthorization(t *testing.T) {
+	server := newTestAuthorizedServer(t)
+	ctx := context.Background()
+	topicClient := dataplanev1alpha1connect.NewTopicServiceClient(http.DefaultClient, server.URL)
+	aclClient := dataplanev1alpha1connect.NewACLServiceClient(http.DefaultClient, server.URL)
+
+	t.Run("read-only role can not delete topics", func(t *testing.T) {
+		_, err := topicClient.DeleteTopic(ctx, connect.NewRequest(&v1alpha1.DeleteTopicRequest{Name: "orders-v1"}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("read-only role can not create ACLs", func(t *testing.T) {
+		_, err := aclClient.CreateACL(ctx, connect.NewRequest(&v1alpha1.CreateACLRequest{}))
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("read-only role can list ACLs", func(t *testing.T) {
+		_, err := aclClient.ListACLs(ctx, connect.NewRequest(&v1alpha1.ListACLsRequest{}))
+		require.Error(t, err)
+		// The request passes the authorization and reaches the handler
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+
+	t.Run("listed topics are filtered", func(t *testing.T) {
+		res, err := topicClient.ListTopics(ctx, connect.NewRequest(&v1alpha1.ListTopicsRequest{}))
+		require.NoError(t, err)
+		require.Len(t, res.Msg.Topics, 1)
+		assert.Equal(t, "orders-v1", res.Msg.Topics[0].Name)
+	})
+}
+
+func TestConnectRPCAuthorizationWithAPITokensOnly(t *testing.T) {
+	// Without RBAC, the hooks authorize requests with API tokens by their scopes
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	api := &API{APITokenSvc: apitoken.NewService(cfg.APITokens, zap.NewNop(), apitoken.NewMemoryStore(), nil)}
+	token := apitoken.Token{
+		ServiceAccount: "ci",
+		Scopes:         rbac.Scopes{{Resource: rbac.ResourceTopic, Pattern: "orders-.*", Actions: []string{"seeTopic"}}},
+	}
+	require.NoError(t, token.Scopes.Validate())
+	server := newTestConnectServer(t, api, func(ctx context.Context) context.Context {
+		return auth.ContextWithPrincipal(apitoken.ContextWithToken(ctx, token), token.Principal())
+	})
+	ctx := context.Background()
+	topicClient := dataplanev1alpha1connect.NewTopicServiceClient(http.DefaultClient, server.URL)
+	aclClient := dataplanev1alpha1connect.NewACLServiceClient(http.DefaultClient, server.URL)
+
+	_, err := topicClient.DeleteTopic(ctx, connect.NewRequest(&v1alpha1.DeleteTopicRequest{Name: "orders-v1"}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = aclClient.ListACLs(ctx, connect.NewRequest(&v1alpha1.ListACLsRequest{}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	res, err := topicClient.ListTopics(ctx, connect.NewRequest(&v1alpha1.ListTopicsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, res.Msg.Topics, 1)
+	assert.Equal(t, "orders-v1", res.Msg.Topics[0].Name)
+}
+
+func TestRequireClusterPermission(t *testing.T) {
+	policyFilepath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFilepath, []byte(readOnlyPolicy), 0o600))
+	rbacSvc, err := rbac.NewService(config.AuthRBAC{Enabled: true, PolicyFilepath: policyFilepath, RefreshInterval: time.Minute}, zap.NewNop())
+	require.NoError(t, err)
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	api := &API{RBACSvc: rbacSvc, APITokenSvc: apitoken.NewService(cfg.APITokens, zap.NewNop(), apitoken.NewMemoryStore(), rbacSvc)}
+
+	// The deploy transform handler is mounted like this below /api and /v1alpha1
+	deploy := api.requireClusterPermission("manageTransforms")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	deployAs := func(ctx context.Context) int {
+		rec := httptest.NewRecorder()
+		deploy.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/api/transforms", http.NoBody).WithContext(ctx))
+		return rec.Code
+	}
+
+	t.Run("read-only role can not deploy transforms", func(t *testing.T) {
+		ctx := auth.ContextWithPrincipal(context.Background(), &auth.Principal{Name: "bob"})
+		assert.Equal(t, http.StatusForbidden, deployAs(ctx))
+	})
+
+	t.Run("token without transform scope can not deploy transforms", func(t *testing.T) {
+		token := apitoken.Token{
+			ServiceAccount: "ci",
+			Scopes:         rbac.Scopes{{Resource: rbac.ResourceTopic, Pattern: ".*", Actions: []string{"seeTopic"}}},
+		}
+		require.NoError(t, token.Scopes.Validate())
+		ctx := auth.ContextWithPrincipal(apitoken.ContextWithToken(context.Background(), token), token.Principal())
+		assert.Equal(t, http.StatusForbidden, deployAs(ctx))
+	})
+	t.Run("token with transform scope can deploy transforms", func(t *testing.T) {
+		token := apitoken.Token{
+			ServiceAccount: "ci",
+			Scopes:         rbac.Scopes{{Resource: rbac.ResourceCluster, Actions: []string{"manageTransforms"}}},
+		}
+		require.NoError(t, token.Scopes.Validate())
+		ctx := auth.ContextWithPrincipal(apitoken.ContextWithToken(context.Background(), token), token.Principal())
+		assert.Equal(t, http.StatusCreated, deployAs(ctx))
+	})
+}
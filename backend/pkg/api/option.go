@@ -12,6 +12,7 @@ package api
 import (
 	"io/fs"
 
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
 	"github.com/redpanda-data/console/backend/pkg/redpanda"
 )
 
@@ -35,3 +36,13 @@ func WithLicense(license redpanda.License) Option {
 		api.License = license
 	}
 }
+
+// WithAPITokenStore replaces the configured storage of API tokens, e.g. with a
+// database. It has no effect if API tokens are disabled.
+func WithAPITokenStore(store apitoken.Store) Option {
+	return func(api *API) {
+		if api.APITokenSvc != nil {
+			api.APITokenSvc = apitoken.NewService(api.Cfg.Auth.APITokens, api.Logger.Named("api_tokens"), store, api.RBACSvc)
+		}
+	}
+}
@@ -10,6 +10,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 
 	"connectrpc.com/connect"
@@ -32,6 +33,7 @@ import (
 	apierrors "github.com/redpanda-data/console/backend/pkg/api/connect/errors"
 	"github.com/redpanda-data/console/backend/pkg/api/connect/interceptor"
 	apiaclsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/acl"
+	apiapprovalsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/approval"
 	consolesvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/console"
 	apikafkaconnectsvc "github.com/redpanda-data/console/backend/pkg/api/connect/service/kafkaconnect"
 	"github.com/redpanda-data/console/backend/pkg/api/connect/service/rpconnect"
@@ -67,10 +69,20 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 		interceptor.NewRequestValidationInterceptor(v, api.Logger.Named("validator")),
 		interceptor.NewEndpointCheckInterceptor(&api.Cfg.Console.API, api.Logger.Named("endpoint_checker")),
 	}
+	if api.AuthSvc != nil {
+		baseInterceptors = append(baseInterceptors, interceptor.NewAuthenticationInterceptor(api.AuthSvc, api.APITokenSvc))
+	}
+	baseInterceptors = append(baseInterceptors, interceptor.NewAuthorizationInterceptor(api.Hooks.Authorization, api.checkClusterPermissionContext))
+	if api.AuditSvc != nil {
+		baseInterceptors = append(baseInterceptors, interceptor.NewAuditInterceptor(api.AuditSvc))
+	}
 
 	api.Hooks.Route.InitConnectRPCRouter(r)
 
 	r.Use(observerInterceptor.WrapHandler)
+	if api.AuthSvc != nil {
+		r.Use(api.principalContextMiddleware)
+	}
 
 	// Setup gRPC-Gateway
 	gwMux := runtime.NewServeMux(
@@ -98,7 +110,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	userSvc := apiusersvc.NewService(api.Cfg, api.Logger.Named("user_service"), api.RedpandaSvc, api.ConsoleSvc, api.Hooks.Authorization.IsProtectedKafkaUser)
 	aclSvc := apiaclsvc.NewService(api.Cfg, api.Logger.Named("kafka_service"), api.ConsoleSvc)
 	kafkaConnectSvc := apikafkaconnectsvc.NewService(api.Cfg, api.Logger.Named("kafka_connect_service"), api.ConnectSvc)
-	topicSvc := topicsvc.NewService(api.Cfg, api.Logger.Named("topic_service"), api.ConsoleSvc)
+	topicSvc := topicsvc.NewService(api.Cfg, api.Logger.Named("topic_service"), api.ConsoleSvc, api.ApprovalSvc)
 	transformSvc := transformsvc.NewService(api.Cfg, api.Logger.Named("transform_service"), api.RedpandaSvc, v)
 	consoleSvc := consolesvc.NewService(api.Logger.Named("console_service"), api.ConsoleSvc, api.Hooks.Authorization)
 	securitySvc := consolev1alpha1connect.UnimplementedSecurityServiceHandler{}
@@ -107,6 +119,12 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 		api.Logger.Fatal("failed to create redpanda connect service", zap.Error(err))
 	}
 	consoleTransformSvc := &transformsvc.ConsoleService{Impl: transformSvc}
+	var approvalSvc consolev1alpha1connect.ApprovalServiceHandler = consolev1alpha1connect.UnimplementedApprovalServiceHandler{}
+	if api.ApprovalSvc != nil {
+		approvalSvc = apiapprovalsvc.NewService(api.Logger.Named("approval_service"), api.ApprovalSvc, func(ctx context.Context) bool {
+			return api.checkClusterPermissionContext(ctx, "approveChanges") == nil
+		})
+	}
 
 	// Call Hook
 	hookOutput := api.Hooks.Route.ConfigConnectRPC(ConfigConnectRPCRequest{
@@ -122,6 +140,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 			consolev1alpha1connect.SecurityServiceName:        securitySvc,
 			consolev1alpha1connect.RedpandaConnectServiceName: rpConnectSvc,
 			consolev1alpha1connect.TransformServiceName:       consoleTransformSvc,
+			consolev1alpha1connect.ApprovalServiceName:        approvalSvc,
 		},
 	})
 
@@ -132,7 +151,7 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	r.Mount("/v1alpha1", gwMux) // Dataplane API
 
 	// Wasm Transforms
-	r.Put("/v1alpha1/transforms", transformSvc.HandleDeployTransform())
+	r.With(api.requireClusterPermission("manageTransforms")).Put("/v1alpha1/transforms", transformSvc.HandleDeployTransform())
 
 	userSvcPath, userSvcHandler := dataplanev1alpha1connect.NewUserServiceHandler(
 		hookOutput.Services[dataplanev1alpha1connect.UserServiceName].(dataplanev1alpha1connect.UserServiceHandler),
@@ -161,6 +180,9 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 	consoleTransformSvcPath, consoleTransformSvcHandler := consolev1alpha1connect.NewTransformServiceHandler(
 		hookOutput.Services[consolev1alpha1connect.TransformServiceName].(consolev1alpha1connect.TransformServiceHandler),
 		connect.WithInterceptors(hookOutput.Interceptors...))
+	approvalSvcPath, approvalSvcHandler := consolev1alpha1connect.NewApprovalServiceHandler(
+		hookOutput.Services[consolev1alpha1connect.ApprovalServiceName].(consolev1alpha1connect.ApprovalServiceHandler),
+		connect.WithInterceptors(hookOutput.Interceptors...))
 
 	ossServices := []ConnectService{
 		{
@@ -208,6 +230,11 @@ func (api *API) setupConnectWithGRPCGateway(r chi.Router) {
 			MountPath:   consoleTransformSvcPath,
 			Handler:     consoleTransformSvcHandler,
 		},
+		{
+			ServiceName: consolev1alpha1connect.ApprovalServiceName,
+			MountPath:   approvalSvcPath,
+			Handler:     approvalSvcHandler,
+		},
 	}
 
 	// Order matters. OSS services first, so Enterprise handlers override OSS.
@@ -312,12 +339,49 @@ func (api *API) routes() *chi.Mux {
 			})
 		}
 
+		// Login routes of the built-in authentication
+		if api.AuthSvc != nil {
+			router.Route("/auth", func(r chi.Router) {
+				r.Get("/methods", api.handleGetLoginMethods())
+				r.Post("/login/basic", api.handleLoginBasic())
+				r.Get("/login/oidc", api.handleLoginOIDC())
+				r.Get("/callbacks/oidc", api.handleOIDCCallback())
+				r.Get("/logout", api.handleLogout())
+			})
+		}
+
 		// API routes
 		router.Group(func(r chi.Router) {
 			r.Use(createSetVersionInfoHeader(version.BuiltAt))
 			api.Hooks.Route.ConfigAPIRouter(r)
 
 			r.Route("/api", func(r chi.Router) {
+				if api.AuthSvc != nil {
+					r.Use(api.authenticationMiddleware)
+				}
+				if api.AuditSvc != nil {
+					r.Use(api.auditMiddleware)
+					r.Get("/audit-log", api.handleQueryAuditLog())
+				}
+				if api.AuthSvc != nil {
+					r.Get("/users/me", api.handleGetCurrentPrincipal())
+				}
+				if api.RBACSvc != nil {
+					r.Get("/authorization/explain", api.handleExplainAuthorization())
+				}
+				if api.APITokenSvc != nil {
+					r.Get("/api-tokens", api.handleListAPITokens())
+					r.Post("/api-tokens", api.handleCreateAPIToken())
+					r.Delete("/api-tokens/{tokenId}", api.handleRevokeAPIToken())
+				}
+				if api.ApprovalSvc != nil {
+					r.Get("/change-requests", api.handleListChangeRequests())
+					r.Post("/change-requests", api.handleCreateChangeRequest())
+					r.Get("/change-requests/{changeRequestId}", api.handleGetChangeRequest())
+					r.Post("/change-requests/{changeRequestId}/approve", api.handleApproveChangeRequest())
+					r.Post("/change-requests/{changeRequestId}/reject", api.handleRejectChangeRequest())
+				}
+
 				// Overview
 				r.Get("/cluster/overview", api.handleOverview())
 				r.Get("/cluster", api.handleDescribeCluster())
@@ -329,16 +393,36 @@ func (api *API) routes() *chi.Mux {
 				r.Get("/acls", api.handleGetACLsOverview())
 				r.Post("/acls", api.handleCreateACL())
 				r.Delete("/acls", api.handleDeleteACLs())
+				r.Post("/acls/simulate", api.handleSimulateACLAccess())
+				r.Get("/acls/access-report", api.handleGetPrincipalAccessReport())
+				r.Get("/acls/analysis", api.handleAnalyzeACLs())
+				r.Post("/acls/delete-plan", api.handleExecuteACLDeletePlan())
+
+				// Redpanda roles with their ACLs
+				r.Post("/roles/definitions", api.handleCreateRoleWithACLs())
+				r.Get("/roles/diff", api.handleDiffRoles())
+				r.Get("/roles/export", api.handleExportRoles())
+				r.Post("/roles/import", api.handleImportRoles())
+				r.Get("/roles/{roleName}/definition", api.handleGetRoleDefinition())
+				r.Post("/roles/{roleName}/clone", api.handleCloneRole())
 
 				// Kafka Users/Principals
 				r.Get("/users", api.handleGetUsers())
 				r.Post("/users", api.handleCreateUser())
 				r.Delete("/users/{principalID}", api.handleDeleteUser())
+				if api.CredentialSvc != nil {
+					r.Get("/user-credentials", api.handleGetCredentialReport())
+					r.Post("/user-credentials", api.handleCreateCredential())
+					r.Delete("/user-credentials/{username}", api.handleDeleteCredential())
+					r.Post("/user-credentials/{username}/rotate", api.handleRotateCredential())
+					r.Post("/user-credentials/{username}/complete-rotation", api.handleCompleteCredentialRotation())
+				}
 
 				// Topics
 				r.Get("/topics-configs", api.handleGetTopicsConfigs())
 				r.Get("/topics-offsets", api.handleGetTopicsOffsets())
 				r.Post("/topics-records", api.handlePublishTopicsRecords())
+				r.Post("/topics-records/compare", api.handleCompareTopicsRecords())
 				r.Get("/topics", api.handleGetTopics())
 				r.Post("/topics", api.handleCreateTopic())
 				r.Delete("/topics/{topicName}", api.handleDeleteTopic())
@@ -348,6 +432,8 @@ func (api *API) routes() *chi.Mux {
 				r.Patch("/topics/{topicName}/configuration", api.handleEditTopicConfig())
 				r.Get("/topics/{topicName}/consumers", api.handleGetTopicConsumers())
 				r.Get("/topics/{topicName}/documentation", api.handleGetTopicDocumentation())
+				r.Post("/topics/{topicName}/records/lookup", api.handleLookupTopicRecordsByKey())
+				r.Get("/topics/{topicName}/records/latest", api.handleListTopicLatestValues())
 
 				// Quotas
 				r.Get("/quotas", api.handleGetQuotas())
@@ -359,6 +445,11 @@ func (api *API) routes() *chi.Mux {
 				r.Delete("/consumer-groups/{groupId}/offsets", api.handleDeleteConsumerGroupOffsets())
 				r.Delete("/consumer-groups/{groupId}", api.handleDeleteConsumerGroup())
 
+				// MirrorMaker 2
+				r.Get("/mirror-maker", api.handleGetMirrorMakerOverview())
+				r.Get("/mirror-maker/{sourceClusterAlias}/consumer-groups/{groupId}/offsets", api.handleTranslateConsumerGroupOffsets())
+				r.Post("/mirror-maker/{sourceClusterAlias}/consumer-groups/{groupId}/offsets/apply", api.handleApplyTranslatedConsumerGroupOffsets())
+
 				// Bulk Operations
 				r.Get("/operations/topic-details", api.handleGetAllTopicDetails())
 				r.Get("/operations/reassign-partitions", api.handleGetPartitionReassignments())
@@ -383,12 +474,29 @@ func (api *API) routes() *chi.Mux {
 
 				// Kafka Connect
 				r.Get("/kafka-connect/connectors", api.handleGetConnectors())
+				r.Get("/kafka-connect/connector-plugins", api.handleGetPluginCatalog())
+				r.Get("/kafka-connect/connector-plugins/{pluginClassName}/config/compare", api.handleComparePluginConfigDefinitions())
+				r.Get("/kafka-connect/cluster-registrations", api.handleListConnectClusterRegistrations())
+				r.Post("/kafka-connect/cluster-registrations", api.handleRegisterConnectCluster())
+				r.Put("/kafka-connect/cluster-registrations/{clusterName}", api.handleUpdateConnectCluster())
+				r.Delete("/kafka-connect/cluster-registrations/{clusterName}", api.handleRemoveConnectCluster())
+				r.Get("/kafka-connect/templates", api.handleListConnectorTemplates())
+				r.Get("/kafka-connect/templates/{templateName}", api.handleGetConnectorTemplate())
+				r.Put("/kafka-connect/templates/{templateName}", api.handlePutConnectorTemplate())
+				r.Delete("/kafka-connect/templates/{templateName}", api.handleDeleteConnectorTemplate())
+				r.Post("/kafka-connect/templates/{templateName}/apply", api.handleApplyConnectorTemplate())
 				r.Get("/kafka-connect/clusters/{clusterName}", api.handleGetClusterInfo())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors", api.handleGetClusterConnectors())
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors", api.handleCreateConnector())
 				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleGetConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handlePutConnectorConfig())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/revisions", api.handleListConnectorConfigRevisions())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/revisions/compare", api.handleCompareConnectorConfigRevisions())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/revisions/{revision}/rollback", api.handleRollbackConnectorConfig())
+				r.Get("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dead-letter-queue", api.handleListConnectorDeadLetterRecords())
+				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/dead-letter-queue/replay", api.handleReplayConnectorDeadLetterRecords())
 				r.Put("/kafka-connect/clusters/{clusterName}/connector-plugins/{pluginClassName}/config/validate", api.handlePutValidateConnectorConfig())
+				r.Get("/kafka-connect/clusters/{clusterName}/connector-plugins/{pluginClassName}/config", api.handleGetPluginConfigDefinitions())
 				r.Delete("/kafka-connect/clusters/{clusterName}/connectors/{connector}", api.handleDeleteConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/pause", api.handlePauseConnector())
 				r.Put("/kafka-connect/clusters/{clusterName}/connectors/{connector}/resume", api.handleResumeConnector())
@@ -396,7 +504,7 @@ func (api *API) routes() *chi.Mux {
 				r.Post("/kafka-connect/clusters/{clusterName}/connectors/{connector}/tasks/{taskID}/restart", api.handleRestartConnectorTask())
 
 				// Wasm Transforms
-				r.Put("/transforms", transformSvc.HandleDeployTransform())
+				r.With(api.requireClusterPermission("manageTransforms")).Put("/transforms", transformSvc.HandleDeployTransform())
 
 				// Console Endpoints that inform which endpoints & features are available to the frontend.
 				r.Get("/console/endpoints", api.handleGetEndpoints())
@@ -0,0 +1,273 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package apitoken implements scoped API tokens of service accounts. Scripts pass
+// a token as bearer token instead of logging in, and are authorized with the scopes
+// of the token only.
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
+)
+
+// tokenPrefix is prepended to all issued tokens, so that they are easy to recognize,
+// e.g. by secret scanners.
+const tokenPrefix = "cnsl_"
+
+var (
+	// ErrInvalidToken is returned if a bearer token is malformed, unknown or revoked.
+	ErrInvalidToken = errors.New("invalid api token")
+	// ErrTokenExpired is returned if a bearer token has expired.
+	ErrTokenExpired = errors.New("api token has expired")
+	// ErrNoBearerToken is returned if a request carries no bearer token.
+	ErrNoBearerToken = errors.New("no bearer token")
+	// ErrInvalidRequest is returned if a token can not be created as requested.
+	ErrInvalidRequest = errors.New("invalid api token request")
+	// ErrScopeNotGranted is returned if a token is requested with a scope that
+	// the creator does not have.
+	ErrScopeNotGranted = errors.New("api token scope is not granted to the creator")
+)
+
+// Token describes an API token without its secret.
+type Token struct {
+	ID string `json:"id"`
+	// ServiceAccount is the name of the principal that requests authenticated
+	// with the token are made as.
+	ServiceAccount string      `json:"serviceAccount"`
+	Description    string      `json:"description"`
+	Scopes         rbac.Scopes `json:"scopes"`
+	CreatedBy      string      `json:"createdBy"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	ExpiresAt      time.Time   `json:"expiresAt"`
+}
+
+// IsExpired returns true if the token can no longer be used.
+func (t *Token) IsExpired() bool {
+	return !time.Now().Before(t.ExpiresAt)
+}
+
+// Principal returns the principal of requests that are authenticated with the token.
+func (t *Token) Principal() *auth.Principal {
+	return &auth.Principal{Name: t.ServiceAccount, Groups: []string{}, Provider: auth.ProviderAPIToken}
+}
+
+// CreateTokenRequest is the request to create a new token.
+type CreateTokenRequest struct {
+	ServiceAccount string      `json:"serviceAccount"`
+	Description    string      `json:"description"`
+	Scopes         rbac.Scopes `json:"scopes"`
+	// ExpiresAt is optional. If unset, the configured default expiry is used.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Service creates, lists, revokes and authenticates API tokens.
+type Service struct {
+	cfg    config.AuthAPITokens
+	logger *zap.Logger
+	store  Store
+	// rbacSvc is used to check that creators only delegate permissions they
+	// have. It is nil if RBAC is disabled, in which case all users have all
+	// permissions.
+	rbacSvc *rbac.Service
+}
+
+// NewService creates the API token service with the given store. The RBAC
+// service may be nil.
+func NewService(cfg config.AuthAPITokens, logger *zap.Logger, store Store, rbacSvc *rbac.Service) *Service {
+	return &Service{
+		cfg:     cfg,
+		logger:  logger,
+		store:   store,
+		rbacSvc: rbacSvc,
+	}
+}
+
+// NewStore creates the configured token store.
+func NewStore(cfg config.AuthAPITokens) (Store, error) {
+	switch cfg.Storage {
+	case config.AuthAPITokensStorageFile:
+		return NewFileStore(cfg.FilePath)
+	default:
+		return NewMemoryStore(), nil
+	}
+}
+
+// Create issues a new token on behalf of the creator. The creator must have all
+// permissions of the requested scopes, otherwise ErrScopeNotGranted is returned.
+// The returned secret is the bearer token and can not be retrieved again.
+func (s *Service) Create(ctx context.Context, creator *auth.Principal, req CreateTokenRequest) (Token, string, error) {
+	if req.ServiceAccount == "" {
+		return Token{}, "", fmt.Errorf("%w: service account must be set", ErrInvalidRequest)
+	}
+	if err := req.Scopes.Validate(); err != nil {
+		return Token{}, "", fmt.Errorf("%w: invalid scopes: %v", ErrInvalidRequest, err)
+	}
+	if s.rbacSvc != nil {
+		for i, scope := range req.Scopes {
+			if !s.rbacSvc.Grants(creator, scope) {
+				return Token{}, "", fmt.Errorf("%w: scope at index %d grants actions on %v resources that %q does not have",
+					ErrScopeNotGranted, i, scope.Resource, creator.Name)
+			}
+		}
+	}
+
+	now := time.Now()
+	expiresAt := req.ExpiresAt
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(s.cfg.DefaultExpiry)
+	}
+	if !expiresAt.After(now) {
+		return Token{}, "", fmt.Errorf("%w: expiry must be in the future", ErrInvalidRequest)
+	}
+	if expiresAt.After(now.Add(s.cfg.MaxExpiry)) {
+		return Token{}, "", fmt.Errorf("%w: expiry must not be later than %v from now", ErrInvalidRequest, s.cfg.MaxExpiry)
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return Token{}, "", err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return Token{}, "", err
+	}
+
+	token := Token{
+		ID:             id,
+		ServiceAccount: req.ServiceAccount,
+		Description:    req.Description,
+		Scopes:         req.Scopes,
+		CreatedBy:      creator.Name,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+	}
+	if err := s.store.Create(ctx, StoredToken{Token: token, SecretHash: hashSecret(secret)}); err != nil {
+		return Token{}, "", fmt.Errorf("failed to store api token: %w", err)
+	}
+	s.logger.Info("created api token",
+		zap.String("token_id", id),
+		zap.String("service_account", req.ServiceAccount),
+		zap.String("created_by", creator.Name),
+		zap.Time("expires_at", expiresAt))
+
+	return token, tokenPrefix + id + "_" + secret, nil
+}
+
+// List returns all tokens, including expired tokens. If serviceAccount is not
+// empty, only tokens of that service account are returned.
+func (s *Service) List(ctx context.Context, serviceAccount string) ([]Token, error) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api tokens: %w", err)
+	}
+	tokens := make([]Token, 0, len(stored))
+	for _, token := range stored {
+		if serviceAccount == "" || token.ServiceAccount == serviceAccount {
+			tokens = append(tokens, token.Token)
+		}
+	}
+	return tokens, nil
+}
+
+// Revoke deletes the token, so that it can no longer be used. It returns
+// ErrNotFound if the token does not exist.
+func (s *Service) Revoke(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.logger.Info("revoked api token", zap.String("token_id", id))
+	return nil
+}
+
+// Authenticate returns the token that belongs to the bearer token. It returns
+// ErrInvalidToken if the bearer token is not known and ErrTokenExpired if it has
+// expired.
+func (s *Service) Authenticate(ctx context.Context, bearerToken string) (Token, error) {
+	id, secret, found := strings.Cut(strings.TrimPrefix(bearerToken, tokenPrefix), "_")
+	if !found || !strings.HasPrefix(bearerToken, tokenPrefix) {
+		return Token{}, ErrInvalidToken
+	}
+
+	stored, err := s.store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Token{}, ErrInvalidToken
+		}
+		return Token{}, fmt.Errorf("failed to get api token: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(stored.SecretHash)) != 1 {
+		return Token{}, ErrInvalidToken
+	}
+	if stored.IsExpired() {
+		return Token{}, ErrTokenExpired
+	}
+	if err := stored.Scopes.Validate(); err != nil {
+		return Token{}, fmt.Errorf("stored api token %q has invalid scopes: %w", id, err)
+	}
+
+	return stored.Token, nil
+}
+
+// AuthenticateRequest authenticates the bearer token of the request headers and
+// returns a copy of ctx that carries the token and its principal. It returns
+// ErrNoBearerToken if the request has no bearer token.
+func (s *Service) AuthenticateRequest(ctx context.Context, header http.Header) (context.Context, error) {
+	bearerToken, found := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !found {
+		return ctx, ErrNoBearerToken
+	}
+	token, err := s.Authenticate(ctx, bearerToken)
+	if err != nil {
+		return ctx, err
+	}
+	return auth.ContextWithPrincipal(ContextWithToken(ctx, token), token.Principal()), nil
+}
+
+type tokenCtxKey struct{}
+
+// ContextWithToken returns a copy of ctx that carries the token the request has
+// been authenticated with.
+func ContextWithToken(ctx context.Context, token Token) context.Context {
+	return context.WithValue(ctx, tokenCtxKey{}, token)
+}
+
+// TokenFromContext returns the token the request has been authenticated with. It
+// returns false if the request has not been authenticated with an API token.
+func TokenFromContext(ctx context.Context) (Token, bool) {
+	token, ok := ctx.Value(tokenCtxKey{}).(Token)
+	return token, ok
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func randomHex(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package apitoken
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/rbac"
+)
+
+func newTestService(t *testing.T, store Store) *Service {
+	t.Helper()
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	return NewService(cfg.APITokens, zap.NewNop(), store, nil)
+}
+
+var alice = &auth.Principal{Name: "alice"}
+
+func topicScopes() rbac.Scopes {
+	return rbac.Scopes{{Resource: rbac.ResourceTopic, Pattern: "orders-.*", Actions: []string{"seeTopic", "viewMessages"}}}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t, NewMemoryStore())
+
+	token, secret, err := svc.Create(ctx, alice, CreateTokenRequest{
+		ServiceAccount: "ci",
+		Description:    "deploy pipeline",
+		Scopes:         topicScopes(),
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, tokenPrefix+token.ID+"_"))
+	assert.Equal(t, "alice", token.CreatedBy)
+	assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), token.ExpiresAt, time.Minute)
+
+	// Only the hash of the secret is stored
+	stored, err := svc.store.Get(ctx, token.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, stored.SecretHash, strings.TrimPrefix(secret, tokenPrefix+token.ID+"_"))
+
+	authenticated, err := svc.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, authenticated.ID)
+	assert.True(t, authenticated.Scopes.IsAllowed(rbac.ResourceTopic, "orders-eu", "viewMessages"))
+	assert.False(t, authenticated.Scopes.IsAllowed(rbac.ResourceTopic, "orders-eu", "deleteTopic"))
+	assert.False(t, authenticated.Scopes.IsAllowed(rbac.ResourceTopic, "payments", "seeTopic"))
+
+	_, err = svc.Authenticate(ctx, secret+"0")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = svc.Authenticate(ctx, "not-a-token")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	tokens, err := svc.List(ctx, "ci")
+	require.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	tokens, err = svc.List(ctx, "other")
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	require.NoError(t, svc.Revoke(ctx, token.ID))
+	_, err = svc.Authenticate(ctx, secret)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	assert.ErrorIs(t, svc.Revoke(ctx, token.ID), ErrNotFound)
+}
+
+func TestServiceCreateValidation(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t, NewMemoryStore())
+
+	tests := []struct {
+		name string
+		req  CreateTokenRequest
+		err  string
+	}{
+		{
+			name: "no service account",
+			req:  CreateTokenRequest{Scopes: topicScopes()},
+			err:  "service account must be set",
+		},
+		{
+			name: "no scopes",
+			req:  CreateTokenRequest{ServiceAccount: "ci"},
+			err:  "at least one scope is required",
+		},
+		{
+			name: "unknown action",
+			req: CreateTokenRequest{ServiceAccount: "ci", Scopes: rbac.Scopes{
+				{Resource: rbac.ResourceTopic, Actions: []string{"eraseEverything"}},
+			}},
+			err: `unknown action "eraseEverything"`,
+		},
+		{
+			name: "expiry in the past",
+			req:  CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes(), ExpiresAt: time.Now().Add(-time.Hour)},
+			err:  "expiry must be in the future",
+		},
+		{
+			name: "expiry exceeds the max expiry",
+			req:  CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes(), ExpiresAt: time.Now().Add(2 * 365 * 24 * time.Hour)},
+			err:  "expiry must not be later than",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := svc.Create(ctx, alice, tt.req)
+			assert.ErrorIs(t, err, ErrInvalidRequest)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestServiceCreateRequiresCreatorPermissions(t *testing.T) {
+	policyFilepath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFilepath, []byte(`
+roles:
+  - name: orders-reader
+    permissions:
+      - resource: topic
+        pattern: "orders-.*"
+        actions: ["seeTopic", "viewMessages"]
+roleBindings:
+  - role: orders-reader
+    users: ["alice"]
+`), 0o600))
+	rbacSvc, err := rbac.NewService(config.AuthRBAC{Enabled: true, PolicyFilepath: policyFilepath, RefreshInterval: time.Minute}, zap.NewNop())
+	require.NoError(t, err)
+
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	svc := NewService(cfg.APITokens, zap.NewNop(), NewMemoryStore(), rbacSvc)
+	ctx := context.Background()
+
+	_, _, err = svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes()})
+	require.NoError(t, err)
+
+	_, _, err = svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: rbac.Scopes{
+		{Resource: rbac.ResourceTopic, Pattern: "orders-.*", Actions: []string{"deleteTopic"}},
+	}})
+	assert.ErrorIs(t, err, ErrScopeNotGranted)
+
+	_, _, err = svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: rbac.Scopes{
+		{Resource: rbac.ResourceCluster, Actions: []string{"manageApiTokens"}},
+	}})
+	assert.ErrorIs(t, err, ErrScopeNotGranted)
+}
+
+func TestServiceRejectsExpiredTokens(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t, NewMemoryStore())
+
+	token, secret, err := svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes()})
+	require.NoError(t, err)
+
+	// Expire the token in the store
+	stored, err := svc.store.Get(ctx, token.ID)
+	require.NoError(t, err)
+	require.NoError(t, svc.store.Delete(ctx, token.ID))
+	stored.ExpiresAt = time.Now().Add(-time.Second)
+	require.NoError(t, svc.store.Create(ctx, stored))
+
+	_, err = svc.Authenticate(ctx, secret)
+	assert.ErrorIs(t, err, ErrTokenExpired)
+}
+
+func TestServiceAuthenticateRequest(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t, NewMemoryStore())
+
+	_, secret, err := svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes()})
+	require.NoError(t, err)
+
+	_, err = svc.AuthenticateRequest(ctx, http.Header{"Authorization": {"Basic YWxpY2U6c2VjcmV0"}})
+	assert.ErrorIs(t, err, ErrNoBearerToken)
+
+	tokenCtx, err := svc.AuthenticateRequest(ctx, http.Header{"Authorization": {"Bearer " + secret}})
+	require.NoError(t, err)
+	principal := auth.PrincipalFromContext(tokenCtx)
+	require.NotNil(t, principal)
+	assert.Equal(t, "ci", principal.Name)
+	assert.Equal(t, auth.ProviderAPIToken, principal.Provider)
+	_, ok := TokenFromContext(tokenCtx)
+	assert.True(t, ok)
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "tokens", "api-tokens.json")
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	svc := newTestService(t, store)
+	token, secret, err := svc.Create(ctx, alice, CreateTokenRequest{ServiceAccount: "ci", Scopes: topicScopes()})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), secret)
+
+	// Tokens survive a restart
+	reloaded, err := NewFileStore(path)
+	require.NoError(t, err)
+	authenticated, err := newTestService(t, reloaded).Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, authenticated.ID)
+
+	require.NoError(t, reloaded.Delete(ctx, token.ID))
+	reloaded, err = NewFileStore(path)
+	require.NoError(t, err)
+	tokens, err := reloaded.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package apitoken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// ErrNotFound is returned if a token with the requested ID does not exist.
+var ErrNotFound = errors.New("api token not found")
+
+// StoredToken is a token as it is persisted. Only the hash of the secret is kept,
+// so that leaked storage does not leak usable tokens.
+type StoredToken struct {
+	Token
+	// SecretHash is the hex encoded SHA-256 hash of the token secret.
+	SecretHash string `json:"secretHash"`
+}
+
+// Store persists API tokens. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the token with the given ID or ErrNotFound.
+	Get(ctx context.Context, id string) (StoredToken, error)
+
+	// List returns all tokens, sorted by creation time.
+	List(ctx context.Context) ([]StoredToken, error)
+
+	// Create persists a new token.
+	Create(ctx context.Context, token StoredToken) error
+
+	// Delete removes the token with the given ID or returns ErrNotFound.
+	Delete(ctx context.Context, id string) error
+}
+
+func tokenID(token StoredToken) string {
+	return token.ID
+}
+
+// store implements Store on top of a JSON store that is keyed by token ID.
+type store struct {
+	tokens jsonstore.Store[StoredToken]
+}
+
+// Get returns the token with the given ID.
+func (s *store) Get(_ context.Context, id string) (StoredToken, error) {
+	token, err := s.tokens.Get(id)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return StoredToken{}, ErrNotFound
+	}
+	return token, err
+}
+
+// List returns all tokens, sorted by creation time.
+func (s *store) List(_ context.Context) ([]StoredToken, error) {
+	tokens, err := s.tokens.List()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(tokens, func(i, j int) bool { return tokens[i].CreatedAt.Before(tokens[j].CreatedAt) })
+	return tokens, nil
+}
+
+// Create persists a new token.
+func (s *store) Create(_ context.Context, token StoredToken) error {
+	err := s.tokens.Create(token)
+	if errors.Is(err, jsonstore.ErrAlreadyExists) {
+		return fmt.Errorf("api token with id %q already exists", token.ID)
+	}
+	return err
+}
+
+// Delete removes the token with the given ID.
+func (s *store) Delete(_ context.Context, id string) error {
+	err := s.tokens.Delete(id)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// MemoryStore keeps tokens in memory. All tokens are lost when the process exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates an empty in-memory token store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store{tokens: jsonstore.NewMemoryStore(tokenID)}}
+}
+
+// FileStore keeps all tokens in a single JSON file and serves reads from memory.
+// The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads all tokens from the file at the given path. The file is
+// created on the first change if it does not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	tokens, err := jsonstore.NewFileStore(path, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load api tokens: %w", err)
+	}
+	return &FileStore{store{tokens: tokens}}, nil
+}
@@ -0,0 +1,480 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package approval implements the four-eyes workflow for dangerous operations.
+// Instead of executing a configured operation, a change request with the exact
+// payload is created, which a second user has to approve before it is executed.
+package approval
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var (
+	// ErrInvalidRequest is returned if a change request can not be created as
+	// requested.
+	ErrInvalidRequest = errors.New("invalid change request")
+	// ErrNotAuthorized is returned if the user is not allowed to request or
+	// approve the operation of a change request.
+	ErrNotAuthorized = errors.New("not authorized")
+	// ErrSelfApproval is returned if the requester tries to approve their own
+	// change request.
+	ErrSelfApproval = errors.New("change requests must be approved by another user")
+	// ErrNotPending is returned if a change request is reviewed that is no
+	// longer pending.
+	ErrNotPending = errors.New("change request is not pending")
+)
+
+// Status of a change request.
+type Status string
+
+// All states of a change request. A change request is pending until it is
+// approved, rejected or has expired. Once approved, it is executed and ends up
+// either executed or failed.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusExecuted Status = "executed"
+	StatusFailed   Status = "failed"
+	StatusRejected Status = "rejected"
+	StatusExpired  Status = "expired"
+)
+
+// Actions that are recorded in the history of a change request.
+const (
+	ActionRequested = "requested"
+	ActionApproved  = "approved"
+	ActionExecuted  = "executed"
+	ActionFailed    = "failed"
+	ActionRejected  = "rejected"
+	ActionExpired   = "expired"
+)
+
+// Event is an entry in the history of a change request.
+type Event struct {
+	Time    time.Time `json:"time"`
+	Actor   string    `json:"actor,omitempty"`
+	Action  string    `json:"action"`
+	Comment string    `json:"comment,omitempty"`
+}
+
+// ChangeRequest is a requested operation that has to be approved by a second user.
+type ChangeRequest struct {
+	ID        string `json:"id"`
+	Operation string `json:"operation"`
+	// Target contains the names of the resources the operation is invoked on,
+	// e.g. {"topicName": "orders"}.
+	Target map[string]string `json:"target"`
+	// Payload is the exact input the operation is executed with once approved.
+	Payload json.RawMessage `json:"payload"`
+	Comment string          `json:"comment,omitempty"`
+
+	RequestedBy string `json:"requestedBy"`
+	// RequestedWithTokenOf is the creator of the API token the change request
+	// has been submitted with. The creator can not approve it either.
+	RequestedWithTokenOf string    `json:"requestedWithTokenOf,omitempty"`
+	RequestedAt          time.Time `json:"requestedAt"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+
+	Status        Status     `json:"status"`
+	ReviewedBy    string     `json:"reviewedBy,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+	ReviewComment string     `json:"reviewComment,omitempty"`
+
+	// Result is the response of the executed operation.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Error is set if the execution of the approved operation failed.
+	Error string `json:"error,omitempty"`
+
+	// Events is the full history of the change request.
+	Events []Event `json:"events"`
+}
+
+// Executor validates, authorizes and executes the payload of an operation.
+type Executor interface {
+	// Target returns the names of the resources the payload refers to. It returns
+	// an error if the payload is invalid.
+	Target(payload json.RawMessage) (map[string]string, error)
+
+	// Authorize returns an error if the user of the context is not allowed to
+	// perform the operation.
+	Authorize(ctx context.Context, payload json.RawMessage) error
+
+	// Execute performs the operation and returns its response.
+	Execute(ctx context.Context, payload json.RawMessage) (any, error)
+}
+
+// Recorder records the execution of approved change requests, e.g. the audit
+// service.
+type Recorder interface {
+	Record(entry *audit.Entry)
+	MaxRequestSize() int
+}
+
+// ListFilter restricts the change requests that are listed. Empty fields match
+// all change requests.
+type ListFilter struct {
+	Status      Status
+	RequestedBy string
+}
+
+// Service creates change requests for configured operations and executes them
+// once they are approved.
+type Service struct {
+	cfg      config.AuthApprovals
+	logger   *zap.Logger
+	store    Store
+	recorder Recorder
+
+	executorsByOperation map[string]Executor
+
+	// mutex serializes state transitions, so that a change request can not be
+	// approved twice.
+	mutex sync.Mutex
+}
+
+// NewService creates the approval service with the given store. The recorder is
+// optional.
+func NewService(cfg config.AuthApprovals, logger *zap.Logger, store Store, recorder Recorder) *Service {
+	return &Service{
+		cfg:                  cfg,
+		logger:               logger,
+		store:                store,
+		recorder:             recorder,
+		executorsByOperation: make(map[string]Executor),
+	}
+}
+
+// NewStore creates the configured change request store.
+func NewStore(cfg config.AuthApprovals) (Store, error) {
+	switch cfg.Storage {
+	case config.AuthApprovalsStorageFile:
+		return NewFileStore(cfg.FilePath)
+	default:
+		return NewMemoryStore(), nil
+	}
+}
+
+// RegisterExecutor registers the executor of an operation. It must be called for
+// all configured operations before the service is used.
+func (s *Service) RegisterExecutor(operation string, executor Executor) {
+	s.executorsByOperation[operation] = executor
+}
+
+// IsRequired returns true if the operation requires an approval.
+func (s *Service) IsRequired(operation string) bool {
+	return slices.Contains(s.cfg.Operations, operation)
+}
+
+// Submit creates a pending change request for the operation on behalf of the user
+// of the context, who must be allowed to perform the operation.
+func (s *Service) Submit(ctx context.Context, operation string, payload json.RawMessage, comment string) (ChangeRequest, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return ChangeRequest{}, fmt.Errorf("%w: requester is not authenticated", ErrNotAuthorized)
+	}
+	if !s.IsRequired(operation) {
+		return ChangeRequest{}, fmt.Errorf("%w: operation %q does not require an approval", ErrInvalidRequest, operation)
+	}
+	executor, exists := s.executorsByOperation[operation]
+	if !exists {
+		return ChangeRequest{}, fmt.Errorf("no executor registered for operation %q", operation)
+	}
+
+	target, err := executor.Target(payload)
+	if err != nil {
+		return ChangeRequest{}, fmt.Errorf("%w: invalid payload: %v", ErrInvalidRequest, err)
+	}
+	if err := executor.Authorize(ctx, payload); err != nil {
+		return ChangeRequest{}, fmt.Errorf("%w: %v", ErrNotAuthorized, err)
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return ChangeRequest{}, err
+	}
+	now := time.Now()
+	var tokenCreator string
+	if token, isToken := apitoken.TokenFromContext(ctx); isToken {
+		tokenCreator = token.CreatedBy
+	}
+	changeRequest := ChangeRequest{
+		ID:                   id,
+		Operation:            operation,
+		Target:               target,
+		Payload:              payload,
+		Comment:              comment,
+		RequestedBy:          principal.Name,
+		RequestedWithTokenOf: tokenCreator,
+		RequestedAt:          now,
+		ExpiresAt:            now.Add(s.cfg.Expiry),
+		Status:               StatusPending,
+		Events:               []Event{{Time: now, Actor: principal.Name, Action: ActionRequested, Comment: comment}},
+	}
+	if err := s.store.Create(ctx, changeRequest); err != nil {
+		return ChangeRequest{}, fmt.Errorf("failed to store change request: %w", err)
+	}
+	s.logger.Info("created change request",
+		zap.String("change_request_id", id),
+		zap.String("operation", operation),
+		zap.String("requested_by", principal.Name),
+		zap.Any("target", target))
+
+	return changeRequest, nil
+}
+
+// Get returns the change request with the given ID or ErrNotFound.
+func (s *Service) Get(ctx context.Context, id string) (ChangeRequest, error) {
+	changeRequest, err := s.store.Get(ctx, id)
+	if err != nil {
+		return ChangeRequest{}, err
+	}
+	return s.expireIfDue(ctx, changeRequest)
+}
+
+// List returns all change requests that match the filter, sorted by request time.
+func (s *Service) List(ctx context.Context, filter ListFilter) ([]ChangeRequest, error) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list change requests: %w", err)
+	}
+	changeRequests := make([]ChangeRequest, 0, len(stored))
+	for _, changeRequest := range stored {
+		changeRequest, err = s.expireIfDue(ctx, changeRequest)
+		if err != nil {
+			return nil, err
+		}
+		if filter.Status != "" && changeRequest.Status != filter.Status {
+			continue
+		}
+		if filter.RequestedBy != "" && changeRequest.RequestedBy != filter.RequestedBy {
+			continue
+		}
+		changeRequests = append(changeRequests, changeRequest)
+	}
+	return changeRequests, nil
+}
+
+// Approve approves the pending change request on behalf of the user of the
+// context and executes it with the permissions of that user. The requester, and
+// the creator of the API token it has been requested with, can not approve the
+// change request. Approvals require a user, they can not be made with API tokens,
+// as a token's service account can be used by its creator to approve their own
+// change requests. If the execution fails, the change request is returned with
+// the failed status and the error.
+func (s *Service) Approve(ctx context.Context, id, comment string) (ChangeRequest, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return ChangeRequest{}, fmt.Errorf("%w: approver is not authenticated", ErrNotAuthorized)
+	}
+	if _, isToken := apitoken.TokenFromContext(ctx); isToken {
+		return ChangeRequest{}, fmt.Errorf("%w: change requests can not be approved with an api token", ErrNotAuthorized)
+	}
+
+	s.mutex.Lock()
+	changeRequest, executor, err := s.getPending(ctx, id)
+	if err != nil {
+		s.mutex.Unlock()
+		return ChangeRequest{}, err
+	}
+	if changeRequest.RequestedBy == principal.Name || changeRequest.RequestedWithTokenOf == principal.Name {
+		s.mutex.Unlock()
+		return ChangeRequest{}, ErrSelfApproval
+	}
+	if err := executor.Authorize(ctx, changeRequest.Payload); err != nil {
+		s.mutex.Unlock()
+		return ChangeRequest{}, fmt.Errorf("%w: %v", ErrNotAuthorized, err)
+	}
+
+	now := time.Now()
+	changeRequest.Status = StatusApproved
+	changeRequest.ReviewedBy = principal.Name
+	changeRequest.ReviewedAt = &now
+	changeRequest.ReviewComment = comment
+	changeRequest.Events = append(changeRequest.Events, Event{Time: now, Actor: principal.Name, Action: ActionApproved, Comment: comment})
+	err = s.store.Update(ctx, changeRequest)
+	s.mutex.Unlock()
+	if err != nil {
+		return ChangeRequest{}, fmt.Errorf("failed to store change request: %w", err)
+	}
+
+	// The change request is no longer pending, so that it is executed exactly
+	// once, even though the lock is not held during the execution.
+	start := time.Now()
+	result, execErr := executor.Execute(ctx, changeRequest.Payload)
+	if execErr == nil {
+		changeRequest.Status = StatusExecuted
+		changeRequest.Events = append(changeRequest.Events, Event{Time: time.Now(), Action: ActionExecuted})
+		if result != nil {
+			encoded, err := json.Marshal(result)
+			if err != nil {
+				s.logger.Warn("failed to encode result of change request", zap.String("change_request_id", id), zap.Error(err))
+			}
+			changeRequest.Result = encoded
+		}
+	} else {
+		changeRequest.Status = StatusFailed
+		changeRequest.Error = execErr.Error()
+		changeRequest.Events = append(changeRequest.Events, Event{Time: time.Now(), Action: ActionFailed, Comment: execErr.Error()})
+	}
+	s.record(ctx, changeRequest, start)
+	s.logger.Info("executed approved change request",
+		zap.String("change_request_id", id),
+		zap.String("operation", changeRequest.Operation),
+		zap.String("requested_by", changeRequest.RequestedBy),
+		zap.String("approved_by", principal.Name),
+		zap.String("status", string(changeRequest.Status)))
+
+	if err := s.store.Update(ctx, changeRequest); err != nil {
+		return ChangeRequest{}, fmt.Errorf("failed to store change request: %w", err)
+	}
+	return changeRequest, nil
+}
+
+// Reject rejects the pending change request on behalf of the user of the context.
+// Authorizing the reviewer is up to the caller, requesters may withdraw their own
+// change requests.
+func (s *Service) Reject(ctx context.Context, id, comment string) (ChangeRequest, error) {
+	principal := auth.PrincipalFromContext(ctx)
+	if principal == nil {
+		return ChangeRequest{}, fmt.Errorf("%w: reviewer is not authenticated", ErrNotAuthorized)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	changeRequest, _, err := s.getPending(ctx, id)
+	if err != nil {
+		return ChangeRequest{}, err
+	}
+
+	now := time.Now()
+	changeRequest.Status = StatusRejected
+	changeRequest.ReviewedBy = principal.Name
+	changeRequest.ReviewedAt = &now
+	changeRequest.ReviewComment = comment
+	changeRequest.Events = append(changeRequest.Events, Event{Time: now, Actor: principal.Name, Action: ActionRejected, Comment: comment})
+	if err := s.store.Update(ctx, changeRequest); err != nil {
+		return ChangeRequest{}, fmt.Errorf("failed to store change request: %w", err)
+	}
+	s.logger.Info("rejected change request",
+		zap.String("change_request_id", id),
+		zap.String("operation", changeRequest.Operation),
+		zap.String("rejected_by", principal.Name))
+
+	return changeRequest, nil
+}
+
+// getPending returns the change request and the executor of its operation. It
+// returns ErrNotPending if the change request has already been reviewed or has
+// expired. The caller must hold the mutex.
+func (s *Service) getPending(ctx context.Context, id string) (ChangeRequest, Executor, error) {
+	changeRequest, err := s.store.Get(ctx, id)
+	if err != nil {
+		return ChangeRequest{}, nil, err
+	}
+	changeRequest, err = s.expire(ctx, changeRequest)
+	if err != nil {
+		return ChangeRequest{}, nil, err
+	}
+	if changeRequest.Status != StatusPending {
+		return ChangeRequest{}, nil, fmt.Errorf("%w: status is %q", ErrNotPending, changeRequest.Status)
+	}
+	executor, exists := s.executorsByOperation[changeRequest.Operation]
+	if !exists {
+		return ChangeRequest{}, nil, fmt.Errorf("no executor registered for operation %q", changeRequest.Operation)
+	}
+	return changeRequest, executor, nil
+}
+
+// expireIfDue marks the change request as expired if it is pending and its expiry
+// has passed.
+func (s *Service) expireIfDue(ctx context.Context, changeRequest ChangeRequest) (ChangeRequest, error) {
+	if changeRequest.Status != StatusPending || time.Now().Before(changeRequest.ExpiresAt) {
+		return changeRequest, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// Reload the change request, it may have been reviewed in the meantime
+	changeRequest, err := s.store.Get(ctx, changeRequest.ID)
+	if err != nil {
+		return ChangeRequest{}, err
+	}
+	return s.expire(ctx, changeRequest)
+}
+
+// expire marks the change request as expired if it is pending and its expiry has
+// passed. The caller must hold the mutex.
+func (s *Service) expire(ctx context.Context, changeRequest ChangeRequest) (ChangeRequest, error) {
+	if changeRequest.Status != StatusPending || time.Now().Before(changeRequest.ExpiresAt) {
+		return changeRequest, nil
+	}
+	changeRequest.Status = StatusExpired
+	changeRequest.Events = append(changeRequest.Events, Event{Time: changeRequest.ExpiresAt, Action: ActionExpired})
+	if err := s.store.Update(ctx, changeRequest); err != nil {
+		return ChangeRequest{}, fmt.Errorf("failed to store change request: %w", err)
+	}
+	return changeRequest, nil
+}
+
+// record adds the execution of an approved change request to the audit log. The
+// approver is recorded as principal.
+func (s *Service) record(ctx context.Context, changeRequest ChangeRequest, start time.Time) {
+	if s.recorder == nil {
+		return
+	}
+
+	entry := &audit.Entry{
+		Timestamp: start,
+		Protocol:  audit.ProtocolApproval,
+		Operation: changeRequest.Operation,
+		Target:    map[string]string{"changeRequestId": changeRequest.ID, "requestedBy": changeRequest.RequestedBy},
+		Result: audit.Result{
+			Success: changeRequest.Status == StatusExecuted,
+			Status:  string(changeRequest.Status),
+			Error:   changeRequest.Error,
+		},
+		Duration: time.Since(start).Milliseconds(),
+	}
+	for key, value := range changeRequest.Target {
+		entry.Target[key] = value
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		entry.Principal = principal.Name
+		entry.Provider = principal.Provider
+	}
+	entry.Request, entry.RequestTruncated = audit.RedactRequest(changeRequest.Payload, s.recorder.MaxRequestSize())
+
+	s.recorder.Record(entry)
+}
+
+func randomHex(length int) (string, error) {
+	b := make([]byte, length)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,330 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/apitoken"
+	"github.com/redpanda-data/console/backend/pkg/audit"
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+const testOperation = config.AuthApprovalsOperationDeleteTopic
+
+// testExecutor deletes topics from a set and only authorizes users in allowed.
+type testExecutor struct {
+	mutex      sync.Mutex
+	allowed    map[string]bool
+	executeErr error
+	executedBy []string
+}
+
+func (e *testExecutor) Target(payload json.RawMessage) (map[string]string, error) {
+	var p struct {
+		TopicName string `json:"topicName"`
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, err
+	}
+	if p.TopicName == "" {
+		return nil, errors.New("topic name must be set")
+	}
+	return map[string]string{"topicName": p.TopicName}, nil
+}
+
+func (e *testExecutor) Authorize(ctx context.Context, _ json.RawMessage) error {
+	if !e.allowed[auth.PrincipalFromContext(ctx).Name] {
+		return errors.New("you don't have permissions to delete this topic")
+	}
+	return nil
+}
+
+func (e *testExecutor) Execute(ctx context.Context, _ json.RawMessage) (any, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.executeErr != nil {
+		return nil, e.executeErr
+	}
+	e.executedBy = append(e.executedBy, auth.PrincipalFromContext(ctx).Name)
+	return map[string]string{"deleted": "orders"}, nil
+}
+
+type testRecorder struct {
+	entries []*audit.Entry
+}
+
+func (r *testRecorder) Record(entry *audit.Entry) {
+	r.entries = append(r.entries, entry)
+}
+
+func (*testRecorder) MaxRequestSize() int {
+	return 1024
+}
+
+func newTestService(t *testing.T, store Store, recorder Recorder) (*Service, *testExecutor) {
+	t.Helper()
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	svc := NewService(cfg.Approvals, zap.NewNop(), store, recorder)
+	executor := &testExecutor{allowed: map[string]bool{"alice": true, "bob": true}}
+	svc.RegisterExecutor(testOperation, executor)
+	return svc, executor
+}
+
+func contextFor(name string) context.Context {
+	return auth.ContextWithPrincipal(context.Background(), &auth.Principal{Name: name, Provider: auth.ProviderBasic})
+}
+
+func TestService_ApproveWithAPIToken(t *testing.T) {
+	svc, executor := newTestService(t, NewMemoryStore(), nil)
+	executor.allowed["ci"] = true
+	payload := json.RawMessage(`{"topicName":"orders"}`)
+	token := apitoken.Token{ServiceAccount: "ci", CreatedBy: "alice"}
+	tokenCtx := auth.ContextWithPrincipal(apitoken.ContextWithToken(context.Background(), token), token.Principal())
+
+	changeRequest, err := svc.Submit(tokenCtx, testOperation, payload, "")
+	require.NoError(t, err)
+	assert.Equal(t, "ci", changeRequest.RequestedBy)
+	assert.Equal(t, "alice", changeRequest.RequestedWithTokenOf)
+
+	// The creator of the token can not approve the change request of the token
+	_, err = svc.Approve(contextFor("alice"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrSelfApproval)
+
+	// Change requests of a user can not be approved with a token of that user
+	changeRequest, err = svc.Submit(contextFor("alice"), testOperation, payload, "")
+	require.NoError(t, err)
+	_, err = svc.Approve(tokenCtx, changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrNotAuthorized)
+}
+
+func TestService_Approve(t *testing.T) {
+	recorder := &testRecorder{}
+	svc, executor := newTestService(t, NewMemoryStore(), recorder)
+	payload := json.RawMessage(`{"topicName":"orders"}`)
+
+	changeRequest, err := svc.Submit(contextFor("alice"), testOperation, payload, "cleanup")
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, changeRequest.Status)
+	assert.Equal(t, "alice", changeRequest.RequestedBy)
+	assert.Equal(t, map[string]string{"topicName": "orders"}, changeRequest.Target)
+	assert.JSONEq(t, string(payload), string(changeRequest.Payload))
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), changeRequest.ExpiresAt, time.Minute)
+	assert.Empty(t, executor.executedBy, "operation must not be executed before the approval")
+
+	// The requester can not approve their own change request
+	_, err = svc.Approve(contextFor("alice"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrSelfApproval)
+
+	// The approver must be allowed to perform the operation
+	_, err = svc.Approve(contextFor("mallory"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrNotAuthorized)
+
+	approved, err := svc.Approve(contextFor("bob"), changeRequest.ID, "looks good")
+	require.NoError(t, err)
+	assert.Equal(t, StatusExecuted, approved.Status)
+	assert.Equal(t, "bob", approved.ReviewedBy)
+	assert.Equal(t, "looks good", approved.ReviewComment)
+	assert.JSONEq(t, `{"deleted":"orders"}`, string(approved.Result))
+	assert.Equal(t, []string{"bob"}, executor.executedBy)
+
+	actions := make([]string, len(approved.Events))
+	for i, event := range approved.Events {
+		actions[i] = event.Action
+	}
+	assert.Equal(t, []string{ActionRequested, ActionApproved, ActionExecuted}, actions)
+
+	// A change request is executed at most once
+	_, err = svc.Approve(contextFor("bob"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrNotPending)
+	assert.Len(t, executor.executedBy, 1)
+
+	require.Len(t, recorder.entries, 1)
+	entry := recorder.entries[0]
+	assert.Equal(t, audit.ProtocolApproval, entry.Protocol)
+	assert.Equal(t, testOperation, entry.Operation)
+	assert.Equal(t, "bob", entry.Principal)
+	assert.True(t, entry.Result.Success)
+	assert.Equal(t, map[string]string{"changeRequestId": changeRequest.ID, "requestedBy": "alice", "topicName": "orders"}, entry.Target)
+
+	stored, err := svc.Get(context.Background(), changeRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusExecuted, stored.Status)
+}
+
+func TestService_ApproveFailedExecution(t *testing.T) {
+	recorder := &testRecorder{}
+	svc, executor := newTestService(t, NewMemoryStore(), recorder)
+	executor.executeErr = errors.New("topic does not exist")
+
+	changeRequest, err := svc.Submit(contextFor("alice"), testOperation, json.RawMessage(`{"topicName":"orders"}`), "")
+	require.NoError(t, err)
+
+	failed, err := svc.Approve(contextFor("bob"), changeRequest.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailed, failed.Status)
+	assert.Equal(t, "topic does not exist", failed.Error)
+
+	require.Len(t, recorder.entries, 1)
+	assert.False(t, recorder.entries[0].Result.Success)
+	assert.Equal(t, "topic does not exist", recorder.entries[0].Result.Error)
+}
+
+func TestService_Submit(t *testing.T) {
+	svc, _ := newTestService(t, NewMemoryStore(), nil)
+
+	tt := []struct {
+		name      string
+		ctx       context.Context
+		operation string
+		payload   string
+		wantErr   error
+	}{
+		{
+			name:      "unauthenticated",
+			ctx:       context.Background(),
+			operation: testOperation,
+			payload:   `{"topicName":"orders"}`,
+			wantErr:   ErrNotAuthorized,
+		},
+		{
+			name:      "operation without approval",
+			ctx:       contextFor("alice"),
+			operation: "createTopic",
+			payload:   `{"topicName":"orders"}`,
+			wantErr:   ErrInvalidRequest,
+		},
+		{
+			name:      "invalid payload",
+			ctx:       contextFor("alice"),
+			operation: testOperation,
+			payload:   `{}`,
+			wantErr:   ErrInvalidRequest,
+		},
+		{
+			name:      "requester not allowed",
+			ctx:       contextFor("mallory"),
+			operation: testOperation,
+			payload:   `{"topicName":"orders"}`,
+			wantErr:   ErrNotAuthorized,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.Submit(tc.ctx, tc.operation, json.RawMessage(tc.payload), "")
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestService_Reject(t *testing.T) {
+	svc, executor := newTestService(t, NewMemoryStore(), nil)
+
+	changeRequest, err := svc.Submit(contextFor("alice"), testOperation, json.RawMessage(`{"topicName":"orders"}`), "")
+	require.NoError(t, err)
+
+	rejected, err := svc.Reject(contextFor("alice"), changeRequest.ID, "withdrawn")
+	require.NoError(t, err)
+	assert.Equal(t, StatusRejected, rejected.Status)
+	assert.Equal(t, "withdrawn", rejected.ReviewComment)
+
+	_, err = svc.Approve(contextFor("bob"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrNotPending)
+	assert.Empty(t, executor.executedBy)
+
+	_, err = svc.Reject(contextFor("bob"), "unknown", "")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestService_Expiry(t *testing.T) {
+	svc, executor := newTestService(t, NewMemoryStore(), nil)
+	svc.cfg.Expiry = time.Millisecond
+
+	changeRequest, err := svc.Submit(contextFor("alice"), testOperation, json.RawMessage(`{"topicName":"orders"}`), "")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	pending, err := svc.List(context.Background(), ListFilter{Status: StatusPending})
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	expired, err := svc.Get(context.Background(), changeRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusExpired, expired.Status)
+
+	_, err = svc.Approve(contextFor("bob"), changeRequest.ID, "")
+	assert.ErrorIs(t, err, ErrNotPending)
+	assert.Empty(t, executor.executedBy)
+}
+
+func TestService_List(t *testing.T) {
+	svc, _ := newTestService(t, NewMemoryStore(), nil)
+
+	first, err := svc.Submit(contextFor("alice"), testOperation, json.RawMessage(`{"topicName":"orders"}`), "")
+	require.NoError(t, err)
+	_, err = svc.Submit(contextFor("bob"), testOperation, json.RawMessage(`{"topicName":"payments"}`), "")
+	require.NoError(t, err)
+	_, err = svc.Reject(contextFor("alice"), first.ID, "")
+	require.NoError(t, err)
+
+	all, err := svc.List(context.Background(), ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	byAlice, err := svc.List(context.Background(), ListFilter{RequestedBy: "alice"})
+	require.NoError(t, err)
+	require.Len(t, byAlice, 1)
+	assert.Equal(t, first.ID, byAlice[0].ID)
+
+	pending, err := svc.List(context.Background(), ListFilter{Status: StatusPending})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, "bob", pending[0].RequestedBy)
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "approvals", "change-requests.json")
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	svc, _ := newTestService(t, store, nil)
+
+	changeRequest, err := svc.Submit(contextFor("alice"), testOperation, json.RawMessage(`{"topicName":"orders"}`), "cleanup")
+	require.NoError(t, err)
+	_, err = svc.Approve(contextFor("bob"), changeRequest.ID, "")
+	require.NoError(t, err)
+
+	// Change requests survive a restart
+	reloaded, err := NewFileStore(path)
+	require.NoError(t, err)
+	stored, err := reloaded.Get(ctx, changeRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusExecuted, stored.Status)
+	assert.Equal(t, "cleanup", stored.Comment)
+	assert.Equal(t, "bob", stored.ReviewedBy)
+	assert.Len(t, stored.Events, 3)
+
+	_, err = reloaded.Get(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// ErrNotFound is returned if a change request with the requested ID does not exist.
+var ErrNotFound = errors.New("change request not found")
+
+// Store persists change requests. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the change request with the given ID or ErrNotFound.
+	Get(ctx context.Context, id string) (ChangeRequest, error)
+
+	// List returns all change requests, sorted by request time.
+	List(ctx context.Context) ([]ChangeRequest, error)
+
+	// Create persists a new change request.
+	Create(ctx context.Context, changeRequest ChangeRequest) error
+
+	// Update replaces an existing change request or returns ErrNotFound.
+	Update(ctx context.Context, changeRequest ChangeRequest) error
+}
+
+func changeRequestID(changeRequest ChangeRequest) string {
+	return changeRequest.ID
+}
+
+// store implements Store on top of a JSON store that is keyed by change request ID.
+type store struct {
+	changeRequests jsonstore.Store[ChangeRequest]
+}
+
+// Get returns the change request with the given ID.
+func (s *store) Get(_ context.Context, id string) (ChangeRequest, error) {
+	changeRequest, err := s.changeRequests.Get(id)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ChangeRequest{}, ErrNotFound
+	}
+	return changeRequest, err
+}
+
+// List returns all change requests, sorted by request time.
+func (s *store) List(_ context.Context) ([]ChangeRequest, error) {
+	changeRequests, err := s.changeRequests.List()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(changeRequests, func(i, j int) bool {
+		return changeRequests[i].RequestedAt.Before(changeRequests[j].RequestedAt)
+	})
+	return changeRequests, nil
+}
+
+// Create persists a new change request.
+func (s *store) Create(_ context.Context, changeRequest ChangeRequest) error {
+	err := s.changeRequests.Create(changeRequest)
+	if errors.Is(err, jsonstore.ErrAlreadyExists) {
+		return fmt.Errorf("change request with id %q already exists", changeRequest.ID)
+	}
+	return err
+}
+
+// Update replaces an existing change request.
+func (s *store) Update(_ context.Context, changeRequest ChangeRequest) error {
+	err := s.changeRequests.Update(changeRequest)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// MemoryStore keeps change requests in memory. All change requests are lost when
+// the process exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates an empty in-memory change request store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store{changeRequests: jsonstore.NewMemoryStore(changeRequestID)}}
+}
+
+// FileStore keeps all change requests in a single JSON file and serves reads from
+// memory. The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads all change requests from the file at the given path. The
+// file is created on the first change if it does not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	changeRequests, err := jsonstore.NewFileStore(path, changeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load change requests: %w", err)
+	}
+	return &FileStore{store{changeRequests: changeRequests}}, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/sensitive"
+)
+
+// Protocols through which operations are invoked.
+const (
+	ProtocolREST    = "rest"
+	ProtocolConnect = "connect"
+	// ProtocolApproval is used for approved change requests that are executed
+	// on behalf of the approver.
+	ProtocolApproval = "approval"
+)
+
+// redactedValue replaces the values of sensitive request fields.
+const redactedValue = sensitive.RedactedValue
+
+// Entry is a single audited operation.
+type Entry struct {
+	ID        string    `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+
+	Principal  string `json:"principal"`
+	Provider   string `json:"provider,omitempty"`
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	Protocol string `json:"protocol"`
+	// Operation is the HTTP method and route pattern for REST calls, e.g.
+	// "DELETE /api/topics/{topicName}", the procedure for ConnectRPC calls, or
+	// the operation of an approved change request, e.g. "deleteTopic".
+	Operation string `json:"operation"`
+	// Target contains the names of the resources the operation was invoked on,
+	// e.g. {"topicName": "orders"}.
+	Target map[string]string `json:"target"`
+	// Request is a summary of the request with sensitive fields redacted. It is
+	// omitted if the request is larger than the configured maximum size.
+	Request          json.RawMessage `json:"request,omitempty"`
+	RequestTruncated bool            `json:"requestTruncated,omitempty"`
+
+	Result   Result `json:"result"`
+	Duration int64  `json:"durationMs"`
+}
+
+// Result is the outcome of an audited operation.
+type Result struct {
+	Success bool `json:"success"`
+	// Status is the HTTP status code for REST calls or the Connect code for
+	// ConnectRPC calls.
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// RedactRequest decodes the JSON request, redacts all sensitive fields and returns
+// the encoded result. If the request exceeds maxSize bytes, or is not a JSON object
+// or array, nil and true are returned.
+func RedactRequest(request []byte, maxSize int) (json.RawMessage, bool) {
+	if len(request) == 0 {
+		return nil, false
+	}
+	if len(request) > maxSize {
+		return nil, true
+	}
+
+	var decoded any
+	if err := json.Unmarshal(request, &decoded); err != nil {
+		return nil, true
+	}
+	switch decoded.(type) {
+	case map[string]any, []any:
+	default:
+		return nil, true
+	}
+
+	redacted, err := json.Marshal(redact(decoded))
+	if err != nil {
+		return nil, true
+	}
+	return redacted, false
+}
+
+func redact(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		// Configs are also sent as lists of name/value pairs, e.g.
+		// {"name":"sasl.jaas.config","value":"..."} or {"key":"...","value":"..."}
+		if _, hasValue := v["value"]; hasValue {
+			name, isString := v["name"].(string)
+			if !isString {
+				name, _ = v["key"].(string)
+			}
+			if isSensitiveFieldName(name) {
+				v["value"] = redactedValue
+			}
+		}
+		for key, fieldValue := range v {
+			if isSensitiveFieldName(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(fieldValue)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// isSensitiveFieldName uses the same rules as the redaction of connector configs,
+// so that keys such as "sasl.jaas.config" or "basic.auth.user.info" are covered.
+func isSensitiveFieldName(name string) bool {
+	return sensitive.IsKey(name)
+}
+
+// TargetFromRequest extracts the names of the resources from the top-level fields
+// of a JSON request, such as "name", "topic_name" or "group_id".
+func TargetFromRequest(request json.RawMessage) map[string]string {
+	target := make(map[string]string)
+	var fields map[string]any
+	if err := json.Unmarshal(request, &fields); err != nil {
+		return target
+	}
+
+	for key, value := range fields {
+		str, isString := value.(string)
+		if !isString || str == "" || isSensitiveFieldName(key) {
+			continue
+		}
+		lowerKey := strings.ToLower(key)
+		if lowerKey == "name" || lowerKey == "principal" ||
+			strings.HasSuffix(lowerKey, "name") || strings.HasSuffix(lowerKey, "_id") || strings.HasSuffix(key, "Id") {
+			target[key] = str
+		}
+	}
+	return target
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       string
+		maxSize       int
+		expected      string
+		wantTruncated bool
+	}{
+		{
+			name:     "empty request",
+			request:  "",
+			maxSize:  1024,
+			expected: "",
+		},
+		{
+			name:     "nested sensitive fields",
+			request:  `{"username":"alice","password":"secret","config":[{"name":"sasl.jaas.config","value":"x"},{"clientSecret":"y"}]}`,
+			maxSize:  1024,
+			expected: `{"config":[{"name":"sasl.jaas.config","value":"[REDACTED]"},{"clientSecret":"[REDACTED]"}],"password":"[REDACTED]","username":"alice"}`,
+		},
+		{
+			name:     "connector config",
+			request:  `{"name":"s3-sink","config":{"connector.class":"S3SinkConnector","sasl.jaas.config":"x","value.converter.basic.auth.user.info":"user:pass","aws.access.key.id":"id"}}`,
+			maxSize:  1024,
+			expected: `{"name":"s3-sink","config":{"connector.class":"S3SinkConnector","sasl.jaas.config":"[REDACTED]","value.converter.basic.auth.user.info":"[REDACTED]","aws.access.key.id":"id"}}`,
+		},
+		{
+			name:     "topic config pairs",
+			request:  `{"configs":[{"key":"retention.ms","op":"SET","value":"86400000"},{"key":"sasl.jaas.config","op":"SET","value":"x"}]}`,
+			maxSize:  1024,
+			expected: `{"configs":[{"key":"retention.ms","op":"SET","value":"86400000"},{"key":"sasl.jaas.config","op":"SET","value":"[REDACTED]"}]}`,
+		},
+		{
+			name:          "request too large",
+			request:       `{"topicName":"orders"}`,
+			maxSize:       10,
+			wantTruncated: true,
+		},
+		{
+			name:          "not a json object",
+			request:       `"orders"`,
+			maxSize:       1024,
+			wantTruncated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redacted, truncated := RedactRequest([]byte(tt.request), tt.maxSize)
+			assert.Equal(t, tt.wantTruncated, truncated)
+			if tt.expected == "" {
+				assert.Nil(t, redacted)
+				return
+			}
+			assert.JSONEq(t, tt.expected, string(redacted))
+		})
+	}
+}
+
+func TestTargetFromRequest(t *testing.T) {
+	target := TargetFromRequest([]byte(`{"topic_name":"orders","groupId":"billing","partitions":3,"name":"x","token_name":"t","description":"d"}`))
+	assert.Equal(t, map[string]string{"topic_name": "orders", "groupId": "billing", "name": "x"}, target)
+
+	assert.Empty(t, TargetFromRequest([]byte(`[1,2]`)))
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package audit records all mutating operations that are invoked via the REST and
+// ConnectRPC APIs and writes them to the configured sinks.
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+)
+
+// ErrQueryNotSupported is returned if none of the enabled sinks can be queried.
+var ErrQueryNotSupported = errors.New("querying the audit log requires the kafka sink to be enabled")
+
+// Sink writes audit entries to a destination.
+type Sink interface {
+	// Write persists the entry. Implementations must be safe for concurrent use
+	// and should not block for long, because entries are written while the
+	// audited request is being answered.
+	Write(entry *Entry) error
+	// Close flushes pending entries and releases all resources.
+	Close() error
+}
+
+// Querier is implemented by sinks that can be queried.
+type Querier interface {
+	Query(ctx context.Context, query Query) (*QueryResult, error)
+}
+
+// Service writes audit entries to all configured sinks.
+type Service struct {
+	cfg    config.Audit
+	logger *zap.Logger
+
+	sinks   []Sink
+	querier Querier
+}
+
+// NewService creates the audit service with all enabled sinks. The Kafka service
+// is only used if the Kafka sink is enabled.
+func NewService(cfg config.Audit, logger *zap.Logger, kafkaSvc *kafka.Service) (*Service, error) {
+	svc := &Service{
+		cfg:    cfg,
+		logger: logger,
+		sinks:  make([]Sink, 0, 3),
+	}
+
+	if cfg.Log.Enabled {
+		svc.sinks = append(svc.sinks, NewLogSink(logger))
+	}
+	if cfg.File.Enabled {
+		fileSink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		svc.sinks = append(svc.sinks, fileSink)
+	}
+	if cfg.Kafka.Enabled {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		kafkaSink, err := NewKafkaSink(ctx, cfg.Kafka, kafkaSvc, logger)
+		if err != nil {
+			return nil, err
+		}
+		svc.sinks = append(svc.sinks, kafkaSink)
+		svc.querier = kafkaSink
+	}
+
+	return svc, nil
+}
+
+// MaxRequestSize returns the maximum size of request bodies that are recorded.
+func (s *Service) MaxRequestSize() int {
+	return s.cfg.MaxRequestSize
+}
+
+// Record completes the entry and writes it to all sinks. Sink errors are logged,
+// but not returned, so that the audited operation is not affected.
+func (s *Service) Record(entry *Entry) {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+	if entry.Principal == "" {
+		entry.Principal = "anonymous"
+	}
+	if entry.Target == nil {
+		entry.Target = make(map[string]string)
+	}
+
+	for _, sink := range s.sinks {
+		if err := sink.Write(entry); err != nil {
+			s.logger.Error("failed to write audit log entry",
+				zap.String("entry_id", entry.ID),
+				zap.String("operation", entry.Operation),
+				zap.Error(err))
+		}
+	}
+}
+
+// Query searches the audit log of the queryable sink.
+func (s *Service) Query(ctx context.Context, query Query) (*QueryResult, error) {
+	if s.querier == nil {
+		return nil, ErrQueryNotSupported
+	}
+	return s.querier.Query(ctx, query)
+}
+
+// Close closes all sinks.
+func (s *Service) Close() error {
+	var errs []error
+	for _, sink := range s.sinks {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to close audit sinks: %w", errors.Join(errs...))
+	}
+	return nil
+}
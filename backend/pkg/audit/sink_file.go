@@ -0,0 +1,119 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// FileSink writes audit entries as JSON lines to a local file. Once the file
+// exceeds the maximum size, it is renamed to "<filepath>.1", existing backups
+// are shifted by one and the oldest backup is removed.
+type FileSink struct {
+	cfg config.AuditFileSink
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+// NewFileSink opens the audit file for appending.
+func NewFileSink(cfg config.AuditFileSink) (*FileSink, error) {
+	s := &FileSink{cfg: cfg}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Write appends the entry to the file and rotates the file if required.
+func (s *FileSink) Write(entry *Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.size > 0 && s.size+int64(len(line)) > s.cfg.MaxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit file: %w", err)
+	}
+	return nil
+}
+
+// Close closes the audit file.
+func (s *FileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.file.Close()
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.cfg.Filepath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat audit file: %w", err)
+	}
+
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit file before rotation: %w", err)
+	}
+
+	if s.cfg.MaxBackups == 0 {
+		if err := os.Remove(s.cfg.Filepath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove audit file: %w", err)
+		}
+		return s.open()
+	}
+
+	oldest := s.backupFilepath(s.cfg.MaxBackups)
+	if err := os.Remove(oldest); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove oldest audit file backup: %w", err)
+	}
+	for i := s.cfg.MaxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupFilepath(i), s.backupFilepath(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rotate audit file backup: %w", err)
+		}
+	}
+	if err := os.Rename(s.cfg.Filepath, s.backupFilepath(1)); err != nil {
+		return fmt.Errorf("failed to rotate audit file: %w", err)
+	}
+
+	return s.open()
+}
+
+func (s *FileSink) backupFilepath(index int) string {
+	return fmt.Sprintf("%s.%d", s.cfg.Filepath, index)
+}
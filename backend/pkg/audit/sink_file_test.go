@@ -0,0 +1,76 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func readEntryIDs(t *testing.T, path string) []string {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		ids = append(ids, entry.ID)
+	}
+	require.NoError(t, scanner.Err())
+	return ids
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	entry := func(id string) *Entry {
+		return &Entry{ID: id, Principal: "alice", Operation: "DELETE /api/topics/{topicName}"}
+	}
+	encoded, err := json.Marshal(entry("0"))
+	require.NoError(t, err)
+
+	// Each file holds two entries
+	sink, err := NewFileSink(config.AuditFileSink{
+		Enabled:    true,
+		Filepath:   path,
+		MaxSize:    int64(2 * (len(encoded) + 1)),
+		MaxBackups: 2,
+	})
+	require.NoError(t, err)
+	for _, id := range []string{"0", "1", "2", "3", "4", "5", "6"} {
+		require.NoError(t, sink.Write(entry(id)))
+	}
+	require.NoError(t, sink.Close())
+
+	assert.Equal(t, []string{"6"}, readEntryIDs(t, path))
+	assert.Equal(t, []string{"4", "5"}, readEntryIDs(t, path+".1"))
+	assert.Equal(t, []string{"2", "3"}, readEntryIDs(t, path+".2"))
+	assert.NoFileExists(t, path+".3")
+
+	// Reopening continues with the size of the existing file
+	sink, err = NewFileSink(config.AuditFileSink{Enabled: true, Filepath: path, MaxSize: int64(2 * (len(encoded) + 1))})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(entry("7")))
+	require.NoError(t, sink.Write(entry("8")))
+	require.NoError(t, sink.Close())
+	assert.Equal(t, []string{"8"}, readEntryIDs(t, path))
+}
@@ -0,0 +1,190 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+)
+
+// kafkaSinkFlushTimeout is the time to wait for pending entries to be produced
+// when the sink is closed.
+const kafkaSinkFlushTimeout = 10 * time.Second
+
+// Query filters the entries of the audit log. Empty fields match all entries.
+type Query struct {
+	Principal string
+	// Operation matches entries whose operation contains the given string.
+	Operation string
+	// Target matches entries with a target value that contains the given string.
+	Target string
+	// Success matches only successful or only failed operations if set.
+	Success *bool
+	From    time.Time
+	To      time.Time
+	Limit   int
+}
+
+// QueryResult contains the newest entries that match the query.
+type QueryResult struct {
+	Entries []*Entry `json:"entries"`
+	// IsComplete is false if older entries may exist that have not been searched,
+	// because the maximum number of records to scan has been reached.
+	IsComplete bool `json:"isComplete"`
+}
+
+// KafkaSink produces audit entries to a Kafka topic. The entry ID is the record
+// key and the JSON-encoded entry the record value.
+type KafkaSink struct {
+	cfg      config.AuditKafkaSink
+	kafkaSvc *kafka.Service
+	logger   *zap.Logger
+}
+
+// NewKafkaSink creates the audit topic if it does not exist yet.
+func NewKafkaSink(ctx context.Context, cfg config.AuditKafkaSink, kafkaSvc *kafka.Service, logger *zap.Logger) (*KafkaSink, error) {
+	_, err := kafkaSvc.KafkaAdmClient.CreateTopic(ctx, cfg.Partitions, cfg.ReplicationFactor, nil, cfg.Topic)
+	if err != nil && !errors.Is(err, kerr.TopicAlreadyExists) {
+		return nil, fmt.Errorf("failed to create audit log topic: %w", err)
+	}
+
+	return &KafkaSink{
+		cfg:      cfg,
+		kafkaSvc: kafkaSvc,
+		logger:   logger,
+	}, nil
+}
+
+// Write produces the entry asynchronously. Produce errors are logged.
+func (s *KafkaSink) Write(entry *Entry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+
+	record := &kgo.Record{
+		Topic:     s.cfg.Topic,
+		Key:       []byte(entry.ID),
+		Value:     value,
+		Timestamp: entry.Timestamp,
+	}
+	s.kafkaSvc.KafkaClient.Produce(context.Background(), record, func(_ *kgo.Record, err error) {
+		if err != nil {
+			s.logger.Error("failed to produce audit log entry",
+				zap.String("topic", s.cfg.Topic),
+				zap.String("entry_id", entry.ID),
+				zap.Error(err))
+		}
+	})
+	return nil
+}
+
+// Close waits until all pending entries have been produced. The Kafka client
+// is owned by the Kafka service and remains open.
+func (s *KafkaSink) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaSinkFlushTimeout)
+	defer cancel()
+	if err := s.kafkaSvc.KafkaClient.Flush(ctx); err != nil {
+		return fmt.Errorf("failed to flush audit log entries: %w", err)
+	}
+	return nil
+}
+
+// Query returns the newest entries of the audit topic that match the query.
+func (s *KafkaSink) Query(ctx context.Context, query Query) (*QueryResult, error) {
+	endOffsets, err := s.kafkaSvc.KafkaAdmClient.ListEndOffsets(ctx, s.cfg.Topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of audit log topic: %w", err)
+	}
+	if err := endOffsets.Error(); err != nil {
+		return nil, fmt.Errorf("failed to list partitions of audit log topic: %w", err)
+	}
+	partitionIDs := make([]int32, 0)
+	endOffsets.Each(func(o kadm.ListedOffset) {
+		partitionIDs = append(partitionIDs, o.Partition)
+	})
+	if len(partitionIDs) == 0 {
+		return &QueryResult{Entries: []*Entry{}, IsComplete: true}, nil
+	}
+
+	// Each partition can contribute at most query.Limit entries, so scanning of a
+	// partition stops once it has contributed this many or is older than query.From.
+	maxScanOffsets := max(1, s.cfg.MaxScanRecords/int64(len(partitionIDs)))
+	matchesByPartition := make(map[int32]int)
+	entries := make([]*Entry, 0)
+	isComplete, err := s.kafkaSvc.ScanNewestRecords(ctx, s.cfg.Topic, partitionIDs, maxScanOffsets, func(record *kgo.Record) bool {
+		if !query.From.IsZero() && record.Timestamp.Before(query.From) {
+			return false
+		}
+		var entry Entry
+		if err := json.Unmarshal(record.Value, &entry); err != nil {
+			s.logger.Debug("skipping audit log record that can not be decoded",
+				zap.Int32("partition_id", record.Partition), zap.Int64("offset", record.Offset), zap.Error(err))
+			return true
+		}
+		if !query.matches(&entry) {
+			return true
+		}
+
+		entries = append(entries, &entry)
+		matchesByPartition[record.Partition]++
+		return matchesByPartition[record.Partition] < query.Limit
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume audit log topic: %w", err)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp.After(entries[j].Timestamp) })
+	if len(entries) > query.Limit {
+		entries = entries[:query.Limit]
+	}
+
+	return &QueryResult{Entries: entries, IsComplete: isComplete}, nil
+}
+
+func (q *Query) matches(entry *Entry) bool {
+	if q.Principal != "" && entry.Principal != q.Principal {
+		return false
+	}
+	if q.Operation != "" && !strings.Contains(entry.Operation, q.Operation) {
+		return false
+	}
+	if q.Success != nil && entry.Result.Success != *q.Success {
+		return false
+	}
+	if !q.From.IsZero() && entry.Timestamp.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && entry.Timestamp.After(q.To) {
+		return false
+	}
+	if q.Target != "" {
+		for _, value := range entry.Target {
+			if strings.Contains(value, q.Target) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package audit
+
+import (
+	"go.uber.org/zap"
+)
+
+// LogSink writes audit entries as structured log messages.
+type LogSink struct {
+	logger *zap.Logger
+}
+
+// NewLogSink creates a sink that logs with the given logger.
+func NewLogSink(logger *zap.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+// Write logs the entry.
+func (s *LogSink) Write(entry *Entry) error {
+	s.logger.Info("audit log",
+		zap.String("id", entry.ID),
+		zap.Time("timestamp", entry.Timestamp),
+		zap.String("principal", entry.Principal),
+		zap.String("provider", entry.Provider),
+		zap.String("remote_addr", entry.RemoteAddr),
+		zap.String("protocol", entry.Protocol),
+		zap.String("operation", entry.Operation),
+		zap.Any("target", entry.Target),
+		zap.ByteString("request", entry.Request),
+		zap.Bool("request_truncated", entry.RequestTruncated),
+		zap.Bool("success", entry.Result.Success),
+		zap.String("status", entry.Result.Status),
+		zap.String("error", entry.Result.Error),
+		zap.Int64("duration_ms", entry.Duration))
+	return nil
+}
+
+// Close is a no-op for the log sink.
+func (*LogSink) Close() error {
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+)
+
+var (
+	// ErrImpersonationTokenExpired is returned if the token of an OIDC user, which is
+	// required to impersonate the user towards Kafka, has expired.
+	ErrImpersonationTokenExpired = errors.New("the token of the identity provider has expired, please log in again")
+	// ErrImpersonationNotPossible is returned if the user has no token that could be
+	// used for impersonation and falling back to the service identity is not allowed.
+	ErrImpersonationNotPossible = errors.New("kafka requests can not be made on behalf of users without an OIDC login")
+)
+
+// ImpersonationContext returns a copy of ctx, so that Kafka requests made with it
+// authenticate as the principal. If impersonation is disabled, ctx is returned as is.
+// Users that logged in with basic auth fall back to the configured Kafka credentials
+// if allowed.
+func (s *Service) ImpersonationContext(ctx context.Context, principal *Principal) (context.Context, error) {
+	if !s.cfg.Impersonation.Enabled {
+		return ctx, nil
+	}
+
+	token, ok := principal.OAuthBearerToken()
+	if ok {
+		return kafka.ContextWithImpersonation(ctx, kafka.Impersonation{
+			Principal: principal.Provider + ":" + principal.Name,
+			Token:     token,
+		}), nil
+	}
+
+	if principal.Provider == ProviderOIDC {
+		return ctx, ErrImpersonationTokenExpired
+	}
+	if !s.cfg.Impersonation.AllowServiceIdentity {
+		return ctx, ErrImpersonationNotPossible
+	}
+	return ctx, nil
+}
@@ -0,0 +1,375 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// jwksMinRefreshInterval limits how often the signing keys are fetched again if an
+// ID token is signed with an unknown key.
+const jwksMinRefreshInterval = time.Minute
+
+// oidcDiscovery is the subset of the OpenID provider metadata that is used.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oidcTokenResponse is the response of the token endpoint.
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// jsonWebKey is a public key of the JSON web key set of the identity provider.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// oidcProvider performs the OpenID Connect authorization code flow with PKCE.
+type oidcProvider struct {
+	cfg        config.AuthOIDC
+	httpClient *http.Client
+	// impersonationToken is the token that is kept on the principal for Kafka
+	// impersonation, or empty if impersonation is disabled.
+	impersonationToken string
+
+	mutex         sync.Mutex
+	discovery     *oidcDiscovery
+	keysByID      map[string]any
+	keysFetchedAt time.Time
+}
+
+func newOIDCProvider(cfg config.AuthOIDC, httpClient *http.Client, impersonationToken string) *oidcProvider {
+	return &oidcProvider{
+		cfg:                cfg,
+		httpClient:         httpClient,
+		impersonationToken: impersonationToken,
+		keysByID:           make(map[string]any),
+	}
+}
+
+// authCodeURL returns the URL of the identity provider the user is redirected to
+// in order to log in.
+func (p *oidcProvider) authCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse authorization endpoint: %w", err)
+	}
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// exchange redeems the authorization code for an ID token, verifies the ID token
+// and returns the principal it identifies.
+func (p *oidcProvider) exchange(ctx context.Context, code, codeVerifier, nonce string) (*Principal, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var tokenRes oidcTokenResponse
+	status, err := p.doJSON(req, &tokenRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeem authorization code: %w", err)
+	}
+	if status != http.StatusOK || tokenRes.Error != "" {
+		return nil, fmt.Errorf("failed to redeem authorization code: token endpoint returned status %d: %v %v", status, tokenRes.Error, tokenRes.ErrorDescription)
+	}
+	if tokenRes.IDToken == "" {
+		return nil, errors.New("token endpoint did not return an id token")
+	}
+
+	principal, idTokenExpiresAt, err := p.verifyIDToken(ctx, discovery, tokenRes.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.impersonationToken {
+	case config.AuthImpersonationTokenAccess:
+		if tokenRes.AccessToken == "" {
+			return nil, errors.New("token endpoint did not return an access token, which is required for impersonation")
+		}
+		principal.oauthToken = tokenRes.AccessToken
+		if tokenRes.ExpiresIn > 0 {
+			principal.oauthTokenExpiresAt = time.Now().Add(time.Duration(tokenRes.ExpiresIn) * time.Second)
+		}
+	case config.AuthImpersonationTokenID:
+		principal.oauthToken = tokenRes.IDToken
+		principal.oauthTokenExpiresAt = idTokenExpiresAt
+	default:
+	}
+
+	return principal, nil
+}
+
+// verifyIDToken verifies the ID token and returns the principal it identifies along
+// with its expiry.
+func (p *oidcProvider) verifyIDToken(ctx context.Context, discovery *oidcDiscovery, idToken, nonce string) (*Principal, time.Time, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims,
+		func(token *jwt.Token) (any, error) {
+			keyID, _ := token.Header["kid"].(string)
+			return p.getSigningKey(ctx, discovery, keyID)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to verify id token: %w", err)
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, time.Time{}, errors.New("failed to verify id token: nonce does not match")
+	}
+
+	name, _ := claims[p.cfg.UsernameClaim].(string)
+	if name == "" {
+		return nil, time.Time{}, fmt.Errorf("id token has no %q claim", p.cfg.UsernameClaim)
+	}
+
+	groups := make([]string, 0)
+	switch claimValue := claims[p.cfg.GroupsClaim].(type) {
+	case string:
+		groups = append(groups, claimValue)
+	case []any:
+		for _, group := range claimValue {
+			if groupName, ok := group.(string); ok {
+				groups = append(groups, groupName)
+			}
+		}
+	}
+
+	var expiresAt time.Time
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		expiresAt = exp.Time
+	}
+
+	return &Principal{
+		Name:     name,
+		Groups:   groups,
+		Provider: ProviderOIDC,
+	}, expiresAt, nil
+}
+
+// getDiscovery returns the provider metadata. It is fetched once and cached.
+func (p *oidcProvider) getDiscovery(ctx context.Context) (*oidcDiscovery, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(p.cfg.IssuerURL, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery request: %w", err)
+	}
+	var discovery oidcDiscovery
+	status, err := p.doJSON(req, &discovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch openid configuration: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch openid configuration: status %d", status)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(p.cfg.IssuerURL, "/") {
+		return nil, fmt.Errorf("issuer %q of openid configuration does not match the configured issuer url", discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("openid configuration lacks the authorization, token or jwks endpoint")
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+// getSigningKey returns the public key with the given key ID. The key set is fetched
+// again if the key is unknown, because the identity provider may have rotated its keys.
+func (p *oidcProvider) getSigningKey(ctx context.Context, discovery *oidcDiscovery, keyID string) (any, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if key, exists := p.keysByID[keyID]; exists {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < jwksMinRefreshInterval {
+		return nil, fmt.Errorf("signing key %q is unknown", keyID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.JWKSURI, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jwks request: %w", err)
+	}
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := p.doJSON(req, &keySet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch signing keys: status %d", status)
+	}
+
+	keysByID := make(map[string]any, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Keys of unsupported types are skipped
+			continue
+		}
+		keysByID[jwk.KeyID] = key
+	}
+	p.keysByID = keysByID
+	p.keysFetchedAt = time.Now()
+
+	key, exists := p.keysByID[keyID]
+	if !exists {
+		return nil, fmt.Errorf("signing key %q is unknown", keyID)
+	}
+	return key, nil
+}
+
+func (p *oidcProvider) doJSON(req *http.Request, target any) (int, error) {
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return res.StatusCode, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if err := json.Unmarshal(body, target); err != nil && res.StatusCode == http.StatusOK {
+		return res.StatusCode, fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	return res.StatusCode, nil
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBase64BigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64BigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("rsa exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBase64BigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64BigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+func decodeBase64BigInt(encoded string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key parameter: %w", err)
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
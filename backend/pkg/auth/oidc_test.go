@@ -0,0 +1,286 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// mockIdentityProvider is a minimal OpenID Connect provider that issues a single
+// authorization code per login and verifies the PKCE code verifier.
+type mockIdentityProvider struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	// claims are added to every issued ID token.
+	claims jwt.MapClaims
+
+	mutex          sync.Mutex
+	challengesByID map[string]string
+	noncesByCode   map[string]string
+}
+
+func newMockIdentityProvider(t *testing.T, claims jwt.MapClaims) *mockIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	idp := &mockIdentityProvider{
+		t:              t,
+		key:            key,
+		claims:         claims,
+		challengesByID: make(map[string]string),
+		noncesByCode:   make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, oidcDiscovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"keys": []jsonWebKey{{
+			KeyType: "RSA",
+			KeyID:   "test-key",
+			Use:     "sig",
+			N:       base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", idp.handleToken)
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// authorize simulates a successful login of the user at the authorization endpoint.
+// It returns the query parameters of the redirect to the callback.
+func (idp *mockIdentityProvider) authorize(authURL string) url.Values {
+	parsed, err := url.Parse(authURL)
+	require.NoError(idp.t, err)
+	query := parsed.Query()
+	require.Equal(idp.t, "code", query.Get("response_type"))
+	require.Equal(idp.t, "S256", query.Get("code_challenge_method"))
+
+	code := randomToken()
+	idp.mutex.Lock()
+	idp.challengesByID[code] = query.Get("code_challenge")
+	idp.noncesByCode[code] = query.Get("nonce")
+	idp.mutex.Unlock()
+
+	return url.Values{"code": {code}, "state": {query.Get("state")}}
+}
+
+func (idp *mockIdentityProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	require.NoError(idp.t, r.ParseForm())
+	code := r.PostForm.Get("code")
+
+	idp.mutex.Lock()
+	challenge, exists := idp.challengesByID[code]
+	nonce := idp.noncesByCode[code]
+	delete(idp.challengesByID, code)
+	idp.mutex.Unlock()
+	if !exists {
+		writeJSON(w, http.StatusBadRequest, oidcTokenResponse{Error: "invalid_grant"})
+		return
+	}
+	verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(verifierHash[:]) != challenge {
+		writeJSON(w, http.StatusBadRequest, oidcTokenResponse{Error: "invalid_grant", ErrorDescription: "code verifier does not match"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   idp.server.URL,
+		"aud":   r.PostForm.Get("client_id"),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": nonce,
+	}
+	for k, v := range idp.claims {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	idToken, err := token.SignedString(idp.key)
+	require.NoError(idp.t, err)
+
+	writeJSON(w, http.StatusOK, oidcTokenResponse{IDToken: idToken, AccessToken: "access-token-" + code, ExpiresIn: 3600})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newOIDCTestService(t *testing.T, idp *mockIdentityProvider, configure ...func(cfg *config.Auth)) *Service {
+	cfg := config.Auth{Enabled: true}
+	cfg.SetDefaults()
+	cfg.Session.Secret = "secret"
+	cfg.OIDC.Enabled = true
+	cfg.OIDC.IssuerURL = idp.server.URL
+	cfg.OIDC.ClientID = "console"
+	cfg.OIDC.RedirectURL = "http://localhost:8080/auth/callbacks/oidc"
+	for _, fn := range configure {
+		fn(&cfg)
+	}
+
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	return svc
+}
+
+// callbackRequest creates the callback request of the browser that has started
+// the login, carrying the login state cookie.
+func callbackRequest(startRec *httptest.ResponseRecorder, query url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/auth/callbacks/oidc?"+query.Encode(), http.NoBody)
+	for _, cookie := range startRec.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	return req
+}
+
+func TestOIDCLogin(t *testing.T) {
+	idp := newMockIdentityProvider(t, jwt.MapClaims{
+		"email":  "alice@example.com",
+		"groups": []string{"admins", "developers"},
+	})
+	svc := newOIDCTestService(t, idp)
+
+	startRec := httptest.NewRecorder()
+	authURL, err := svc.StartOIDCLogin(context.Background(), startRec, "/topics")
+	require.NoError(t, err)
+
+	callbackRec := httptest.NewRecorder()
+	principal, redirectPath, err := svc.FinishOIDCLogin(callbackRequest(startRec, idp.authorize(authURL)), callbackRec)
+	require.NoError(t, err)
+	assert.Equal(t, "/topics", redirectPath)
+	assert.Equal(t, &Principal{Name: "alice@example.com", Groups: []string{"admins", "developers"}, Provider: ProviderOIDC}, principal)
+
+	// The session cookie authenticates subsequent requests
+	sessionReq := requestWithCookies(callbackRec)
+	authenticated, err := svc.Authenticate(sessionReq.Header)
+	require.NoError(t, err)
+	assert.Equal(t, principal, authenticated)
+}
+
+func TestOIDCLoginWithImpersonation(t *testing.T) {
+	idp := newMockIdentityProvider(t, jwt.MapClaims{"email": "alice@example.com"})
+	svc := newOIDCTestService(t, idp, func(cfg *config.Auth) {
+		cfg.Impersonation.Enabled = true
+	})
+
+	startRec := httptest.NewRecorder()
+	authURL, err := svc.StartOIDCLogin(context.Background(), startRec, "/")
+	require.NoError(t, err)
+	query := idp.authorize(authURL)
+
+	callbackRec := httptest.NewRecorder()
+	_, _, err = svc.FinishOIDCLogin(callbackRequest(startRec, query), callbackRec)
+	require.NoError(t, err)
+
+	// The access token is sealed in the session cookie and restored on every request
+	for _, cookie := range callbackRec.Result().Cookies() {
+		assert.NotContains(t, cookie.Value, "access-token-")
+	}
+	principal, err := svc.Authenticate(requestWithCookies(callbackRec).Header)
+	require.NoError(t, err)
+	token, ok := principal.OAuthBearerToken()
+	require.True(t, ok)
+	assert.Equal(t, "access-token-"+query.Get("code"), token)
+
+	ctx, err := svc.ImpersonationContext(context.Background(), principal)
+	require.NoError(t, err)
+	assert.NotEqual(t, context.Background(), ctx)
+
+	// Tokens that have expired require a new login
+	principal.oauthTokenExpiresAt = time.Now().Add(-time.Minute)
+	_, err = svc.ImpersonationContext(context.Background(), principal)
+	assert.ErrorIs(t, err, ErrImpersonationTokenExpired)
+
+	// Basic auth users have no token and may only use the service identity if allowed
+	basicUser := &Principal{Name: "bob", Provider: ProviderBasic}
+	_, err = svc.ImpersonationContext(context.Background(), basicUser)
+	assert.ErrorIs(t, err, ErrImpersonationNotPossible)
+	svc.cfg.Impersonation.AllowServiceIdentity = true
+	_, err = svc.ImpersonationContext(context.Background(), basicUser)
+	assert.NoError(t, err)
+}
+
+func TestOIDCLoginRejectsMismatchingState(t *testing.T) {
+	idp := newMockIdentityProvider(t, jwt.MapClaims{"email": "alice@example.com"})
+	svc := newOIDCTestService(t, idp)
+
+	startRec := httptest.NewRecorder()
+	authURL, err := svc.StartOIDCLogin(context.Background(), startRec, "/")
+	require.NoError(t, err)
+
+	query := idp.authorize(authURL)
+	query.Set("state", "forged")
+	_, _, err = svc.FinishOIDCLogin(callbackRequest(startRec, query), httptest.NewRecorder())
+	assert.ErrorContains(t, err, "state does not match")
+}
+
+func TestOIDCLoginRejectsWrongCodeVerifier(t *testing.T) {
+	idp := newMockIdentityProvider(t, jwt.MapClaims{"email": "alice@example.com"})
+	svc := newOIDCTestService(t, idp)
+
+	// The authorization code was issued for another login, whose code verifier is unknown
+	// to the browser that redeems the code.
+	otherAuthURL, err := svc.StartOIDCLogin(context.Background(), httptest.NewRecorder(), "/")
+	require.NoError(t, err)
+	stolenQuery := idp.authorize(otherAuthURL)
+
+	startRec := httptest.NewRecorder()
+	authURL, err := svc.StartOIDCLogin(context.Background(), startRec, "/")
+	require.NoError(t, err)
+	query := idp.authorize(authURL)
+	query.Set("code", stolenQuery.Get("code"))
+
+	_, _, err = svc.FinishOIDCLogin(callbackRequest(startRec, query), httptest.NewRecorder())
+	assert.ErrorContains(t, err, "code verifier does not match")
+}
+
+func TestOIDCLoginRequiresUsernameClaim(t *testing.T) {
+	idp := newMockIdentityProvider(t, jwt.MapClaims{"sub": "1234"})
+	svc := newOIDCTestService(t, idp)
+
+	startRec := httptest.NewRecorder()
+	authURL, err := svc.StartOIDCLogin(context.Background(), startRec, "/")
+	require.NoError(t, err)
+
+	_, _, err = svc.FinishOIDCLogin(callbackRequest(startRec, idp.authorize(authURL)), httptest.NewRecorder())
+	assert.ErrorContains(t, err, `no "email" claim`)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package auth implements the built-in authentication of Console. Users log in
+// via an OpenID Connect identity provider or with a local user file and are
+// identified by a signed session cookie afterwards.
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Provider names that are set on authenticated principals.
+const (
+	ProviderBasic = "basic"
+	ProviderOIDC  = "oidc"
+	// ProviderAPIToken is set on service accounts that authenticated with an API token.
+	ProviderAPIToken = "apiToken"
+)
+
+// Principal is an authenticated user.
+type Principal struct {
+	// Name is the username, or the configured username claim for OIDC users.
+	Name string `json:"name"`
+	// Groups are the groups of the user in the user file or identity provider.
+	Groups []string `json:"groups"`
+	// Provider is the login method that has authenticated the user.
+	Provider string `json:"provider"`
+
+	// oauthToken is the token of the identity provider that is used to impersonate
+	// the user towards Kafka. It is only set if impersonation is enabled.
+	oauthToken          string
+	oauthTokenExpiresAt time.Time
+}
+
+// OAuthBearerToken returns the token of the identity provider that is used to
+// authenticate as the user towards Kafka. It returns false if the principal has
+// no token or the token has expired.
+func (p *Principal) OAuthBearerToken() (string, bool) {
+	if p.oauthToken == "" {
+		return "", false
+	}
+	if !p.oauthTokenExpiresAt.IsZero() && time.Now().After(p.oauthTokenExpiresAt) {
+		return "", false
+	}
+	return p.oauthToken, true
+}
+
+type principalCtxKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx that carries the given principal.
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal of the request, or nil if the request
+// has not been authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalCtxKey{}).(*Principal)
+	return principal
+}
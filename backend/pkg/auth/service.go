@@ -0,0 +1,326 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// oidcLoginStateMaxAge is the time a user has to log in at the identity provider.
+const oidcLoginStateMaxAge = 10 * time.Minute
+
+const (
+	// oauthTokenChunkSize is the number of characters of the sealed token that are
+	// stored per cookie, so that a chunk along with its signature and attributes
+	// stays below maxCookieSize.
+	oauthTokenChunkSize = 2560
+	// maxOAuthTokenChunks is the number of cookies a sealed token may be split
+	// across. It allows for tokens of roughly 7KB.
+	maxOAuthTokenChunks = 4
+)
+
+// LoginMethod describes a login method that is enabled, so that the frontend can
+// render the login page.
+type LoginMethod struct {
+	// Provider is one of ProviderBasic or ProviderOIDC.
+	Provider    string `json:"provider"`
+	DisplayName string `json:"displayName"`
+	// LoginURL is the path to navigate to in order to start the login. It is empty
+	// for basic auth, whose credentials are posted to /auth/login/basic.
+	LoginURL string `json:"loginUrl,omitempty"`
+}
+
+// oidcLoginState is kept in a signed cookie while the user logs in at the identity
+// provider, so that the callback can be bound to the browser that started the login.
+type oidcLoginState struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+	RedirectPath string `json:"redirectPath"`
+}
+
+// session is the content of the session cookie.
+type session struct {
+	Principal
+	// OAuthTokenChunks is the number of cookies the sealed token of the identity
+	// provider, which is used for impersonation, is split across. Tokens of
+	// identity providers are often too large to fit into a single cookie.
+	OAuthTokenChunks    int   `json:"oauthTokenChunks,omitempty"`
+	OAuthTokenExpiresAt int64 `json:"oauthTokenExpiresAt,omitempty"`
+}
+
+// Service authenticates requests and manages the login sessions.
+type Service struct {
+	cfg    config.Auth
+	logger *zap.Logger
+
+	cookies *cookieSigner
+	users   *UserFile
+	oidc    *oidcProvider
+}
+
+// NewService creates the authentication service for the enabled login methods.
+func NewService(cfg config.Auth, logger *zap.Logger) (*Service, error) {
+	secret := []byte(cfg.Session.Secret)
+	if len(secret) == 0 {
+		logger.Warn("no session secret configured, generating a random secret. Sessions will not survive restarts and are not shared across replicas")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate session secret: %w", err)
+		}
+	}
+
+	svc := &Service{
+		cfg:     cfg,
+		logger:  logger,
+		cookies: &cookieSigner{secret: secret, secure: cfg.Session.Secure},
+	}
+
+	if cfg.Basic.Enabled {
+		users, err := LoadUserFile(cfg.Basic.UsersFilepath)
+		if err != nil {
+			return nil, err
+		}
+		svc.users = users
+		logger.Info("loaded users for basic auth", zap.Int("user_count", len(users.usersByName)))
+	}
+	if cfg.OIDC.Enabled {
+		var impersonationToken string
+		if cfg.Impersonation.Enabled {
+			impersonationToken = cfg.Impersonation.Token
+		}
+		svc.oidc = newOIDCProvider(cfg.OIDC, &http.Client{Timeout: 10 * time.Second}, impersonationToken)
+	}
+
+	return svc, nil
+}
+
+// LoginMethods returns the enabled login methods.
+func (s *Service) LoginMethods() []LoginMethod {
+	methods := make([]LoginMethod, 0, 2)
+	if s.oidc != nil {
+		methods = append(methods, LoginMethod{
+			Provider:    ProviderOIDC,
+			DisplayName: s.cfg.OIDC.DisplayName,
+			LoginURL:    "/auth/login/oidc",
+		})
+	}
+	if s.users != nil {
+		methods = append(methods, LoginMethod{
+			Provider:    ProviderBasic,
+			DisplayName: "Username and password",
+		})
+	}
+	return methods
+}
+
+// Authenticate returns the principal of the request, identified either by the session
+// cookie or by HTTP basic auth credentials of a user from the user file.
+func (s *Service) Authenticate(header http.Header) (*Principal, error) {
+	req := &http.Request{Header: header}
+	var sess session
+	if err := s.cookies.get(req, s.cfg.Session.CookieName, &sess); err == nil {
+		principal := sess.Principal
+		if sess.OAuthTokenChunks > 0 {
+			token, err := s.readOAuthToken(req, sess.OAuthTokenChunks)
+			if err != nil {
+				return nil, ErrNoSession
+			}
+			principal.oauthToken = token
+			if sess.OAuthTokenExpiresAt != 0 {
+				principal.oauthTokenExpiresAt = time.Unix(sess.OAuthTokenExpiresAt, 0)
+			}
+		}
+		return &principal, nil
+	}
+
+	if username, password, ok := req.BasicAuth(); ok && s.users != nil {
+		return s.users.Authenticate(username, password)
+	}
+
+	return nil, ErrNoSession
+}
+
+// LoginBasic checks the credentials against the user file and issues a session cookie.
+func (s *Service) LoginBasic(w http.ResponseWriter, username, password string) (*Principal, error) {
+	if s.users == nil {
+		return nil, errors.New("basic auth is not enabled")
+	}
+	principal, err := s.users.Authenticate(username, password)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.setSession(w, principal); err != nil {
+		return nil, err
+	}
+
+	return principal, nil
+}
+
+// StartOIDCLogin stores a new login state in a cookie and returns the URL of the identity
+// provider the user must be redirected to. After login, the user is sent to redirectPath.
+func (s *Service) StartOIDCLogin(ctx context.Context, w http.ResponseWriter, redirectPath string) (string, error) {
+	if s.oidc == nil {
+		return "", errors.New("oidc is not enabled")
+	}
+
+	state := oidcLoginState{
+		State:        randomToken(),
+		Nonce:        randomToken(),
+		CodeVerifier: randomToken(),
+		RedirectPath: sanitizeRedirectPath(redirectPath),
+	}
+	authURL, err := s.oidc.authCodeURL(ctx, state.State, state.Nonce, state.CodeVerifier)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cookies.set(w, s.oidcStateCookieName(), state, oidcLoginStateMaxAge); err != nil {
+		return "", err
+	}
+
+	return authURL, nil
+}
+
+// FinishOIDCLogin handles the callback of the identity provider. It verifies the state,
+// redeems the authorization code and issues a session cookie. It returns the principal
+// and the path the user wanted to visit before logging in.
+func (s *Service) FinishOIDCLogin(r *http.Request, w http.ResponseWriter) (*Principal, string, error) {
+	if s.oidc == nil {
+		return nil, "", errors.New("oidc is not enabled")
+	}
+
+	var state oidcLoginState
+	if err := s.cookies.get(r, s.oidcStateCookieName(), &state); err != nil {
+		return nil, "", errors.New("login state is missing or expired, please try again")
+	}
+	s.cookies.clear(w, s.oidcStateCookieName())
+
+	query := r.URL.Query()
+	if errCode := query.Get("error"); errCode != "" {
+		return nil, "", fmt.Errorf("identity provider returned an error: %v %v", errCode, query.Get("error_description"))
+	}
+	if query.Get("state") != state.State {
+		return nil, "", errors.New("login state does not match, please try again")
+	}
+	code := query.Get("code")
+	if code == "" {
+		return nil, "", errors.New("identity provider did not return an authorization code")
+	}
+
+	principal, err := s.oidc.exchange(r.Context(), code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.setSession(w, principal); err != nil {
+		return nil, "", err
+	}
+
+	return principal, state.RedirectPath, nil
+}
+
+// setSession issues the session cookie for the principal. The sealed token of the
+// identity provider is split across separate cookies, which are written before the
+// session cookie, so that a failure does not leave a session without its token.
+func (s *Service) setSession(w http.ResponseWriter, principal *Principal) error {
+	sess := session{Principal: *principal}
+	if principal.oauthToken != "" {
+		sealed, err := s.cookies.seal(principal.oauthToken)
+		if err != nil {
+			return fmt.Errorf("failed to seal oauth token: %w", err)
+		}
+		chunks := splitIntoChunks(sealed, oauthTokenChunkSize)
+		if len(chunks) > maxOAuthTokenChunks {
+			return fmt.Errorf("oauth token has %d bytes, which is too large to be stored in the session", len(principal.oauthToken))
+		}
+		for i, chunk := range chunks {
+			if err := s.cookies.set(w, s.oauthTokenCookieName(i), chunk, s.cfg.Session.MaxAge); err != nil {
+				return err
+			}
+		}
+		sess.OAuthTokenChunks = len(chunks)
+		if !principal.oauthTokenExpiresAt.IsZero() {
+			sess.OAuthTokenExpiresAt = principal.oauthTokenExpiresAt.Unix()
+		}
+	}
+	return s.cookies.set(w, s.cfg.Session.CookieName, sess, s.cfg.Session.MaxAge)
+}
+
+// readOAuthToken joins the chunks of the sealed token of the identity provider and
+// decrypts it.
+func (s *Service) readOAuthToken(r *http.Request, chunkCount int) (string, error) {
+	if chunkCount > maxOAuthTokenChunks {
+		return "", ErrNoSession
+	}
+	var sealed strings.Builder
+	for i := 0; i < chunkCount; i++ {
+		var chunk string
+		if err := s.cookies.get(r, s.oauthTokenCookieName(i), &chunk); err != nil {
+			return "", err
+		}
+		sealed.WriteString(chunk)
+	}
+	return s.cookies.open(sealed.String())
+}
+
+// Logout removes the session cookie and the cookies of the token.
+func (s *Service) Logout(w http.ResponseWriter) {
+	s.cookies.clear(w, s.cfg.Session.CookieName)
+	for i := 0; i < maxOAuthTokenChunks; i++ {
+		s.cookies.clear(w, s.oauthTokenCookieName(i))
+	}
+}
+
+func (s *Service) oidcStateCookieName() string {
+	return s.cfg.Session.CookieName + "_oidc_state"
+}
+
+func (s *Service) oauthTokenCookieName(chunk int) string {
+	return s.cfg.Session.CookieName + "_token_" + strconv.Itoa(chunk)
+}
+
+// splitIntoChunks splits s into chunks of at most size characters.
+func splitIntoChunks(s string, size int) []string {
+	chunks := make([]string, 0, (len(s)+size-1)/size)
+	for len(s) > size {
+		chunks = append(chunks, s[:size])
+		s = s[size:]
+	}
+	return append(chunks, s)
+}
+
+// sanitizeRedirectPath only allows local paths, so that the login can not be abused
+// to redirect users to other sites.
+func sanitizeRedirectPath(path string) string {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return "/"
+	}
+	return path
+}
+
+func randomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand never fails on supported platforms
+		panic(fmt.Errorf("failed to read random bytes: %w", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrNoSession is returned if the request carries no valid session cookie.
+var ErrNoSession = errors.New("no valid session")
+
+// maxCookieSize is the size of a single cookie, including its name and attributes,
+// that browsers must support at least (RFC 6265, section 6.1). Larger cookies are
+// silently dropped by some browsers.
+const maxCookieSize = 4096
+
+// cookieSigner encodes values into cookies that are signed with HMAC-SHA256, so
+// that they can not be altered by the client. The values are not encrypted.
+type cookieSigner struct {
+	secret []byte
+	secure bool
+}
+
+// signedCookiePayload is the signed content of a cookie.
+type signedCookiePayload struct {
+	// Name is the cookie name. It is signed, so that a value can not be replayed
+	// in a cookie that is used for a different purpose.
+	Name      string          `json:"n"`
+	ExpiresAt int64           `json:"e"`
+	Value     json.RawMessage `json:"v"`
+}
+
+// set writes the value as signed cookie that expires after maxAge. It returns an
+// error if the cookie would exceed maxCookieSize.
+func (s *cookieSigner) set(w http.ResponseWriter, name string, value any, maxAge time.Duration) error {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode cookie value: %w", err)
+	}
+	expiresAt := time.Now().Add(maxAge)
+	payload, err := json.Marshal(signedCookiePayload{Name: name, ExpiresAt: expiresAt.Unix(), Value: encodedValue})
+	if err != nil {
+		return fmt.Errorf("failed to encode cookie payload: %w", err)
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	cookie := &http.Cookie{
+		Name:     name,
+		Value:    encodedPayload + "." + base64.RawURLEncoding.EncodeToString(s.sign(encodedPayload)),
+		Path:     "/",
+		Expires:  expiresAt,
+		MaxAge:   int(maxAge.Seconds()),
+		Secure:   s.secure,
+		HttpOnly: true,
+		// Lax is required, so that the cookies are sent along with the redirect
+		// from the identity provider to the OIDC callback.
+		SameSite: http.SameSiteLaxMode,
+	}
+	if size := len(cookie.String()); size > maxCookieSize {
+		return fmt.Errorf("cookie %q has %d bytes, which exceeds the limit of %d bytes", name, size, maxCookieSize)
+	}
+	http.SetCookie(w, cookie)
+
+	return nil
+}
+
+// get verifies the signature and expiry of the cookie with the given name and
+// decodes its value into target. It returns ErrNoSession if the cookie is missing,
+// expired or has been tampered with.
+func (s *cookieSigner) get(r *http.Request, name string, target any) error {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ErrNoSession
+	}
+
+	encodedPayload, encodedSignature, found := strings.Cut(cookie.Value, ".")
+	if !found {
+		return ErrNoSession
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(encodedPayload)) {
+		return ErrNoSession
+	}
+	rawPayload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrNoSession
+	}
+
+	var payload signedCookiePayload
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return ErrNoSession
+	}
+	if payload.Name != name || time.Now().Unix() >= payload.ExpiresAt {
+		return ErrNoSession
+	}
+	if err := json.Unmarshal(payload.Value, target); err != nil {
+		return ErrNoSession
+	}
+
+	return nil
+}
+
+// clear removes the cookie with the given name.
+func (s *cookieSigner) clear(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		Expires:  time.Unix(0, 0),
+		MaxAge:   -1,
+		Secure:   s.secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (s *cookieSigner) sign(encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
+}
+
+// seal encrypts a value with a key that is derived from the secret, so that it can
+// be stored in a cookie without being readable by the client.
+func (s *cookieSigner) seal(plaintext string) (string, error) {
+	aead, err := s.aead()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts a value that has been encrypted with seal.
+func (s *cookieSigner) open(encoded string) (string, error) {
+	aead, err := s.aead()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("sealed value is malformed")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt sealed value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func (s *cookieSigner) aead() (cipher.AEAD, error) {
+	// Use a different key than for signing
+	key := sha256.Sum256(append([]byte("encryption:"), s.secret...))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// requestWithCookies returns a request that carries the cookies set on the recorder.
+func requestWithCookies(rec *httptest.ResponseRecorder) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	for _, cookie := range rec.Result().Cookies() {
+		req.AddCookie(cookie)
+	}
+	return req
+}
+
+func TestCookieSigner(t *testing.T) {
+	signer := &cookieSigner{secret: []byte("secret"), secure: true}
+	principal := &Principal{Name: "alice", Groups: []string{"admins"}, Provider: ProviderBasic}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, signer.set(rec, "session", principal, time.Hour))
+	cookie := rec.Result().Cookies()[0]
+	assert.True(t, cookie.HttpOnly)
+	assert.True(t, cookie.Secure)
+	assert.Equal(t, http.SameSiteLaxMode, cookie.SameSite)
+
+	t.Run("valid cookie", func(t *testing.T) {
+		var decoded Principal
+		require.NoError(t, signer.get(requestWithCookies(rec), "session", &decoded))
+		assert.Equal(t, *principal, decoded)
+	})
+
+	t.Run("different secret", func(t *testing.T) {
+		otherSigner := &cookieSigner{secret: []byte("other")}
+		var decoded Principal
+		assert.ErrorIs(t, otherSigner.get(requestWithCookies(rec), "session", &decoded), ErrNoSession)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		_, signature, _ := strings.Cut(cookie.Value, ".")
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req.AddCookie(&http.Cookie{Name: "session", Value: "eyJuIjoic2Vzc2lvbiJ9." + signature})
+		var decoded Principal
+		assert.ErrorIs(t, signer.get(req, "session", &decoded), ErrNoSession)
+	})
+
+	t.Run("replayed in other cookie", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req.AddCookie(&http.Cookie{Name: "other", Value: cookie.Value})
+		var decoded Principal
+		assert.ErrorIs(t, signer.get(req, "other", &decoded), ErrNoSession)
+	})
+
+	t.Run("expired cookie", func(t *testing.T) {
+		expiredRec := httptest.NewRecorder()
+		require.NoError(t, signer.set(expiredRec, "session", principal, -time.Minute))
+		var decoded Principal
+		assert.ErrorIs(t, signer.get(requestWithCookies(expiredRec), "session", &decoded), ErrNoSession)
+	})
+
+	t.Run("too large value", func(t *testing.T) {
+		largeRec := httptest.NewRecorder()
+		err := signer.set(largeRec, "session", strings.Repeat("a", maxCookieSize), time.Hour)
+		assert.ErrorContains(t, err, "exceeds the limit")
+		assert.Empty(t, largeRec.Result().Cookies())
+	})
+}
+
+// newLargeJWT returns a signed token of an identity provider that carries many
+// group claims, as they are commonly issued by enterprise identity providers.
+func newLargeJWT(t *testing.T, groupCount int) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	groups := make([]string, groupCount)
+	for i := range groups {
+		groups[i] = fmt.Sprintf("team-%03d-engineering", i)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":    "https://login.example.com/4f1e3c2a-9d8b-4a7e-b6c5-0d1f2e3a4b5c/v2.0",
+		"sub":    "AAAAAAAAAAAAAAAAAAAAAIkzqFVrSaSaFHy782bbtaQ",
+		"aud":    "api://console",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"email":  "alice@example.com",
+		"groups": groups,
+	})
+	token.Header["kid"] = "nOo3ZDrODXEK1jKWhXslHR_KXEg"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestSessionWithLargeOAuthToken(t *testing.T) {
+	cfg := config.Auth{}
+	cfg.SetDefaults()
+	cfg.Session.Secret = "secret"
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	token := newLargeJWT(t, 70)
+	require.Greater(t, len(token), 2500)
+	require.Less(t, len(token), 3072)
+	principal := &Principal{
+		Name:                "alice@example.com",
+		Groups:              []string{"admins"},
+		Provider:            ProviderOIDC,
+		oauthToken:          token,
+		oauthTokenExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	rec := httptest.NewRecorder()
+	require.NoError(t, svc.setSession(rec, principal))
+	cookies := rec.Result().Cookies()
+	assert.Greater(t, len(cookies), 2)
+	for _, cookie := range cookies {
+		assert.LessOrEqual(t, len(cookie.String()), maxCookieSize, "cookie %v is too large", cookie.Name)
+		assert.NotContains(t, cookie.Value, token[:100])
+	}
+
+	authenticated, err := svc.Authenticate(requestWithCookies(rec).Header)
+	require.NoError(t, err)
+	restored, ok := authenticated.OAuthBearerToken()
+	require.True(t, ok)
+	assert.Equal(t, token, restored)
+
+	t.Run("missing chunk", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		for _, cookie := range cookies {
+			if cookie.Name != svc.oauthTokenCookieName(1) {
+				req.AddCookie(cookie)
+			}
+		}
+		_, err := svc.Authenticate(req.Header)
+		assert.ErrorIs(t, err, ErrNoSession)
+	})
+
+	t.Run("token too large", func(t *testing.T) {
+		tooLarge := *principal
+		tooLarge.oauthToken = newLargeJWT(t, 500)
+		assert.Error(t, svc.setSession(httptest.NewRecorder(), &tooLarge))
+	})
+}
+
+func TestSanitizeRedirectPath(t *testing.T) {
+	assert.Equal(t, "/topics/orders", sanitizeRedirectPath("/topics/orders"))
+	assert.Equal(t, "/", sanitizeRedirectPath(""))
+	assert.Equal(t, "/", sanitizeRedirectPath("https://evil.example.com"))
+	assert.Equal(t, "/", sanitizeRedirectPath("//evil.example.com"))
+	assert.Equal(t, "/", sanitizeRedirectPath("/\\evil.example.com"))
+}
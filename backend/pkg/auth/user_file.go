@@ -0,0 +1,136 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // SHA1 is supported for compatibility with "htpasswd -s"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidCredentials is returned if the username or password is wrong.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// dummyBcryptHash is compared against for unknown users, so that the response time
+// does not reveal whether a user exists.
+var dummyBcryptHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy"), bcrypt.DefaultCost)
+	return hash
+})
+
+// userFileEntry is a user of the htpasswd-style user file.
+type userFileEntry struct {
+	hash   string
+	groups []string
+}
+
+// UserFile contains the local users that can log in with username and password.
+type UserFile struct {
+	usersByName map[string]userFileEntry
+}
+
+// LoadUserFile reads the users from the htpasswd-style file at the given path.
+func LoadUserFile(path string) (*UserFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open user file: %w", err)
+	}
+	defer f.Close()
+
+	return parseUserFile(f)
+}
+
+// parseUserFile parses lines in the format "username:hash" or "username:hash:group1,group2".
+func parseUserFile(r io.Reader) (*UserFile, error) {
+	users := &UserFile{usersByName: make(map[string]userFileEntry)}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ":")
+		if len(fields) < 2 || len(fields) > 3 || fields[0] == "" || fields[1] == "" {
+			return nil, fmt.Errorf("line %d is not in the format username:hash[:groups]", lineNumber)
+		}
+		if !isSupportedPasswordHash(fields[1]) {
+			return nil, fmt.Errorf("line %d: password hash of user %q is not supported, use bcrypt or SHA1", lineNumber, fields[0])
+		}
+		if _, exists := users.usersByName[fields[0]]; exists {
+			return nil, fmt.Errorf("line %d: user %q is defined more than once", lineNumber, fields[0])
+		}
+
+		entry := userFileEntry{hash: fields[1], groups: make([]string, 0)}
+		if len(fields) == 3 {
+			for _, group := range strings.Split(fields[2], ",") {
+				if group = strings.TrimSpace(group); group != "" {
+					entry.groups = append(entry.groups, group)
+				}
+			}
+		}
+		users.usersByName[fields[0]] = entry
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read user file: %w", err)
+	}
+
+	return users, nil
+}
+
+// Authenticate checks the password of the given user and returns the principal.
+func (u *UserFile) Authenticate(username, password string) (*Principal, error) {
+	entry, exists := u.usersByName[username]
+	if !exists {
+		_ = bcrypt.CompareHashAndPassword(dummyBcryptHash(), []byte(password))
+		return nil, ErrInvalidCredentials
+	}
+	if !verifyPasswordHash(entry.hash, password) {
+		return nil, ErrInvalidCredentials
+	}
+
+	return &Principal{
+		Name:     username,
+		Groups:   entry.groups,
+		Provider: ProviderBasic,
+	}, nil
+}
+
+func isSupportedPasswordHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$") ||
+		strings.HasPrefix(hash, "{SHA}")
+}
+
+func verifyPasswordHash(hash, password string) bool {
+	if encoded, isSHA := strings.CutPrefix(hash, "{SHA}"); isSHA {
+		expected, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return false
+		}
+		actual := sha1.Sum([]byte(password)) //nolint:gosec // see import
+		return subtle.ConstantTimeCompare(expected, actual[:]) == 1
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserFileAuthenticate(t *testing.T) {
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	// {SHA} hash of "password", as generated by "htpasswd -s"
+	content := strings.Join([]string{
+		"# local users",
+		"alice:" + string(bcryptHash) + ":admins, developers",
+		"",
+		"bob:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=",
+	}, "\n")
+	users, err := parseUserFile(strings.NewReader(content))
+	require.NoError(t, err)
+
+	principal, err := users.Authenticate("alice", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{Name: "alice", Groups: []string{"admins", "developers"}, Provider: ProviderBasic}, principal)
+
+	principal, err = users.Authenticate("bob", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", principal.Name)
+	assert.Empty(t, principal.Groups)
+
+	_, err = users.Authenticate("alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = users.Authenticate("bob", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = users.Authenticate("carol", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestParseUserFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "missing hash", content: "alice"},
+		{name: "empty username", content: ":{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="},
+		{name: "unsupported hash", content: "alice:$apr1$abc$def"},
+		{name: "plaintext password", content: "alice:secret"},
+		{name: "duplicate user", content: "alice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\nalice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g="},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseUserFile(strings.NewReader(tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+)
+
+// Audit configures the audit log, which records all mutating REST and ConnectRPC
+// calls. Entries are written to all enabled sinks.
+type Audit struct {
+	Enabled bool `yaml:"enabled"`
+
+	// MaxRequestSize is the maximum size of a request body in bytes that is
+	// recorded. Larger requests are recorded without their body.
+	MaxRequestSize int `yaml:"maxRequestSize"`
+
+	Log   AuditLogSink   `yaml:"log"`
+	Kafka AuditKafkaSink `yaml:"kafka"`
+	File  AuditFileSink  `yaml:"file"`
+}
+
+// AuditLogSink writes audit entries as structured log messages.
+type AuditLogSink struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// AuditKafkaSink produces audit entries to a Kafka topic. The audit log can only
+// be queried via the API if this sink is enabled.
+type AuditKafkaSink struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Topic is created with the given partitions and replication factor if it
+	// does not exist. A replication factor of -1 uses the broker default.
+	Topic             string `yaml:"topic"`
+	Partitions        int32  `yaml:"partitions"`
+	ReplicationFactor int16  `yaml:"replicationFactor"`
+
+	// MaxScanRecords is the maximum number of records that are consumed to answer
+	// a single query.
+	MaxScanRecords int64 `yaml:"maxScanRecords"`
+}
+
+// AuditFileSink writes audit entries as JSON lines to a local file. The file is
+// rotated once it exceeds the maximum size.
+type AuditFileSink struct {
+	Enabled bool `yaml:"enabled"`
+
+	Filepath string `yaml:"filepath"`
+	// MaxSize is the size in bytes after which the file is rotated.
+	MaxSize int64 `yaml:"maxSize"`
+	// MaxBackups is the number of rotated files that are kept.
+	MaxBackups int `yaml:"maxBackups"`
+}
+
+// SetDefaults for the audit config.
+func (c *Audit) SetDefaults() {
+	c.MaxRequestSize = 16 * 1024
+	c.Log.Enabled = true
+	c.Kafka.Topic = "__redpanda_console_audit_log"
+	c.Kafka.Partitions = 1
+	c.Kafka.ReplicationFactor = -1
+	c.Kafka.MaxScanRecords = 10_000
+	c.File.MaxSize = 100 * 1024 * 1024
+	c.File.MaxBackups = 5
+}
+
+// Validate the audit config.
+func (c *Audit) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.Log.Enabled && !c.Kafka.Enabled && !c.File.Enabled {
+		return errors.New("at least one audit sink (log, kafka or file) must be enabled")
+	}
+	if c.MaxRequestSize < 0 {
+		return errors.New("max request size must not be negative")
+	}
+
+	if c.Kafka.Enabled {
+		if c.Kafka.Topic == "" {
+			return errors.New("kafka sink requires a topic")
+		}
+		if c.Kafka.Partitions <= 0 {
+			return errors.New("kafka sink partitions must be greater than 0")
+		}
+		if c.Kafka.MaxScanRecords <= 0 {
+			return errors.New("kafka sink max scan records must be greater than 0")
+		}
+	}
+
+	if c.File.Enabled {
+		if c.File.Filepath == "" {
+			return errors.New("file sink requires a filepath")
+		}
+		if c.File.MaxSize <= 0 {
+			return errors.New("file sink max size must be greater than 0")
+		}
+		if c.File.MaxBackups < 0 {
+			return errors.New("file sink max backups must not be negative")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,385 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Auth configures the built-in authentication. If enabled, all API requests
+// must be authenticated either with a session cookie, that is issued after
+// logging in via one of the enabled login methods, or with HTTP basic auth
+// against the local user file.
+type Auth struct {
+	Enabled bool `yaml:"enabled"`
+
+	Session AuthSession `yaml:"session"`
+	Basic   AuthBasic   `yaml:"basic"`
+	OIDC    AuthOIDC    `yaml:"oidc"`
+
+	// RBAC authorizes the authenticated users. If disabled, all authenticated
+	// users are allowed to perform all actions.
+	RBAC AuthRBAC `yaml:"rbac"`
+
+	// Impersonation makes Kafka requests with the identity of the logged in OIDC
+	// user instead of the configured Kafka SASL credentials.
+	Impersonation AuthImpersonation `yaml:"impersonation"`
+
+	// APITokens lets users create scoped tokens for service accounts, which
+	// scripts can pass as bearer token instead of logging in.
+	APITokens AuthAPITokens `yaml:"apiTokens"`
+
+	// Approvals require a second user to approve dangerous operations before
+	// they are executed.
+	Approvals AuthApprovals `yaml:"approvals"`
+}
+
+// AuthSession configures the session cookies that are issued after login.
+type AuthSession struct {
+	// Secret is used to sign the session cookies. If empty, a random secret is
+	// generated at startup, so that sessions do not survive a restart and are
+	// not shared between replicas.
+	Secret     string        `yaml:"secret"`
+	CookieName string        `yaml:"cookieName"`
+	MaxAge     time.Duration `yaml:"maxAge"`
+	// Secure sets the Secure attribute of the cookies, so that browsers only send
+	// them via HTTPS. Disable it only for local development without TLS.
+	Secure bool `yaml:"secure"`
+}
+
+// AuthBasic configures the login with users from a local htpasswd-style file.
+type AuthBasic struct {
+	Enabled bool `yaml:"enabled"`
+
+	// UsersFilepath is the path to a file with one user per line in the format
+	// "username:hash" or "username:hash:group1,group2". Supported hashes are bcrypt
+	// ("htpasswd -B") and SHA1 ("htpasswd -s"). Lines starting with # are ignored.
+	UsersFilepath string `yaml:"usersFilepath"`
+}
+
+// AuthOIDC configures the login via an OpenID Connect identity provider, using the
+// authorization code flow with PKCE.
+type AuthOIDC struct {
+	Enabled bool `yaml:"enabled"`
+
+	// DisplayName is shown on the login button, e.g. "Okta".
+	DisplayName  string `yaml:"displayName"`
+	IssuerURL    string `yaml:"issuerUrl"`
+	ClientID     string `yaml:"clientId"`
+	ClientSecret string `yaml:"clientSecret"`
+	// RedirectURL must point to the /auth/callbacks/oidc route of Console and be
+	// registered as redirect URL at the identity provider.
+	RedirectURL string   `yaml:"redirectUrl"`
+	Scopes      []string `yaml:"scopes"`
+
+	// UsernameClaim is the ID token claim that is used as principal name.
+	UsernameClaim string `yaml:"usernameClaim"`
+	// GroupsClaim is the ID token claim that contains the groups of the user. It
+	// may be a list of strings or a single string.
+	GroupsClaim string `yaml:"groupsClaim"`
+}
+
+// AuthRBAC configures the authorization with the role bindings of a policy file.
+type AuthRBAC struct {
+	Enabled bool `yaml:"enabled"`
+
+	// PolicyFilepath is the path to the YAML file that defines the roles and
+	// role bindings.
+	PolicyFilepath string `yaml:"policyFilepath"`
+	// RefreshInterval specifies how often the policy file is checked for changes.
+	RefreshInterval time.Duration `yaml:"refreshInterval"`
+}
+
+// Tokens of the identity provider that can be passed to Kafka for impersonation.
+const (
+	AuthImpersonationTokenAccess = "accessToken"
+	AuthImpersonationTokenID     = "idToken"
+)
+
+// AuthImpersonation configures the per-user Kafka clients that authenticate with
+// the OAUTHBEARER token the identity provider has issued to the logged in user.
+type AuthImpersonation struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Token is the token that is passed to Kafka, either "accessToken" or "idToken".
+	Token string `yaml:"token"`
+	// MaxClients is the maximum number of pooled per-user Kafka clients. If exceeded,
+	// the least recently used client is closed once no request uses it anymore.
+	MaxClients int `yaml:"maxClients"`
+	// IdleTimeout is the time after which unused per-user clients are closed.
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+	// AllowServiceIdentity lets users without a token, such as users of the local
+	// user file, use the configured Kafka credentials. Otherwise, their API
+	// requests are rejected.
+	AllowServiceIdentity bool `yaml:"allowServiceIdentity"`
+}
+
+// Storages for API tokens.
+const (
+	// AuthAPITokensStorageMemory keeps tokens in memory only, so that they are
+	// lost on restart and not shared between replicas.
+	AuthAPITokensStorageMemory = "memory"
+	// AuthAPITokensStorageFile stores tokens in a local file.
+	AuthAPITokensStorageFile = "file"
+)
+
+// AuthAPITokens configures the API tokens of service accounts.
+type AuthAPITokens struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Storage is the backend for tokens, either "memory" or "file". Only hashes
+	// of the token secrets are stored.
+	Storage string `yaml:"storage"`
+	// FilePath is the path of the file that tokens are stored in, if the file
+	// storage is used.
+	FilePath string `yaml:"filePath"`
+
+	// DefaultExpiry is the lifetime of tokens that are created without expiry.
+	DefaultExpiry time.Duration `yaml:"defaultExpiry"`
+	// MaxExpiry is the longest lifetime a token may be created with.
+	MaxExpiry time.Duration `yaml:"maxExpiry"`
+}
+
+// Operations that can require an approval.
+const (
+	AuthApprovalsOperationDeleteTopic                         = "deleteTopic"
+	AuthApprovalsOperationDeleteTopicRecords                  = "deleteTopicRecords"
+	AuthApprovalsOperationResetConsumerGroupOffsets           = "resetConsumerGroupOffsets"
+	AuthApprovalsOperationDeleteConsumerGroupOffsets          = "deleteConsumerGroupOffsets"
+	AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets = "applyTranslatedConsumerGroupOffsets"
+	AuthApprovalsOperationDeleteSchemaSubject                 = "deleteSchemaSubject"
+	AuthApprovalsOperationDeleteSchemaSubjectVersion          = "deleteSchemaSubjectVersion"
+)
+
+// Storages for change requests.
+const (
+	// AuthApprovalsStorageMemory keeps change requests in memory only, so that
+	// pending change requests are lost on restart.
+	AuthApprovalsStorageMemory = "memory"
+	// AuthApprovalsStorageFile stores change requests in a local file.
+	AuthApprovalsStorageFile = "file"
+)
+
+// AuthApprovals configures the four-eyes workflow for dangerous operations. The
+// configured operations are not executed immediately, instead a change request
+// is created that another user has to approve.
+type AuthApprovals struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Operations that require an approval. Supported operations are
+	// "deleteTopic", "deleteTopicRecords", "resetConsumerGroupOffsets",
+	// "deleteConsumerGroupOffsets", "applyTranslatedConsumerGroupOffsets",
+	// "deleteSchemaSubject" and "deleteSchemaSubjectVersion".
+	Operations []string `yaml:"operations"`
+	// Expiry is the time after which pending change requests can no longer be
+	// approved.
+	Expiry time.Duration `yaml:"expiry"`
+
+	// Storage is the backend for change requests, either "memory" or "file".
+	Storage string `yaml:"storage"`
+	// FilePath is the path of the file that change requests are stored in, if
+	// the file storage is used.
+	FilePath string `yaml:"filePath"`
+}
+
+// RegisterFlags registers all sensitive auth settings as flag.
+func (c *Auth) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.Session.Secret, "auth.session.secret", "", "Secret to sign session cookies")
+	f.StringVar(&c.OIDC.ClientSecret, "auth.oidc.clientSecret", "", "OIDC client secret")
+}
+
+// SetDefaults for the auth config.
+func (c *Auth) SetDefaults() {
+	c.Session.CookieName = "console_session"
+	c.Session.MaxAge = 12 * time.Hour
+	c.Session.Secure = true
+	c.OIDC.DisplayName = "OpenID Connect"
+	c.OIDC.Scopes = []string{"openid", "profile", "email"}
+	c.OIDC.UsernameClaim = "email"
+	c.OIDC.GroupsClaim = "groups"
+	c.RBAC.RefreshInterval = 10 * time.Second
+	c.Impersonation.Token = AuthImpersonationTokenAccess
+	c.Impersonation.MaxClients = 100
+	c.Impersonation.IdleTimeout = 10 * time.Minute
+	c.APITokens.Storage = AuthAPITokensStorageMemory
+	c.APITokens.DefaultExpiry = 30 * 24 * time.Hour
+	c.APITokens.MaxExpiry = 365 * 24 * time.Hour
+	c.Approvals.Operations = []string{
+		AuthApprovalsOperationDeleteTopic,
+		AuthApprovalsOperationDeleteTopicRecords,
+		AuthApprovalsOperationResetConsumerGroupOffsets,
+		AuthApprovalsOperationDeleteConsumerGroupOffsets,
+		AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets,
+		AuthApprovalsOperationDeleteSchemaSubject,
+		AuthApprovalsOperationDeleteSchemaSubjectVersion,
+	}
+	c.Approvals.Expiry = 24 * time.Hour
+	c.Approvals.Storage = AuthApprovalsStorageMemory
+}
+
+// Validate the auth config.
+func (c *Auth) Validate() error {
+	if !c.Enabled {
+		if c.RBAC.Enabled {
+			return errors.New("rbac requires auth to be enabled")
+		}
+		if c.Impersonation.Enabled {
+			return errors.New("impersonation requires auth to be enabled")
+		}
+		if c.APITokens.Enabled {
+			return errors.New("api tokens require auth to be enabled")
+		}
+		if c.Approvals.Enabled {
+			return errors.New("approvals require auth to be enabled")
+		}
+		return nil
+	}
+	if !c.Basic.Enabled && !c.OIDC.Enabled {
+		return errors.New("at least one login method (basic or oidc) must be enabled")
+	}
+	if c.Session.CookieName == "" {
+		return errors.New("session cookie name must not be empty")
+	}
+	if c.Session.MaxAge <= 0 {
+		return errors.New("session max age must be greater than 0")
+	}
+
+	if c.Basic.Enabled && c.Basic.UsersFilepath == "" {
+		return errors.New("basic auth requires a users filepath")
+	}
+
+	if c.OIDC.Enabled {
+		if err := c.OIDC.Validate(); err != nil {
+			return fmt.Errorf("failed to validate oidc config: %w", err)
+		}
+	}
+
+	if c.RBAC.Enabled {
+		if c.RBAC.PolicyFilepath == "" {
+			return errors.New("rbac requires a policy filepath")
+		}
+		if c.RBAC.RefreshInterval <= 0 {
+			return errors.New("rbac refresh interval must be greater than 0")
+		}
+	}
+
+	if c.Impersonation.Enabled {
+		if err := c.Impersonation.Validate(c.OIDC.Enabled); err != nil {
+			return fmt.Errorf("failed to validate impersonation config: %w", err)
+		}
+	}
+
+	if c.APITokens.Enabled {
+		if err := c.APITokens.Validate(); err != nil {
+			return fmt.Errorf("failed to validate api tokens config: %w", err)
+		}
+	}
+
+	if c.Approvals.Enabled {
+		if err := c.Approvals.Validate(); err != nil {
+			return fmt.Errorf("failed to validate approvals config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the OIDC config.
+func (c *AuthOIDC) Validate() error {
+	if _, err := url.ParseRequestURI(c.IssuerURL); err != nil {
+		return fmt.Errorf("issuer url is invalid: %w", err)
+	}
+	if _, err := url.ParseRequestURI(c.RedirectURL); err != nil {
+		return fmt.Errorf("redirect url is invalid: %w", err)
+	}
+	if c.ClientID == "" {
+		return errors.New("client id must be set")
+	}
+	if c.UsernameClaim == "" {
+		return errors.New("username claim must not be empty")
+	}
+
+	return nil
+}
+
+// Validate the impersonation config.
+func (c *AuthImpersonation) Validate(isOIDCEnabled bool) error {
+	if !isOIDCEnabled {
+		return errors.New("impersonation requires oidc to be enabled")
+	}
+	if c.Token != AuthImpersonationTokenAccess && c.Token != AuthImpersonationTokenID {
+		return fmt.Errorf("token must be either %q or %q", AuthImpersonationTokenAccess, AuthImpersonationTokenID)
+	}
+	if c.MaxClients <= 0 {
+		return errors.New("max clients must be greater than 0")
+	}
+	if c.IdleTimeout <= 0 {
+		return errors.New("idle timeout must be greater than 0")
+	}
+
+	return nil
+}
+
+// Validate the API tokens config.
+func (c *AuthAPITokens) Validate() error {
+	switch c.Storage {
+	case AuthAPITokensStorageMemory:
+	case AuthAPITokensStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file storage requires a file path")
+		}
+	default:
+		return fmt.Errorf("storage must be either %q or %q", AuthAPITokensStorageMemory, AuthAPITokensStorageFile)
+	}
+	if c.MaxExpiry <= 0 {
+		return errors.New("max expiry must be greater than 0")
+	}
+	if c.DefaultExpiry <= 0 || c.DefaultExpiry > c.MaxExpiry {
+		return errors.New("default expiry must be greater than 0 and must not exceed the max expiry")
+	}
+	return nil
+}
+
+// Validate the approvals config.
+func (c *AuthApprovals) Validate() error {
+	if len(c.Operations) == 0 {
+		return errors.New("at least one operation must be set")
+	}
+	for _, operation := range c.Operations {
+		switch operation {
+		case AuthApprovalsOperationDeleteTopic,
+			AuthApprovalsOperationDeleteTopicRecords,
+			AuthApprovalsOperationResetConsumerGroupOffsets,
+			AuthApprovalsOperationDeleteConsumerGroupOffsets,
+			AuthApprovalsOperationApplyTranslatedConsumerGroupOffsets,
+			AuthApprovalsOperationDeleteSchemaSubject,
+			AuthApprovalsOperationDeleteSchemaSubjectVersion:
+		default:
+			return fmt.Errorf("unknown operation %q", operation)
+		}
+	}
+	if c.Expiry <= 0 {
+		return errors.New("expiry must be greater than 0")
+	}
+	switch c.Storage {
+	case AuthApprovalsStorageMemory:
+	case AuthApprovalsStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file storage requires a file path")
+		}
+	default:
+		return fmt.Errorf("storage must be either %q or %q", AuthApprovalsStorageMemory, AuthApprovalsStorageFile)
+	}
+	return nil
+}
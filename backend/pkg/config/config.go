@@ -38,6 +38,8 @@ type Config struct {
 	Redpanda Redpanda       `yaml:"redpanda"`
 	Connect  Connect        `yaml:"connect"`
 	REST     Server         `yaml:"server"`
+	Auth     Auth           `yaml:"auth"`
+	Audit    Audit          `yaml:"audit"`
 	Kafka    Kafka          `yaml:"kafka"`
 	Logger   logging.Config `yaml:"logger"`
 }
@@ -50,6 +52,7 @@ func (c *Config) RegisterFlags(f *flag.FlagSet) {
 	c.Kafka.RegisterFlags(f)
 	c.Console.RegisterFlags(f)
 	c.Connect.RegisterFlags(f)
+	c.Auth.RegisterFlags(f)
 }
 
 // Validate all root and child config structs
@@ -79,6 +82,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("failed to validate Connect config: %w", err)
 	}
 
+	err = c.Auth.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate auth config: %w", err)
+	}
+
+	err = c.Audit.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate audit config: %w", err)
+	}
+
 	return nil
 }
 
@@ -93,6 +106,8 @@ func (c *Config) SetDefaults() {
 	c.Redpanda.SetDefaults()
 	c.Console.SetDefaults()
 	c.Connect.SetDefaults()
+	c.Auth.SetDefaults()
+	c.Audit.SetDefaults()
 }
 
 // LoadConfig read YAML-formatted config from filename into cfg.
@@ -23,6 +23,24 @@ type Connect struct {
 	ConnectTimeout time.Duration    `yaml:"connectTimeout"` // used for connectivity test
 	ReadTimeout    time.Duration    `yaml:"readTimeout"`    // overall REST/HTTP read timeout
 	RequestTimeout time.Duration    `yaml:"requestTimeout"` // timeout for REST requests to Kafka Connect
+
+	// Customizations are connector guides and config patches that are declared in YAML files.
+	Customizations ConnectCustomizations `yaml:"customizations"`
+
+	// AutoRemediation restarts failed connector tasks.
+	AutoRemediation ConnectAutoRemediation `yaml:"autoRemediation"`
+
+	// ConfigHistory stores revisions of connector configs.
+	ConfigHistory ConnectConfigHistory `yaml:"configHistory"`
+
+	// Templates are parameterized connector configs that are stored in Console.
+	Templates ConnectTemplates `yaml:"templates"`
+
+	// Registry allows registering Kafka connect clusters at runtime.
+	Registry ConnectRegistry `yaml:"registry"`
+
+	// Discovery finds Kafka connect clusters by their consumer groups.
+	Discovery ConnectDiscovery `yaml:"discovery"`
 }
 
 // SetDefaults for Kafka connect configuration.
@@ -33,6 +51,12 @@ func (c *Connect) SetDefaults() {
 	c.ConnectTimeout = 15 * time.Second
 	c.ReadTimeout = 6 * time.Second
 	c.RequestTimeout = 6 * time.Second
+	c.Customizations.SetDefaults()
+	c.AutoRemediation.SetDefaults()
+	c.ConfigHistory.SetDefaults()
+	c.Templates.SetDefaults()
+	c.Registry.SetDefaults()
+	c.Discovery.SetDefaults()
 }
 
 // RegisterFlags registers all nested config flags.
@@ -41,6 +65,9 @@ func (c *Connect) RegisterFlags(f *flag.FlagSet) {
 		flagNamePrefix := fmt.Sprintf("connect.clusters.%d.", i)
 		cluster.RegisterFlagsWithPrefix(f, flagNamePrefix)
 	}
+	c.Customizations.RegisterFlags(f)
+	c.Registry.RegisterFlags(f)
+	c.Discovery.RegisterFlags(f)
 }
 
 // Validate provided configurations for Kafka connect clusters.
@@ -51,5 +78,23 @@ func (c *Connect) Validate() error {
 			return fmt.Errorf("failed to validate cluster at index '%d' (name: '%v'): %w", i, cluster.Name, err)
 		}
 	}
+	if err := c.Customizations.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connector customizations: %w", err)
+	}
+	if err := c.AutoRemediation.Validate(); err != nil {
+		return fmt.Errorf("failed to validate auto remediation config: %w", err)
+	}
+	if err := c.ConfigHistory.Validate(); err != nil {
+		return fmt.Errorf("failed to validate config history: %w", err)
+	}
+	if err := c.Templates.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connector templates: %w", err)
+	}
+	if err := c.Registry.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connect cluster registry: %w", err)
+	}
+	if err := c.Discovery.Validate(); err != nil {
+		return fmt.Errorf("failed to validate connect cluster discovery: %w", err)
+	}
 	return nil
 }
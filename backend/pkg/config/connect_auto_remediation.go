@@ -0,0 +1,164 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ConnectAutoRemediation configures the supervisor that periodically checks the
+// connectors of all configured Kafka connect clusters and restarts failed tasks.
+type ConnectAutoRemediation struct {
+	Enabled bool `yaml:"enabled"`
+
+	// PollInterval is the interval in which the connector states are checked.
+	PollInterval time.Duration `yaml:"pollInterval"`
+
+	// HistorySize is the number of remediation events that are kept per connector.
+	HistorySize int `yaml:"historySize"`
+
+	// Policy is the remediation policy for all connectors that are not matched by
+	// any of the overrides.
+	Policy ConnectRemediationPolicy `yaml:"policy"`
+
+	// Overrides change the policy for specific connectors. The first matching
+	// override is applied.
+	Overrides []ConnectRemediationPolicyOverride `yaml:"overrides"`
+}
+
+// ConnectRemediationPolicy defines how often and in what intervals a failed task
+// is restarted before the supervisor gives up.
+type ConnectRemediationPolicy struct {
+	// MaxAttempts is the number of restarts for a failed task. Once exhausted the
+	// task is left in the failed state until it is restarted manually.
+	MaxAttempts int `yaml:"maxAttempts"`
+
+	// BaseInterval is the wait time after the first restart attempt. The wait time
+	// is multiplied by Multiplier for each further attempt, up to MaxInterval.
+	BaseInterval time.Duration `yaml:"baseInterval"`
+	MaxInterval  time.Duration `yaml:"maxInterval"`
+	Multiplier   float64       `yaml:"multiplier"`
+}
+
+// ConnectRemediationPolicyOverride changes the remediation policy for all matching
+// connectors. Policy properties that are not set are inherited from the default policy.
+type ConnectRemediationPolicyOverride struct {
+	// ClusterName is the name of the connect cluster this override applies to. This
+	// supports regex (e.g. "/prod-.*/"). If not set, all clusters are matched.
+	ClusterName Regexp `yaml:"clusterName"`
+
+	// ConnectorName is the name of the connectors this override applies to. This
+	// supports regex (e.g. "/debezium-.*/").
+	ConnectorName Regexp `yaml:"connectorName"`
+
+	// Disabled turns off the remediation for matching connectors.
+	Disabled bool `yaml:"disabled"`
+
+	Policy ConnectRemediationPolicy `yaml:"policy"`
+}
+
+// SetDefaults for the auto remediation config.
+func (c *ConnectAutoRemediation) SetDefaults() {
+	c.PollInterval = 30 * time.Second
+	c.HistorySize = 50
+	c.Policy = ConnectRemediationPolicy{
+		MaxAttempts:  5,
+		BaseInterval: time.Minute,
+		MaxInterval:  15 * time.Minute,
+		Multiplier:   2,
+	}
+}
+
+// Validate the auto remediation config.
+func (c *ConnectAutoRemediation) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PollInterval <= 0 {
+		return errors.New("poll interval must be greater than 0")
+	}
+	if c.HistorySize <= 0 {
+		return errors.New("history size must be greater than 0")
+	}
+	if err := c.Policy.Validate(); err != nil {
+		return fmt.Errorf("failed to validate policy: %w", err)
+	}
+
+	for i, override := range c.Overrides {
+		if override.ConnectorName.Regexp == nil {
+			return fmt.Errorf("override at index %d must set a connector name", i)
+		}
+		policy := override.Policy.withDefaults(c.Policy)
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("failed to validate policy of override at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the remediation policy.
+func (c *ConnectRemediationPolicy) Validate() error {
+	if c.MaxAttempts <= 0 {
+		return errors.New("max attempts must be greater than 0")
+	}
+	if c.BaseInterval <= 0 {
+		return errors.New("base interval must be greater than 0")
+	}
+	if c.MaxInterval < c.BaseInterval {
+		return errors.New("max interval must not be smaller than the base interval")
+	}
+	if c.Multiplier < 1 {
+		return errors.New("multiplier must be at least 1")
+	}
+
+	return nil
+}
+
+// PolicyForConnector returns the remediation policy for the given connector and
+// whether remediation is enabled for it.
+func (c *ConnectAutoRemediation) PolicyForConnector(clusterName, connectorName string) (ConnectRemediationPolicy, bool) {
+	if !c.Enabled {
+		return c.Policy, false
+	}
+
+	for _, override := range c.Overrides {
+		if override.ClusterName.Regexp != nil && !override.ClusterName.MatchString(clusterName) {
+			continue
+		}
+		if !override.ConnectorName.MatchString(connectorName) {
+			continue
+		}
+		return override.Policy.withDefaults(c.Policy), !override.Disabled
+	}
+
+	return c.Policy, true
+}
+
+// withDefaults returns the policy where all unset properties are taken from the
+// given default policy.
+func (c ConnectRemediationPolicy) withDefaults(defaults ConnectRemediationPolicy) ConnectRemediationPolicy {
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = defaults.MaxAttempts
+	}
+	if c.BaseInterval == 0 {
+		c.BaseInterval = defaults.BaseInterval
+	}
+	if c.MaxInterval == 0 {
+		c.MaxInterval = defaults.MaxInterval
+	}
+	if c.Multiplier == 0 {
+		c.Multiplier = defaults.Multiplier
+	}
+
+	return c
+}
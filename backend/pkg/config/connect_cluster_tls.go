@@ -24,6 +24,13 @@ type ConnectClusterTLS struct {
 	CertFilepath          string `yaml:"certFilepath"`
 	KeyFilepath           string `yaml:"keyFilepath"`
 	InsecureSkipTLSVerify bool   `yaml:"insecureSkipTlsVerify"`
+
+	// CaPEM, CertPEM and KeyPEM contain the PEM encoded certificates and key
+	// instead of paths to files that contain them. They take precedence over
+	// the file paths and are used by clusters that are registered at runtime.
+	CaPEM   string `yaml:"caPem"`
+	CertPEM string `yaml:"certPem"`
+	KeyPEM  string `yaml:"keyPem"`
 }
 
 // SetDefaults for the Kafka connect TLS config.
@@ -50,8 +57,8 @@ func (c *ConnectClusterTLS) TLSConfig() (*tls.Config, error) {
 
 	// 1. Create CA cert pool
 	var caCertPool *x509.CertPool
-	if c.CaFilepath != "" {
-		ca, err := os.ReadFile(c.CaFilepath)
+	if c.CaPEM != "" || c.CaFilepath != "" {
+		ca, err := readPEM(c.CaPEM, c.CaFilepath)
 		if err != nil {
 			return nil, err
 		}
@@ -64,13 +71,13 @@ func (c *ConnectClusterTLS) TLSConfig() (*tls.Config, error) {
 
 	// 2. If configured load TLS cert & key - Mutual TLS
 	var certificates []tls.Certificate
-	if c.CertFilepath != "" && c.KeyFilepath != "" {
-		cert, err := os.ReadFile(c.CertFilepath)
+	if (c.CertPEM != "" || c.CertFilepath != "") && (c.KeyPEM != "" || c.KeyFilepath != "") {
+		cert, err := readPEM(c.CertPEM, c.CertFilepath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read cert file for schema registry client: %w", err)
 		}
 
-		privateKey, err := os.ReadFile(c.KeyFilepath)
+		privateKey, err := readPEM(c.KeyPEM, c.KeyFilepath)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read key file for schema registry client: %w", err)
 		}
@@ -94,3 +101,12 @@ func (c *ConnectClusterTLS) TLSConfig() (*tls.Config, error) {
 		RootCAs:            caCertPool,
 	}, nil
 }
+
+// readPEM returns the PEM content if it is set, otherwise the content of the
+// file at the given path.
+func readPEM(content string, path string) ([]byte, error) {
+	if content != "" {
+		return []byte(content), nil
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ConnectConfigHistoryStorageMemory keeps the revisions in memory only, so
+	// that they are lost when Console restarts.
+	ConnectConfigHistoryStorageMemory = "memory"
+	// ConnectConfigHistoryStorageFile appends the revisions to a local file.
+	ConnectConfigHistoryStorageFile = "file"
+)
+
+// ConnectConfigHistory configures the versioning of connector configurations.
+// Every config change that is made through Console is stored as a revision,
+// so that revisions can be compared and rolled back.
+type ConnectConfigHistory struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Storage is the backend for the revisions, either "memory" or "file".
+	Storage string `yaml:"storage"`
+
+	// FilePath is the path of the file that revisions are stored in, if the
+	// file storage is used.
+	FilePath string `yaml:"filePath"`
+
+	// MaxRevisions is the number of revisions that are kept per connector.
+	MaxRevisions int `yaml:"maxRevisions"`
+
+	// ObserveInterval is the interval in which the configs of all connectors are
+	// compared against their latest revision. Changes that have been made outside
+	// of Console are stored as observed revisions. Set 0 to disable.
+	ObserveInterval time.Duration `yaml:"observeInterval"`
+}
+
+// SetDefaults for the connector config history.
+func (c *ConnectConfigHistory) SetDefaults() {
+	c.Storage = ConnectConfigHistoryStorageMemory
+	c.MaxRevisions = 100
+	c.ObserveInterval = 5 * time.Minute
+}
+
+// Validate the connector config history config.
+func (c *ConnectConfigHistory) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Storage {
+	case ConnectConfigHistoryStorageMemory:
+	case ConnectConfigHistoryStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file path must be set when using the file storage")
+		}
+	default:
+		return fmt.Errorf("storage %q is invalid, must be one of %q or %q",
+			c.Storage, ConnectConfigHistoryStorageMemory, ConnectConfigHistoryStorageFile)
+	}
+	if c.MaxRevisions <= 0 {
+		return errors.New("max revisions must be greater than 0")
+	}
+	if c.ObserveInterval < 0 {
+		return errors.New("observe interval must not be negative")
+	}
+
+	return nil
+}
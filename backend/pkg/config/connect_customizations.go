@@ -0,0 +1,60 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"flag"
+	"fmt"
+)
+
+// ConnectCustomizations configures the sources for connector guides and config
+// patches that are declared in YAML files. These are loaded once at startup and
+// merged with the built-in guides and patches.
+type ConnectCustomizations struct {
+	Enabled    bool       `yaml:"enabled"`
+	Git        Git        `yaml:"git"`
+	FileSystem Filesystem `yaml:"fileSystem"`
+}
+
+// RegisterFlags registers all sensitive flags for the git source.
+func (c *ConnectCustomizations) RegisterFlags(f *flag.FlagSet) {
+	c.Git.RegisterFlagsWithPrefix(f, "connect.customizations.")
+}
+
+// Validate the connector customizations config.
+func (c *ConnectCustomizations) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if !c.Git.Enabled && !c.FileSystem.Enabled {
+		return fmt.Errorf("connector customizations are enabled, but neither git nor filesystem source is enabled")
+	}
+	if err := c.Git.Validate(); err != nil {
+		return fmt.Errorf("failed to validate git config: %w", err)
+	}
+	if err := c.FileSystem.Validate(); err != nil {
+		return fmt.Errorf("failed to validate filesystem config: %w", err)
+	}
+
+	return nil
+}
+
+// SetDefaults for the connector customizations config.
+func (c *ConnectCustomizations) SetDefaults() {
+	c.Git.SetDefaults()
+	c.FileSystem.SetDefaults()
+
+	// Index by full filepath so that files with the same name in different directories
+	// are all loaded.
+	c.Git.IndexByFullFilepath = true
+	c.Git.AllowedFileExtensions = []string{"yaml", "yml"}
+	c.FileSystem.IndexByFullFilepath = true
+	c.FileSystem.AllowedFileExtensions = []string{"yaml", "yml"}
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+)
+
+const (
+	// ConnectRegistryStorageMemory keeps registered clusters in memory only, so
+	// that they are lost when Console restarts.
+	ConnectRegistryStorageMemory = "memory"
+	// ConnectRegistryStorageFile stores registered clusters in a local file.
+	ConnectRegistryStorageFile = "file"
+	// ConnectRegistryStorageKafka stores registered clusters in a compacted Kafka
+	// topic, so that they are shared by all Console instances.
+	ConnectRegistryStorageKafka = "kafka"
+)
+
+// ConnectRegistry configures the registration of Kafka connect clusters at
+// runtime through the API, in addition to the clusters in this config.
+type ConnectRegistry struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Storage is the backend for registered clusters, either "memory", "file"
+	// or "kafka".
+	Storage string `yaml:"storage"`
+
+	// EncryptionKey is the secret that the passwords, tokens and TLS keys of
+	// registered clusters are encrypted with. It is required by the file and
+	// kafka storage and must be the same for all Console instances that share
+	// the storage.
+	EncryptionKey string `yaml:"encryptionKey"`
+
+	// FilePath is the path of the file that registered clusters are stored in,
+	// if the file storage is used.
+	FilePath string `yaml:"filePath"`
+
+	// Topic is the compacted topic that registered clusters are stored in, if
+	// the kafka storage is used. It is created if it does not exist.
+	Topic string `yaml:"topic"`
+
+	// SyncInterval is the interval in which registered clusters are reloaded
+	// from the storage, so that registrations of other Console instances that
+	// share the same storage are picked up. Set 0 to disable.
+	SyncInterval time.Duration `yaml:"syncInterval"`
+}
+
+// RegisterFlags registers all sensitive connect cluster registry flags.
+func (c *ConnectRegistry) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.EncryptionKey, "connect.registry.encryption-key", "", "Secret that credentials of registered connect clusters are encrypted with")
+}
+
+// SetDefaults for the connect cluster registry.
+func (c *ConnectRegistry) SetDefaults() {
+	c.Storage = ConnectRegistryStorageMemory
+	c.Topic = "_redpanda_console_connect_clusters"
+	c.SyncInterval = time.Minute
+}
+
+// Validate the connect cluster registry config.
+func (c *ConnectRegistry) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Storage {
+	case ConnectRegistryStorageMemory:
+	case ConnectRegistryStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file path must be set when using the file storage")
+		}
+		if c.EncryptionKey == "" {
+			return errors.New("encryption key must be set when using the file storage")
+		}
+	case ConnectRegistryStorageKafka:
+		if c.Topic == "" {
+			return errors.New("topic must be set when using the kafka storage")
+		}
+		if c.EncryptionKey == "" {
+			return errors.New("encryption key must be set when using the kafka storage")
+		}
+	default:
+		return fmt.Errorf("storage %q is invalid, must be one of %q, %q or %q",
+			c.Storage, ConnectRegistryStorageMemory, ConnectRegistryStorageFile, ConnectRegistryStorageKafka)
+	}
+	if c.SyncInterval < 0 {
+		return errors.New("sync interval must not be negative")
+	}
+
+	return nil
+}
+
+// ConnectDiscovery configures the discovery of Kafka connect clusters. Each
+// consumer group with protocol type "connect" is a Kafka connect cluster, named
+// after its group.id. The workers' REST URLs are read from the group member
+// metadata.
+type ConnectDiscovery struct {
+	Enabled bool `yaml:"enabled"`
+
+	// GroupIDs restricts the discovery to the given group IDs. All groups with
+	// protocol type "connect" are discovered if it is empty.
+	GroupIDs []string `yaml:"groupIds"`
+
+	// Interval in which the consumer groups are scanned for Kafka connect clusters.
+	Interval time.Duration `yaml:"interval"`
+
+	// Authentication that is used for all discovered clusters.
+	TLS      ConnectClusterTLS `yaml:"tls"`
+	Username string            `yaml:"username"`
+	Password string            `yaml:"password"`
+	Token    string            `yaml:"token"`
+}
+
+// RegisterFlags registers all sensitive connect discovery flags.
+func (c *ConnectDiscovery) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.Password, "connect.discovery.password", "", "Basic auth password for discovered connect clusters")
+	f.StringVar(&c.Token, "connect.discovery.token", "", "Bearer token for discovered connect clusters")
+}
+
+// SetDefaults for the connect cluster discovery.
+func (c *ConnectDiscovery) SetDefaults() {
+	c.Interval = time.Minute
+	c.TLS.SetDefaults()
+}
+
+// Validate the connect cluster discovery config.
+func (c *ConnectDiscovery) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return errors.New("interval must be greater than 0")
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("failed to validate TLS config: %w", err)
+	}
+	return nil
+}
+
+// ClusterConfig returns the config of a discovered Kafka connect cluster.
+func (c *ConnectDiscovery) ClusterConfig(name string, url string) ConnectCluster {
+	return ConnectCluster{
+		Name:     name,
+		URL:      url,
+		TLS:      c.TLS,
+		Username: c.Username,
+		Password: c.Password,
+		Token:    c.Token,
+	}
+}
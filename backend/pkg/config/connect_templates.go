@@ -0,0 +1,61 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// ConnectTemplatesStorageMemory keeps the templates in memory only, so that
+	// they are lost when Console restarts.
+	ConnectTemplatesStorageMemory = "memory"
+	// ConnectTemplatesStorageFile stores the templates in a local file.
+	ConnectTemplatesStorageFile = "file"
+)
+
+// ConnectTemplates configures parameterized connector templates, which can be
+// rendered and applied to many topics and Kafka connect clusters at once.
+type ConnectTemplates struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Storage is the backend for the templates, either "memory" or "file".
+	Storage string `yaml:"storage"`
+
+	// FilePath is the path of the file that templates are stored in, if the
+	// file storage is used.
+	FilePath string `yaml:"filePath"`
+}
+
+// SetDefaults for the connector templates.
+func (c *ConnectTemplates) SetDefaults() {
+	c.Storage = ConnectTemplatesStorageMemory
+}
+
+// Validate the connector templates config.
+func (c *ConnectTemplates) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Storage {
+	case ConnectTemplatesStorageMemory:
+	case ConnectTemplatesStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file path must be set when using the file storage")
+		}
+	default:
+		return fmt.Errorf("storage %q is invalid, must be one of %q or %q",
+			c.Storage, ConnectTemplatesStorageMemory, ConnectTemplatesStorageFile)
+	}
+
+	return nil
+}
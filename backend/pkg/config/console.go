@@ -26,6 +26,7 @@ type Console struct {
 	TopicDocumentation            ConsoleTopicDocumentation `yaml:"topicDocumentation"`
 	MaxDeserializationPayloadSize int                       `yaml:"maxDeserializationPayloadSize"`
 	API                           ConsoleAPI                `yaml:"api"`
+	RecordDiff                    ConsoleRecordDiff         `yaml:"recordDiff"`
 }
 
 // SetDefaults for Console configs.
@@ -34,6 +35,7 @@ func (c *Console) SetDefaults() {
 	c.TopicDocumentation.SetDefaults()
 	c.MaxDeserializationPayloadSize = DefaultMaxDeserializationPayloadSize
 	c.API.SetDefaults()
+	c.RecordDiff.SetDefaults()
 }
 
 // RegisterFlags for sensitive Console configurations.
@@ -51,5 +53,9 @@ func (c *Console) Validate() error {
 		return fmt.Errorf("failed to validate API config: %w", err)
 	}
 
+	if err := c.RecordDiff.Validate(); err != nil {
+		return fmt.Errorf("failed to validate record diff config: %w", err)
+	}
+
 	return nil
 }
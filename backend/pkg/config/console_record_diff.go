@@ -0,0 +1,41 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConsoleRecordDiff configures the comparison of two Kafka records.
+type ConsoleRecordDiff struct {
+	// IgnoredFields is a list of field paths that are ignored when comparing two
+	// records, unless the request specifies its own list. Paths are dot separated
+	// and start with one of "timestamp", "key", "value" or "headers", for example
+	// "value.metadata.updatedAt". A "*" segment matches any single field name or
+	// array index. Ignoring a field also ignores all its nested fields.
+	IgnoredFields []string `yaml:"ignoredFields"`
+}
+
+// SetDefaults for the record diff configuration.
+func (c *ConsoleRecordDiff) SetDefaults() {
+	c.IgnoredFields = []string{"timestamp"}
+}
+
+// Validate the record diff configuration.
+func (c *ConsoleRecordDiff) Validate() error {
+	for _, field := range c.IgnoredFields {
+		if field == "" || strings.Contains(field, "..") || strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") {
+			return fmt.Errorf("ignored field %q is not a valid dot separated field path", field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// EncryptionKEKProviderLocal loads key encryption keys from a local keyfile.
+	EncryptionKEKProviderLocal = "local"
+	// EncryptionKEKProviderVault uses a Vault transit compatible HTTP endpoint
+	// to wrap and unwrap data encryption keys.
+	EncryptionKEKProviderVault = "vault"
+)
+
+// Encryption is the configuration for client-side field level encryption (CSFLE)
+// and payload envelope encryption. When enabled, encryption rules that are stored
+// in the schema registry's rule sets are applied when deserializing and serializing
+// records.
+type Encryption struct {
+	Enabled bool `yaml:"enabled"`
+
+	// KEKProvider is the provider for key encryption keys (KEK). Supported values
+	// are "local" and "vault".
+	KEKProvider string `yaml:"kekProvider"`
+
+	Local EncryptionLocal `yaml:"local"`
+	Vault EncryptionVault `yaml:"vault"`
+
+	// DEKCacheTTL is the duration for which unwrapped data encryption keys are
+	// kept in memory, so that we don't have to call the KEK provider for each record.
+	DEKCacheTTL time.Duration `yaml:"dekCacheTtl"`
+}
+
+// EncryptionLocal configures the local keyfile KEK provider.
+type EncryptionLocal struct {
+	// KeyFilepath is the path to a YAML or JSON file that maps KEK names to
+	// base64 encoded 256-bit AES keys.
+	KeyFilepath string `yaml:"keyFilepath"`
+}
+
+// EncryptionVault configures the Vault transit compatible KEK provider.
+type EncryptionVault struct {
+	URL       string `yaml:"url"`
+	Token     string `yaml:"token"`
+	MountPath string `yaml:"mountPath"`
+	Namespace string `yaml:"namespace"`
+}
+
+// RegisterFlags for sensitive encryption configurations.
+func (c *Encryption) RegisterFlags(f *flag.FlagSet) {
+	f.StringVar(&c.Vault.Token, "kafka.encryption.vault.token", "", "Token for authenticating against the Vault transit endpoint (optional)")
+}
+
+// SetDefaults for the encryption configuration.
+func (c *Encryption) SetDefaults() {
+	c.KEKProvider = EncryptionKEKProviderLocal
+	c.Vault.MountPath = "transit"
+	c.DEKCacheTTL = 10 * time.Minute
+}
+
+// Validate the encryption configuration.
+func (c *Encryption) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.KEKProvider {
+	case EncryptionKEKProviderLocal:
+		if c.Local.KeyFilepath == "" {
+			return fmt.Errorf("local kek provider requires a keyFilepath")
+		}
+	case EncryptionKEKProviderVault:
+		if c.Vault.URL == "" {
+			return fmt.Errorf("vault kek provider requires a url")
+		}
+		if _, err := url.Parse(c.Vault.URL); err != nil {
+			return fmt.Errorf("failed to parse vault url %q: %w", c.Vault.URL, err)
+		}
+		if c.Vault.MountPath == "" {
+			return fmt.Errorf("vault kek provider requires a mountPath")
+		}
+	default:
+		return fmt.Errorf("unknown kek provider %q, must be one of: %q, %q",
+			c.KEKProvider, EncryptionKEKProviderLocal, EncryptionKEKProviderVault)
+	}
+
+	if c.DEKCacheTTL < 0 {
+		return fmt.Errorf("dekCacheTtl must not be negative")
+	}
+
+	return nil
+}
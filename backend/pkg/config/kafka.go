@@ -26,6 +26,21 @@ type Kafka struct {
 	Protobuf    Proto   `yaml:"protobuf"`
 	MessagePack Msgpack `yaml:"messagePack"`
 
+	// Encryption configures client-side field level encryption.
+	Encryption Encryption `yaml:"encryption"`
+
+	// Headers configures the deserialization of record header values.
+	Headers KafkaHeaders `yaml:"headers"`
+
+	// KeyLookup configures the lookup of records by key.
+	KeyLookup KafkaKeyLookup `yaml:"keyLookup"`
+
+	// MirrorMaker configures the MirrorMaker 2 replication dashboard.
+	MirrorMaker KafkaMirrorMaker `yaml:"mirrorMaker"`
+
+	// Credentials configures the lifecycle management of SCRAM credentials.
+	Credentials KafkaCredentials `yaml:"credentials"`
+
 	TLS  KafkaTLS  `yaml:"tls"`
 	SASL KafkaSASL `yaml:"sasl"`
 
@@ -40,6 +55,7 @@ func (c *Kafka) RegisterFlags(f *flag.FlagSet) {
 	c.SASL.RegisterFlags(f)
 	c.Protobuf.RegisterFlags(f)
 	c.Schema.RegisterFlags(f)
+	c.Encryption.RegisterFlags(f)
 }
 
 // Validate the Kafka config
@@ -68,6 +84,31 @@ func (c *Kafka) Validate() error {
 		return fmt.Errorf("failed to validate msgpack config: %w", err)
 	}
 
+	err = c.Encryption.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate encryption config: %w", err)
+	}
+
+	err = c.Headers.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate headers config: %w", err)
+	}
+
+	err = c.KeyLookup.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate key lookup config: %w", err)
+	}
+
+	err = c.MirrorMaker.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate mirror maker config: %w", err)
+	}
+
+	err = c.Credentials.Validate()
+	if err != nil {
+		return fmt.Errorf("failed to validate credentials config: %w", err)
+	}
+
 	err = c.Startup.Validate()
 	if err != nil {
 		return fmt.Errorf("failed to validate startup config: %w", err)
@@ -83,6 +124,10 @@ func (c *Kafka) SetDefaults() {
 	c.SASL.SetDefaults()
 	c.Protobuf.SetDefaults()
 	c.MessagePack.SetDefaults()
+	c.Encryption.SetDefaults()
+	c.KeyLookup.SetDefaults()
+	c.MirrorMaker.SetDefaults()
+	c.Credentials.SetDefaults()
 	c.Startup.SetDefaults()
 }
 
@@ -102,6 +147,7 @@ func (c Kafka) RedactedConfig() Kafka {
 	copiedCfg.SASL.OAUth.ClientSecret = redactString(c.SASL.OAUth.ClientSecret)
 	copiedCfg.SASL.AWSMskIam.SecretKey = redactString(c.SASL.AWSMskIam.SecretKey)
 	copiedCfg.SASL.AWSMskIam.SessionToken = redactString(c.SASL.AWSMskIam.SessionToken)
+	copiedCfg.Encryption.Vault.Token = redactString(c.Encryption.Vault.Token)
 
 	return copiedCfg
 }
@@ -0,0 +1,97 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Storages for the metadata of SCRAM credentials.
+const (
+	// KafkaCredentialsStorageMemory keeps the metadata in memory only, so that
+	// creation and rotation dates are lost on restart. Rotations with a grace
+	// period are not supported.
+	KafkaCredentialsStorageMemory = "memory"
+	// KafkaCredentialsStorageFile stores the metadata in a local file.
+	KafkaCredentialsStorageFile = "file"
+)
+
+// KafkaCredentials configures the lifecycle management of SCRAM credentials:
+// generated passwords, rotations with a grace period and the detection of stale
+// passwords.
+type KafkaCredentials struct {
+	Enabled bool `yaml:"enabled"`
+
+	// PasswordLength is the number of characters of generated passwords.
+	PasswordLength int `yaml:"passwordLength"`
+	// Iterations is the number of SCRAM iterations of created credentials. Kafka
+	// accepts values between 4096 and 16384.
+	Iterations int32 `yaml:"iterations"`
+	// MaxPasswordAge is the age after which a password is reported as stale. Zero
+	// disables the check.
+	MaxPasswordAge time.Duration `yaml:"maxPasswordAge"`
+	// DefaultGracePeriod is the time during which the old and the new password
+	// are both valid after a rotation, if the rotation does not set its own.
+	DefaultGracePeriod time.Duration `yaml:"defaultGracePeriod"`
+	// CheckInterval is the interval in which the old credentials of rotations
+	// whose grace period has ended are deleted.
+	CheckInterval time.Duration `yaml:"checkInterval"`
+
+	// Storage is the backend for the credential metadata, either "memory" or "file".
+	Storage string `yaml:"storage"`
+	// FilePath is the path of the file that the metadata is stored in, if the file
+	// storage is used.
+	FilePath string `yaml:"filePath"`
+}
+
+// SetDefaults for the credentials config.
+func (c *KafkaCredentials) SetDefaults() {
+	c.PasswordLength = 32
+	c.Iterations = 4096
+	c.MaxPasswordAge = 90 * 24 * time.Hour
+	c.DefaultGracePeriod = 24 * time.Hour
+	c.CheckInterval = time.Minute
+	c.Storage = KafkaCredentialsStorageMemory
+}
+
+// Validate the credentials config.
+func (c *KafkaCredentials) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.PasswordLength < 16 || c.PasswordLength > 128 {
+		return errors.New("password length must be between 16 and 128")
+	}
+	if c.Iterations < 4096 || c.Iterations > 16384 {
+		return errors.New("iterations must be between 4096 and 16384")
+	}
+	if c.MaxPasswordAge < 0 {
+		return errors.New("max password age must not be negative")
+	}
+	if c.DefaultGracePeriod <= 0 {
+		return errors.New("default grace period must be greater than 0")
+	}
+	if c.CheckInterval <= 0 {
+		return errors.New("check interval must be greater than 0")
+	}
+	switch c.Storage {
+	case KafkaCredentialsStorageMemory:
+	case KafkaCredentialsStorageFile:
+		if c.FilePath == "" {
+			return errors.New("file path must be set when using the file storage")
+		}
+	default:
+		return fmt.Errorf("storage must be either %q or %q", KafkaCredentialsStorageMemory, KafkaCredentialsStorageFile)
+	}
+
+	return nil
+}
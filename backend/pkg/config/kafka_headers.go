@@ -0,0 +1,100 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// headerDeserializationEncodings are the payload encodings that can be configured
+// for record headers. The names must match the payload encodings of the serde package.
+var headerDeserializationEncodings = []string{
+	"json",
+	"jsonSchema",
+	"xml",
+	"avro",
+	"protobuf",
+	"protobufSchema",
+	"msgpack",
+	"smile",
+	"cbor",
+	"bson",
+	"utf8WithControlChars",
+	"text",
+	"uint",
+	"binary",
+}
+
+// KafkaHeaders configures how record header values shall be deserialized.
+type KafkaHeaders struct {
+	// Rules define the encodings that shall be used to deserialize record header values.
+	// The first rule whose topic name and header key match a header is applied. Header
+	// values that are not matched by any rule are presented as text or binary.
+	Rules []KafkaHeaderRule `yaml:"rules"`
+}
+
+// KafkaHeaderRule defines the encoding that shall be used for the values of
+// all record headers whose key matches.
+type KafkaHeaderRule struct {
+	// TopicName restricts the rule to topics that match the given name. This supports
+	// regex (e.g. "/orders-.*/"). If not set, the rule applies to all topics.
+	TopicName Regexp `yaml:"topicName"`
+
+	// Key is the header key this rule applies to. This supports regex (e.g. "/trace-.*/").
+	Key Regexp `yaml:"key"`
+
+	// Encoding is the payload encoding that shall be used to deserialize the header value,
+	// for example "avro", "protobufSchema", "json" or "uint". Schema registry encodings
+	// expect the header value to use the schema registry wire format.
+	Encoding string `yaml:"encoding"`
+
+	// ProtoType is the fully qualified name of the proto type that shall be used to deserialize
+	// the header value. This is required if the encoding is "protobuf".
+	ProtoType string `yaml:"protoType"`
+}
+
+// Validate the header deserialization rules.
+func (c *KafkaHeaders) Validate() error {
+	for i, rule := range c.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("failed to validate header rule at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate the header deserialization rule.
+func (c *KafkaHeaderRule) Validate() error {
+	if c.Key.Regexp == nil {
+		return errors.New("a header key must be set")
+	}
+
+	if !slices.Contains(headerDeserializationEncodings, c.Encoding) {
+		return fmt.Errorf("encoding %q is not supported, must be one of: %v", c.Encoding, headerDeserializationEncodings)
+	}
+
+	if c.Encoding == "protobuf" && c.ProtoType == "" {
+		return errors.New("a proto type must be set for protobuf encoded headers")
+	}
+
+	return nil
+}
+
+// Matches returns true if the rule applies to the given topic and header key.
+func (c *KafkaHeaderRule) Matches(topicName, headerKey string) bool {
+	if c.TopicName.Regexp != nil && !c.TopicName.MatchString(topicName) {
+		return false
+	}
+
+	return c.Key.Regexp != nil && c.Key.MatchString(headerKey)
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// KeyPartitioners are the supported partitioners that can be used to compute the
+// partition of a record key.
+var KeyPartitioners = []string{
+	// murmur2 is the default partitioner of the Java client and franz-go.
+	"murmur2",
+	// crc32 matches librdkafka's consistent partitioner.
+	"crc32",
+	// fnv1a matches Sarama's default hash partitioner.
+	"fnv1a",
+}
+
+// KafkaKeyLookup configures the lookup of records by key and the latest value
+// view of compacted topics.
+type KafkaKeyLookup struct {
+	// Partitioners define the partitioners that have been used by the producers of
+	// the matching topics. The first matching rule is applied. Topics that are not
+	// matched by any rule use the murmur2 partitioner.
+	Partitioners []KafkaKeyPartitionerRule `yaml:"partitioners"`
+
+	// MaxScanRecords is the maximum number of records that are scanned per partition
+	// to serve a single request.
+	MaxScanRecords int64 `yaml:"maxScanRecords"`
+}
+
+// KafkaKeyPartitionerRule defines the partitioner for all topics that match.
+type KafkaKeyPartitionerRule struct {
+	// TopicName is the name of the topic this rule applies to. This supports
+	// regex (e.g. "/orders-.*/").
+	TopicName Regexp `yaml:"topicName"`
+
+	// Partitioner is one of "murmur2", "crc32" or "fnv1a".
+	Partitioner string `yaml:"partitioner"`
+}
+
+// SetDefaults for the key lookup config.
+func (c *KafkaKeyLookup) SetDefaults() {
+	c.MaxScanRecords = 100_000
+}
+
+// Validate the key lookup config.
+func (c *KafkaKeyLookup) Validate() error {
+	if c.MaxScanRecords <= 0 {
+		return errors.New("max scan records must be greater than 0")
+	}
+
+	for i, rule := range c.Partitioners {
+		if rule.TopicName.Regexp == nil {
+			return fmt.Errorf("partitioner rule at index %d must set a topic name", i)
+		}
+		if !slices.Contains(KeyPartitioners, rule.Partitioner) {
+			return fmt.Errorf("partitioner %q at index %d is not supported, must be one of: %v", rule.Partitioner, i, KeyPartitioners)
+		}
+	}
+
+	return nil
+}
+
+// PartitionerForTopic returns the configured partitioner for the given topic.
+func (c *KafkaKeyLookup) PartitionerForTopic(topicName string) string {
+	for _, rule := range c.Partitioners {
+		if rule.TopicName.MatchString(topicName) {
+			return rule.Partitioner
+		}
+	}
+
+	return "murmur2"
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package config
+
+import (
+	"errors"
+	"strings"
+)
+
+// KafkaMirrorMaker configures how the MirrorMaker 2 replication dashboard detects
+// the internal MirrorMaker 2 topics and the replicated topics in the connected
+// (target) cluster.
+type KafkaMirrorMaker struct {
+	// ReplicationPolicySeparator is the separator between the source cluster alias
+	// and the topic name of replicated topics. This must match the
+	// replication.policy.separator setting of MirrorMaker 2.
+	ReplicationPolicySeparator string `yaml:"replicationPolicySeparator"`
+
+	// HeartbeatsTopic is the name of the topic that MirrorHeartbeatConnector emits
+	// heartbeats to. Replicated heartbeat topics are prefixed with the source
+	// cluster alias and the separator.
+	HeartbeatsTopic string `yaml:"heartbeatsTopic"`
+
+	// MaxScanRecords is the maximum number of records that are scanned per partition
+	// of a heartbeat or checkpoint topic to serve a single request.
+	MaxScanRecords int64 `yaml:"maxScanRecords"`
+}
+
+// SetDefaults for the MirrorMaker config.
+func (c *KafkaMirrorMaker) SetDefaults() {
+	c.ReplicationPolicySeparator = "."
+	c.HeartbeatsTopic = "heartbeats"
+	c.MaxScanRecords = 100_000
+}
+
+// Validate the MirrorMaker config.
+func (c *KafkaMirrorMaker) Validate() error {
+	if c.ReplicationPolicySeparator == "" {
+		return errors.New("replication policy separator must not be empty")
+	}
+	if c.HeartbeatsTopic == "" {
+		return errors.New("heartbeats topic must not be empty")
+	}
+	if strings.Contains(c.HeartbeatsTopic, c.ReplicationPolicySeparator) {
+		return errors.New("heartbeats topic must not contain the replication policy separator")
+	}
+	if c.MaxScanRecords <= 0 {
+		return errors.New("max scan records must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// connectGroupProtocolType is the protocol type of the consumer groups that
+// Kafka connect workers join.
+const connectGroupProtocolType = "connect"
+
+// startClusterDiscovery periodically scans the consumer groups for Kafka connect
+// clusters. The discovery stops when a termination signal is received or the
+// service is stopped, which also closes the Kafka client.
+func (s *Service) startClusterDiscovery() {
+	if s.kafkaClient == nil {
+		return
+	}
+
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(s.Cfg.Discovery.Interval)
+		defer ticker.Stop()
+
+		s.discoverClusters()
+		for {
+			select {
+			case <-quit:
+				s.Logger.Info("stopped connect cluster discovery", zap.String("reason", "received signal"))
+				return
+			case <-s.stopped:
+				s.Logger.Info("stopped connect cluster discovery", zap.String("reason", "service stopped"))
+				return
+			case <-ticker.C:
+				s.discoverClusters()
+			}
+		}
+	}()
+}
+
+func (s *Service) discoverClusters() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.RequestTimeout)
+	defer cancel()
+
+	groupIDs := s.Cfg.Discovery.GroupIDs
+	if len(groupIDs) == 0 {
+		listed, err := kadm.NewClient(s.kafkaClient).ListGroups(ctx)
+		if err != nil {
+			s.Logger.Warn("failed to list consumer groups for connect cluster discovery", zap.Error(err))
+			return
+		}
+		for _, group := range listed.Sorted() {
+			if group.ProtocolType == connectGroupProtocolType {
+				groupIDs = append(groupIDs, group.Group)
+			}
+		}
+	}
+	if len(groupIDs) == 0 {
+		s.replaceClusters(ClusterSourceDiscovery, nil)
+		return
+	}
+
+	req := kmsg.NewPtrDescribeGroupsRequest()
+	req.Groups = groupIDs
+	var groups []kmsg.DescribeGroupsResponseGroup
+	for _, shard := range s.kafkaClient.RequestSharded(ctx, req) {
+		if shard.Err != nil {
+			// Keep the previously discovered clusters, because the groups of this
+			// coordinator are unknown
+			s.Logger.Warn("failed to describe consumer groups for connect cluster discovery",
+				zap.Int32("broker_id", shard.Meta.NodeID), zap.Error(shard.Err))
+			return
+		}
+		groups = append(groups, shard.Resp.(*kmsg.DescribeGroupsResponse).Groups...)
+	}
+
+	s.replaceClusters(ClusterSourceDiscovery, discoveredClusters(s.Cfg.Discovery, groups, s.Logger))
+}
+
+// discoveredClusters creates a cluster for each Kafka connect group with at
+// least one member. Requests are sent to the worker with the lowest URL, which
+// forwards them to the leader if necessary.
+func discoveredClusters(cfg config.ConnectDiscovery, groups []kmsg.DescribeGroupsResponseGroup, logger *zap.Logger) []*ClientWithConfig {
+	clusters := make([]*ClientWithConfig, 0, len(groups))
+	for _, group := range groups {
+		if err := kerr.ErrorForCode(group.ErrorCode); err != nil {
+			logger.Warn("failed to describe consumer group for connect cluster discovery",
+				zap.String("group_id", group.Group), zap.Error(err))
+			continue
+		}
+		if group.ProtocolType != connectGroupProtocolType {
+			continue
+		}
+
+		urls, err := workerURLs(group.Members)
+		if err != nil {
+			logger.Warn("failed to read worker URLs of connect cluster",
+				zap.String("group_id", group.Group), zap.Error(err))
+			continue
+		}
+		if len(urls) == 0 {
+			continue
+		}
+
+		clusters = append(clusters, &ClientWithConfig{
+			Cfg:        cfg.ClusterConfig(group.Group, urls[0]),
+			Source:     ClusterSourceDiscovery,
+			WorkerURLs: urls,
+		})
+	}
+	return clusters
+}
+
+// workerURLs returns the sorted, unique REST URLs that the workers advertise in
+// their group member metadata.
+func workerURLs(members []kmsg.DescribeGroupsResponseGroupMember) ([]string, error) {
+	urls := make([]string, 0, len(members))
+	for _, member := range members {
+		if len(member.ProtocolMetadata) == 0 {
+			// Members have no metadata while the group is rebalancing
+			continue
+		}
+		var metadata kmsg.ConnectMemberMetadata
+		if err := metadata.ReadFrom(member.ProtocolMetadata); err != nil {
+			return nil, fmt.Errorf("failed to decode metadata of member %q: %w", member.MemberID, err)
+		}
+		url := strings.TrimSuffix(metadata.URL, "/")
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	sort.Strings(urls)
+	return slices.Compact(urls), nil
+}
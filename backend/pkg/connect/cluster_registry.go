@@ -0,0 +1,366 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/registry"
+)
+
+// ClusterRegistration describes a Kafka connect cluster along with the source
+// of its config. Credentials are never exposed.
+type ClusterRegistration struct {
+	Name         string        `json:"name"`
+	URL          string        `json:"url"`
+	Source       ClusterSource `json:"source"`
+	TLSEnabled   bool          `json:"tlsEnabled"`
+	HasBasicAuth bool          `json:"hasBasicAuth"`
+	HasToken     bool          `json:"hasToken"`
+	// WorkerURLs are the REST URLs of all workers of a discovered cluster.
+	WorkerURLs []string `json:"workerUrls,omitempty"`
+	// CreatedAt and UpdatedAt are only set for registered clusters.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// newClusterStore creates the store with the configured storage. It returns nil
+// if the cluster registry is disabled.
+func newClusterStore(cfg config.Connect, logger *zap.Logger, kafkaOpts []kgo.Opt) (registry.Store, error) {
+	if !cfg.Registry.Enabled {
+		return nil, nil
+	}
+
+	var store registry.Store
+	switch cfg.Registry.Storage {
+	case config.ConnectRegistryStorageFile:
+		fileStore, err := registry.NewFileStore(cfg.Registry.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		store = fileStore
+	case config.ConnectRegistryStorageKafka:
+		if kafkaOpts == nil {
+			return nil, errors.New("the kafka storage requires a connection to Kafka")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		topicStore, err := registry.NewTopicStore(ctx, kafkaOpts, cfg.Registry.Topic, logger.Named("connect_cluster_registry"))
+		if err != nil {
+			return nil, err
+		}
+		store = topicStore
+	default:
+		// Clusters are never persisted, so that credentials need not be encrypted
+		return registry.NewMemoryStore(), nil
+	}
+
+	// Credentials of persisted clusters are always encrypted
+	encryptedStore, err := registry.NewEncryptedStore(store, cfg.Registry.EncryptionKey)
+	if err != nil {
+		if closer, ok := store.(interface{ Close() }); ok {
+			closer.Close()
+		}
+		return nil, fmt.Errorf("failed to set up encryption of registered connect clusters: %w", err)
+	}
+	return encryptedStore, nil
+}
+
+// precedence returns the priority of the source if clusters from different
+// sources have the same name. Lower values take precedence.
+func (c ClusterSource) precedence() int {
+	switch c {
+	case ClusterSourceConfig:
+		return 0
+	case ClusterSourceRegistry:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// replaceClusters sets the clusters of the given source. Clusters of that source
+// that are not part of the given clusters are removed. Clusters of sources with
+// higher precedence and the same name are kept. Clients are only recreated if
+// the cluster config has changed.
+func (s *Service) replaceClusters(source ClusterSource, clusters []*ClientWithConfig) {
+	s.clientsMutex.Lock()
+	defer s.clientsMutex.Unlock()
+
+	names := make(map[string]struct{}, len(clusters))
+	for _, cluster := range clusters {
+		names[cluster.Cfg.Name] = struct{}{}
+	}
+	for name, existing := range s.clientsByCluster {
+		if _, exists := names[name]; !exists && existing.Source == source {
+			delete(s.clientsByCluster, name)
+			s.Logger.Info("removed Kafka connect cluster",
+				zap.String("cluster_name", name), zap.String("source", string(source)))
+		}
+	}
+
+	for _, cluster := range clusters {
+		existing, exists := s.clientsByCluster[cluster.Cfg.Name]
+		if exists && existing.Source.precedence() < source.precedence() {
+			continue
+		}
+		if exists && existing.Source == source && existing.Cfg == cluster.Cfg {
+			// Readers may hold the existing entry, so it must not be modified
+			unchanged := *existing
+			unchanged.WorkerURLs = cluster.WorkerURLs
+			s.clientsByCluster[cluster.Cfg.Name] = &unchanged
+			continue
+		}
+		client := newClientWithConfig(s.Cfg, cluster.Cfg, source, s.Logger)
+		client.WorkerURLs = cluster.WorkerURLs
+		s.clientsByCluster[cluster.Cfg.Name] = client
+		s.Logger.Info("added Kafka connect cluster",
+			zap.String("cluster_name", cluster.Cfg.Name),
+			zap.String("cluster_address", cluster.Cfg.URL),
+			zap.String("source", string(source)))
+	}
+}
+
+// syncRegisteredClusters reloads the registered clusters from the store.
+func (s *Service) syncRegisteredClusters() error {
+	if s.clusterStore == nil {
+		return nil
+	}
+
+	registered, err := s.clusterStore.List()
+	if err != nil {
+		return fmt.Errorf("failed to list registered connect clusters: %w", err)
+	}
+	clusters := make([]*ClientWithConfig, len(registered))
+	for i, cluster := range registered {
+		clusters[i] = &ClientWithConfig{Cfg: cluster.Config}
+	}
+	s.replaceClusters(ClusterSourceRegistry, clusters)
+
+	return nil
+}
+
+// startClusterSync periodically reloads the registered clusters, so that clusters
+// which have been registered by other Console instances are picked up. The sync
+// stops when a termination signal is received or the service is stopped.
+func (s *Service) startClusterSync() {
+	if s.clusterStore == nil || s.Cfg.Registry.SyncInterval == 0 {
+		return
+	}
+
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(s.Cfg.Registry.SyncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-quit:
+				s.Logger.Info("stopped connect cluster registry sync", zap.String("reason", "received signal"))
+				return
+			case <-s.stopped:
+				s.Logger.Info("stopped connect cluster registry sync", zap.String("reason", "service stopped"))
+				return
+			case <-ticker.C:
+				if err := s.syncRegisteredClusters(); err != nil {
+					s.Logger.Warn("failed to sync registered connect clusters", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+func (s *Service) checkRegistryEnabled() *rest.Error {
+	if s.clusterStore == nil {
+		return &rest.Error{
+			Err:      errors.New("connect cluster registry is not enabled"),
+			Status:   http.StatusNotFound,
+			Message:  "Connect cluster registry is not enabled",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+func registryStoreError(err error, msg string, clusterName string) *rest.Error {
+	status := http.StatusInternalServerError
+	if errors.Is(err, registry.ErrNotFound) {
+		status = http.StatusNotFound
+	}
+	return &rest.Error{
+		Err:          err,
+		Status:       status,
+		Message:      fmt.Sprintf("%v: %v", msg, err.Error()),
+		InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+		IsSilent:     false,
+	}
+}
+
+// getRegisteredCluster returns the cluster from the store, or nil if it is not registered.
+func (s *Service) getRegisteredCluster(name string) (*registry.Cluster, error) {
+	registered, err := s.clusterStore.Get(name)
+	if err != nil {
+		if errors.Is(err, registry.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &registered, nil
+}
+
+// ListClusterRegistrations returns all Kafka connect clusters, regardless of
+// whether they are configured, registered or discovered, sorted by name.
+func (s *Service) ListClusterRegistrations() ([]ClusterRegistration, *rest.Error) {
+	registeredByName := make(map[string]registry.Cluster)
+	if s.clusterStore != nil {
+		registered, err := s.clusterStore.List()
+		if err != nil {
+			return nil, registryStoreError(err, "Failed to list registered connect clusters", "")
+		}
+		for _, cluster := range registered {
+			registeredByName[cluster.Config.Name] = cluster
+		}
+	}
+
+	clusters := s.Clusters()
+	registrations := make([]ClusterRegistration, len(clusters))
+	for i, cluster := range clusters {
+		registrations[i] = ClusterRegistration{
+			Name:         cluster.Cfg.Name,
+			URL:          cluster.Cfg.URL,
+			Source:       cluster.Source,
+			TLSEnabled:   cluster.Cfg.TLS.Enabled,
+			HasBasicAuth: cluster.Cfg.Username != "",
+			HasToken:     cluster.Cfg.Token != "",
+			WorkerURLs:   cluster.WorkerURLs,
+		}
+		if registered, exists := registeredByName[cluster.Cfg.Name]; exists && cluster.Source == ClusterSourceRegistry {
+			registrations[i].CreatedAt = &registered.CreatedAt
+			registrations[i].UpdatedAt = &registered.UpdatedAt
+		}
+	}
+	return registrations, nil
+}
+
+// RegisterCluster registers a new Kafka connect cluster and persists it. A
+// registered cluster replaces a discovered cluster with the same name, but
+// clusters from the config can not be replaced.
+func (s *Service) RegisterCluster(clusterCfg config.ConnectCluster) *rest.Error {
+	if restErr := s.checkRegistryEnabled(); restErr != nil {
+		return restErr
+	}
+	if restErr := validateClusterConfig(clusterCfg); restErr != nil {
+		return restErr
+	}
+
+	if existing, restErr := s.getConnectClusterByName(clusterCfg.Name); restErr == nil && existing.Source != ClusterSourceDiscovery {
+		return &rest.Error{
+			Err:          fmt.Errorf("connect cluster %q already exists", clusterCfg.Name),
+			Status:       http.StatusConflict,
+			Message:      fmt.Sprintf("A Kafka connect cluster with the name %q already exists", clusterCfg.Name),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterCfg.Name)},
+			IsSilent:     false,
+		}
+	}
+
+	now := time.Now()
+	if err := s.clusterStore.Put(registry.Cluster{Config: clusterCfg, CreatedAt: now, UpdatedAt: now}); err != nil {
+		return registryStoreError(err, "Failed to register connect cluster", clusterCfg.Name)
+	}
+	if err := s.syncRegisteredClusters(); err != nil {
+		return registryStoreError(err, "Failed to reload registered connect clusters", clusterCfg.Name)
+	}
+
+	return nil
+}
+
+// UpdateCluster replaces the config of a registered Kafka connect cluster. The
+// password, token and TLS key of the existing config are kept if they are not
+// set.
+func (s *Service) UpdateCluster(clusterCfg config.ConnectCluster) *rest.Error {
+	if restErr := s.checkRegistryEnabled(); restErr != nil {
+		return restErr
+	}
+
+	existing, err := s.getRegisteredCluster(clusterCfg.Name)
+	if err != nil {
+		return registryStoreError(err, "Failed to get registered connect cluster", clusterCfg.Name)
+	}
+	if existing == nil {
+		return registryStoreError(registry.ErrNotFound, "Failed to update connect cluster", clusterCfg.Name)
+	}
+	if clusterCfg.Password == "" && clusterCfg.Username == existing.Config.Username {
+		clusterCfg.Password = existing.Config.Password
+	}
+	if clusterCfg.Token == "" {
+		clusterCfg.Token = existing.Config.Token
+	}
+	if clusterCfg.TLS.KeyPEM == "" && clusterCfg.TLS.CertPEM == existing.Config.TLS.CertPEM {
+		clusterCfg.TLS.KeyPEM = existing.Config.TLS.KeyPEM
+	}
+	if restErr := validateClusterConfig(clusterCfg); restErr != nil {
+		return restErr
+	}
+
+	updated := registry.Cluster{Config: clusterCfg, CreatedAt: existing.CreatedAt, UpdatedAt: time.Now()}
+	if err := s.clusterStore.Put(updated); err != nil {
+		return registryStoreError(err, "Failed to update connect cluster", clusterCfg.Name)
+	}
+	if err := s.syncRegisteredClusters(); err != nil {
+		return registryStoreError(err, "Failed to reload registered connect clusters", clusterCfg.Name)
+	}
+
+	return nil
+}
+
+// RemoveCluster removes a registered Kafka connect cluster. The connectors of
+// the cluster are not affected.
+func (s *Service) RemoveCluster(name string) *rest.Error {
+	if restErr := s.checkRegistryEnabled(); restErr != nil {
+		return restErr
+	}
+
+	if err := s.clusterStore.Delete(name); err != nil {
+		return registryStoreError(err, "Failed to remove connect cluster", name)
+	}
+	if err := s.syncRegisteredClusters(); err != nil {
+		return registryStoreError(err, "Failed to reload registered connect clusters", name)
+	}
+
+	return nil
+}
+
+func validateClusterConfig(clusterCfg config.ConnectCluster) *rest.Error {
+	if err := clusterCfg.Validate(); err != nil {
+		return &rest.Error{
+			Err:          err,
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Invalid connect cluster config: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterCfg.Name)},
+			IsSilent:     false,
+		}
+	}
+	return nil
+}
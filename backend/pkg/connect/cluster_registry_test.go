@@ -0,0 +1,151 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/registry"
+)
+
+func newTestRegistryService(t *testing.T) *Service {
+	t.Helper()
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Clusters = []config.ConnectCluster{{Name: "static", URL: "http://static:8083"}}
+
+	svc := &Service{
+		Cfg:              cfg,
+		Logger:           zap.NewNop(),
+		clientsByCluster: map[string]*ClientWithConfig{},
+		clusterStore:     registry.NewMemoryStore(),
+		stopped:          make(chan struct{}),
+	}
+	svc.clientsByCluster["static"] = newClientWithConfig(cfg, cfg.Clusters[0], ClusterSourceConfig, svc.Logger)
+	return svc
+}
+
+func TestRegisterCluster(t *testing.T) {
+	svc := newTestRegistryService(t)
+
+	require.Nil(t, svc.RegisterCluster(config.ConnectCluster{Name: "dev", URL: "http://dev:8083", Username: "admin", Password: "secret"}))
+	assert.Equal(t, []string{"dev", "static"}, svc.ClusterNames())
+
+	restErr := svc.RegisterCluster(config.ConnectCluster{Name: "static", URL: "http://other:8083"})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusConflict, restErr.Status)
+
+	restErr = svc.RegisterCluster(config.ConnectCluster{Name: "no-url"})
+	require.NotNil(t, restErr)
+	assert.Equal(t, http.StatusBadRequest, restErr.Status)
+
+	// Config clusters can neither be updated nor removed
+	assert.Equal(t, http.StatusNotFound, svc.UpdateCluster(config.ConnectCluster{Name: "static", URL: "http://other:8083"}).Status)
+	assert.Equal(t, http.StatusNotFound, svc.RemoveCluster("static").Status)
+
+	// Credentials are kept if they are not set on update
+	require.Nil(t, svc.UpdateCluster(config.ConnectCluster{Name: "dev", URL: "http://dev-new:8083", Username: "admin"}))
+	dev, restErr := svc.getConnectClusterByName("dev")
+	require.Nil(t, restErr)
+	assert.Equal(t, "http://dev-new:8083", dev.Cfg.URL)
+	assert.Equal(t, "secret", dev.Cfg.Password)
+
+	registrations, restErr := svc.ListClusterRegistrations()
+	require.Nil(t, restErr)
+	require.Len(t, registrations, 2)
+	assert.Equal(t, ClusterSourceRegistry, registrations[0].Source)
+	assert.True(t, registrations[0].HasBasicAuth)
+	assert.NotNil(t, registrations[0].CreatedAt)
+	assert.Equal(t, ClusterSourceConfig, registrations[1].Source)
+	assert.Nil(t, registrations[1].CreatedAt)
+
+	require.Nil(t, svc.RemoveCluster("dev"))
+	assert.Equal(t, []string{"static"}, svc.ClusterNames())
+}
+
+func TestReplaceClustersPrecedence(t *testing.T) {
+	svc := newTestRegistryService(t)
+
+	discovered := []*ClientWithConfig{
+		{Cfg: config.ConnectCluster{Name: "static", URL: "http://worker-1:8083"}},
+		{Cfg: config.ConnectCluster{Name: "connect-cluster", URL: "http://worker-2:8083"}},
+	}
+	svc.replaceClusters(ClusterSourceDiscovery, discovered)
+	assert.Equal(t, []string{"connect-cluster", "static"}, svc.ClusterNames())
+	static, _ := svc.getConnectClusterByName("static")
+	assert.Equal(t, ClusterSourceConfig, static.Source)
+
+	// A registered cluster replaces a discovered cluster with the same name
+	require.Nil(t, svc.RegisterCluster(config.ConnectCluster{Name: "connect-cluster", URL: "http://lb:8083"}))
+	cluster, _ := svc.getConnectClusterByName("connect-cluster")
+	assert.Equal(t, ClusterSourceRegistry, cluster.Source)
+
+	// Discovery does not replace or remove clusters of other sources
+	svc.replaceClusters(ClusterSourceDiscovery, discovered)
+	cluster, _ = svc.getConnectClusterByName("connect-cluster")
+	assert.Equal(t, "http://lb:8083", cluster.Cfg.URL)
+	svc.replaceClusters(ClusterSourceDiscovery, nil)
+	assert.Equal(t, []string{"connect-cluster", "static"}, svc.ClusterNames())
+}
+
+func TestDiscoveredClusters(t *testing.T) {
+	member := func(id string, url string) kmsg.DescribeGroupsResponseGroupMember {
+		metadata := kmsg.ConnectMemberMetadata{Version: 1, URL: url, ConfigOffset: 5}
+		return kmsg.DescribeGroupsResponseGroupMember{MemberID: id, ProtocolMetadata: metadata.AppendTo(nil)}
+	}
+
+	groups := []kmsg.DescribeGroupsResponseGroup{
+		{
+			Group:        "connect-prod",
+			ProtocolType: "connect",
+			Members: []kmsg.DescribeGroupsResponseGroupMember{
+				member("b", "http://worker-2:8083/"),
+				member("a", "http://worker-1:8083/"),
+				member("c", "http://worker-1:8083"),
+			},
+		},
+		{Group: "orders-consumer", ProtocolType: "consumer", Members: []kmsg.DescribeGroupsResponseGroupMember{{MemberID: "a"}}},
+		{Group: "connect-empty", ProtocolType: "connect"},
+		{Group: "connect-denied", ProtocolType: "connect", ErrorCode: 30},
+	}
+
+	discoveryCfg := config.ConnectDiscovery{Username: "admin", Password: "secret"}
+	clusters := discoveredClusters(discoveryCfg, groups, zap.NewNop())
+	require.Len(t, clusters, 1)
+	assert.Equal(t, config.ConnectCluster{Name: "connect-prod", URL: "http://worker-1:8083", Username: "admin", Password: "secret"}, clusters[0].Cfg)
+	assert.Equal(t, []string{"http://worker-1:8083", "http://worker-2:8083"}, clusters[0].WorkerURLs)
+}
+
+func TestServiceStop(t *testing.T) {
+	svc := newTestRegistryService(t)
+	require.Nil(t, svc.RegisterCluster(config.ConnectCluster{Name: "dev", URL: "http://dev:8083"}))
+
+	clients := svc.ClientsByCluster()
+	assert.Len(t, clients, 2)
+	assert.Equal(t, "http://dev:8083", clients["dev"].Cfg.URL)
+
+	// Stop can be called more than once
+	svc.Stop()
+	svc.Stop()
+	select {
+	case <-svc.stopped:
+	default:
+		t.Fatal("stop channel has not been closed")
+	}
+}
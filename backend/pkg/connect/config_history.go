@@ -0,0 +1,284 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
+	"github.com/redpanda-data/console/backend/pkg/connect/sensitive"
+)
+
+// newConfigHistory creates the history with the configured storage. It returns
+// nil if the config history is disabled.
+func newConfigHistory(cfg config.ConnectConfigHistory) (*history.History, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Storage {
+	case config.ConnectConfigHistoryStorageFile:
+		store, err := history.NewFileStore(cfg.FilePath, cfg.MaxRevisions)
+		if err != nil {
+			return nil, err
+		}
+		return history.New(store), nil
+	default:
+		return history.New(history.NewMemoryStore(cfg.MaxRevisions)), nil
+	}
+}
+
+// recordConfigRevision stores the connector config as a new revision if the
+// config history is enabled. Failures are logged only, because the config
+// change itself has already been applied.
+func (s *Service) recordConfigRevision(ctx context.Context, rev history.Revision) {
+	if s.ConfigHistory == nil {
+		return
+	}
+
+	if rev.Source != history.SourceObserved {
+		rev.Author = history.AuthorFromContext(ctx)
+	}
+	stored, created, err := s.ConfigHistory.Record(rev)
+	if err != nil {
+		s.Logger.Warn("failed to record connector config revision",
+			zap.String("cluster_name", rev.ClusterName),
+			zap.String("connector", rev.ConnectorName),
+			zap.Error(err))
+		return
+	}
+	if created {
+		s.Logger.Debug("recorded connector config revision",
+			zap.String("cluster_name", rev.ClusterName),
+			zap.String("connector", rev.ConnectorName),
+			zap.Int("revision", stored.Revision),
+			zap.String("source", string(stored.Source)))
+	}
+}
+
+// startConfigObserver periodically records the configs of all connectors, so
+// that changes which have been made outside of Console become revisions too.
+// The observer stops when a termination signal is received or the service is
+// stopped.
+func (s *Service) startConfigObserver() {
+	if s.ConfigHistory == nil || s.Cfg.ConfigHistory.ObserveInterval == 0 {
+		return
+	}
+
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(s.Cfg.ConfigHistory.ObserveInterval)
+		defer ticker.Stop()
+
+		s.observeConnectorConfigs()
+		for {
+			select {
+			case <-quit:
+				s.Logger.Info("stopped connector config observer", zap.String("reason", "received signal"))
+				return
+			case <-s.stopped:
+				s.Logger.Info("stopped connector config observer", zap.String("reason", "service stopped"))
+				return
+			case <-ticker.C:
+				s.observeConnectorConfigs()
+			}
+		}
+	}()
+}
+
+func (s *Service) observeConnectorConfigs() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.Cfg.RequestTimeout)
+	defer cancel()
+
+	clusters, err := s.GetAllClusterConnectors(ctx)
+	if err != nil {
+		s.Logger.Warn("failed to list connectors for config history", zap.Error(err))
+		return
+	}
+	for _, cluster := range clusters {
+		if cluster.Error != "" {
+			continue
+		}
+		for _, connector := range cluster.Connectors {
+			s.recordConfigRevision(ctx, history.Revision{
+				ClusterName:   cluster.ClusterName,
+				ConnectorName: connector.Name,
+				Source:        history.SourceObserved,
+				Config:        connector.Config,
+			})
+		}
+	}
+}
+
+func (s *Service) checkConfigHistoryEnabled(clusterName string) *rest.Error {
+	if s.ConfigHistory == nil {
+		return &rest.Error{
+			Err:      errors.New("connector config history is not enabled"),
+			Status:   http.StatusNotFound,
+			Message:  "Connector config history is not enabled",
+			IsSilent: false,
+		}
+	}
+	_, restErr := s.getConnectClusterByName(clusterName)
+	return restErr
+}
+
+// ListConnectorConfigRevisions returns the stored config revisions of a connector, newest first.
+func (s *Service) ListConnectorConfigRevisions(clusterName string, connectorName string) ([]history.Revision, *rest.Error) {
+	if restErr := s.checkConfigHistoryEnabled(clusterName); restErr != nil {
+		return nil, restErr
+	}
+
+	revisions, err := s.ConfigHistory.List(clusterName, connectorName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to list connector config revisions: %w", err),
+			Status:       http.StatusInternalServerError,
+			Message:      fmt.Sprintf("Failed to list connector config revisions: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	return revisions, nil
+}
+
+// CompareConnectorConfigRevisions returns the config changes between two revisions of a connector.
+func (s *Service) CompareConnectorConfigRevisions(clusterName string, connectorName string, fromRevision, toRevision int) ([]history.ConfigChange, *rest.Error) {
+	if restErr := s.checkConfigHistoryEnabled(clusterName); restErr != nil {
+		return nil, restErr
+	}
+
+	changes, err := s.ConfigHistory.Compare(clusterName, connectorName, fromRevision, toRevision)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, history.ErrRevisionNotFound) {
+			status = http.StatusNotFound
+		}
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to compare connector config revisions: %w", err),
+			Status:       status,
+			Message:      fmt.Sprintf("Failed to compare connector config revisions: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	return changes, nil
+}
+
+// RollbackConnectorConfig applies the config of a previous revision via PutConnectorConfig
+// and records it as a new revision. Redacted secrets of the revision are taken from the
+// connector's current config.
+func (s *Service) RollbackConnectorConfig(ctx context.Context, clusterName string, connectorName string, revision int) (con.ConnectorInfo, *rest.Error) {
+	if restErr := s.checkConfigHistoryEnabled(clusterName); restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+
+	rev, exists, err := s.ConfigHistory.Get(clusterName, connectorName, revision)
+	if err != nil {
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:          fmt.Errorf("failed to get connector config revision: %w", err),
+			Status:       http.StatusInternalServerError,
+			Message:      fmt.Sprintf("Failed to get connector config revision: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+	if !exists {
+		return con.ConnectorInfo{}, &rest.Error{
+			Err:          fmt.Errorf("%w: %d", history.ErrRevisionNotFound, revision),
+			Status:       http.StatusNotFound,
+			Message:      fmt.Sprintf("Revision %d of the connector config does not exist", revision),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+
+	cfg, restErr := s.restoreRedactedConfig(ctx, clusterName, connectorName, rev)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	cInfo, restErr := s.putConnectorConfig(ctx, clusterName, connectorName, con.PutConnectorConfigOptions{Config: cfg})
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	s.recordConfigRevision(ctx, history.Revision{
+		ClusterName:   clusterName,
+		ConnectorName: connectorName,
+		Source:        history.SourceRollback,
+		RollbackOf:    revision,
+		Config:        cInfo.Config,
+	})
+
+	return cInfo, nil
+}
+
+// restoreRedactedConfig returns the config of the revision in which all redacted
+// secrets are replaced by the values of the connector's current config. Revisions
+// never contain secrets, so a secret that is no longer part of the current config
+// cannot be restored.
+func (s *Service) restoreRedactedConfig(ctx context.Context, clusterName string, connectorName string, rev history.Revision) (map[string]any, *rest.Error) {
+	cfg := make(map[string]any, len(rev.Config))
+	var redactedKeys []string
+	for key, value := range rev.Config {
+		cfg[key] = value
+		if value == sensitive.RedactedValue {
+			redactedKeys = append(redactedKeys, key)
+		}
+	}
+	if len(redactedKeys) == 0 {
+		return cfg, nil
+	}
+
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	current, err := c.Client.GetConnectorConfig(ctx, connectorName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("failed to get current connector config: %w", err),
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get current connector config to restore redacted secrets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+			IsSilent:     false,
+		}
+	}
+	for _, key := range redactedKeys {
+		value, exists := current[key]
+		if !exists {
+			return nil, &rest.Error{
+				Err:          fmt.Errorf("redacted config key %q is not part of the current connector config", key),
+				Status:       http.StatusConflict,
+				Message:      fmt.Sprintf("Cannot restore revision %d, because the secret of config key %q is not stored in the config history and not part of the current connector config", rev.Revision, key),
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connectorName)},
+				IsSilent:     false,
+			}
+		}
+		cfg[key] = value
+	}
+	return cfg, nil
+}
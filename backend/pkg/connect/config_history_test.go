@@ -0,0 +1,44 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
+)
+
+func TestConfigObserverStopsWithService(t *testing.T) {
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.ConfigHistory.ObserveInterval = 10 * time.Millisecond
+
+	core, logs := observer.New(zap.InfoLevel)
+	svc := &Service{
+		Cfg:              cfg,
+		Logger:           zap.New(core),
+		ConfigHistory:    history.New(history.NewMemoryStore(10)),
+		clientsByCluster: map[string]*ClientWithConfig{},
+		stopped:          make(chan struct{}),
+	}
+
+	svc.startConfigObserver()
+	svc.Stop()
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("stopped connector config observer").FilterField(zap.String("reason", "service stopped")).Len() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
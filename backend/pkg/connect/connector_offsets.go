@@ -0,0 +1,329 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"github.com/go-resty/resty/v2"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	connectorTypeSource = "source"
+	connectorTypeSink   = "sink"
+
+	sinkOffsetPartitionTopicKey = "kafka_topic"
+	sinkOffsetPartitionKey      = "kafka_partition"
+	sinkOffsetOffsetKey         = "kafka_offset"
+
+	// stopConnectorPollInterval is the interval in which the connector status is
+	// checked after a stop request, until the connector is stopped.
+	stopConnectorPollInterval = 500 * time.Millisecond
+)
+
+// ConnectorOffset is the offset of a single connector partition as exposed by the
+// Kafka Connect offsets API (KIP-875). Partition and offset are connector-defined
+// for source connectors. For sink connectors the partition is the consumed topic
+// partition and the offset is the consumer offset.
+type ConnectorOffset struct {
+	Partition map[string]any `json:"partition"`
+	// Offset is nil when resetting a single partition's offset.
+	Offset map[string]any `json:"offset"`
+}
+
+// ConnectorOffsets are the offsets of a connector along with its type.
+type ConnectorOffsets struct {
+	// Type is the connector type, either "source" or "sink".
+	Type    string            `json:"type"`
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+type connectorOffsetsRequest struct {
+	Offsets []ConnectorOffset `json:"offsets"`
+}
+
+type connectorOffsetsMessage struct {
+	Message string `json:"message"`
+}
+
+// offsetsRequest creates a request against the Kafka Connect REST API of the given
+// cluster. The offsets endpoints are not covered by the connect client, so the
+// request reuses the client's HTTP transport and the cluster's credentials.
+func offsetsRequest(ctx context.Context, c *ClientWithConfig, connector string) *resty.Request {
+	client := resty.NewWithClient(c.Client.GetClient()).
+		SetBaseURL(c.Cfg.URL).
+		SetHeader("User-Agent", "Redpanda Console").
+		SetHeader("Accept", "application/json").
+		SetHeader("Content-Type", "application/json").
+		SetDisableWarn(true)
+	if c.Cfg.Username != "" {
+		client.SetBasicAuth(c.Cfg.Username, c.Cfg.Password)
+	}
+	if c.Cfg.Token != "" {
+		client.SetAuthToken(c.Cfg.Token)
+	}
+
+	return client.NewRequest().
+		SetContext(ctx).
+		SetError(&con.ApiError{}).
+		SetPathParam("connector", connector)
+}
+
+// errorFromOffsetsResponse returns the Kafka Connect API error of a failed response.
+func errorFromOffsetsResponse(response *resty.Response) error {
+	if !response.IsError() {
+		return nil
+	}
+	if apiErr, ok := response.Error().(*con.ApiError); ok && apiErr.ErrorCode != 0 {
+		return *apiErr
+	}
+	return fmt.Errorf("unexpected response status: %v", response.Status())
+}
+
+// ListConnectorOffsets returns the current offsets of a connector.
+func (s *Service) ListConnectorOffsets(ctx context.Context, clusterName string, connector string) (ConnectorOffsets, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return ConnectorOffsets{}, restErr
+	}
+
+	status, err := c.Client.GetConnectorStatus(ctx, connector)
+	if err != nil {
+		return ConnectorOffsets{}, offsetsRestError(err, "Failed to get connector status", clusterName, connector)
+	}
+
+	var offsets connectorOffsetsRequest
+	response, err := offsetsRequest(ctx, c, connector).
+		SetResult(&offsets).
+		Get("/connectors/{connector}/offsets")
+	if err == nil {
+		err = errorFromOffsetsResponse(response)
+	}
+	if err != nil {
+		return ConnectorOffsets{}, offsetsRestError(err, "Failed to list connector offsets", clusterName, connector)
+	}
+	if offsets.Offsets == nil {
+		offsets.Offsets = []ConnectorOffset{}
+	}
+
+	return ConnectorOffsets{Type: status.Type, Offsets: offsets.Offsets}, nil
+}
+
+// AlterConnectorOffsets writes the given offsets for a connector. The connector must
+// be stopped. If stopConnector is true, a connector that is not stopped yet will be
+// stopped first.
+func (s *Service) AlterConnectorOffsets(ctx context.Context, clusterName string, connector string, offsets []ConnectorOffset, stopConnector bool) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+
+	status, restErr := s.ensureConnectorStopped(ctx, c, connector, stopConnector)
+	if restErr != nil {
+		return "", restErr
+	}
+
+	normalized := make([]ConnectorOffset, len(offsets))
+	for i, offset := range offsets {
+		normalized[i] = ConnectorOffset{
+			Partition: normalizeOffsetNumbers(offset.Partition),
+			Offset:    normalizeOffsetNumbers(offset.Offset),
+		}
+	}
+	if err := validateConnectorOffsets(status.Type, normalized); err != nil {
+		return "", &rest.Error{
+			Err:          err,
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Invalid connector offsets: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	var msg connectorOffsetsMessage
+	response, err := offsetsRequest(ctx, c, connector).
+		SetBody(connectorOffsetsRequest{Offsets: normalized}).
+		SetResult(&msg).
+		Patch("/connectors/{connector}/offsets")
+	if err == nil {
+		err = errorFromOffsetsResponse(response)
+	}
+	if err != nil {
+		return "", offsetsRestError(err, "Failed to alter connector offsets", clusterName, connector)
+	}
+
+	return msg.Message, nil
+}
+
+// ResetConnectorOffsets resets all offsets of a connector. The connector must be
+// stopped. If stopConnector is true, a connector that is not stopped yet will be
+// stopped first.
+func (s *Service) ResetConnectorOffsets(ctx context.Context, clusterName string, connector string, stopConnector bool) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+
+	if _, restErr := s.ensureConnectorStopped(ctx, c, connector, stopConnector); restErr != nil {
+		return "", restErr
+	}
+
+	var msg connectorOffsetsMessage
+	response, err := offsetsRequest(ctx, c, connector).
+		SetResult(&msg).
+		Delete("/connectors/{connector}/offsets")
+	if err == nil {
+		err = errorFromOffsetsResponse(response)
+	}
+	if err != nil {
+		return "", offsetsRestError(err, "Failed to reset connector offsets", clusterName, connector)
+	}
+
+	return msg.Message, nil
+}
+
+// ensureConnectorStopped returns the connector status if the connector is stopped.
+// Otherwise, it either stops the connector and waits until it is stopped, or
+// returns an error if stopConnector is false.
+func (s *Service) ensureConnectorStopped(ctx context.Context, c *ClientWithConfig, connector string, stopConnector bool) (con.ConnectorStateInfo, *rest.Error) {
+	clusterName := c.Cfg.Name
+	status, err := c.Client.GetConnectorStatus(ctx, connector)
+	if err != nil {
+		return con.ConnectorStateInfo{}, offsetsRestError(err, "Failed to get connector status", clusterName, connector)
+	}
+	if status.Connector.State == connectorStateStopped {
+		return status, nil
+	}
+	if !stopConnector {
+		return con.ConnectorStateInfo{}, &rest.Error{
+			Err:          fmt.Errorf("connector is in state %q, but must be stopped", status.Connector.State),
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Connector offsets can only be modified while the connector is stopped, but it is %v. Stop the connector first.", status.Connector.State),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	if restErr := s.StopConnector(ctx, clusterName, connector); restErr != nil {
+		return con.ConnectorStateInfo{}, restErr
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return con.ConnectorStateInfo{}, &rest.Error{
+				Err:          fmt.Errorf("connector has not been stopped in time: %w", ctx.Err()),
+				Status:       http.StatusServiceUnavailable,
+				Message:      "Connector has been requested to stop, but it has not stopped in time. Retry once it is stopped.",
+				InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+				IsSilent:     false,
+			}
+		case <-time.After(stopConnectorPollInterval):
+		}
+
+		status, err = c.Client.GetConnectorStatus(ctx, connector)
+		if err != nil {
+			return con.ConnectorStateInfo{}, offsetsRestError(err, "Failed to get connector status", clusterName, connector)
+		}
+		if status.Connector.State == connectorStateStopped {
+			return status, nil
+		}
+	}
+}
+
+func offsetsRestError(err error, msg string, clusterName string, connector string) *rest.Error {
+	return &rest.Error{
+		Err:          err,
+		Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+		Message:      fmt.Sprintf("%v: %v", msg, err.Error()),
+		InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+		IsSilent:     false,
+	}
+}
+
+// normalizeOffsetNumbers converts whole float64 values, as decoded from JSON or
+// protobuf structs, into int64 so that they are not serialized in exponent
+// notation and are accepted for integer fields such as partitions and offsets.
+func normalizeOffsetNumbers(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	normalized := make(map[string]any, len(m))
+	for key, value := range m {
+		if f, ok := value.(float64); ok && f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+			value = int64(f)
+		}
+		normalized[key] = value
+	}
+	return normalized
+}
+
+// validateConnectorOffsets checks that the offsets have the shape that Kafka Connect
+// expects for the given connector type.
+func validateConnectorOffsets(connectorType string, offsets []ConnectorOffset) error {
+	if len(offsets) == 0 {
+		return errors.New("at least one offset must be given")
+	}
+
+	for i, offset := range offsets {
+		if len(offset.Partition) == 0 {
+			return fmt.Errorf("offset at index %d: partition must be set", i)
+		}
+
+		var err error
+		switch connectorType {
+		case connectorTypeSink:
+			err = validateSinkConnectorOffset(offset)
+		case connectorTypeSource:
+			if offset.Offset != nil && len(offset.Offset) == 0 {
+				err = errors.New("offset must not be empty, omit it to reset the partition's offset")
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("offset at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateSinkConnectorOffset(offset ConnectorOffset) error {
+	if len(offset.Partition) != 2 {
+		return fmt.Errorf("sink connector partitions must only contain %q and %q", sinkOffsetPartitionTopicKey, sinkOffsetPartitionKey)
+	}
+	topic, ok := offset.Partition[sinkOffsetPartitionTopicKey].(string)
+	if !ok || topic == "" {
+		return fmt.Errorf("partition key %q must be a non-empty string", sinkOffsetPartitionTopicKey)
+	}
+	if partition, ok := offset.Partition[sinkOffsetPartitionKey].(int64); !ok || partition < 0 {
+		return fmt.Errorf("partition key %q must be a non-negative integer", sinkOffsetPartitionKey)
+	}
+
+	if offset.Offset == nil {
+		return nil
+	}
+	if len(offset.Offset) != 1 {
+		return fmt.Errorf("sink connector offsets must only contain %q", sinkOffsetOffsetKey)
+	}
+	if kafkaOffset, ok := offset.Offset[sinkOffsetOffsetKey].(int64); !ok || kafkaOffset < 0 {
+		return fmt.Errorf("offset key %q must be a non-negative integer", sinkOffsetOffsetKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOffsetNumbers(t *testing.T) {
+	assert.Nil(t, normalizeOffsetNumbers(nil))
+	assert.Equal(t,
+		map[string]any{"kafka_topic": "orders", "kafka_partition": int64(2), "ratio": 0.5, "position": int64(1e12)},
+		normalizeOffsetNumbers(map[string]any{"kafka_topic": "orders", "kafka_partition": 2.0, "ratio": 0.5, "position": 1e12}),
+	)
+}
+
+func TestValidateConnectorOffsets(t *testing.T) {
+	sinkPartition := map[string]any{"kafka_topic": "orders", "kafka_partition": int64(0)}
+
+	tests := []struct {
+		name          string
+		connectorType string
+		offsets       []ConnectorOffset
+		wantErr       string
+	}{
+		{
+			name:          "no offsets",
+			connectorType: connectorTypeSink,
+			wantErr:       "at least one offset must be given",
+		},
+		{
+			name:          "missing partition",
+			connectorType: connectorTypeSource,
+			offsets:       []ConnectorOffset{{Offset: map[string]any{"position": int64(1)}}},
+			wantErr:       "partition must be set",
+		},
+		{
+			name:          "valid sink offset",
+			connectorType: connectorTypeSink,
+			offsets:       []ConnectorOffset{{Partition: sinkPartition, Offset: map[string]any{"kafka_offset": int64(10)}}},
+		},
+		{
+			name:          "sink partition reset",
+			connectorType: connectorTypeSink,
+			offsets:       []ConnectorOffset{{Partition: sinkPartition}},
+		},
+		{
+			name:          "sink partition with unknown key",
+			connectorType: connectorTypeSink,
+			offsets:       []ConnectorOffset{{Partition: map[string]any{"kafka_topic": "orders", "kafka_partition": int64(0), "foo": "bar"}}},
+			wantErr:       "must only contain",
+		},
+		{
+			name:          "sink partition with negative partition",
+			connectorType: connectorTypeSink,
+			offsets:       []ConnectorOffset{{Partition: map[string]any{"kafka_topic": "orders", "kafka_partition": int64(-1)}}},
+			wantErr:       `"kafka_partition" must be a non-negative integer`,
+		},
+		{
+			name:          "sink offset with wrong key",
+			connectorType: connectorTypeSink,
+			offsets:       []ConnectorOffset{{Partition: sinkPartition, Offset: map[string]any{"offset": int64(1)}}},
+			wantErr:       `"kafka_offset" must be a non-negative integer`,
+		},
+		{
+			name:          "valid source offset",
+			connectorType: connectorTypeSource,
+			offsets:       []ConnectorOffset{{Partition: map[string]any{"filename": "/data/input.txt"}, Offset: map[string]any{"position": int64(42)}}},
+		},
+		{
+			name:          "empty source offset",
+			connectorType: connectorTypeSource,
+			offsets:       []ConnectorOffset{{Partition: map[string]any{"filename": "/data/input.txt"}, Offset: map[string]any{}}},
+			wantErr:       "offset must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConnectorOffsets(tt.connectorType, tt.offsets)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
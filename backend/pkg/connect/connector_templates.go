@@ -0,0 +1,358 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/sensitive"
+	"github.com/redpanda-data/console/backend/pkg/connect/template"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// templateApplyConcurrency limits the number of connectors that are validated
+// and created concurrently when applying a template.
+const templateApplyConcurrency = 5
+
+// TemplateApplyAction is the outcome of applying a template to a single target.
+type TemplateApplyAction string
+
+const (
+	// TemplateApplyActionCreated means that a new connector has been created.
+	TemplateApplyActionCreated TemplateApplyAction = "CREATED"
+	// TemplateApplyActionUpdated means that the config of an existing connector
+	// has been replaced.
+	TemplateApplyActionUpdated TemplateApplyAction = "UPDATED"
+	// TemplateApplyActionValidated means that the rendered config is valid, but
+	// has not been applied because of a dry run.
+	TemplateApplyActionValidated TemplateApplyAction = "VALIDATED"
+	// TemplateApplyActionFailed means that the template could not be rendered,
+	// the rendered config is invalid or the connector could not be applied.
+	TemplateApplyActionFailed TemplateApplyAction = "FAILED"
+)
+
+// TemplateTarget is a Kafka connect cluster and topic that a template is
+// rendered for.
+type TemplateTarget struct {
+	ClusterName string `json:"clusterName"`
+	Topic       string `json:"topic"`
+	// Variables take precedence over the variables of the request.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// ApplyConnectorTemplateRequest is the request to render a template for
+// multiple targets and to create or update the resulting connectors.
+type ApplyConnectorTemplateRequest struct {
+	TemplateName string
+	Targets      []TemplateTarget
+	// Variables are used for all targets.
+	Variables map[string]string
+	// DryRun renders and validates the connector configs without applying them.
+	DryRun bool
+}
+
+// TemplateApplyResult is the result of applying a template to a single target.
+// Secrets in the rendered config are redacted.
+type TemplateApplyResult struct {
+	ClusterName   string              `json:"clusterName"`
+	Topic         string              `json:"topic"`
+	ConnectorName string              `json:"connectorName,omitempty"`
+	Action        TemplateApplyAction `json:"action"`
+	// Config is the rendered connector config.
+	Config map[string]string `json:"config,omitempty"`
+	// ValidationErrors are the errors that Kafka connect reported for the
+	// rendered config, by config key.
+	ValidationErrors map[string][]string `json:"validationErrors,omitempty"`
+	Error            string              `json:"error,omitempty"`
+}
+
+// newTemplateStore creates the template store with the configured storage. It
+// returns nil if connector templates are disabled.
+func newTemplateStore(cfg config.ConnectTemplates) (template.Store, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Storage {
+	case config.ConnectTemplatesStorageFile:
+		return template.NewFileStore(cfg.FilePath)
+	default:
+		return template.NewMemoryStore(), nil
+	}
+}
+
+func (s *Service) checkTemplatesEnabled() *rest.Error {
+	if s.Templates == nil {
+		return &rest.Error{
+			Err:      errors.New("connector templates are not enabled"),
+			Status:   http.StatusNotFound,
+			Message:  "Connector templates are not enabled",
+			IsSilent: false,
+		}
+	}
+	return nil
+}
+
+func templateStoreError(err error, msg string, templateName string) *rest.Error {
+	status := http.StatusInternalServerError
+	if errors.Is(err, template.ErrNotFound) {
+		status = http.StatusNotFound
+	}
+	return &rest.Error{
+		Err:          err,
+		Status:       status,
+		Message:      fmt.Sprintf("%v: %v", msg, err.Error()),
+		InternalLogs: []zapcore.Field{zap.String("template_name", templateName)},
+		IsSilent:     false,
+	}
+}
+
+// ListConnectorTemplates returns all stored connector templates, sorted by name.
+func (s *Service) ListConnectorTemplates() ([]template.Template, *rest.Error) {
+	if restErr := s.checkTemplatesEnabled(); restErr != nil {
+		return nil, restErr
+	}
+
+	templates, err := s.Templates.List()
+	if err != nil {
+		return nil, templateStoreError(err, "Failed to list connector templates", "")
+	}
+	for i := range templates {
+		templates[i] = templates[i].Redacted()
+	}
+	return templates, nil
+}
+
+// GetConnectorTemplate returns the connector template with the given name.
+func (s *Service) GetConnectorTemplate(name string) (template.Template, *rest.Error) {
+	tpl, restErr := s.getConnectorTemplate(name)
+	if restErr != nil {
+		return template.Template{}, restErr
+	}
+	return tpl.Redacted(), nil
+}
+
+// getConnectorTemplate returns the stored template without redacting it.
+func (s *Service) getConnectorTemplate(name string) (template.Template, *rest.Error) {
+	if restErr := s.checkTemplatesEnabled(); restErr != nil {
+		return template.Template{}, restErr
+	}
+
+	tpl, err := s.Templates.Get(name)
+	if err != nil {
+		return template.Template{}, templateStoreError(err, "Failed to get connector template", name)
+	}
+	return tpl, nil
+}
+
+// PutConnectorTemplate validates and stores the template. An existing template
+// with the same name is replaced.
+func (s *Service) PutConnectorTemplate(tpl template.Template) (template.Template, *rest.Error) {
+	if restErr := s.checkTemplatesEnabled(); restErr != nil {
+		return template.Template{}, restErr
+	}
+
+	if err := tpl.Validate(); err != nil {
+		return template.Template{}, &rest.Error{
+			Err:          err,
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Invalid connector template: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("template_name", tpl.Name)},
+			IsSilent:     false,
+		}
+	}
+
+	now := time.Now()
+	tpl.CreatedAt, tpl.UpdatedAt = now, now
+	existing, err := s.Templates.Get(tpl.Name)
+	switch {
+	case err == nil:
+		tpl.CreatedAt = existing.CreatedAt
+	case !errors.Is(err, template.ErrNotFound):
+		return template.Template{}, templateStoreError(err, "Failed to get connector template", tpl.Name)
+	}
+
+	tpl = tpl.Redacted()
+	if err := s.Templates.Put(tpl); err != nil {
+		return template.Template{}, templateStoreError(err, "Failed to store connector template", tpl.Name)
+	}
+	return tpl, nil
+}
+
+// DeleteConnectorTemplate deletes the template with the given name. Connectors
+// that have been created from the template are not affected.
+func (s *Service) DeleteConnectorTemplate(name string) *rest.Error {
+	if restErr := s.checkTemplatesEnabled(); restErr != nil {
+		return restErr
+	}
+
+	if err := s.Templates.Delete(name); err != nil {
+		return templateStoreError(err, "Failed to delete connector template", name)
+	}
+	return nil
+}
+
+// ApplyConnectorTemplate renders the template for each target, validates the
+// rendered config against the target's Kafka connect cluster and creates the
+// connector, or replaces the config of an existing connector with the same name.
+// Failures of single targets do not abort the remaining targets, but are
+// reported in the results, which are in the same order as the targets.
+func (s *Service) ApplyConnectorTemplate(ctx context.Context, req ApplyConnectorTemplateRequest) ([]TemplateApplyResult, *rest.Error) {
+	tpl, restErr := s.getConnectorTemplate(req.TemplateName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	if len(req.Targets) == 0 {
+		return nil, &rest.Error{
+			Err:      errors.New("no targets given"),
+			Status:   http.StatusBadRequest,
+			Message:  "At least one target must be given to apply the connector template",
+			IsSilent: false,
+		}
+	}
+	for _, target := range req.Targets {
+		if _, restErr := s.getConnectClusterByName(target.ClusterName); restErr != nil {
+			return nil, restErr
+		}
+	}
+
+	results := make([]TemplateApplyResult, len(req.Targets))
+	rendered := make([]template.Rendered, len(req.Targets))
+	targetByConnector := make(map[string]TemplateTarget)
+	for i, target := range req.Targets {
+		results[i] = TemplateApplyResult{ClusterName: target.ClusterName, Topic: target.Topic}
+
+		vars := make(map[string]string, len(req.Variables)+len(target.Variables))
+		for name, value := range req.Variables {
+			vars[name] = value
+		}
+		for name, value := range target.Variables {
+			vars[name] = value
+		}
+		r, err := tpl.Render(target.ClusterName, target.Topic, vars)
+		if err != nil {
+			results[i].Action = TemplateApplyActionFailed
+			results[i].Error = fmt.Sprintf("failed to render template: %v", err.Error())
+			continue
+		}
+		rendered[i] = r
+		results[i].ConnectorName = r.ConnectorName
+		results[i].Config = sensitive.RedactConfig(r.Config)
+
+		// Two targets that render to the same connector would overwrite each other
+		key := target.ClusterName + "/" + r.ConnectorName
+		if other, exists := targetByConnector[key]; exists {
+			return nil, &rest.Error{
+				Err:     fmt.Errorf("targets render to the same connector %q", r.ConnectorName),
+				Status:  http.StatusBadRequest,
+				Message: fmt.Sprintf("Topics %q and %q both render to connector %q in cluster %q. Use the ${topic} variable in the connector name.", other.Topic, target.Topic, r.ConnectorName, target.ClusterName),
+				InternalLogs: []zapcore.Field{
+					zap.String("template_name", tpl.Name),
+					zap.String("cluster_name", target.ClusterName),
+				},
+				IsSilent: false,
+			}
+		}
+		targetByConnector[key] = target
+	}
+
+	g := errgroup.Group{}
+	g.SetLimit(templateApplyConcurrency)
+	for i := range results {
+		if results[i].Action == TemplateApplyActionFailed {
+			continue
+		}
+		i := i
+		g.Go(func() error {
+			s.applyRenderedTemplate(ctx, &results[i], rendered[i], req.DryRun)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	return results, nil
+}
+
+// applyRenderedTemplate validates and applies a single rendered connector config
+// and stores the outcome in the result.
+func (s *Service) applyRenderedTemplate(ctx context.Context, result *TemplateApplyResult, rendered template.Rendered, dryRun bool) {
+	fail := func(msg string, restErr *rest.Error) {
+		result.Action = TemplateApplyActionFailed
+		result.Error = fmt.Sprintf("%v: %v", msg, restErr.Message)
+	}
+
+	configs := make(map[string]any, len(rendered.Config)+1)
+	for key, value := range rendered.Config {
+		configs[key] = value
+	}
+	configs["name"] = rendered.ConnectorName
+
+	validation, restErr := s.ValidateConnectorConfig(ctx, result.ClusterName, rendered.Config["connector.class"], configs)
+	if restErr != nil {
+		fail("failed to validate connector config", restErr)
+		return
+	}
+	result.ValidationErrors = validationErrorsByKey(validation.Configs)
+	if len(result.ValidationErrors) > 0 {
+		result.Action = TemplateApplyActionFailed
+		result.Error = "connector config is invalid"
+		return
+	}
+	if dryRun {
+		result.Action = TemplateApplyActionValidated
+		return
+	}
+
+	_, restErr = s.GetConnectorInfo(ctx, result.ClusterName, rendered.ConnectorName)
+	switch {
+	case restErr == nil:
+		if _, restErr := s.PutConnectorConfig(ctx, result.ClusterName, rendered.ConnectorName, con.PutConnectorConfigOptions{Config: configs}); restErr != nil {
+			fail("failed to update connector", restErr)
+			return
+		}
+		result.Action = TemplateApplyActionUpdated
+	case restErr.Status == http.StatusNotFound:
+		if _, restErr := s.CreateConnector(ctx, result.ClusterName, con.CreateConnectorRequest{Name: rendered.ConnectorName, Config: configs}); restErr != nil {
+			fail("failed to create connector", restErr)
+			return
+		}
+		result.Action = TemplateApplyActionCreated
+	default:
+		fail("failed to check whether connector exists", restErr)
+	}
+}
+
+// validationErrorsByKey returns the errors of all invalid configs, or nil if the
+// config is valid.
+func validationErrorsByKey(configs []model.ConfigDefinition) map[string][]string {
+	var errorsByKey map[string][]string
+	for _, cfg := range configs {
+		if len(cfg.Value.Errors) == 0 {
+			continue
+		}
+		if errorsByKey == nil {
+			errorsByKey = make(map[string][]string)
+		}
+		errorsByKey[cfg.Definition.Name] = cfg.Value.Errors
+	}
+	return errorsByKey
+}
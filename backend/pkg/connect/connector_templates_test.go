@@ -0,0 +1,35 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+func TestValidationErrorsByKey(t *testing.T) {
+	assert.Nil(t, validationErrorsByKey([]model.ConfigDefinition{
+		{Definition: model.ConfigDefinitionKey{Name: "topics"}, Value: model.ConfigDefinitionValue{Errors: []string{}}},
+	}))
+
+	assert.Equal(t,
+		map[string][]string{"s3.bucket.name": {"Missing required configuration \"s3.bucket.name\" which has no default value."}},
+		validationErrorsByKey([]model.ConfigDefinition{
+			{Definition: model.ConfigDefinitionKey{Name: "topics"}},
+			{
+				Definition: model.ConfigDefinitionKey{Name: "s3.bucket.name"},
+				Value:      model.ConfigDefinitionValue{Errors: []string{"Missing required configuration \"s3.bucket.name\" which has no default value."}},
+			},
+		}),
+	)
+}
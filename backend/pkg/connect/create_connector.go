@@ -18,6 +18,8 @@ import (
 	con "github.com/cloudhut/connect-client"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
 )
 
 // CreateConnector applies a new connector configuration on the target Kafka connect cluster.
@@ -56,6 +58,12 @@ func (s *Service) CreateConnector(ctx context.Context, clusterName string, req c
 			IsSilent:     false,
 		}
 	}
+	s.recordConfigRevision(ctx, history.Revision{
+		ClusterName:   clusterName,
+		ConnectorName: cInfo.Name,
+		Source:        history.SourceConsole,
+		Config:        cInfo.Config,
+	})
 
 	return cInfo, nil
 }
@@ -0,0 +1,86 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/declarative"
+	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
+	"github.com/redpanda-data/console/backend/pkg/filesystem"
+	"github.com/redpanda-data/console/backend/pkg/git"
+)
+
+// loadCustomizations reads all YAML files from the configured sources once and
+// returns interceptor options that add the declared patches and guides. Files
+// are applied in the order of their path, git files after filesystem files, so
+// that a later guide for the same connector class wins.
+func loadCustomizations(ctx context.Context, cfg config.ConnectCustomizations, logger *zap.Logger) ([]interceptor.Option, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var files []filesystem.File
+	if cfg.FileSystem.Enabled {
+		fsSvc, err := filesystem.NewService(cfg.FileSystem, logger, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create filesystem service: %w", err)
+		}
+		if _, err := fsSvc.LoadFilesIntoCache(); err != nil {
+			return nil, err
+		}
+		files = append(files, sortedFiles(fsSvc.GetFilesByFilename())...)
+	}
+	if cfg.Git.Enabled {
+		gitSvc, err := git.NewService(cfg.Git, logger, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create git service: %w", err)
+		}
+		if err := gitSvc.CloneRepository(ctx); err != nil {
+			return nil, fmt.Errorf("failed to clone git repo: %w", err)
+		}
+		files = append(files, sortedFiles(gitSvc.GetFilesByFilename())...)
+	}
+
+	var opts []interceptor.Option
+	for _, file := range files {
+		customizations, err := declarative.Parse(file.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse connector customizations in file '%v': %w", file.Path, err)
+		}
+		logger.Info("loaded connector customizations",
+			zap.String("file", file.Path),
+			zap.Int("patches", len(customizations.Patches)),
+			zap.Int("guides", len(customizations.Guides)))
+
+		opts = append(opts,
+			interceptor.WithAdditionalPatches(customizations.Patches...),
+			interceptor.WithAdditionalGuides(customizations.Guides...))
+	}
+
+	return opts, nil
+}
+
+func sortedFiles(filesByName map[string]filesystem.File) []filesystem.File {
+	files := make([]filesystem.File, 0, len(filesByName))
+	for _, file := range filesByName {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files
+}
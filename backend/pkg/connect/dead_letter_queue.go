@@ -0,0 +1,55 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const deadLetterQueueTopicConfig = "errors.deadletterqueue.topic.name"
+
+// GetConnectorDeadLetterQueueTopic returns the name of the dead letter queue
+// topic that is configured for a sink connector.
+func (s *Service) GetConnectorDeadLetterQueueTopic(ctx context.Context, clusterName string, connector string) (string, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return "", restErr
+	}
+
+	cInfo, err := c.Client.GetConnector(ctx, connector)
+	if err != nil {
+		return "", &rest.Error{
+			Err:          err,
+			Status:       GetStatusCodeFromAPIError(err, http.StatusServiceUnavailable),
+			Message:      fmt.Sprintf("Failed to get connector config: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	topicName := cInfo.Config[deadLetterQueueTopicConfig]
+	if topicName == "" {
+		return "", &rest.Error{
+			Err:          fmt.Errorf("connector has no dead letter queue configured"),
+			Status:       http.StatusBadRequest,
+			Message:      fmt.Sprintf("Connector '%v' has no dead letter queue topic configured (%v)", connector, deadLetterQueueTopicConfig),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("connector", connector)},
+			IsSilent:     false,
+		}
+	}
+
+	return topicName, nil
+}
@@ -83,8 +83,9 @@ type ClusterInfoWithError struct {
 
 // GetAllClusterInfo requests cluster info from all configured connect clusters concurrently.
 func (s *Service) GetAllClusterInfo(ctx context.Context) []ClusterInfoWithError {
-	ch := make(chan ClusterInfoWithError, len(s.ClientsByCluster))
-	for clusterName := range s.ClientsByCluster {
+	clusterNames := s.ClusterNames()
+	ch := make(chan ClusterInfoWithError, len(clusterNames))
+	for _, clusterName := range clusterNames {
 		go func(name string) {
 			var requestError error
 			cInfo, restErr := s.GetClusterInfo(ctx, name)
@@ -156,8 +156,9 @@ func (s *Service) GetAllClusterConnectors(ctx context.Context) ([]*ClusterConnec
 		return nil, ErrKafkaConnectNotConfigured
 	}
 
-	ch := make(chan *ClusterConnectors, len(s.ClientsByCluster))
-	for _, cluster := range s.ClientsByCluster {
+	clusters := s.Clusters()
+	ch := make(chan *ClusterConnectors, len(clusters))
+	for _, cluster := range clusters {
 		go func(cfg config.ConnectCluster, c *con.Client) {
 			connectors, err := c.ListConnectorsExpanded(ctx)
 			errMsg := ""
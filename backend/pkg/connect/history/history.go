@@ -0,0 +1,147 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package history
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/sensitive"
+)
+
+// History numbers and records connector config revisions in a Store.
+type History struct {
+	store Store
+	now   func() time.Time
+
+	// mutex serializes Record calls, so that revision numbers are unique.
+	mutex sync.Mutex
+}
+
+// New creates a History that records revisions in the given store.
+func New(store Store) *History {
+	return &History{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Record stores the config as the next revision of the connector. The
+// revision's number, timestamp and changes are set by Record. If the config
+// equals the latest revision's config, no revision is stored and false is
+// returned. Secrets in the config are redacted before the revision is stored,
+// so changes that only affect secrets do not create a new revision.
+func (h *History) Record(rev Revision) (Revision, bool, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	rev.Config = sensitive.RedactConfig(rev.Config)
+
+	revisions, err := h.store.List(rev.ClusterName, rev.ConnectorName)
+	if err != nil {
+		return Revision{}, false, err
+	}
+
+	var previousConfig map[string]string
+	rev.Revision = 1
+	if len(revisions) > 0 {
+		latest := revisions[len(revisions)-1]
+		previousConfig = sensitive.RedactConfig(latest.Config)
+		if maps.Equal(previousConfig, rev.Config) {
+			return redactRevision(latest), false, nil
+		}
+		rev.Revision = latest.Revision + 1
+	}
+	rev.Timestamp = h.now()
+	rev.Changes = Diff(previousConfig, rev.Config)
+
+	if err := h.store.Append(rev); err != nil {
+		return Revision{}, false, err
+	}
+
+	return rev, true, nil
+}
+
+// List returns the stored revisions of a connector, newest first.
+func (h *History) List(clusterName, connectorName string) ([]Revision, error) {
+	revisions, err := h.store.List(clusterName, connectorName)
+	if err != nil {
+		return nil, err
+	}
+
+	reversed := make([]Revision, len(revisions))
+	for i, rev := range revisions {
+		reversed[len(revisions)-1-i] = redactRevision(rev)
+	}
+	return reversed, nil
+}
+
+// Get returns a single revision of a connector. It returns false if the
+// revision does not exist or is no longer retained.
+func (h *History) Get(clusterName, connectorName string, revision int) (Revision, bool, error) {
+	revisions, err := h.store.List(clusterName, connectorName)
+	if err != nil {
+		return Revision{}, false, err
+	}
+
+	for _, rev := range revisions {
+		if rev.Revision == revision {
+			return redactRevision(rev), true, nil
+		}
+	}
+	return Revision{}, false, nil
+}
+
+// Compare returns the changes between two revisions of a connector.
+func (h *History) Compare(clusterName, connectorName string, fromRevision, toRevision int) ([]ConfigChange, error) {
+	from, exists, err := h.Get(clusterName, connectorName, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %d", ErrRevisionNotFound, fromRevision)
+	}
+	to, exists, err := h.Get(clusterName, connectorName, toRevision)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: %d", ErrRevisionNotFound, toRevision)
+	}
+
+	return Diff(from.Config, to.Config), nil
+}
+
+// redactRevision returns a copy of the revision in which all secrets are
+// redacted. Revisions are redacted before they are stored, this guards against
+// revisions that have been stored before secrets were redacted.
+func redactRevision(rev Revision) Revision {
+	rev.Config = sensitive.RedactConfig(rev.Config)
+	changes := make([]ConfigChange, len(rev.Changes))
+	for i, change := range rev.Changes {
+		if sensitive.IsKey(change.Key) {
+			change.OldValue = redactValue(change.Key, change.OldValue)
+			change.NewValue = redactValue(change.Key, change.NewValue)
+		}
+		changes[i] = change
+	}
+	rev.Changes = changes
+	return rev
+}
+
+func redactValue(key string, value *string) *string {
+	if value == nil || !sensitive.IsSecretValue(key, *value) {
+		return value
+	}
+	redacted := sensitive.RedactedValue
+	return &redacted
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package history
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestDiff(t *testing.T) {
+	changes := Diff(
+		map[string]string{"tasks.max": "1", "topics": "orders", "batch.size": "100"},
+		map[string]string{"tasks.max": "2", "topics": "orders", "linger.ms": "5"},
+	)
+	assert.Equal(t, []ConfigChange{
+		{Key: "batch.size", Type: ChangeTypeRemoved, OldValue: strPtr("100")},
+		{Key: "linger.ms", Type: ChangeTypeAdded, NewValue: strPtr("5")},
+		{Key: "tasks.max", Type: ChangeTypeChanged, OldValue: strPtr("1"), NewValue: strPtr("2")},
+	}, changes)
+
+	assert.Empty(t, Diff(map[string]string{"a": "b"}, map[string]string{"a": "b"}))
+}
+
+func TestHistoryRecord(t *testing.T) {
+	h := New(NewMemoryStore(2))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h.now = func() time.Time { return now }
+
+	rev, created, err := h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceObserved, Config: map[string]string{"tasks.max": "1"}})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, 1, rev.Revision)
+	assert.Equal(t, now, rev.Timestamp)
+	assert.Len(t, rev.Changes, 1)
+
+	// Unchanged configs are not recorded
+	_, created, err = h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceObserved, Config: map[string]string{"tasks.max": "1"}})
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	rev, created, err = h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceConsole, Author: "alice", Config: map[string]string{"tasks.max": "2"}})
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, 2, rev.Revision)
+	assert.Equal(t, []ConfigChange{{Key: "tasks.max", Type: ChangeTypeChanged, OldValue: strPtr("1"), NewValue: strPtr("2")}}, rev.Changes)
+
+	// Revision numbers continue after the oldest revision has been dropped
+	rev, _, err = h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceRollback, RollbackOf: 1, Config: map[string]string{"tasks.max": "1"}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, rev.Revision)
+
+	revisions, err := h.List("main", "sink")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 3, revisions[0].Revision)
+	assert.Equal(t, 2, revisions[1].Revision)
+
+	changes, err := h.Compare("main", "sink", 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []ConfigChange{{Key: "tasks.max", Type: ChangeTypeChanged, OldValue: strPtr("1"), NewValue: strPtr("2")}}, changes)
+
+	_, err = h.Compare("main", "sink", 1, 3)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "connectors.json")
+
+	store, err := NewFileStore(path, 3)
+	require.NoError(t, err)
+	h := New(store)
+	for _, tasks := range []string{"1", "2", "3", "4"} {
+		_, _, err := h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceConsole, Config: map[string]string{"tasks.max": tasks}})
+		require.NoError(t, err)
+	}
+
+	// Reopening loads the retained revisions and keeps numbering them
+	store, err = NewFileStore(path, 2)
+	require.NoError(t, err)
+	h = New(store)
+
+	revisions, err := h.List("main", "sink")
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 4, revisions[0].Revision)
+	assert.Equal(t, "4", revisions[0].Config["tasks.max"])
+
+	rev, _, err := h.Record(Revision{ClusterName: "main", ConnectorName: "sink", Source: SourceConsole, Config: map[string]string{"tasks.max": "5"}})
+	require.NoError(t, err)
+	assert.Equal(t, 5, rev.Revision)
+}
+
+func TestHistoryRedactsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "connectors.json")
+	store, err := NewFileStore(path, 10)
+	require.NoError(t, err)
+	h := New(store)
+
+	rev, created, err := h.Record(Revision{ClusterName: "main", ConnectorName: "source", Source: SourceConsole, Config: map[string]string{
+		"database.password":   "hunter2",
+		"connection.password": "${file:/opt/secrets.properties:password}",
+		"tasks.max":           "1",
+	}})
+	require.NoError(t, err)
+	require.True(t, created)
+	assert.Equal(t, "[REDACTED]", rev.Config["database.password"])
+	assert.Equal(t, "${file:/opt/secrets.properties:password}", rev.Config["connection.password"])
+
+	// Changing only the secret does not create a new revision
+	_, created, err = h.Record(Revision{ClusterName: "main", ConnectorName: "source", Source: SourceConsole, Config: map[string]string{
+		"database.password":   "hunter3",
+		"connection.password": "${file:/opt/secrets.properties:password}",
+		"tasks.max":           "1",
+	}})
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "hunter")
+}
+
+func TestAuthorFromContext(t *testing.T) {
+	assert.Empty(t, AuthorFromContext(context.Background()))
+	assert.Equal(t, "alice", AuthorFromContext(ContextWithAuthor(context.Background(), "alice")))
+}
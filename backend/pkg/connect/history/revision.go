@@ -0,0 +1,122 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package history stores revisions of Kafka connector configurations, so that
+// config changes can be listed, compared and rolled back.
+package history
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// Source describes how a revision has been created.
+type Source string
+
+const (
+	// SourceConsole is a config change that has been made through Console.
+	SourceConsole Source = "CONSOLE"
+	// SourceRollback is a config change that restored a previous revision.
+	SourceRollback Source = "ROLLBACK"
+	// SourceObserved is a config change that has been made outside of Console
+	// and has been noticed when polling the connector configs.
+	SourceObserved Source = "OBSERVED"
+)
+
+// Revision is a single version of a connector's configuration.
+type Revision struct {
+	ClusterName   string `json:"clusterName"`
+	ConnectorName string `json:"connectorName"`
+
+	// Revision is the version number of the connector config, starting at 1.
+	Revision  int       `json:"revision"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Author is the user who changed the config. It is empty for observed
+	// revisions and if the author is unknown.
+	Author string `json:"author,omitempty"`
+	Source Source `json:"source"`
+
+	// RollbackOf is the revision that has been restored by a rollback.
+	RollbackOf int `json:"rollbackOf,omitempty"`
+
+	Config map[string]string `json:"config"`
+
+	// Changes are the differences to the previous revision.
+	Changes []ConfigChange `json:"changes"`
+}
+
+// ChangeType describes how a config key has been changed.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded is a config key that did not exist before.
+	ChangeTypeAdded ChangeType = "ADDED"
+	// ChangeTypeRemoved is a config key that no longer exists.
+	ChangeTypeRemoved ChangeType = "REMOVED"
+	// ChangeTypeChanged is a config key whose value has changed.
+	ChangeTypeChanged ChangeType = "CHANGED"
+)
+
+// ConfigChange is the change of a single config key between two revisions.
+type ConfigChange struct {
+	Key      string     `json:"key"`
+	Type     ChangeType `json:"type"`
+	OldValue *string    `json:"oldValue,omitempty"`
+	NewValue *string    `json:"newValue,omitempty"`
+}
+
+// Diff returns the changes that turn the config from into the config to,
+// sorted by config key.
+func Diff(from, to map[string]string) []ConfigChange {
+	changes := make([]ConfigChange, 0)
+	for key, oldValue := range from {
+		oldValue := oldValue
+		newValue, exists := to[key]
+		switch {
+		case !exists:
+			changes = append(changes, ConfigChange{Key: key, Type: ChangeTypeRemoved, OldValue: &oldValue})
+		case newValue != oldValue:
+			changes = append(changes, ConfigChange{Key: key, Type: ChangeTypeChanged, OldValue: &oldValue, NewValue: &newValue})
+		}
+	}
+	for key, newValue := range to {
+		newValue := newValue
+		if _, exists := from[key]; !exists {
+			changes = append(changes, ConfigChange{Key: key, Type: ChangeTypeAdded, NewValue: &newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+type authorContextKey struct{}
+
+// ContextWithAuthor returns a context that carries the name of the user who
+// makes config changes. Middlewares that authenticate the requester should
+// set it, so that revisions are attributed to the right user.
+func ContextWithAuthor(ctx context.Context, author string) context.Context {
+	return context.WithValue(ctx, authorContextKey{}, author)
+}
+
+// AuthorFromContext returns the author that has been set via ContextWithAuthor
+// or an empty string if there is none.
+func AuthorFromContext(ctx context.Context) string {
+	author, _ := ctx.Value(authorContextKey{}).(string)
+	return author
+}
+
+// ErrRevisionNotFound is returned if a revision does not exist or is no longer
+// retained.
+var ErrRevisionNotFound = errors.New("revision not found")
@@ -0,0 +1,143 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package history
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// Store persists connector config revisions.
+type Store interface {
+	// Append stores a new revision. The store may drop the oldest revisions of
+	// the same connector to stay within its retention limit.
+	Append(rev Revision) error
+
+	// List returns all stored revisions of a connector, oldest first.
+	List(clusterName, connectorName string) ([]Revision, error)
+}
+
+// connectorRevisions are the retained revisions of a single connector.
+type connectorRevisions struct {
+	ClusterName   string     `json:"clusterName"`
+	ConnectorName string     `json:"connectorName"`
+	Revisions     []Revision `json:"revisions"`
+}
+
+func connectorKey(clusterName, connectorName string) string {
+	// Connect cluster names can't contain a NUL character, so the key is unique
+	return clusterName + "\x00" + connectorName
+}
+
+func connectorRevisionsKey(revisions connectorRevisions) string {
+	return connectorKey(revisions.ClusterName, revisions.ConnectorName)
+}
+
+// store implements Store on top of a JSON store that keeps the revisions of
+// each connector under a single key.
+type store struct {
+	maxRevisions int
+
+	// mutex serializes appends, which read and replace the connector's revisions.
+	mutex     sync.Mutex
+	revisions jsonstore.Store[connectorRevisions]
+}
+
+// Append stores the revision and drops the oldest revisions that exceed the
+// configured limit.
+func (s *store) Append(rev Revision) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, err := s.revisions.Get(connectorKey(rev.ClusterName, rev.ConnectorName))
+	if err != nil && !errors.Is(err, jsonstore.ErrNotFound) {
+		return err
+	}
+	stored.ClusterName = rev.ClusterName
+	stored.ConnectorName = rev.ConnectorName
+	stored.Revisions = s.trim(append(append([]Revision(nil), stored.Revisions...), rev))
+
+	if err := s.revisions.Put(stored); err != nil {
+		return fmt.Errorf("failed to store revision: %w", err)
+	}
+	return nil
+}
+
+// List returns a copy of the connector's revisions, oldest first.
+func (s *store) List(clusterName, connectorName string) ([]Revision, error) {
+	stored, err := s.revisions.Get(connectorKey(clusterName, connectorName))
+	if err != nil {
+		if errors.Is(err, jsonstore.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return append([]Revision(nil), stored.Revisions...), nil
+}
+
+func (s *store) trim(revisions []Revision) []Revision {
+	if len(revisions) > s.maxRevisions {
+		return revisions[len(revisions)-s.maxRevisions:]
+	}
+	return revisions
+}
+
+// MemoryStore keeps revisions in memory. All revisions are lost when the
+// process exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates a store that keeps up to maxRevisions per connector.
+func NewMemoryStore(maxRevisions int) *MemoryStore {
+	return &MemoryStore{store{
+		maxRevisions: maxRevisions,
+		revisions:    jsonstore.NewMemoryStore(connectorRevisionsKey),
+	}}
+}
+
+// FileStore keeps the revisions of all connectors in a single JSON file and
+// serves reads from memory. The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads all revisions from the file at the given path. The file
+// is created on the first change if it does not exist yet. Revisions that
+// exceed the configured limit are dropped when the store is opened.
+func NewFileStore(path string, maxRevisions int) (*FileStore, error) {
+	revisions, err := jsonstore.NewFileStore(path, connectorRevisionsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config history: %w", err)
+	}
+	s := &FileStore{store{
+		maxRevisions: maxRevisions,
+		revisions:    revisions,
+	}}
+
+	all, err := revisions.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, stored := range all {
+		if len(stored.Revisions) <= maxRevisions {
+			continue
+		}
+		stored.Revisions = s.trim(stored.Revisions)
+		if err := revisions.Put(stored); err != nil {
+			return nil, fmt.Errorf("failed to drop exceeding revisions from config history: %w", err)
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,455 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	con "github.com/cloudhut/connect-client"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// maxPluginUpgrades is the number of detected plugin upgrades that are kept in memory.
+const maxPluginUpgrades = 100
+
+// PluginCatalog aggregates the connector plugins that are installed on the
+// configured Kafka connect clusters.
+type PluginCatalog struct {
+	Plugins  []CatalogPlugin        `json:"plugins"`
+	Clusters []PluginCatalogCluster `json:"clusters"`
+	// Upgrades are the plugin version changes that have been detected since
+	// Console has been started, newest first.
+	Upgrades []PluginUpgrade `json:"upgrades"`
+}
+
+// PluginCatalogCluster reports whether the plugins of a connect cluster could be listed.
+type PluginCatalogCluster struct {
+	ClusterName string `json:"clusterName"`
+	Error       string `json:"error,omitempty"`
+}
+
+// CatalogPlugin is a connector plugin along with the clusters it is installed on.
+type CatalogPlugin struct {
+	Class         string               `json:"class"`
+	Type          string               `json:"type"`
+	Installations []PluginInstallation `json:"installations"`
+	// VersionMismatch is true if the plugin is installed with different versions
+	// across the clusters.
+	VersionMismatch bool `json:"versionMismatch"`
+	// MissingFrom lists the reachable clusters that do not have the plugin installed.
+	MissingFrom []string `json:"missingFrom"`
+}
+
+// PluginInstallation is the installed version of a plugin on a connect cluster.
+type PluginInstallation struct {
+	ClusterName string `json:"clusterName"`
+	Version     string `json:"version"`
+}
+
+// PluginUpgrade is a version change of a plugin on a connect cluster.
+type PluginUpgrade struct {
+	ClusterName string    `json:"clusterName"`
+	Class       string    `json:"class"`
+	FromVersion string    `json:"fromVersion"`
+	ToVersion   string    `json:"toVersion"`
+	DetectedAt  time.Time `json:"detectedAt"`
+	// ConfigDiff is nil if the config definitions of either version could not
+	// be retrieved.
+	ConfigDiff *PluginConfigDiff `json:"configDiff,omitempty"`
+}
+
+// PluginConfigDefinitions are the config definitions of a plugin version, after
+// they have been run through the connector guides.
+type PluginConfigDefinitions struct {
+	Class       string                   `json:"class"`
+	Version     string                   `json:"version"`
+	ClusterName string                   `json:"clusterName"`
+	Definitions model.ValidationResponse `json:"definitions"`
+}
+
+// PluginConfigDiff lists the config keys that differ between two versions of a plugin.
+type PluginConfigDiff struct {
+	Class       string   `json:"class"`
+	FromVersion string   `json:"fromVersion"`
+	ToVersion   string   `json:"toVersion"`
+	AddedKeys   []string `json:"addedKeys"`
+	RemovedKeys []string `json:"removedKeys"`
+}
+
+type pluginVersionKey struct {
+	class   string
+	version string
+	// clusterName is only set if the plugin does not report a version, because
+	// definitions can not be shared across clusters in that case.
+	clusterName string
+}
+
+// pluginCatalog caches plugin config definitions and keeps track of the plugin
+// versions installed on each cluster, so that upgrades can be detected.
+type pluginCatalog struct {
+	mu          sync.Mutex
+	definitions map[pluginVersionKey]PluginConfigDefinitions
+	// installed maps cluster name to plugin class to the last seen version.
+	installed map[string]map[string]string
+	upgrades  []PluginUpgrade
+	now       func() time.Time
+}
+
+func newPluginCatalog() *pluginCatalog {
+	return &pluginCatalog{
+		definitions: make(map[pluginVersionKey]PluginConfigDefinitions),
+		installed:   make(map[string]map[string]string),
+		now:         time.Now,
+	}
+}
+
+func newPluginVersionKey(clusterName, class, version string) pluginVersionKey {
+	key := pluginVersionKey{class: class, version: version}
+	if version == "" {
+		key.clusterName = clusterName
+	}
+	return key
+}
+
+func (p *pluginCatalog) getDefinitions(key pluginVersionKey) (PluginConfigDefinitions, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	defs, exists := p.definitions[key]
+	return defs, exists
+}
+
+func (p *pluginCatalog) putDefinitions(key pluginVersionKey, defs PluginConfigDefinitions) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.definitions[key] = defs
+}
+
+// observe records the plugins installed on a cluster and returns the upgrades
+// compared to the previously observed versions. Plugins that have been seen for
+// the first time are not reported as upgrade.
+func (p *pluginCatalog) observe(clusterName string, plugins []con.ConnectorPluginInfo) []PluginUpgrade {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	previous, seenBefore := p.installed[clusterName]
+	current := make(map[string]string, len(plugins))
+	var upgrades []PluginUpgrade
+	for _, plugin := range plugins {
+		current[plugin.Class] = plugin.Version
+		if !seenBefore {
+			continue
+		}
+		if prevVersion, exists := previous[plugin.Class]; exists && prevVersion != plugin.Version {
+			upgrades = append(upgrades, PluginUpgrade{
+				ClusterName: clusterName,
+				Class:       plugin.Class,
+				FromVersion: prevVersion,
+				ToVersion:   plugin.Version,
+				DetectedAt:  p.now(),
+			})
+		}
+	}
+	p.installed[clusterName] = current
+
+	return upgrades
+}
+
+func (p *pluginCatalog) addUpgrades(upgrades []PluginUpgrade) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.upgrades = append(p.upgrades, upgrades...)
+	if len(p.upgrades) > maxPluginUpgrades {
+		p.upgrades = p.upgrades[len(p.upgrades)-maxPluginUpgrades:]
+	}
+}
+
+// listUpgrades returns the detected upgrades, newest first.
+func (p *pluginCatalog) listUpgrades() []PluginUpgrade {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	upgrades := make([]PluginUpgrade, len(p.upgrades))
+	for i, upgrade := range p.upgrades {
+		upgrades[len(p.upgrades)-1-i] = upgrade
+	}
+	return upgrades
+}
+
+// aggregatePlugins merges the plugin lists of all clusters into one entry per
+// plugin class, sorted by class name.
+func aggregatePlugins(pluginsByCluster map[string][]con.ConnectorPluginInfo) []CatalogPlugin {
+	clusterNames := make([]string, 0, len(pluginsByCluster))
+	for clusterName := range pluginsByCluster {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	pluginsByClass := make(map[string]*CatalogPlugin)
+	for _, clusterName := range clusterNames {
+		for _, plugin := range pluginsByCluster[clusterName] {
+			catalogPlugin, exists := pluginsByClass[plugin.Class]
+			if !exists {
+				catalogPlugin = &CatalogPlugin{Class: plugin.Class, Type: plugin.Type}
+				pluginsByClass[plugin.Class] = catalogPlugin
+			}
+			catalogPlugin.Installations = append(catalogPlugin.Installations, PluginInstallation{
+				ClusterName: clusterName,
+				Version:     plugin.Version,
+			})
+		}
+	}
+
+	plugins := make([]CatalogPlugin, 0, len(pluginsByClass))
+	for _, plugin := range pluginsByClass {
+		installedOn := make(map[string]struct{}, len(plugin.Installations))
+		for _, installation := range plugin.Installations {
+			installedOn[installation.ClusterName] = struct{}{}
+			if installation.Version != plugin.Installations[0].Version {
+				plugin.VersionMismatch = true
+			}
+		}
+		plugin.MissingFrom = []string{}
+		for _, clusterName := range clusterNames {
+			if _, exists := installedOn[clusterName]; !exists {
+				plugin.MissingFrom = append(plugin.MissingFrom, clusterName)
+			}
+		}
+		plugins = append(plugins, *plugin)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Class < plugins[j].Class })
+
+	return plugins
+}
+
+// diffPluginConfigKeys returns the config keys that have been added or removed
+// between two plugin versions.
+func diffPluginConfigKeys(from, to PluginConfigDefinitions) PluginConfigDiff {
+	fromKeys := make(map[string]struct{}, len(from.Definitions.Configs))
+	for _, cfg := range from.Definitions.Configs {
+		fromKeys[cfg.Definition.Name] = struct{}{}
+	}
+	toKeys := make(map[string]struct{}, len(to.Definitions.Configs))
+	for _, cfg := range to.Definitions.Configs {
+		toKeys[cfg.Definition.Name] = struct{}{}
+	}
+
+	diff := PluginConfigDiff{
+		Class:       to.Class,
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		AddedKeys:   []string{},
+		RemovedKeys: []string{},
+	}
+	for key := range toKeys {
+		if _, exists := fromKeys[key]; !exists {
+			diff.AddedKeys = append(diff.AddedKeys, key)
+		}
+	}
+	for key := range fromKeys {
+		if _, exists := toKeys[key]; !exists {
+			diff.RemovedKeys = append(diff.RemovedKeys, key)
+		}
+	}
+	slices.Sort(diff.AddedKeys)
+	slices.Sort(diff.RemovedKeys)
+
+	return diff
+}
+
+// GetPluginCatalog lists the connector plugins of the given connect clusters and
+// aggregates them by plugin class. Version changes since the previous listing
+// are recorded as upgrades, including the added and removed config keys if the
+// config definitions of both versions could be retrieved. Upgrades are only kept
+// in memory, so that they are lost when Console restarts.
+func (s *Service) GetPluginCatalog(ctx context.Context, clusterNames []string) (PluginCatalog, error) {
+	if !s.Cfg.Enabled {
+		return PluginCatalog{}, ErrKafkaConnectNotConfigured
+	}
+
+	type clusterPlugins struct {
+		clusterName string
+		plugins     []con.ConnectorPluginInfo
+		err         error
+	}
+	ch := make(chan clusterPlugins, len(clusterNames))
+	for _, clusterName := range clusterNames {
+		go func(clusterName string) {
+			c, restErr := s.getConnectClusterByName(clusterName)
+			if restErr != nil {
+				ch <- clusterPlugins{clusterName: clusterName, err: restErr.Err}
+				return
+			}
+			plugins, err := c.Client.GetConnectorPlugins(ctx)
+			ch <- clusterPlugins{clusterName: clusterName, plugins: plugins, err: err}
+		}(clusterName)
+	}
+
+	pluginsByCluster := make(map[string][]con.ConnectorPluginInfo, len(clusterNames))
+	clusters := make([]PluginCatalogCluster, 0, len(clusterNames))
+	var upgrades []PluginUpgrade
+	for i := 0; i < len(clusterNames); i++ {
+		res := <-ch
+		if res.err != nil {
+			s.Logger.Warn("failed to list connector plugins from Kafka connect cluster",
+				zap.String("cluster_name", res.clusterName), zap.Error(res.err))
+			clusters = append(clusters, PluginCatalogCluster{ClusterName: res.clusterName, Error: res.err.Error()})
+			continue
+		}
+		clusters = append(clusters, PluginCatalogCluster{ClusterName: res.clusterName})
+		pluginsByCluster[res.clusterName] = res.plugins
+		upgrades = append(upgrades, s.pluginCatalog.observe(res.clusterName, res.plugins)...)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].ClusterName < clusters[j].ClusterName })
+
+	// The definitions of each newly observed plugin version are cached right
+	// away, so that the config diff can be computed once it is upgraded.
+	s.cachePluginConfigDefinitions(ctx, pluginsByCluster)
+
+	for i, upgrade := range upgrades {
+		previous, exists := s.pluginCatalog.getDefinitions(newPluginVersionKey(upgrade.ClusterName, upgrade.Class, upgrade.FromVersion))
+		if !exists {
+			continue
+		}
+		current, exists := s.pluginCatalog.getDefinitions(newPluginVersionKey(upgrade.ClusterName, upgrade.Class, upgrade.ToVersion))
+		if !exists {
+			continue
+		}
+		diff := diffPluginConfigKeys(previous, current)
+		upgrades[i].ConfigDiff = &diff
+	}
+	s.pluginCatalog.addUpgrades(upgrades)
+
+	return PluginCatalog{
+		Plugins:  aggregatePlugins(pluginsByCluster),
+		Clusters: clusters,
+		Upgrades: s.pluginCatalog.listUpgrades(),
+	}, nil
+}
+
+// cachePluginConfigDefinitions retrieves the config definitions of all plugin
+// versions that are not cached yet. Each version is only retrieved from one
+// cluster and the clusters are queried concurrently. Failures are logged, so that
+// the definitions are retried on the next listing.
+func (s *Service) cachePluginConfigDefinitions(ctx context.Context, pluginsByCluster map[string][]con.ConnectorPluginInfo) {
+	missingByCluster := make(map[string][]con.ConnectorPluginInfo)
+	seen := make(map[pluginVersionKey]struct{})
+	for clusterName, plugins := range pluginsByCluster {
+		for _, plugin := range plugins {
+			key := newPluginVersionKey(clusterName, plugin.Class, plugin.Version)
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+			if _, exists := s.pluginCatalog.getDefinitions(key); !exists {
+				missingByCluster[clusterName] = append(missingByCluster[clusterName], plugin)
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for clusterName, plugins := range missingByCluster {
+		wg.Add(1)
+		go func(clusterName string, plugins []con.ConnectorPluginInfo) {
+			defer wg.Done()
+			for _, plugin := range plugins {
+				if _, restErr := s.loadPluginConfigDefinitions(ctx, clusterName, plugin.Class, plugin.Version); restErr != nil {
+					s.Logger.Warn("failed to get config definitions of connector plugin",
+						zap.String("cluster_name", clusterName), zap.String("plugin_class_name", plugin.Class), zap.Error(restErr.Err))
+				}
+			}
+		}(clusterName, plugins)
+	}
+	wg.Wait()
+}
+
+// GetPluginConfigDefinitions returns the config definitions of a plugin as it is
+// installed on the given cluster. The definitions are retrieved via the validate
+// endpoint, run through the connector guides and cached per plugin version.
+func (s *Service) GetPluginConfigDefinitions(ctx context.Context, clusterName string, pluginClassName string) (PluginConfigDefinitions, *rest.Error) {
+	c, restErr := s.getConnectClusterByName(clusterName)
+	if restErr != nil {
+		return PluginConfigDefinitions{}, restErr
+	}
+
+	plugins, err := c.Client.GetConnectorPlugins(ctx)
+	if err != nil {
+		return PluginConfigDefinitions{}, &rest.Error{
+			Err:          err,
+			Status:       http.StatusServiceUnavailable,
+			Message:      fmt.Sprintf("Failed to get cluster plugins: %v", err.Error()),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	idx := slices.IndexFunc(plugins, func(plugin con.ConnectorPluginInfo) bool { return plugin.Class == pluginClassName })
+	if idx == -1 {
+		return PluginConfigDefinitions{}, &rest.Error{
+			Err:          fmt.Errorf("connector plugin %q is not installed", pluginClassName),
+			Status:       http.StatusNotFound,
+			Message:      fmt.Sprintf("Connector plugin %q is not installed on connect cluster %q", pluginClassName, clusterName),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("plugin_class_name", pluginClassName)},
+			IsSilent:     false,
+		}
+	}
+
+	return s.loadPluginConfigDefinitions(ctx, clusterName, pluginClassName, plugins[idx].Version)
+}
+
+// loadPluginConfigDefinitions returns the cached config definitions of the plugin
+// version or retrieves them from the given cluster.
+func (s *Service) loadPluginConfigDefinitions(ctx context.Context, clusterName string, pluginClassName string, version string) (PluginConfigDefinitions, *rest.Error) {
+	key := newPluginVersionKey(clusterName, pluginClassName, version)
+	if defs, exists := s.pluginCatalog.getDefinitions(key); exists {
+		return defs, nil
+	}
+
+	validation, restErr := s.ValidateConnectorConfig(ctx, clusterName, pluginClassName, map[string]any{"connector.class": pluginClassName})
+	if restErr != nil {
+		return PluginConfigDefinitions{}, restErr
+	}
+
+	defs := PluginConfigDefinitions{
+		Class:       pluginClassName,
+		Version:     version,
+		ClusterName: clusterName,
+		Definitions: validation,
+	}
+	s.pluginCatalog.putDefinitions(key, defs)
+
+	return defs, nil
+}
+
+// ComparePluginConfigDefinitions returns the config keys that differ between the
+// plugin versions installed on two connect clusters.
+func (s *Service) ComparePluginConfigDefinitions(ctx context.Context, pluginClassName string, fromClusterName string, toClusterName string) (PluginConfigDiff, *rest.Error) {
+	from, restErr := s.GetPluginConfigDefinitions(ctx, fromClusterName, pluginClassName)
+	if restErr != nil {
+		return PluginConfigDiff{}, restErr
+	}
+	to, restErr := s.GetPluginConfigDefinitions(ctx, toClusterName, pluginClassName)
+	if restErr != nil {
+		return PluginConfigDiff{}, restErr
+	}
+
+	return diffPluginConfigKeys(from, to), nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	con "github.com/cloudhut/connect-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+func TestAggregatePlugins(t *testing.T) {
+	plugins := aggregatePlugins(map[string][]con.ConnectorPluginInfo{
+		"staging": {
+			{Class: "io.debezium.connector.postgresql.PostgresConnector", Type: "source", Version: "2.6.0"},
+			{Class: "org.apache.kafka.connect.mirror.MirrorSourceConnector", Type: "source", Version: "3.7.0"},
+		},
+		"production": {
+			{Class: "io.debezium.connector.postgresql.PostgresConnector", Type: "source", Version: "2.5.1"},
+		},
+	})
+
+	assert.Equal(t, []CatalogPlugin{
+		{
+			Class: "io.debezium.connector.postgresql.PostgresConnector",
+			Type:  "source",
+			Installations: []PluginInstallation{
+				{ClusterName: "production", Version: "2.5.1"},
+				{ClusterName: "staging", Version: "2.6.0"},
+			},
+			VersionMismatch: true,
+			MissingFrom:     []string{},
+		},
+		{
+			Class:           "org.apache.kafka.connect.mirror.MirrorSourceConnector",
+			Type:            "source",
+			Installations:   []PluginInstallation{{ClusterName: "staging", Version: "3.7.0"}},
+			VersionMismatch: false,
+			MissingFrom:     []string{"production"},
+		},
+	}, plugins)
+}
+
+func TestPluginCatalogObserve(t *testing.T) {
+	catalog := newPluginCatalog()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	catalog.now = func() time.Time { return now }
+
+	// Plugins that are observed for the first time are no upgrades
+	upgrades := catalog.observe("main", []con.ConnectorPluginInfo{{Class: "a.Sink", Version: "1.0.0"}})
+	assert.Empty(t, upgrades)
+
+	upgrades = catalog.observe("main", []con.ConnectorPluginInfo{
+		{Class: "a.Sink", Version: "1.1.0"},
+		{Class: "b.Source", Version: "2.0.0"},
+	})
+	require.Len(t, upgrades, 1)
+	assert.Equal(t, PluginUpgrade{ClusterName: "main", Class: "a.Sink", FromVersion: "1.0.0", ToVersion: "1.1.0", DetectedAt: now}, upgrades[0])
+
+	catalog.addUpgrades(upgrades)
+	catalog.addUpgrades([]PluginUpgrade{{ClusterName: "main", Class: "b.Source", FromVersion: "2.0.0", ToVersion: "2.1.0"}})
+	listed := catalog.listUpgrades()
+	require.Len(t, listed, 2)
+	assert.Equal(t, "b.Source", listed[0].Class)
+}
+
+func TestGetPluginCatalogDiffsUpgradedConfigKeys(t *testing.T) {
+	var version atomic.Value
+	version.Store("1.0.0")
+	keysByVersion := map[string][]string{
+		"1.0.0": {"connector.class", "topics", "batch.size"},
+		"1.1.0": {"connector.class", "topics", "flush.interval"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/connector-plugins":
+			fmt.Fprintf(w, `[{"class":"a.Sink","type":"sink","version":%q}]`, version.Load())
+		case r.Method == http.MethodPut && r.URL.Path == "/connector-plugins/a.Sink/config/validate":
+			configs := make([]map[string]any, 0)
+			for _, key := range keysByVersion[version.Load().(string)] {
+				configs = append(configs, map[string]any{
+					"definition": map[string]any{"name": key, "type": "STRING"},
+					"value":      map[string]any{"name": key, "errors": []string{}},
+				})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "a.Sink", "configs": configs, "groups": []string{}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Connect{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	clusterCfg := config.ConnectCluster{Name: "main", URL: server.URL}
+	svc := &Service{
+		Cfg:              cfg,
+		Logger:           zap.NewNop(),
+		Interceptor:      interceptor.NewInterceptor(),
+		pluginCatalog:    newPluginCatalog(),
+		clientsByCluster: map[string]*ClientWithConfig{"main": newClientWithConfig(cfg, clusterCfg, ClusterSourceConfig, zap.NewNop())},
+	}
+
+	// The definitions of the first observed version are retrieved during the
+	// listing, without requesting them explicitly
+	_, err := svc.GetPluginCatalog(context.Background(), []string{"main"})
+	require.NoError(t, err)
+
+	version.Store("1.1.0")
+	catalog, err := svc.GetPluginCatalog(context.Background(), []string{"main"})
+	require.NoError(t, err)
+	require.Len(t, catalog.Upgrades, 1)
+	require.NotNil(t, catalog.Upgrades[0].ConfigDiff)
+	assert.Equal(t, []string{"flush.interval"}, catalog.Upgrades[0].ConfigDiff.AddedKeys)
+	assert.Equal(t, []string{"batch.size"}, catalog.Upgrades[0].ConfigDiff.RemovedKeys)
+}
+
+func TestDiffPluginConfigKeys(t *testing.T) {
+	definitions := func(version string, keys ...string) PluginConfigDefinitions {
+		configs := make([]model.ConfigDefinition, len(keys))
+		for i, key := range keys {
+			configs[i].Definition.Name = key
+		}
+		return PluginConfigDefinitions{Class: "a.Sink", Version: version, Definitions: model.ValidationResponse{Configs: configs}}
+	}
+
+	diff := diffPluginConfigKeys(
+		definitions("1.0.0", "topics", "batch.size", "legacy.mode"),
+		definitions("1.1.0", "topics", "batch.size", "linger.ms", "compression.type"),
+	)
+	assert.Equal(t, PluginConfigDiff{
+		Class:       "a.Sink",
+		FromVersion: "1.0.0",
+		ToVersion:   "1.1.0",
+		AddedKeys:   []string{"compression.type", "linger.ms"},
+		RemovedKeys: []string{"legacy.mode"},
+	}, diff)
+}
@@ -18,10 +18,27 @@ import (
 	con "github.com/cloudhut/connect-client"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
 )
 
 // PutConnectorConfig overwrites an existent connector config.
 func (s *Service) PutConnectorConfig(ctx context.Context, clusterName string, connectorName string, req con.PutConnectorConfigOptions) (con.ConnectorInfo, *rest.Error) {
+	cInfo, restErr := s.putConnectorConfig(ctx, clusterName, connectorName, req)
+	if restErr != nil {
+		return con.ConnectorInfo{}, restErr
+	}
+	s.recordConfigRevision(ctx, history.Revision{
+		ClusterName:   clusterName,
+		ConnectorName: connectorName,
+		Source:        history.SourceConsole,
+		Config:        cInfo.Config,
+	})
+
+	return cInfo, nil
+}
+
+func (s *Service) putConnectorConfig(ctx context.Context, clusterName string, connectorName string, req con.PutConnectorConfigOptions) (con.ConnectorInfo, *rest.Error) {
 	c, restErr := s.getConnectClusterByName(clusterName)
 	if restErr != nil {
 		return con.ConnectorInfo{}, restErr
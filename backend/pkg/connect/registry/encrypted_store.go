@@ -0,0 +1,141 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package registry
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// encryptedPrefix marks credentials that have been encrypted by EncryptedStore.
+// Values without it have been stored before encryption was enabled and are
+// encrypted on the next change of their cluster.
+const encryptedPrefix = "encrypted:"
+
+// EncryptedStore encrypts the password, token and TLS key of each cluster
+// before they are passed to the underlying store and decrypts them on reads,
+// so that persisted clusters do not contain credentials in plain text.
+type EncryptedStore struct {
+	store Store
+	aead  cipher.AEAD
+}
+
+// NewEncryptedStore wraps the given store. The encryption key is derived from
+// the given secret, which must be the same for all Console instances that share
+// the storage.
+func NewEncryptedStore(store Store, secret string) (*EncryptedStore, error) {
+	if secret == "" {
+		return nil, errors.New("encryption secret must not be empty")
+	}
+	key := sha256.Sum256([]byte("connect-cluster-registry:" + secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return &EncryptedStore{store: store, aead: aead}, nil
+}
+
+// List returns all registered clusters with decrypted credentials.
+func (s *EncryptedStore) List() ([]Cluster, error) {
+	clusters, err := s.store.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range clusters {
+		if err := s.decrypt(&clusters[i]); err != nil {
+			return nil, err
+		}
+	}
+	return clusters, nil
+}
+
+// Get returns the cluster with the given name with decrypted credentials.
+func (s *EncryptedStore) Get(name string) (Cluster, error) {
+	cluster, err := s.store.Get(name)
+	if err != nil {
+		return Cluster{}, err
+	}
+	if err := s.decrypt(&cluster); err != nil {
+		return Cluster{}, err
+	}
+	return cluster, nil
+}
+
+// Put encrypts the credentials of the cluster and stores it.
+func (s *EncryptedStore) Put(cluster Cluster) error {
+	if err := s.encrypt(&cluster); err != nil {
+		return err
+	}
+	return s.store.Put(cluster)
+}
+
+// Delete removes the cluster with the given name.
+func (s *EncryptedStore) Delete(name string) error {
+	return s.store.Delete(name)
+}
+
+// Close closes the underlying store if it has to be closed.
+func (s *EncryptedStore) Close() {
+	if closer, ok := s.store.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+func (s *EncryptedStore) encrypt(cluster *Cluster) error {
+	for _, value := range credentials(cluster) {
+		if *value == "" {
+			continue
+		}
+		nonce := make([]byte, s.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("failed to generate nonce: %w", err)
+		}
+		// The cluster name is authenticated, so that credentials can not be
+		// moved to another cluster in the storage
+		sealed := s.aead.Seal(nonce, nonce, []byte(*value), []byte(cluster.Config.Name))
+		*value = encryptedPrefix + base64.StdEncoding.EncodeToString(sealed)
+	}
+	return nil
+}
+
+func (s *EncryptedStore) decrypt(cluster *Cluster) error {
+	for _, value := range credentials(cluster) {
+		encoded, isEncrypted := strings.CutPrefix(*value, encryptedPrefix)
+		if !isEncrypted {
+			continue
+		}
+		sealed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(sealed) < s.aead.NonceSize() {
+			return fmt.Errorf("encrypted credentials of cluster %q are malformed", cluster.Config.Name)
+		}
+		nonceSize := s.aead.NonceSize()
+		plaintext, err := s.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(cluster.Config.Name))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt credentials of cluster %q, the encryption key may have changed: %w", cluster.Config.Name, err)
+		}
+		*value = string(plaintext)
+	}
+	return nil
+}
+
+// credentials returns pointers to all secrets of the cluster config.
+func credentials(cluster *Cluster) []*string {
+	return []*string{&cluster.Config.Password, &cluster.Config.Token, &cluster.Config.TLS.KeyPEM}
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package registry persists Kafka connect clusters that have been registered
+// at runtime.
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// ErrNotFound is returned if a cluster with the requested name is not registered.
+var ErrNotFound = errors.New("cluster not registered")
+
+// Cluster is a registered Kafka connect cluster.
+type Cluster struct {
+	Config    config.ConnectCluster `json:"config"`
+	CreatedAt time.Time             `json:"createdAt"`
+	UpdatedAt time.Time             `json:"updatedAt"`
+}
+
+// Store persists registered clusters.
+type Store interface {
+	// List returns all registered clusters, sorted by name.
+	List() ([]Cluster, error)
+
+	// Get returns the cluster with the given name or ErrNotFound.
+	Get(name string) (Cluster, error)
+
+	// Put registers the cluster or replaces the cluster with the same name.
+	Put(cluster Cluster) error
+
+	// Delete removes the cluster with the given name or returns ErrNotFound.
+	Delete(name string) error
+}
+
+func clusterName(cluster Cluster) string {
+	return cluster.Config.Name
+}
+
+// store implements Store on top of a JSON store that is keyed by cluster name.
+type store struct {
+	clusters jsonstore.Store[Cluster]
+}
+
+// List returns all registered clusters, sorted by name.
+func (s *store) List() ([]Cluster, error) {
+	return s.clusters.List()
+}
+
+// Get returns the cluster with the given name.
+func (s *store) Get(name string) (Cluster, error) {
+	cluster, err := s.clusters.Get(name)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return Cluster{}, ErrNotFound
+	}
+	return cluster, err
+}
+
+// Put registers or replaces the cluster.
+func (s *store) Put(cluster Cluster) error {
+	return s.clusters.Put(cluster)
+}
+
+// Delete removes the cluster with the given name.
+func (s *store) Delete(name string) error {
+	err := s.clusters.Delete(name)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// MemoryStore keeps registered clusters in memory. All clusters are lost when
+// the process exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates an empty in-memory cluster store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store{clusters: jsonstore.NewMemoryStore(clusterName)}}
+}
+
+// FileStore keeps all registered clusters in a single JSON file and serves
+// reads from memory. The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads all registered clusters from the file at the given path.
+// The file is created on the first change if it does not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	clusters, err := jsonstore.NewFileStore(path, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connect cluster registry: %w", err)
+	}
+	return &FileStore{store{clusters: clusters}}, nil
+}
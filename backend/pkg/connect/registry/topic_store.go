@@ -0,0 +1,257 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+)
+
+// topicLoadIdleTimeout is the time after which the initial load of the topic is
+// considered complete if no more records are received. Compaction may remove
+// the records at the end of the log, so that the end offset is never reached.
+const topicLoadIdleTimeout = 5 * time.Second
+
+// topicProduceTimeout is the time to wait until a change has been written to
+// and consumed from the topic.
+const topicProduceTimeout = 15 * time.Second
+
+// TopicStore keeps registered clusters in a compacted Kafka topic. The cluster
+// name is the record key and a tombstone removes the cluster. The topic is
+// consumed continuously, so that registrations of other Console instances are
+// picked up.
+type TopicStore struct {
+	topic  string
+	client *kgo.Client
+	logger *zap.Logger
+	memory *MemoryStore
+
+	// appliedOffsets are the offsets of the last consumed record per partition.
+	// Changes wait until their record has been consumed, so that they are not
+	// overwritten by older records that are consumed afterwards.
+	appliedMutex   sync.Mutex
+	appliedCond    *sync.Cond
+	appliedOffsets map[int32]int64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTopicStore creates the topic if it does not exist yet and loads all
+// registered clusters from it. The given client options must configure the
+// connection to the Kafka cluster.
+func NewTopicStore(ctx context.Context, opts []kgo.Opt, topic string, logger *zap.Logger) (*TopicStore, error) {
+	opts = append(opts,
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DefaultProduceTopic(topic),
+	)
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	s := &TopicStore{
+		topic:  topic,
+		client: client,
+		logger: logger,
+		memory: NewMemoryStore(),
+		done:   make(chan struct{}),
+
+		appliedOffsets: make(map[int32]int64),
+	}
+	s.appliedCond = sync.NewCond(&s.appliedMutex)
+	if err := s.load(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.consume(consumeCtx)
+
+	return s, nil
+}
+
+// load ensures that the topic exists and consumes it up to its current end.
+func (s *TopicStore) load(ctx context.Context) error {
+	adm := kadm.NewClient(s.client)
+	_, err := adm.CreateTopic(ctx, 1, -1, map[string]*string{"cleanup.policy": kadm.StringPtr("compact")}, s.topic)
+	switch {
+	case err == nil:
+		// The topic has just been created, so there is nothing to load yet
+		return nil
+	case !errors.Is(err, kerr.TopicAlreadyExists):
+		return fmt.Errorf("failed to create connect cluster registry topic: %w", err)
+	}
+
+	endOffsets, err := adm.ListEndOffsets(ctx, s.topic)
+	if err != nil {
+		return fmt.Errorf("failed to list end offsets of connect cluster registry topic: %w", err)
+	}
+	if err := endOffsets.Error(); err != nil {
+		return fmt.Errorf("failed to list end offsets of connect cluster registry topic: %w", err)
+	}
+	remaining := make(map[int32]int64)
+	endOffsets.Each(func(o kadm.ListedOffset) {
+		if o.Offset > 0 {
+			remaining[o.Partition] = o.Offset
+		}
+	})
+
+	for len(remaining) > 0 {
+		pollCtx, cancel := context.WithTimeout(ctx, topicLoadIdleTimeout)
+		fetches := s.client.PollFetches(pollCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to load connect cluster registry topic: %w", ctx.Err())
+		}
+		if fetches.NumRecords() == 0 && pollCtx.Err() != nil {
+			break
+		}
+		for _, fetchErr := range fetches.Errors() {
+			if !errors.Is(fetchErr.Err, context.DeadlineExceeded) {
+				return fmt.Errorf("failed to consume connect cluster registry topic: %w", fetchErr.Err)
+			}
+		}
+		fetches.EachRecord(func(record *kgo.Record) {
+			s.apply(record)
+			if end, exists := remaining[record.Partition]; exists && record.Offset+1 >= end {
+				delete(remaining, record.Partition)
+			}
+		})
+	}
+
+	return nil
+}
+
+func (s *TopicStore) consume(ctx context.Context) {
+	defer close(s.done)
+	for {
+		fetches := s.client.PollFetches(ctx)
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			s.logger.Warn("failed to consume connect cluster registry topic",
+				zap.String("topic", topic), zap.Int32("partition", partition), zap.Error(err))
+		})
+		fetches.EachRecord(s.apply)
+	}
+}
+
+func (s *TopicStore) apply(record *kgo.Record) {
+	defer func() {
+		s.appliedMutex.Lock()
+		s.appliedOffsets[record.Partition] = record.Offset
+		s.appliedMutex.Unlock()
+		s.appliedCond.Broadcast()
+	}()
+
+	if record.Attrs.IsControl() {
+		return
+	}
+	name := string(record.Key)
+	if record.Value == nil {
+		_ = s.memory.Delete(name)
+		return
+	}
+
+	var cluster Cluster
+	if err := json.Unmarshal(record.Value, &cluster); err != nil {
+		s.logger.Warn("failed to decode registered connect cluster, skipping it",
+			zap.String("cluster_name", name), zap.Int64("offset", record.Offset), zap.Error(err))
+		return
+	}
+	_ = s.memory.Put(cluster)
+}
+
+// produce writes the record and waits until it has been consumed.
+func (s *TopicStore) produce(record *kgo.Record) error {
+	ctx, cancel := context.WithTimeout(context.Background(), topicProduceTimeout)
+	defer cancel()
+
+	produced, err := s.client.ProduceSync(ctx, record).First()
+	if err != nil {
+		return err
+	}
+
+	// Wake up the waiting loop below once the timeout has passed
+	stop := context.AfterFunc(ctx, func() {
+		s.appliedMutex.Lock()
+		defer s.appliedMutex.Unlock()
+		s.appliedCond.Broadcast()
+	})
+	defer stop()
+
+	s.appliedMutex.Lock()
+	defer s.appliedMutex.Unlock()
+	for {
+		if offset, exists := s.appliedOffsets[produced.Partition]; exists && offset >= produced.Offset {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("record has been written, but has not been consumed in time: %w", ctx.Err())
+		}
+		s.appliedCond.Wait()
+	}
+}
+
+// List returns all registered clusters, sorted by name.
+func (s *TopicStore) List() ([]Cluster, error) {
+	return s.memory.List()
+}
+
+// Put produces the cluster to the topic.
+func (s *TopicStore) Put(cluster Cluster) error {
+	encoded, err := json.Marshal(cluster)
+	if err != nil {
+		return fmt.Errorf("failed to encode registered cluster: %w", err)
+	}
+	record := &kgo.Record{Key: []byte(cluster.Config.Name), Value: encoded}
+	if err := s.produce(record); err != nil {
+		return fmt.Errorf("failed to produce registered cluster: %w", err)
+	}
+	return nil
+}
+
+// Get returns the cluster with the given name.
+func (s *TopicStore) Get(name string) (Cluster, error) {
+	return s.memory.Get(name)
+}
+
+// Delete produces a tombstone for the cluster to the topic.
+func (s *TopicStore) Delete(name string) error {
+	if _, err := s.memory.Get(name); err != nil {
+		return err
+	}
+
+	record := &kgo.Record{Key: []byte(name), Value: nil}
+	if err := s.produce(record); err != nil {
+		return fmt.Errorf("failed to produce tombstone for registered cluster: %w", err)
+	}
+	return nil
+}
+
+// Close stops consuming the topic and closes the Kafka client.
+func (s *TopicStore) Close() {
+	s.cancel()
+	<-s.done
+	s.client.Close()
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package sensitive detects and redacts secrets in Kafka connector configs, so
+// that they are neither persisted nor returned by Console.
+package sensitive
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the values of sensitive config keys.
+const RedactedValue = "[REDACTED]"
+
+// keySubstrings are lower case substrings of connector config keys whose
+// values are secrets, e.g. "database.password", "sasl.jaas.config" or
+// "schema.registry.basic.auth.user.info".
+var keySubstrings = []string{
+	"password",
+	"secret",
+	"token",
+	"credential",
+	"passphrase",
+	"privatekey",
+	"private.key",
+	"private_key",
+	"apikey",
+	"api.key",
+	"api_key",
+	"jaas.config",
+	"basic.auth.user.info",
+	"keystore.key",
+}
+
+// providerReferenceRegexp matches values that solely consist of a reference to
+// a config provider, e.g. ${file:/opt/secrets.properties:password}. These are
+// resolved by Kafka connect and do not contain the secret itself.
+var providerReferenceRegexp = regexp.MustCompile(`^\$\{[^}:]*:[^}]*\}$`)
+
+// IsKey returns true if the values of the given connector config key are
+// secrets.
+func IsKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, substring := range keySubstrings {
+		if strings.Contains(lower, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSecretValue returns true if the value of the given key is a secret that
+// must be redacted. Empty values, already redacted values and config provider
+// references are not secrets.
+func IsSecretValue(key, value string) bool {
+	if value == "" || value == RedactedValue || providerReferenceRegexp.MatchString(value) {
+		return false
+	}
+	return IsKey(key)
+}
+
+// RedactConfig returns a copy of the config in which the values of all
+// sensitive keys are replaced by RedactedValue.
+func RedactConfig(config map[string]string) map[string]string {
+	if config == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(config))
+	for key, value := range config {
+		if IsSecretValue(key, value) {
+			value = RedactedValue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
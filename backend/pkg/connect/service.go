@@ -12,110 +12,285 @@ package connect
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 
 	con "github.com/cloudhut/connect-client"
+	"github.com/twmb/franz-go/pkg/kgo"
 	"go.uber.org/zap"
 
 	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/connect/history"
+	"github.com/redpanda-data/console/backend/pkg/connect/registry"
+	"github.com/redpanda-data/console/backend/pkg/connect/template"
 	"github.com/redpanda-data/console/backend/pkg/connector/interceptor"
 )
 
 // Service provides the API for interacting with all configured Kafka connect clusters.
 type Service struct {
-	Cfg    config.Connect
-	Logger *zap.Logger
-	// ClientsByCluster holds the Client and config. The key is the clusters' name
-	ClientsByCluster map[string]*ClientWithConfig
-	Interceptor      *interceptor.Interceptor
+	Cfg         config.Connect
+	Logger      *zap.Logger
+	Interceptor *interceptor.Interceptor
+
+	// Supervisor restarts failed connector tasks, if auto remediation is enabled.
+	Supervisor *Supervisor
+
+	// ConfigHistory stores revisions of connector configs. It is nil if the
+	// config history is disabled.
+	ConfigHistory *history.History
+
+	// Templates stores connector templates. It is nil if connector templates
+	// are disabled.
+	Templates template.Store
+
+	// pluginCatalog caches plugin config definitions and detects plugin upgrades.
+	pluginCatalog *pluginCatalog
+
+	// clientsByCluster holds the Client and config. The key is the clusters' name.
+	// Clusters can be registered, discovered and removed at runtime.
+	clientsMutex     sync.RWMutex
+	clientsByCluster map[string]*ClientWithConfig
+
+	// clusterStore persists clusters that have been registered at runtime. It is
+	// nil if the cluster registry is disabled.
+	clusterStore registry.Store
+
+	// kafkaClient is used to discover Kafka connect clusters. It is nil if the
+	// discovery is disabled.
+	kafkaClient *kgo.Client
+
+	// stopped is closed by Stop, so that the cluster sync and discovery, the
+	// auto remediation and the config observer end.
+	stopped  chan struct{}
+	stopOnce sync.Once
 }
 
+// ClusterSource describes where the config of a Kafka connect cluster comes from.
+type ClusterSource string
+
+const (
+	// ClusterSourceConfig is a cluster from the Console config.
+	ClusterSourceConfig ClusterSource = "CONFIG"
+	// ClusterSourceRegistry is a cluster that has been registered through the API.
+	ClusterSourceRegistry ClusterSource = "REGISTRY"
+	// ClusterSourceDiscovery is a cluster that has been discovered by its
+	// consumer group in Kafka.
+	ClusterSourceDiscovery ClusterSource = "DISCOVERY"
+)
+
 // ClientWithConfig carries the Kafka Connect client, along with the configuration
 // for a single configured Kafka connect cluster.
 type ClientWithConfig struct {
 	Client *con.Client
 	Cfg    config.ConnectCluster
+	Source ClusterSource
+	// WorkerURLs are the REST URLs of all workers of a discovered cluster.
+	WorkerURLs []string
+}
+
+// Option configures optional dependencies of the connect service.
+type Option func(*serviceOptions)
+
+type serviceOptions struct {
+	kafkaOpts []kgo.Opt
+}
+
+// WithKafkaClientOpts sets the options for connecting to the Kafka cluster. A
+// connection is required if registered clusters are stored in Kafka or if
+// clusters are discovered.
+func WithKafkaClientOpts(opts ...kgo.Opt) Option {
+	return func(o *serviceOptions) {
+		o.kafkaOpts = opts
+	}
 }
 
 // NewService creates a new connect.Service. It tests the connectivity for each configured
 // Kafka connect cluster proactively.
-func NewService(cfg config.Connect, logger *zap.Logger) (*Service, error) {
-	clientsByCluster := make(map[string]*ClientWithConfig)
+func NewService(cfg config.Connect, logger *zap.Logger, opts ...Option) (*Service, error) {
+	var o serviceOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 
-	if len(cfg.Clusters) == 0 {
-		return &Service{
-			Cfg:              cfg,
-			Logger:           logger,
-			ClientsByCluster: clientsByCluster,
-			Interceptor:      interceptor.NewInterceptor(),
-		}, nil
+	interceptorOpts, err := loadCustomizations(context.Background(), cfg.Customizations, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connector customizations: %w", err)
 	}
 
-	// 1. Create a client for each configured Connect cluster
-	logger.Info("creating Kafka connect HTTP clients and testing connectivity to all clusters")
+	configHistory, err := newConfigHistory(cfg.ConfigHistory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connector config history: %w", err)
+	}
 
-	for _, clusterCfg := range cfg.Clusters {
-		// Create dedicated Connect HTTP Client for each cluster
-		childLogger := logger.With(
-			zap.String("cluster_name", clusterCfg.Name),
-			zap.String("cluster_address", clusterCfg.URL))
-
-		opts := []con.ClientOption{
-			con.WithTimeout(cfg.ReadTimeout),
-			con.WithUserAgent("Redpanda Console"),
-		}
+	templates, err := newTemplateStore(cfg.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connector template store: %w", err)
+	}
 
-		opts = append(opts, con.WithHost(clusterCfg.URL))
-		// TLS Config
-		tlsCfg, err := clusterCfg.TLS.TLSConfig()
-		if err != nil {
-			childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", zap.Error(err))
-			tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
-		}
-		opts = append(opts, con.WithTLSConfig(tlsCfg))
+	clusterStore, err := newClusterStore(cfg, logger, o.kafkaOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connect cluster registry: %w", err)
+	}
 
-		// Basic Auth
-		if clusterCfg.Username != "" {
-			opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
+	var kafkaClient *kgo.Client
+	if cfg.Discovery.Enabled {
+		if o.kafkaOpts == nil {
+			return nil, errors.New("connect cluster discovery requires a connection to Kafka")
 		}
-
-		// Bearer Token
-		if clusterCfg.Token != "" {
-			opts = append(opts, con.WithAuthToken(clusterCfg.Token))
+		kafkaClient, err = kgo.NewClient(o.kafkaOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka client for connect cluster discovery: %w", err)
 		}
+	}
 
-		// Create client
-		client := con.NewClient(opts...)
-		clientsByCluster[clusterCfg.Name] = &ClientWithConfig{
-			Client: client,
-			Cfg:    clusterCfg,
-		}
+	// 1. Create a client for each configured Connect cluster
+	clientsByCluster := make(map[string]*ClientWithConfig)
+	for _, clusterCfg := range cfg.Clusters {
+		clientsByCluster[clusterCfg.Name] = newClientWithConfig(cfg, clusterCfg, ClusterSourceConfig, logger)
 	}
 
 	svc := &Service{
 		Cfg:              cfg,
 		Logger:           logger,
-		ClientsByCluster: clientsByCluster,
-		Interceptor:      interceptor.NewInterceptor(),
+		Interceptor:      interceptor.NewInterceptor(interceptorOpts...),
+		ConfigHistory:    configHistory,
+		Templates:        templates,
+		pluginCatalog:    newPluginCatalog(),
+		clientsByCluster: clientsByCluster,
+		clusterStore:     clusterStore,
+		kafkaClient:      kafkaClient,
+		stopped:          make(chan struct{}),
 	}
+	svc.Supervisor = newSupervisor(cfg.AutoRemediation, cfg.RequestTimeout, logger, svc)
 
-	// 2. Test connectivity against each cluster concurrently
-	shortCtx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
-	defer cancel()
-	svc.TestConnectivity(shortCtx)
+	// 2. Add the clusters that have been registered at runtime
+	if err := svc.syncRegisteredClusters(); err != nil {
+		return nil, err
+	}
+
+	if len(svc.clientsByCluster) > 0 || cfg.Registry.Enabled || cfg.Discovery.Enabled {
+		// 3. Test connectivity against each cluster concurrently
+		logger.Info("testing connectivity to all Kafka connect clusters")
+		shortCtx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
+		defer cancel()
+		svc.TestConnectivity(shortCtx)
+
+		// 4. Start supervising the connectors' health
+		svc.Supervisor.Start()
 
-	logger.Info("successfully create Kafka connect service")
+		// 5. Start recording config changes that are made outside of Console
+		svc.startConfigObserver()
+
+		// 6. Start picking up registered and discovered clusters
+		svc.startClusterSync()
+		svc.startClusterDiscovery()
+
+		logger.Info("successfully create Kafka connect service")
+	}
 
 	return svc, nil
 }
 
+// newClientWithConfig creates the Kafka connect HTTP client for a single cluster.
+func newClientWithConfig(cfg config.Connect, clusterCfg config.ConnectCluster, source ClusterSource, logger *zap.Logger) *ClientWithConfig {
+	// Create dedicated Connect HTTP Client for each cluster
+	childLogger := logger.With(
+		zap.String("cluster_name", clusterCfg.Name),
+		zap.String("cluster_address", clusterCfg.URL))
+
+	opts := []con.ClientOption{
+		con.WithTimeout(cfg.ReadTimeout),
+		con.WithUserAgent("Redpanda Console"),
+	}
+
+	opts = append(opts, con.WithHost(clusterCfg.URL))
+	// TLS Config
+	tlsCfg, err := clusterCfg.TLS.TLSConfig()
+	if err != nil {
+		childLogger.Error("failed to create TLS config for Kafka connect HTTP client, fallback to default TLS config", zap.Error(err))
+		tlsCfg = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	opts = append(opts, con.WithTLSConfig(tlsCfg))
+
+	// Basic Auth
+	if clusterCfg.Username != "" {
+		opts = append(opts, con.WithBasicAuth(clusterCfg.Username, clusterCfg.Password))
+	}
+
+	// Bearer Token
+	if clusterCfg.Token != "" {
+		opts = append(opts, con.WithAuthToken(clusterCfg.Token))
+	}
+
+	return &ClientWithConfig{
+		Client: con.NewClient(opts...),
+		Cfg:    clusterCfg,
+		Source: source,
+	}
+}
+
+// Stop ends the cluster sync and discovery, the auto remediation and the config
+// observer, and closes the cluster registry storage and the Kafka client that is
+// used for the discovery.
+func (s *Service) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopped)
+		if closer, ok := s.clusterStore.(interface{ Close() }); ok {
+			closer.Close()
+		}
+		if s.kafkaClient != nil {
+			s.kafkaClient.Close()
+		}
+	})
+}
+
+// ClientsByCluster returns the Client and config of all Kafka connect clusters.
+// The key is the clusters' name. The returned map is a snapshot, because
+// clusters can be registered, discovered and removed at runtime.
+func (s *Service) ClientsByCluster() map[string]*ClientWithConfig {
+	s.clientsMutex.RLock()
+	defer s.clientsMutex.RUnlock()
+
+	clientsByCluster := make(map[string]*ClientWithConfig, len(s.clientsByCluster))
+	for name, cluster := range s.clientsByCluster {
+		clientsByCluster[name] = cluster
+	}
+	return clientsByCluster
+}
+
+// Clusters returns all Kafka connect clusters, sorted by name.
+func (s *Service) Clusters() []*ClientWithConfig {
+	s.clientsMutex.RLock()
+	defer s.clientsMutex.RUnlock()
+
+	clusters := make([]*ClientWithConfig, 0, len(s.clientsByCluster))
+	for _, cluster := range s.clientsByCluster {
+		clusters = append(clusters, cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Cfg.Name < clusters[j].Cfg.Name })
+	return clusters
+}
+
+// ClusterNames returns the names of all Kafka connect clusters, sorted by name.
+func (s *Service) ClusterNames() []string {
+	clusters := s.Clusters()
+	names := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		names[i] = cluster.Cfg.Name
+	}
+	return names
+}
+
 // TestConnectivity will send to each Kafka connect client a request to check if it is reachable. If a cluster is not
 // reachable an error log message will be printed.
 func (s *Service) TestConnectivity(ctx context.Context) {
 	var successfulChecks uint32
 	wg := sync.WaitGroup{}
-	for _, clientInfo := range s.ClientsByCluster {
+	clusters := s.Clusters()
+	for _, clientInfo := range clusters {
 		wg.Add(1)
 		go func(cfg config.ConnectCluster, c *con.Client) {
 			defer wg.Done()
@@ -133,5 +308,5 @@ func (s *Service) TestConnectivity(ctx context.Context) {
 	wg.Wait()
 	s.Logger.Info("tested Kafka connect cluster connectivity",
 		zap.Uint32("successful_clusters", successfulChecks),
-		zap.Uint32("failed_clusters", uint32(len(s.ClientsByCluster))-successfulChecks))
+		zap.Uint32("failed_clusters", uint32(len(clusters))-successfulChecks))
 }
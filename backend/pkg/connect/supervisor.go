@@ -0,0 +1,401 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/backoff"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// RemediationAction is the action that the supervisor has taken for a task.
+type RemediationAction string
+
+const (
+	// RemediationActionRestarted means a failed task has been restarted.
+	RemediationActionRestarted RemediationAction = "RESTARTED"
+	// RemediationActionRestartFailed means the restart request for a failed task has failed.
+	RemediationActionRestartFailed RemediationAction = "RESTART_FAILED"
+	// RemediationActionGaveUp means all restart attempts have been exhausted.
+	RemediationActionGaveUp RemediationAction = "GAVE_UP"
+	// RemediationActionRecovered means a previously restarted task has been running
+	// for at least the policy's max interval, so that its attempts have been reset.
+	RemediationActionRecovered RemediationAction = "RECOVERED"
+)
+
+// RemediationEvent is a single entry in the remediation history of a connector.
+type RemediationEvent struct {
+	Timestamp time.Time         `json:"timestamp"`
+	TaskID    int               `json:"taskId"`
+	WorkerID  string            `json:"workerId"`
+	Action    RemediationAction `json:"action"`
+
+	// Attempt is the restart attempt for the task, starting at 1.
+	Attempt int `json:"attempt"`
+
+	// Trace is the stack trace of the failed task that has been observed before
+	// the action has been taken.
+	Trace string `json:"trace,omitempty"`
+
+	// Error is set if the restart request has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// RemediationTaskState is the current remediation state of a single task.
+type RemediationTaskState struct {
+	TaskID   int  `json:"taskId"`
+	Attempts int  `json:"attempts"`
+	GaveUp   bool `json:"gaveUp"`
+
+	// NextAttempt is the earliest time for the next restart if the task is still failed.
+	NextAttempt time.Time `json:"nextAttempt"`
+}
+
+// ConnectorRemediation is the remediation policy, state and history of a connector.
+type ConnectorRemediation struct {
+	Enabled bool                            `json:"enabled"`
+	Policy  config.ConnectRemediationPolicy `json:"policy"`
+	Tasks   []RemediationTaskState          `json:"tasks"`
+
+	// History contains the remediation events, newest first.
+	History []RemediationEvent `json:"history"`
+}
+
+type connectorKey struct {
+	clusterName   string
+	connectorName string
+}
+
+type connectorRemediationState struct {
+	tasks   map[int]*taskRemediationState
+	history []RemediationEvent
+}
+
+type taskRemediationState struct {
+	attempts    int
+	lastAttempt time.Time
+	nextAttempt time.Time
+	gaveUp      bool
+}
+
+// Supervisor periodically checks the connectors of all configured Kafka connect
+// clusters and restarts failed tasks with an exponential backoff, until the
+// attempts of the connector's remediation policy are exhausted.
+type Supervisor struct {
+	cfg    config.ConnectAutoRemediation
+	logger *zap.Logger
+
+	listConnectors func(ctx context.Context) ([]*ClusterConnectors, error)
+	restartTask    func(ctx context.Context, clusterName string, connector string, taskID int) *rest.Error
+	now            func() time.Time
+
+	requestTimeout time.Duration
+
+	// stopped is closed when the connect service is stopped.
+	stopped <-chan struct{}
+
+	mutex            sync.RWMutex
+	stateByConnector map[connectorKey]*connectorRemediationState
+}
+
+func newSupervisor(cfg config.ConnectAutoRemediation, requestTimeout time.Duration, logger *zap.Logger, svc *Service) *Supervisor {
+	return &Supervisor{
+		cfg:              cfg,
+		logger:           logger.With(zap.String("source", "connect_supervisor")),
+		listConnectors:   svc.GetAllClusterConnectors,
+		restartTask:      svc.RestartConnectorTask,
+		now:              time.Now,
+		stateByConnector: make(map[connectorKey]*connectorRemediationState),
+		requestTimeout:   requestTimeout,
+		stopped:          svc.stopped,
+	}
+}
+
+// Start the supervisor in the background if auto remediation is enabled. The
+// supervisor stops when a termination signal is received or the connect
+// service is stopped.
+func (s *Supervisor) Start() {
+	if !s.cfg.Enabled {
+		return
+	}
+
+	s.logger.Info("starting connector auto remediation", zap.Duration("poll_interval", s.cfg.PollInterval))
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				s.logger.Info("stopped connector auto remediation", zap.String("reason", "received signal"))
+				return
+			case <-s.stopped:
+				s.logger.Info("stopped connector auto remediation", zap.String("reason", "service stopped"))
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), s.cfg.PollInterval)
+				s.checkConnectors(ctx)
+				cancel()
+			}
+		}
+	}()
+}
+
+// checkConnectors lists the connectors of all clusters and reconciles their state.
+func (s *Supervisor) checkConnectors(ctx context.Context) {
+	listCtx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+	clusters, err := s.listConnectors(listCtx)
+	if err != nil {
+		s.logger.Warn("failed to list connectors", zap.Error(err))
+		return
+	}
+
+	s.reconcile(ctx, clusters)
+}
+
+// pendingRestart is a task restart that has been decided on while holding the
+// supervisor's lock and is executed after the lock has been released.
+type pendingRestart struct {
+	key   connectorKey
+	event RemediationEvent
+}
+
+// reconcile restarts failed tasks whose backoff has elapsed and updates the
+// remediation state of all connectors in the given clusters. The restart
+// requests are sent without holding the lock, so that a slow Connect cluster
+// neither blocks status reads nor the restarts on other clusters.
+func (s *Supervisor) reconcile(ctx context.Context, clusters []*ClusterConnectors) {
+	restarts := s.planRestarts(clusters)
+	if len(restarts) == 0 {
+		return
+	}
+
+	restartsByCluster := make(map[string][]*pendingRestart)
+	for _, restart := range restarts {
+		restartsByCluster[restart.key.clusterName] = append(restartsByCluster[restart.key.clusterName], restart)
+	}
+
+	wg := sync.WaitGroup{}
+	for _, clusterRestarts := range restartsByCluster {
+		wg.Add(1)
+		go func(clusterRestarts []*pendingRestart) {
+			defer wg.Done()
+			for _, restart := range clusterRestarts {
+				s.executeRestart(ctx, restart)
+			}
+		}(clusterRestarts)
+	}
+	wg.Wait()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, restart := range restarts {
+		// The connector may have been deleted while its tasks were restarted
+		if state, exists := s.stateByConnector[restart.key]; exists {
+			s.addEvent(state, restart.event)
+		}
+	}
+}
+
+// planRestarts updates the remediation state of all connectors in the given
+// clusters and returns the task restarts that are due.
+func (s *Supervisor) planRestarts(clusters []*ClusterConnectors) []*pendingRestart {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var restarts []*pendingRestart
+	for _, cluster := range clusters {
+		if cluster.Error != "" {
+			// We don't know the state of the connectors, so keep the existing state.
+			continue
+		}
+
+		seen := make(map[connectorKey]struct{}, len(cluster.Connectors))
+		for _, connector := range cluster.Connectors {
+			key := connectorKey{clusterName: cluster.ClusterName, connectorName: connector.Name}
+			seen[key] = struct{}{}
+
+			policy, enabled := s.cfg.PolicyForConnector(cluster.ClusterName, connector.Name)
+			if !enabled {
+				continue
+			}
+			restarts = append(restarts, s.reconcileConnector(key, connector, policy)...)
+		}
+
+		// Forget the state of deleted connectors
+		for key := range s.stateByConnector {
+			if _, exists := seen[key]; !exists && key.clusterName == cluster.ClusterName {
+				delete(s.stateByConnector, key)
+			}
+		}
+	}
+	return restarts
+}
+
+// executeRestart sends the restart request for a pending restart and records
+// the outcome in its event. It must be called without holding the lock.
+func (s *Supervisor) executeRestart(ctx context.Context, restart *pendingRestart) {
+	restartCtx, cancel := context.WithTimeout(ctx, s.requestTimeout)
+	defer cancel()
+
+	restErr := s.restartTask(restartCtx, restart.key.clusterName, restart.key.connectorName, restart.event.TaskID)
+	if restErr != nil {
+		restart.event.Action = RemediationActionRestartFailed
+		restart.event.Error = restErr.Message
+		s.logger.Warn("failed to restart failed connector task",
+			zap.String("cluster_name", restart.key.clusterName),
+			zap.String("connector", restart.key.connectorName),
+			zap.Int("task_id", restart.event.TaskID),
+			zap.Error(restErr.Err))
+		return
+	}
+	s.logger.Info("restarted failed connector task",
+		zap.String("cluster_name", restart.key.clusterName),
+		zap.String("connector", restart.key.connectorName),
+		zap.Int("task_id", restart.event.TaskID),
+		zap.Int("attempt", restart.event.Attempt))
+}
+
+func (s *Supervisor) reconcileConnector(key connectorKey, connector ClusterConnectorInfo, policy config.ConnectRemediationPolicy) []*pendingRestart {
+	state, exists := s.stateByConnector[key]
+	if !exists {
+		state = &connectorRemediationState{tasks: make(map[int]*taskRemediationState)}
+		s.stateByConnector[key] = state
+	}
+
+	eb := backoff.ExponentialBackoff{
+		BaseInterval: policy.BaseInterval,
+		MaxInterval:  policy.MaxInterval,
+		Multiplier:   policy.Multiplier,
+	}
+
+	var restarts []*pendingRestart
+	now := s.now()
+	for _, task := range connector.Tasks {
+		taskState, exists := state.tasks[task.TaskID]
+
+		if task.State != connectorStateFailed {
+			if !exists {
+				continue
+			}
+			if task.State == connectorStateRunning && (taskState.gaveUp || now.Sub(taskState.lastAttempt) >= policy.MaxInterval) {
+				delete(state.tasks, task.TaskID)
+				s.addEvent(state, RemediationEvent{
+					Timestamp: now,
+					TaskID:    task.TaskID,
+					WorkerID:  task.WorkerID,
+					Action:    RemediationActionRecovered,
+					Attempt:   taskState.attempts,
+				})
+			}
+			continue
+		}
+
+		if !exists {
+			taskState = &taskRemediationState{}
+			state.tasks[task.TaskID] = taskState
+		}
+		if taskState.gaveUp || now.Before(taskState.nextAttempt) {
+			continue
+		}
+		if taskState.attempts >= policy.MaxAttempts {
+			taskState.gaveUp = true
+			s.addEvent(state, RemediationEvent{
+				Timestamp: now,
+				TaskID:    task.TaskID,
+				WorkerID:  task.WorkerID,
+				Action:    RemediationActionGaveUp,
+				Attempt:   taskState.attempts,
+				Trace:     task.Trace,
+			})
+			s.logger.Warn("giving up restarting failed connector task",
+				zap.String("cluster_name", key.clusterName),
+				zap.String("connector", key.connectorName),
+				zap.Int("task_id", task.TaskID),
+				zap.Int("attempts", taskState.attempts))
+			continue
+		}
+
+		// Count the attempt right away, so that the task is not restarted again
+		// before the backoff has elapsed, even if the request is still in flight.
+		taskState.attempts++
+		taskState.lastAttempt = now
+		taskState.nextAttempt = now.Add(eb.Backoff(taskState.attempts - 1))
+
+		restarts = append(restarts, &pendingRestart{
+			key: key,
+			event: RemediationEvent{
+				Timestamp: now,
+				TaskID:    task.TaskID,
+				WorkerID:  task.WorkerID,
+				Action:    RemediationActionRestarted,
+				Attempt:   taskState.attempts,
+				Trace:     task.Trace,
+			},
+		})
+	}
+	return restarts
+}
+
+// addEvent prepends the event to the connector's history and drops the oldest
+// events that exceed the configured history size.
+func (s *Supervisor) addEvent(state *connectorRemediationState, event RemediationEvent) {
+	state.history = append([]RemediationEvent{event}, state.history...)
+	if len(state.history) > s.cfg.HistorySize {
+		state.history = state.history[:s.cfg.HistorySize]
+	}
+}
+
+// GetConnectorRemediation returns the remediation policy, the current task states and
+// the remediation history for the given connector.
+func (s *Supervisor) GetConnectorRemediation(clusterName string, connectorName string) ConnectorRemediation {
+	policy, enabled := s.cfg.PolicyForConnector(clusterName, connectorName)
+	remediation := ConnectorRemediation{
+		Enabled: enabled,
+		Policy:  policy,
+		Tasks:   []RemediationTaskState{},
+		History: []RemediationEvent{},
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	state, exists := s.stateByConnector[connectorKey{clusterName: clusterName, connectorName: connectorName}]
+	if !exists {
+		return remediation
+	}
+	for taskID, taskState := range state.tasks {
+		remediation.Tasks = append(remediation.Tasks, RemediationTaskState{
+			TaskID:      taskID,
+			Attempts:    taskState.attempts,
+			GaveUp:      taskState.gaveUp,
+			NextAttempt: taskState.nextAttempt,
+		})
+	}
+	sort.Slice(remediation.Tasks, func(i, j int) bool {
+		return remediation.Tasks[i].TaskID < remediation.Tasks[j].TaskID
+	})
+	remediation.History = append(remediation.History, state.history...)
+
+	return remediation
+}
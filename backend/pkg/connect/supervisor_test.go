@@ -0,0 +1,228 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestSupervisorReconcile(t *testing.T) {
+	cfg := config.ConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.Policy = config.ConnectRemediationPolicy{
+		MaxAttempts:  2,
+		BaseInterval: time.Minute,
+		MaxInterval:  4 * time.Minute,
+		Multiplier:   2,
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var restarts []int
+	failRestart := false
+	s := &Supervisor{
+		cfg:    cfg,
+		logger: zap.NewNop(),
+		restartTask: func(_ context.Context, _, _ string, taskID int) *rest.Error {
+			restarts = append(restarts, taskID)
+			if failRestart {
+				return &rest.Error{Err: errors.New("unavailable"), Message: "unavailable"}
+			}
+			return nil
+		},
+		now:              func() time.Time { return now },
+		requestTimeout:   time.Second,
+		stateByConnector: make(map[connectorKey]*connectorRemediationState),
+	}
+
+	clusters := func(taskStates ...string) []*ClusterConnectors {
+		tasks := make([]ClusterConnectorTaskInfo, len(taskStates))
+		for i, state := range taskStates {
+			tasks[i] = ClusterConnectorTaskInfo{TaskID: i, State: state, WorkerID: "worker-1", Trace: "java.lang.RuntimeException: boom"}
+		}
+		return []*ClusterConnectors{{
+			ClusterName: "main",
+			Connectors:  []ClusterConnectorInfo{{Name: "sink", Tasks: tasks}},
+		}}
+	}
+	ctx := context.Background()
+
+	// First failure is restarted immediately
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateFailed))
+	assert.Equal(t, []int{1}, restarts)
+
+	// Backoff has not elapsed yet
+	now = now.Add(30 * time.Second)
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateFailed))
+	assert.Equal(t, []int{1}, restarts)
+
+	// Second attempt after the base interval, the restart request fails
+	now = now.Add(30 * time.Second)
+	failRestart = true
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateFailed))
+	assert.Equal(t, []int{1, 1}, restarts)
+
+	// Attempts are exhausted after the second backoff
+	now = now.Add(2 * time.Minute)
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateFailed))
+	now = now.Add(time.Hour)
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateFailed))
+	assert.Equal(t, []int{1, 1}, restarts)
+
+	remediation := s.GetConnectorRemediation("main", "sink")
+	assert.True(t, remediation.Enabled)
+	require.Len(t, remediation.Tasks, 1)
+	assert.Equal(t, RemediationTaskState{TaskID: 1, Attempts: 2, GaveUp: true, NextAttempt: time.Date(2024, 1, 1, 0, 3, 0, 0, time.UTC)}, remediation.Tasks[0])
+	require.Len(t, remediation.History, 3)
+	assert.Equal(t, RemediationActionGaveUp, remediation.History[0].Action)
+	assert.Equal(t, RemediationActionRestartFailed, remediation.History[1].Action)
+	assert.Equal(t, "unavailable", remediation.History[1].Error)
+	assert.Equal(t, RemediationActionRestarted, remediation.History[2].Action)
+	assert.Equal(t, "java.lang.RuntimeException: boom", remediation.History[2].Trace)
+
+	// Manually restarted task recovers and resets the state
+	s.reconcile(ctx, clusters(connectorStateRunning, connectorStateRunning))
+	remediation = s.GetConnectorRemediation("main", "sink")
+	assert.Empty(t, remediation.Tasks)
+	assert.Equal(t, RemediationActionRecovered, remediation.History[0].Action)
+
+	// Deleted connectors are forgotten
+	s.reconcile(ctx, []*ClusterConnectors{{ClusterName: "main"}})
+	assert.Empty(t, s.GetConnectorRemediation("main", "sink").History)
+}
+
+func TestSupervisorPolicyOverrides(t *testing.T) {
+	cfg := config.ConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+
+	var connectorName config.Regexp
+	require.NoError(t, connectorName.UnmarshalText([]byte("/debezium-.*/")))
+	cfg.Overrides = []config.ConnectRemediationPolicyOverride{{ConnectorName: connectorName, Disabled: true}}
+
+	restarted := false
+	s := &Supervisor{
+		cfg:    cfg,
+		logger: zap.NewNop(),
+		restartTask: func(context.Context, string, string, int) *rest.Error {
+			restarted = true
+			return nil
+		},
+		now:              time.Now,
+		requestTimeout:   time.Second,
+		stateByConnector: make(map[connectorKey]*connectorRemediationState),
+	}
+
+	s.reconcile(context.Background(), []*ClusterConnectors{{
+		ClusterName: "main",
+		Connectors: []ClusterConnectorInfo{{
+			Name:  "debezium-orders",
+			Tasks: []ClusterConnectorTaskInfo{{TaskID: 0, State: connectorStateFailed}},
+		}},
+	}})
+	assert.False(t, restarted)
+	assert.False(t, s.GetConnectorRemediation("main", "debezium-orders").Enabled)
+}
+
+func TestSupervisorReconcileDoesNotBlockOnSlowRestarts(t *testing.T) {
+	cfg := config.ConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+
+	slowRestartStarted := make(chan struct{})
+	releaseSlowRestart := make(chan struct{})
+	fastRestartDone := make(chan struct{})
+	s := &Supervisor{
+		cfg:    cfg,
+		logger: zap.NewNop(),
+		restartTask: func(_ context.Context, clusterName, _ string, _ int) *rest.Error {
+			if clusterName == "slow" {
+				close(slowRestartStarted)
+				<-releaseSlowRestart
+				return nil
+			}
+			close(fastRestartDone)
+			return nil
+		},
+		now:              time.Now,
+		requestTimeout:   time.Minute,
+		stateByConnector: make(map[connectorKey]*connectorRemediationState),
+	}
+
+	failedConnector := []ClusterConnectorInfo{{
+		Name:  "sink",
+		Tasks: []ClusterConnectorTaskInfo{{TaskID: 0, State: connectorStateFailed}},
+	}}
+	reconciled := make(chan struct{})
+	go func() {
+		defer close(reconciled)
+		s.reconcile(context.Background(), []*ClusterConnectors{
+			{ClusterName: "slow", Connectors: failedConnector},
+			{ClusterName: "fast", Connectors: failedConnector},
+		})
+	}()
+
+	<-slowRestartStarted
+	select {
+	case <-fastRestartDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("restart on other cluster is blocked by a slow cluster")
+	}
+
+	// Status reads must not wait for the pending restart
+	remediation := s.GetConnectorRemediation("slow", "sink")
+	require.Len(t, remediation.Tasks, 1)
+	assert.Equal(t, 1, remediation.Tasks[0].Attempts)
+	assert.Empty(t, remediation.History)
+
+	close(releaseSlowRestart)
+	<-reconciled
+	remediation = s.GetConnectorRemediation("slow", "sink")
+	require.Len(t, remediation.History, 1)
+	assert.Equal(t, RemediationActionRestarted, remediation.History[0].Action)
+}
+
+func TestSupervisorStopsWithService(t *testing.T) {
+	cfg := config.ConnectAutoRemediation{}
+	cfg.SetDefaults()
+	cfg.Enabled = true
+	cfg.PollInterval = 10 * time.Millisecond
+
+	core, logs := observer.New(zap.InfoLevel)
+	stopped := make(chan struct{})
+	s := &Supervisor{
+		cfg:    cfg,
+		logger: zap.New(core),
+		listConnectors: func(context.Context) ([]*ClusterConnectors, error) {
+			return nil, nil
+		},
+		now:              time.Now,
+		requestTimeout:   time.Second,
+		stopped:          stopped,
+		stateByConnector: make(map[connectorKey]*connectorRemediationState),
+	}
+
+	s.Start()
+	close(stopped)
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("stopped connector auto remediation").FilterField(zap.String("reason", "service stopped")).Len() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
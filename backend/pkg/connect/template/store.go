@@ -0,0 +1,99 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// ErrNotFound is returned if a template with the requested name does not exist.
+var ErrNotFound = errors.New("template not found")
+
+// Store persists connector templates.
+type Store interface {
+	// List returns all templates, sorted by name.
+	List() ([]Template, error)
+
+	// Get returns the template with the given name or ErrNotFound.
+	Get(name string) (Template, error)
+
+	// Put creates or replaces the template with the same name.
+	Put(tpl Template) error
+
+	// Delete removes the template with the given name or returns ErrNotFound.
+	Delete(name string) error
+}
+
+func templateName(tpl Template) string {
+	return tpl.Name
+}
+
+// store implements Store on top of a JSON store that is keyed by template name.
+type store struct {
+	templates jsonstore.Store[Template]
+}
+
+// List returns all templates, sorted by name.
+func (s *store) List() ([]Template, error) {
+	return s.templates.List()
+}
+
+// Get returns the template with the given name.
+func (s *store) Get(name string) (Template, error) {
+	tpl, err := s.templates.Get(name)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return Template{}, ErrNotFound
+	}
+	return tpl, err
+}
+
+// Put creates or replaces the template.
+func (s *store) Put(tpl Template) error {
+	return s.templates.Put(tpl)
+}
+
+// Delete removes the template with the given name.
+func (s *store) Delete(name string) error {
+	err := s.templates.Delete(name)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// MemoryStore keeps templates in memory. All templates are lost when the
+// process exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates an empty in-memory template store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store{templates: jsonstore.NewMemoryStore(templateName)}}
+}
+
+// FileStore keeps all templates in a single JSON file and serves reads from
+// memory. The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads all templates from the file at the given path. The file is
+// created on the first change if it does not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	templates, err := jsonstore.NewFileStore(path, templateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load connector templates: %w", err)
+	}
+	return &FileStore{store{templates: templates}}, nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package template implements parameterized connector configs that can be
+// rendered for many topics and Kafka connect clusters.
+package template
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/connect/sensitive"
+)
+
+const (
+	// VariableTopic is the built-in variable that resolves to the topic a
+	// template is rendered for.
+	VariableTopic = "topic"
+	// VariableCluster is the built-in variable that resolves to the name of the
+	// Kafka connect cluster a template is rendered for.
+	VariableCluster = "cluster"
+
+	connectorClassKey = "connector.class"
+)
+
+var (
+	// templateNameRegexp restricts template names to characters that can be used
+	// in URL paths without escaping.
+	templateNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+	variableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`)
+
+	// referenceRegexp matches all ${...} references. References that contain a
+	// colon, such as ${file:/opt/secrets.properties:password}, are resolved by
+	// Kafka connect config providers and are therefore kept as they are.
+	referenceRegexp = regexp.MustCompile(`\$\{([^}:]*)\}`)
+)
+
+// Template is a connector config with ${variable} references, which is rendered
+// into a concrete connector config for a topic and a Kafka connect cluster.
+type Template struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// ConnectorName is the name of the created connectors, e.g. "s3-sink-${topic}".
+	ConnectorName string `json:"connectorName"`
+
+	// Config is the connector config. It must contain the connector class.
+	Config map[string]string `json:"config"`
+
+	// Variables are the variables that can be referenced in addition to the
+	// built-in variables "topic" and "cluster".
+	Variables []Variable `json:"variables"`
+
+	// ClusterOverrides are config entries that are merged into the config when
+	// rendering for the Kafka connect cluster with the given name.
+	ClusterOverrides map[string]map[string]string `json:"clusterOverrides,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Variable is a template variable that can be referenced as ${name}.
+type Variable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Default is used if no value is given for the variable. A variable without
+	// default must be set whenever the template is rendered.
+	Default *string `json:"default,omitempty"`
+}
+
+// Rendered is the concrete connector config that results from rendering a template.
+type Rendered struct {
+	ConnectorName string            `json:"connectorName"`
+	Config        map[string]string `json:"config"`
+}
+
+// Validate checks that the template is complete and that all references can be
+// resolved by either a declared or a built-in variable.
+func (t *Template) Validate() error {
+	if !templateNameRegexp.MatchString(t.Name) {
+		return fmt.Errorf("template name %q is invalid, it must only contain letters, digits, '.', '_' and '-'", t.Name)
+	}
+	if t.ConnectorName == "" {
+		return errors.New("connector name must be set")
+	}
+	if t.Config[connectorClassKey] == "" {
+		return fmt.Errorf("config must contain %q", connectorClassKey)
+	}
+
+	declared := map[string]struct{}{VariableTopic: {}, VariableCluster: {}}
+	for _, variable := range t.Variables {
+		if !variableNameRegexp.MatchString(variable.Name) {
+			return fmt.Errorf("variable name %q is invalid", variable.Name)
+		}
+		if _, exists := declared[variable.Name]; exists {
+			if variable.Name == VariableTopic || variable.Name == VariableCluster {
+				return fmt.Errorf("variable %q is built-in and must not be declared", variable.Name)
+			}
+			return fmt.Errorf("variable %q is declared more than once", variable.Name)
+		}
+		declared[variable.Name] = struct{}{}
+	}
+
+	checkReferences := func(field, value string) error {
+		for _, name := range references(value) {
+			if _, exists := declared[name]; !exists {
+				return fmt.Errorf("%v references undeclared variable %q", field, name)
+			}
+		}
+		return nil
+	}
+	if err := checkReferences("connector name", t.ConnectorName); err != nil {
+		return err
+	}
+	for key, value := range t.Config {
+		if err := checkReferences(fmt.Sprintf("config %q", key), value); err != nil {
+			return err
+		}
+		if err := checkSecret(fmt.Sprintf("config %q", key), key, value); err != nil {
+			return err
+		}
+	}
+	for clusterName, overrides := range t.ClusterOverrides {
+		for key, value := range overrides {
+			if err := checkReferences(fmt.Sprintf("override %q of cluster %q", key, clusterName), value); err != nil {
+				return err
+			}
+			if err := checkSecret(fmt.Sprintf("override %q of cluster %q", key, clusterName), key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	secretVariables := t.secretVariables()
+	for _, variable := range t.Variables {
+		if _, isSecret := secretVariables[variable.Name]; isSecret && variable.Default != nil && *variable.Default != "" {
+			return fmt.Errorf("variable %q is used for a secret and must not have a default", variable.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkSecret ensures that secrets are not stored in templates. The values of
+// sensitive keys must reference a variable or a config provider instead.
+func checkSecret(field, key, value string) error {
+	if !sensitive.IsSecretValue(key, value) || len(references(value)) > 0 {
+		return nil
+	}
+	return fmt.Errorf("%v contains a secret, reference a variable or a config provider instead", field)
+}
+
+// secretVariables returns the names of all variables that are referenced by
+// sensitive config keys.
+func (t *Template) secretVariables() map[string]struct{} {
+	names := make(map[string]struct{})
+	collect := func(config map[string]string) {
+		for key, value := range config {
+			if !sensitive.IsKey(key) {
+				continue
+			}
+			for _, name := range references(value) {
+				names[name] = struct{}{}
+			}
+		}
+	}
+	collect(t.Config)
+	for _, overrides := range t.ClusterOverrides {
+		collect(overrides)
+	}
+	return names
+}
+
+// Redacted returns a copy of the template in which all secrets are redacted.
+// Validate rejects templates that contain secrets, this guards against
+// templates that have been stored before.
+func (t Template) Redacted() Template {
+	t.Config = redactConfig(t.Config)
+	if t.ClusterOverrides != nil {
+		overrides := make(map[string]map[string]string, len(t.ClusterOverrides))
+		for clusterName, config := range t.ClusterOverrides {
+			overrides[clusterName] = redactConfig(config)
+		}
+		t.ClusterOverrides = overrides
+	}
+
+	secretVariables := t.secretVariables()
+	variables := make([]Variable, len(t.Variables))
+	for i, variable := range t.Variables {
+		if _, isSecret := secretVariables[variable.Name]; isSecret && variable.Default != nil && *variable.Default != "" {
+			redacted := sensitive.RedactedValue
+			variable.Default = &redacted
+		}
+		variables[i] = variable
+	}
+	if t.Variables != nil {
+		t.Variables = variables
+	}
+	return t
+}
+
+// redactConfig returns a copy of the config in which all secrets are redacted.
+// Values that reference variables are kept, the variables are redacted instead.
+func redactConfig(config map[string]string) map[string]string {
+	if config == nil {
+		return nil
+	}
+	redacted := make(map[string]string, len(config))
+	for key, value := range config {
+		if sensitive.IsSecretValue(key, value) && len(references(value)) == 0 {
+			value = sensitive.RedactedValue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+// Render resolves all variable references for the given cluster and topic. Values
+// in vars take precedence over the variables' defaults. It fails if a variable
+// without default has no value or if vars contains undeclared variables.
+func (t *Template) Render(clusterName string, topic string, vars map[string]string) (Rendered, error) {
+	values := map[string]string{
+		VariableTopic:   topic,
+		VariableCluster: clusterName,
+	}
+	declared := make(map[string]struct{}, len(t.Variables))
+	for _, variable := range t.Variables {
+		declared[variable.Name] = struct{}{}
+		if variable.Default != nil {
+			values[variable.Name] = *variable.Default
+		}
+	}
+	for name, value := range vars {
+		if _, exists := declared[name]; !exists {
+			return Rendered{}, fmt.Errorf("variable %q is not declared in template %q", name, t.Name)
+		}
+		values[name] = value
+	}
+
+	var missing []string
+	for _, variable := range t.Variables {
+		if _, exists := values[variable.Name]; !exists {
+			missing = append(missing, variable.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return Rendered{}, fmt.Errorf("no value given for required variables: %v", strings.Join(missing, ", "))
+	}
+
+	config := make(map[string]string, len(t.Config))
+	for key, value := range t.Config {
+		config[key] = value
+	}
+	for key, value := range t.ClusterOverrides[clusterName] {
+		config[key] = value
+	}
+
+	rendered := Rendered{
+		ConnectorName: substitute(t.ConnectorName, values),
+		Config:        make(map[string]string, len(config)),
+	}
+	for key, value := range config {
+		rendered.Config[key] = substitute(value, values)
+		if sensitive.IsKey(key) && strings.Contains(rendered.Config[key], sensitive.RedactedValue) {
+			return Rendered{}, fmt.Errorf("the secret of config %q is redacted, set it through a variable", key)
+		}
+	}
+
+	return rendered, nil
+}
+
+func references(value string) []string {
+	matches := referenceRegexp.FindAllStringSubmatch(value, -1)
+	names := make([]string, len(matches))
+	for i, match := range matches {
+		names[i] = match[1]
+	}
+	return names
+}
+
+// substitute replaces all references with their values. Validate ensures that
+// every reference has a value.
+func substitute(value string, values map[string]string) string {
+	return referenceRegexp.ReplaceAllStringFunc(value, func(ref string) string {
+		name := ref[2 : len(ref)-1]
+		if v, exists := values[name]; exists {
+			return v
+		}
+		return ref
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package template
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string { return &s }
+
+func s3SinkTemplate() Template {
+	return Template{
+		Name:          "s3-sink",
+		ConnectorName: "s3-sink-${topic}",
+		Config: map[string]string{
+			"connector.class":       "io.confluent.connect.s3.S3SinkConnector",
+			"topics":                "${topic}",
+			"s3.bucket.name":        "${bucket}",
+			"flush.size":            "${flushSize}",
+			"aws.secret.access.key": "${file:/opt/secrets.properties:s3.secret}",
+		},
+		Variables: []Variable{
+			{Name: "bucket"},
+			{Name: "flushSize", Default: strPtr("1000")},
+		},
+		ClusterOverrides: map[string]map[string]string{
+			"prod": {"tasks.max": "4", "s3.bucket.name": "${bucket}-${cluster}"},
+		},
+	}
+}
+
+func TestTemplateValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(tpl *Template)
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Template) {},
+		},
+		{
+			name:   "invalid name",
+			modify: func(tpl *Template) { tpl.Name = "s3 sink/v1" },
+			errMsg: "template name",
+		},
+		{
+			name:   "missing connector class",
+			modify: func(tpl *Template) { delete(tpl.Config, "connector.class") },
+			errMsg: "connector.class",
+		},
+		{
+			name:   "undeclared variable",
+			modify: func(tpl *Template) { tpl.Config["s3.region"] = "${region}" },
+			errMsg: `undeclared variable "region"`,
+		},
+		{
+			name: "undeclared variable in override",
+			modify: func(tpl *Template) {
+				tpl.ClusterOverrides["dev"] = map[string]string{"s3.region": "${region}"}
+			},
+			errMsg: `undeclared variable "region"`,
+		},
+		{
+			name:   "built-in variable declared",
+			modify: func(tpl *Template) { tpl.Variables = append(tpl.Variables, Variable{Name: "topic"}) },
+			errMsg: "built-in",
+		},
+		{
+			name:   "duplicate variable",
+			modify: func(tpl *Template) { tpl.Variables = append(tpl.Variables, Variable{Name: "bucket"}) },
+			errMsg: "more than once",
+		},
+		{
+			name:   "literal secret",
+			modify: func(tpl *Template) { tpl.Config["aws.secret.access.key"] = "AKIA-secret" },
+			errMsg: `config "aws.secret.access.key" contains a secret`,
+		},
+		{
+			name: "literal secret in override",
+			modify: func(tpl *Template) {
+				tpl.ClusterOverrides["dev"] = map[string]string{"producer.override.sasl.jaas.config": "ScramLoginModule required;"}
+			},
+			errMsg: "contains a secret",
+		},
+		{
+			name: "secret variable with default",
+			modify: func(tpl *Template) {
+				password := "hunter2"
+				tpl.Variables = append(tpl.Variables, Variable{Name: "password", Default: &password})
+				tpl.Config["database.password"] = "${password}"
+			},
+			errMsg: `variable "password" is used for a secret`,
+		},
+		{
+			name: "secret variable without default",
+			modify: func(tpl *Template) {
+				tpl.Variables = append(tpl.Variables, Variable{Name: "password"})
+				tpl.Config["database.password"] = "${password}"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpl := s3SinkTemplate()
+			tt.modify(&tpl)
+			err := tpl.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	tpl := s3SinkTemplate()
+
+	rendered, err := tpl.Render("dev", "orders", map[string]string{"bucket": "events"})
+	require.NoError(t, err)
+	assert.Equal(t, Rendered{
+		ConnectorName: "s3-sink-orders",
+		Config: map[string]string{
+			"connector.class":       "io.confluent.connect.s3.S3SinkConnector",
+			"topics":                "orders",
+			"s3.bucket.name":        "events",
+			"flush.size":            "1000",
+			"aws.secret.access.key": "${file:/opt/secrets.properties:s3.secret}",
+		},
+	}, rendered)
+
+	// Cluster overrides are merged and may reference variables
+	rendered, err = tpl.Render("prod", "orders", map[string]string{"bucket": "events", "flushSize": "5000"})
+	require.NoError(t, err)
+	assert.Equal(t, "events-prod", rendered.Config["s3.bucket.name"])
+	assert.Equal(t, "4", rendered.Config["tasks.max"])
+	assert.Equal(t, "5000", rendered.Config["flush.size"])
+
+	// The template itself must not be modified by rendering
+	assert.Equal(t, "${bucket}", tpl.Config["s3.bucket.name"])
+
+	_, err = tpl.Render("dev", "orders", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket")
+
+	_, err = tpl.Render("dev", "orders", map[string]string{"bucket": "events", "region": "eu"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"region" is not declared`)
+}
+
+func TestTemplateRedacted(t *testing.T) {
+	password := "hunter2"
+	tpl := s3SinkTemplate()
+	tpl.Variables = append(tpl.Variables, Variable{Name: "password", Default: &password})
+	tpl.Config["database.password"] = "${password}"
+	tpl.ClusterOverrides["prod"]["database.password"] = "hunter3"
+
+	redacted := tpl.Redacted()
+	assert.Equal(t, "${password}", redacted.Config["database.password"])
+	assert.Equal(t, "${file:/opt/secrets.properties:s3.secret}", redacted.Config["aws.secret.access.key"])
+	assert.Equal(t, "[REDACTED]", redacted.ClusterOverrides["prod"]["database.password"])
+	assert.Equal(t, "[REDACTED]", *redacted.Variables[len(redacted.Variables)-1].Default)
+
+	// The original template must not be modified
+	assert.Equal(t, "hunter3", tpl.ClusterOverrides["prod"]["database.password"])
+	assert.Equal(t, "hunter2", *tpl.Variables[len(tpl.Variables)-1].Default)
+
+	// Redacted secrets can't be rendered
+	_, err := redacted.Render("prod", "orders", map[string]string{"bucket": "events", "password": "secret"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "redacted")
+	rendered, err := redacted.Render("dev", "orders", map[string]string{"bucket": "events", "password": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", rendered.Config["database.password"])
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "templates", "templates.json")
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	tpls, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, tpls)
+
+	second := s3SinkTemplate()
+	second.Name = "a-sink"
+	require.NoError(t, store.Put(s3SinkTemplate()))
+	require.NoError(t, store.Put(second))
+
+	// Templates are loaded from the file on restart
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+	tpls, err = reopened.List()
+	require.NoError(t, err)
+	require.Len(t, tpls, 2)
+	assert.Equal(t, "a-sink", tpls[0].Name)
+	assert.Equal(t, s3SinkTemplate(), tpls[1])
+
+	require.NoError(t, reopened.Delete("a-sink"))
+	assert.ErrorIs(t, reopened.Delete("a-sink"), ErrNotFound)
+	_, err = reopened.Get("a-sink")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	reopened, err = NewFileStore(path)
+	require.NoError(t, err)
+	tpls, err = reopened.List()
+	require.NoError(t, err)
+	assert.Len(t, tpls, 1)
+}
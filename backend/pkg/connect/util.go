@@ -31,7 +31,9 @@ func getMapValueOrString(m map[string]string, key string, fallback string) strin
 }
 
 func (s *Service) getConnectClusterByName(clusterName string) (*ClientWithConfig, *rest.Error) {
-	c, exists := s.ClientsByCluster[clusterName]
+	s.clientsMutex.RLock()
+	c, exists := s.clientsByCluster[clusterName]
+	s.clientsMutex.RUnlock()
 	if !exists {
 		return nil, &rest.Error{
 			Err:          fmt.Errorf("a client for the given cluster name does not exist"),
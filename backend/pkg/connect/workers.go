@@ -0,0 +1,226 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cloudhut/common/rest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ConnectWorker is a Kafka connect worker along with the connector instances
+// and tasks that are assigned to it.
+type ConnectWorker struct {
+	WorkerID     string            `json:"workerId"`
+	Connectors   []WorkerConnector `json:"connectors"`
+	Tasks        []WorkerTask      `json:"tasks"`
+	RunningTasks int               `json:"runningTasks"`
+	FailedTasks  int               `json:"failedTasks"`
+}
+
+// WorkerConnector is a connector instance that is assigned to a worker.
+type WorkerConnector struct {
+	Name  string         `json:"name"`
+	State connectorState `json:"state"`
+}
+
+// WorkerTask is a connector task that is assigned to a worker.
+type WorkerTask struct {
+	Connector string `json:"connector"`
+	TaskID    int    `json:"taskId"`
+	State     string `json:"state"`
+}
+
+// ClusterWorkers is the worker-centric view of a Kafka connect cluster.
+type ClusterWorkers struct {
+	ClusterName string `json:"clusterName"`
+	// Workers are all workers with at least one assigned connector or task,
+	// sorted by ID. Kafka connect does not expose idle workers.
+	Workers         []ConnectWorker `json:"workers"`
+	UnassignedTasks []WorkerTask    `json:"unassignedTasks"`
+	// TaskSkew is the ratio of the task count of the busiest worker to the
+	// average task count per worker. It is 1 if the tasks are evenly spread
+	// and 0 if there are no assigned tasks.
+	TaskSkew float64 `json:"taskSkew"`
+}
+
+// TaskRestart is the result of restarting a single connector task.
+type TaskRestart struct {
+	Connector string `json:"connector"`
+	TaskID    int    `json:"taskId"`
+	// Error is set if the restart request has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// GetClusterWorkers groups the connectors and tasks of a Kafka connect cluster
+// by the workers they are assigned to.
+func (s *Service) GetClusterWorkers(ctx context.Context, clusterName string) (ClusterWorkers, *rest.Error) {
+	connectors, restErr := s.listClusterConnectors(ctx, clusterName)
+	if restErr != nil {
+		return ClusterWorkers{}, restErr
+	}
+
+	return groupConnectorsByWorker(clusterName, connectors), nil
+}
+
+// listClusterConnectors returns the connectors of a cluster. Unlike
+// GetClusterConnectors, it fails if the connectors could not be listed, because
+// an empty list would be mistaken for a cluster without any assignments.
+func (s *Service) listClusterConnectors(ctx context.Context, clusterName string) ([]ClusterConnectorInfo, *rest.Error) {
+	clusterConnectors, restErr := s.GetClusterConnectors(ctx, clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if clusterConnectors.Error != "" {
+		return nil, &rest.Error{
+			Err:          errors.New(clusterConnectors.Error),
+			Status:       http.StatusServiceUnavailable,
+			Message:      fmt.Sprintf("Failed to list connectors: %v", clusterConnectors.Error),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName)},
+			IsSilent:     false,
+		}
+	}
+	return clusterConnectors.Connectors, nil
+}
+
+func groupConnectorsByWorker(clusterName string, connectors []ClusterConnectorInfo) ClusterWorkers {
+	workersByID := make(map[string]*ConnectWorker)
+	getWorker := func(workerID string) *ConnectWorker {
+		worker, exists := workersByID[workerID]
+		if !exists {
+			worker = &ConnectWorker{
+				WorkerID:   workerID,
+				Connectors: []WorkerConnector{},
+				Tasks:      []WorkerTask{},
+			}
+			workersByID[workerID] = worker
+		}
+		return worker
+	}
+
+	unassigned := []WorkerTask{}
+	for _, connector := range connectors {
+		if connector.WorkerID != "" {
+			worker := getWorker(connector.WorkerID)
+			worker.Connectors = append(worker.Connectors, WorkerConnector{Name: connector.Name, State: connector.State})
+		}
+
+		for _, task := range connector.Tasks {
+			workerTask := WorkerTask{Connector: connector.Name, TaskID: task.TaskID, State: task.State}
+			if task.WorkerID == "" || task.State == connectorStateUnassigned {
+				unassigned = append(unassigned, workerTask)
+				continue
+			}
+
+			worker := getWorker(task.WorkerID)
+			worker.Tasks = append(worker.Tasks, workerTask)
+			switch task.State {
+			case connectorStateRunning:
+				worker.RunningTasks++
+			case connectorStateFailed:
+				worker.FailedTasks++
+			}
+		}
+	}
+
+	workers := make([]ConnectWorker, 0, len(workersByID))
+	totalTasks, maxTasks := 0, 0
+	for _, worker := range workersByID {
+		sort.Slice(worker.Connectors, func(i, j int) bool { return worker.Connectors[i].Name < worker.Connectors[j].Name })
+		sort.Slice(worker.Tasks, func(i, j int) bool {
+			if worker.Tasks[i].Connector != worker.Tasks[j].Connector {
+				return worker.Tasks[i].Connector < worker.Tasks[j].Connector
+			}
+			return worker.Tasks[i].TaskID < worker.Tasks[j].TaskID
+		})
+		totalTasks += len(worker.Tasks)
+		maxTasks = max(maxTasks, len(worker.Tasks))
+		workers = append(workers, *worker)
+	}
+	sort.Slice(workers, func(i, j int) bool { return workers[i].WorkerID < workers[j].WorkerID })
+
+	var skew float64
+	if totalTasks > 0 {
+		skew = float64(maxTasks) / (float64(totalTasks) / float64(len(workers)))
+	}
+
+	return ClusterWorkers{
+		ClusterName:     clusterName,
+		Workers:         workers,
+		UnassignedTasks: unassigned,
+		TaskSkew:        skew,
+	}
+}
+
+// RestartWorkerTasks restarts all tasks that are assigned to the given worker,
+// regardless of their state. A failed restart of a single task does not abort
+// the restarts of the remaining tasks, but is reported in the result.
+func (s *Service) RestartWorkerTasks(ctx context.Context, clusterName string, workerID string) ([]TaskRestart, *rest.Error) {
+	clusterWorkers, restErr := s.GetClusterWorkers(ctx, clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	var tasks []WorkerTask
+	found := false
+	for _, worker := range clusterWorkers.Workers {
+		if worker.WorkerID == workerID {
+			tasks, found = worker.Tasks, true
+			break
+		}
+	}
+	if !found {
+		return nil, &rest.Error{
+			Err:          fmt.Errorf("worker %q has no assigned connectors or tasks", workerID),
+			Status:       http.StatusNotFound,
+			Message:      fmt.Sprintf("Worker %q does not exist or has no assigned connectors or tasks", workerID),
+			InternalLogs: []zapcore.Field{zap.String("cluster_name", clusterName), zap.String("worker_id", workerID)},
+			IsSilent:     false,
+		}
+	}
+
+	return s.restartTasks(ctx, clusterName, tasks), nil
+}
+
+// RestartFailedTasks restarts all failed tasks of all connectors in the given cluster.
+func (s *Service) RestartFailedTasks(ctx context.Context, clusterName string) ([]TaskRestart, *rest.Error) {
+	connectors, restErr := s.listClusterConnectors(ctx, clusterName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	var tasks []WorkerTask
+	for _, connector := range connectors {
+		for _, task := range connector.Tasks {
+			if task.State == connectorStateFailed {
+				tasks = append(tasks, WorkerTask{Connector: connector.Name, TaskID: task.TaskID, State: task.State})
+			}
+		}
+	}
+
+	return s.restartTasks(ctx, clusterName, tasks), nil
+}
+
+func (s *Service) restartTasks(ctx context.Context, clusterName string, tasks []WorkerTask) []TaskRestart {
+	restarts := make([]TaskRestart, len(tasks))
+	for i, task := range tasks {
+		restarts[i] = TaskRestart{Connector: task.Connector, TaskID: task.TaskID}
+		if restErr := s.RestartConnectorTask(ctx, clusterName, task.Connector, task.TaskID); restErr != nil {
+			restarts[i].Error = restErr.Err.Error()
+		}
+	}
+	return restarts
+}
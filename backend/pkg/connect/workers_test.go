@@ -0,0 +1,70 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupConnectorsByWorker(t *testing.T) {
+	connectors := []ClusterConnectorInfo{
+		{
+			Name:     "orders-sink",
+			State:    connectorStateRunning,
+			WorkerID: "worker-1:8083",
+			Tasks: []ClusterConnectorTaskInfo{
+				{TaskID: 1, State: connectorStateFailed, WorkerID: "worker-1:8083"},
+				{TaskID: 0, State: connectorStateRunning, WorkerID: "worker-1:8083"},
+				{TaskID: 2, State: connectorStateRunning, WorkerID: "worker-2:8083"},
+			},
+		},
+		{
+			Name:     "users-source",
+			State:    connectorStateRunning,
+			WorkerID: "worker-2:8083",
+			Tasks: []ClusterConnectorTaskInfo{
+				{TaskID: 0, State: connectorStateRunning, WorkerID: "worker-1:8083"},
+				{TaskID: 1, State: connectorStateUnassigned},
+			},
+		},
+	}
+
+	workers := groupConnectorsByWorker("main", connectors)
+	assert.Equal(t, "main", workers.ClusterName)
+	require.Len(t, workers.Workers, 2)
+
+	worker := workers.Workers[0]
+	assert.Equal(t, "worker-1:8083", worker.WorkerID)
+	assert.Equal(t, []WorkerConnector{{Name: "orders-sink", State: connectorStateRunning}}, worker.Connectors)
+	assert.Equal(t, []WorkerTask{
+		{Connector: "orders-sink", TaskID: 0, State: connectorStateRunning},
+		{Connector: "orders-sink", TaskID: 1, State: connectorStateFailed},
+		{Connector: "users-source", TaskID: 0, State: connectorStateRunning},
+	}, worker.Tasks)
+	assert.Equal(t, 2, worker.RunningTasks)
+	assert.Equal(t, 1, worker.FailedTasks)
+
+	assert.Equal(t, "worker-2:8083", workers.Workers[1].WorkerID)
+	assert.Len(t, workers.Workers[1].Tasks, 1)
+	assert.Equal(t, []WorkerTask{{Connector: "users-source", TaskID: 1, State: connectorStateUnassigned}}, workers.UnassignedTasks)
+
+	// 4 tasks on 2 workers, the busiest worker has 3 of them
+	assert.InDelta(t, 1.5, workers.TaskSkew, 0.0001)
+}
+
+func TestGroupConnectorsByWorkerWithoutTasks(t *testing.T) {
+	workers := groupConnectorsByWorker("main", nil)
+	assert.Empty(t, workers.Workers)
+	assert.Empty(t, workers.UnassignedTasks)
+	assert.Zero(t, workers.TaskSkew)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package declarative builds connector guides and config patches from YAML
+// documents, so that the connector setup experience can be tailored without
+// writing Go code. A document may contain both patches and guides:
+//
+//	patches:
+//	  - connectorClass:
+//	      include: com\.acme\..*
+//	    configKeys:
+//	      include: acme\.api\.(key|secret)
+//	    importance: HIGH
+//	    required: true
+//	guides:
+//	  - className: com.acme.AcmeSinkConnector
+//	    injectedConfigs:
+//	      - key: acme.region
+//	        value: eu-west-1
+//	    steps:
+//	      - name: Acme connection
+//	        groups:
+//	          - configKeys: [acme.api.key, acme.api.secret]
+package declarative
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/guide"
+	"github.com/redpanda-data/console/backend/pkg/connector/patch"
+)
+
+// Document is the root of a YAML file that declares connector patches and guides.
+type Document struct {
+	Patches []PatchDefinition `yaml:"patches"`
+	Guides  []GuideDefinition `yaml:"guides"`
+}
+
+// Customizations are the patches and guides that have been built from one or
+// more documents.
+type Customizations struct {
+	Patches []patch.ConfigPatch
+	Guides  []guide.Guide
+}
+
+// Parse decodes all YAML documents in the given payload and builds the declared
+// patches and guides. Unknown fields are rejected so that typos do not go
+// unnoticed.
+func Parse(payload []byte) (Customizations, error) {
+	var result Customizations
+
+	decoder := yaml.NewDecoder(bytes.NewReader(payload))
+	decoder.KnownFields(true)
+	for {
+		var doc Document
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Customizations{}, fmt.Errorf("failed to decode yaml: %w", err)
+		}
+
+		for i, def := range doc.Patches {
+			p, err := def.Build()
+			if err != nil {
+				return Customizations{}, fmt.Errorf("failed to build patch at index '%d': %w", i, err)
+			}
+			result.Patches = append(result.Patches, p)
+		}
+		for i, def := range doc.Guides {
+			g, err := def.Build()
+			if err != nil {
+				return Customizations{}, fmt.Errorf("failed to build guide at index '%d' (class name: '%v'): %w", i, def.ClassName, err)
+			}
+			result.Guides = append(result.Guides, g)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+const acmeCustomizations = `
+patches:
+  - connectorClass:
+      include: com\.acme\..*
+    configKeys:
+      include: acme\..*
+      exclude: acme\.internal\..*
+    displayName: Acme setting
+    importance: HIGH
+    group: Acme
+    defaultValue: "42"
+    required: true
+    recommendedValues:
+      - value: a
+        displayName: Option A
+      - value: b
+guides:
+  - className: com.acme.AcmeSinkConnector
+    injectedConfigs:
+      - key: acme.region
+        value: eu-west-1
+      - key: acme.format
+        value: json
+        authoritative: true
+    steps:
+      - name: Acme connection
+        groups:
+          - name: Credentials
+            documentationLink: https://docs.acme.com/connect
+            configKeys: [acme.api.key]
+`
+
+func TestParse(t *testing.T) {
+	customizations, err := Parse([]byte(acmeCustomizations))
+	require.NoError(t, err)
+	require.Len(t, customizations.Patches, 1)
+	require.Len(t, customizations.Guides, 1)
+
+	t.Run("patch selectors", func(t *testing.T) {
+		p := customizations.Patches[0]
+		assert.True(t, p.IsMatch("acme.api.key", "com.acme.AcmeSinkConnector"))
+		assert.False(t, p.IsMatch("acme.internal.id", "com.acme.AcmeSinkConnector"))
+		assert.False(t, p.IsMatch("acme.api.key", "org.other.com.acme.Connector"))
+		assert.False(t, p.IsMatch("topics", "com.acme.AcmeSinkConnector"))
+	})
+
+	t.Run("patch definition", func(t *testing.T) {
+		d := customizations.Patches[0].PatchDefinition(model.ConfigDefinition{
+			Definition: model.ConfigDefinitionKey{Name: "acme.api.key", Importance: model.ConfigDefinitionImportanceLow, Documentation: "docs"},
+		}, "com.acme.AcmeSinkConnector")
+
+		assert.Equal(t, "Acme setting", d.Definition.DisplayName)
+		assert.Equal(t, "docs", d.Definition.Documentation)
+		assert.Equal(t, model.ConfigDefinitionImportanceHigh, d.Definition.Importance)
+		require.NotNil(t, d.Definition.Group)
+		assert.Equal(t, "Acme", *d.Definition.Group)
+		require.NotNil(t, d.Definition.CustomDefaultValue)
+		assert.Equal(t, "42", *d.Definition.CustomDefaultValue)
+		assert.True(t, d.Definition.Required)
+		assert.Equal(t, []model.RecommendedValueWithMetadata{
+			{Value: "a", DisplayName: "Option A"},
+			{Value: "b", DisplayName: "b"},
+		}, d.Metadata.RecommendedValues)
+	})
+
+	t.Run("guide", func(t *testing.T) {
+		g := customizations.Guides[0]
+		assert.Equal(t, "com.acme.AcmeSinkConnector", g.ClassName())
+
+		configs := g.ConsoleToKafkaConnect(map[string]any{
+			"acme.region": "us-east-1",
+			"acme.format": "avro",
+		})
+		assert.Equal(t, "us-east-1", configs["acme.region"])
+		assert.Equal(t, "json", configs["acme.format"])
+
+		res := g.KafkaConnectValidateToConsole("com.acme.AcmeSinkConnector", []model.ConfigDefinition{
+			{Definition: model.ConfigDefinitionKey{Name: "acme.api.key"}},
+			{Definition: model.ConfigDefinitionKey{Name: "acme.unlisted"}},
+		}, nil)
+		require.Len(t, res.Configs, 1)
+		assert.Equal(t, "acme.api.key", res.Configs[0].Definition.Name)
+		require.Len(t, res.Steps, 1)
+		assert.Equal(t, "https://docs.acme.com/connect", res.Steps[0].Groups[0].DocumentationLink)
+	})
+}
+
+func TestParseInvalid(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		errContains string
+	}{
+		{
+			name:        "unknown field",
+			input:       "patches:\n  - connectorClass: {include: x}\n    importanse: HIGH\n",
+			errContains: "field importanse not found",
+		},
+		{
+			name:        "missing connector class",
+			input:       "patches:\n  - importance: HIGH\n",
+			errContains: "connector class include regex must be set",
+		},
+		{
+			name:        "invalid importance",
+			input:       "patches:\n  - connectorClass: {include: x}\n    importance: CRITICAL\n",
+			errContains: "importance \"CRITICAL\" is invalid",
+		},
+		{
+			name:        "invalid regex",
+			input:       "patches:\n  - connectorClass: {include: \"(\"}\n",
+			errContains: "failed to compile include regex",
+		},
+		{
+			name:        "guide without steps",
+			input:       "guides:\n  - className: com.acme.AcmeSinkConnector\n",
+			errContains: "at least one step must be declared",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/guide"
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+)
+
+// GuideDefinition declares a wizard guide for a connector class. Config keys that
+// are not listed in any of the steps are not rendered. A guide replaces a
+// built-in guide for the same class name.
+type GuideDefinition struct {
+	ClassName       string                     `yaml:"className"`
+	InjectedConfigs []InjectedConfigDefinition `yaml:"injectedConfigs"`
+	Steps           []StepDefinition           `yaml:"steps"`
+}
+
+// InjectedConfigDefinition is a config that is added to the connector config
+// before it is sent to Kafka connect, and hidden from the user afterwards.
+type InjectedConfigDefinition struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+
+	// Authoritative overwrites a config value that has been provided by the user.
+	Authoritative bool `yaml:"authoritative"`
+}
+
+// StepDefinition is a wizard step.
+type StepDefinition struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	Groups      []GroupDefinition `yaml:"groups"`
+}
+
+// GroupDefinition is a group of config keys within a wizard step.
+type GroupDefinition struct {
+	Name              string   `yaml:"name"`
+	Description       string   `yaml:"description"`
+	DocumentationLink string   `yaml:"documentationLink"`
+	ConfigKeys        []string `yaml:"configKeys"`
+}
+
+// Build validates the definition and returns the guide.
+func (g *GuideDefinition) Build() (guide.Guide, error) {
+	if g.ClassName == "" {
+		return nil, errors.New("class name must be set")
+	}
+	if len(g.Steps) == 0 {
+		return nil, errors.New("at least one step must be declared")
+	}
+
+	steps := make([]model.ValidationResponseStep, len(g.Steps))
+	for i, step := range g.Steps {
+		if len(step.Groups) == 0 {
+			return nil, fmt.Errorf("step at index '%d' has no groups", i)
+		}
+		groups := make([]model.ValidationResponseStepGroup, len(step.Groups))
+		for j, group := range step.Groups {
+			groups[j] = model.ValidationResponseStepGroup{
+				Name:              group.Name,
+				Description:       group.Description,
+				DocumentationLink: group.DocumentationLink,
+				ConfigKeys:        group.ConfigKeys,
+			}
+		}
+		steps[i] = model.ValidationResponseStep{
+			Name:        step.Name,
+			Description: step.Description,
+			Groups:      groups,
+		}
+	}
+
+	authoritative := make(map[string]string)
+	nonAuthoritative := make(map[string]string)
+	for i, injected := range g.InjectedConfigs {
+		if injected.Key == "" {
+			return nil, fmt.Errorf("injected config at index '%d' has no key", i)
+		}
+		if injected.Authoritative {
+			authoritative[injected.Key] = injected.Value
+		} else {
+			nonAuthoritative[injected.Key] = injected.Value
+		}
+	}
+
+	return guide.NewWizardGuide(g.ClassName, steps,
+		guide.WithInjectedValues(nonAuthoritative, false),
+		guide.WithInjectedValues(authoritative, true),
+	), nil
+}
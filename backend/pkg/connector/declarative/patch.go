@@ -0,0 +1,163 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/redpanda-data/console/backend/pkg/connector/model"
+	"github.com/redpanda-data/console/backend/pkg/connector/patch"
+)
+
+// SelectorDefinition declares an include and an optional exclude regex. Both
+// expressions must match the whole string.
+type SelectorDefinition struct {
+	Include string `yaml:"include"`
+	Exclude string `yaml:"exclude"`
+}
+
+// RecommendedValueDefinition is a recommended value with the name that shall be
+// rendered in the frontend.
+type RecommendedValueDefinition struct {
+	Value       string `yaml:"value"`
+	DisplayName string `yaml:"displayName"`
+}
+
+// PatchDefinition declares a config patch. All set properties are applied to
+// each config key that matches the configKeys selector, for all connectors that
+// match the connectorClass selector. Unset properties are not modified.
+type PatchDefinition struct {
+	ConnectorClass SelectorDefinition `yaml:"connectorClass"`
+
+	// ConfigKeys selects the patched config keys. If no include regex is set,
+	// all config keys are patched.
+	ConfigKeys SelectorDefinition `yaml:"configKeys"`
+
+	DisplayName       *string                      `yaml:"displayName"`
+	Documentation     *string                      `yaml:"documentation"`
+	Importance        string                       `yaml:"importance"`
+	Group             *string                      `yaml:"group"`
+	DefaultValue      *string                      `yaml:"defaultValue"`
+	Required          *bool                        `yaml:"required"`
+	Visible           *bool                        `yaml:"visible"`
+	ComponentType     string                       `yaml:"componentType"`
+	RecommendedValues []RecommendedValueDefinition `yaml:"recommendedValues"`
+}
+
+// Build validates the definition and returns the config patch.
+func (p *PatchDefinition) Build() (patch.ConfigPatch, error) {
+	if p.ConnectorClass.Include == "" {
+		return nil, errors.New("connector class include regex must be set")
+	}
+	switch p.Importance {
+	case "", model.ConfigDefinitionImportanceHigh, model.ConfigDefinitionImportanceMedium, model.ConfigDefinitionImportanceLow:
+	default:
+		return nil, fmt.Errorf("importance %q is invalid, must be one of HIGH, MEDIUM or LOW", p.Importance)
+	}
+
+	connectorClassSelector, err := p.ConnectorClass.build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid connector class selector: %w", err)
+	}
+	configKeys := p.ConfigKeys
+	if configKeys.Include == "" {
+		configKeys.Include = ".*"
+	}
+	configKeySelector, err := configKeys.build()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config keys selector: %w", err)
+	}
+
+	return &ConfigPatch{
+		ConfigurationKeySelector: configKeySelector,
+		ConnectorClassSelector:   connectorClassSelector,
+		definition:               *p,
+	}, nil
+}
+
+func (s *SelectorDefinition) build() (patch.IncludeExcludeSelector, error) {
+	var selector patch.IncludeExcludeSelector
+
+	include, err := regexp.Compile("^(?:" + s.Include + ")$")
+	if err != nil {
+		return selector, fmt.Errorf("failed to compile include regex: %w", err)
+	}
+	selector.Include = include
+
+	if s.Exclude != "" {
+		exclude, err := regexp.Compile("^(?:" + s.Exclude + ")$")
+		if err != nil {
+			return selector, fmt.Errorf("failed to compile exclude regex: %w", err)
+		}
+		selector.Exclude = exclude
+	}
+
+	return selector, nil
+}
+
+// ConfigPatch is a config patch that has been declared in YAML.
+type ConfigPatch struct {
+	ConfigurationKeySelector patch.IncludeExcludeSelector
+	ConnectorClassSelector   patch.IncludeExcludeSelector
+
+	definition PatchDefinition
+}
+
+var _ patch.ConfigPatch = (*ConfigPatch)(nil)
+
+// IsMatch implements the ConfigPatch.IsMatch interface.
+func (c *ConfigPatch) IsMatch(configKey, connectorClass string) bool {
+	return c.ConfigurationKeySelector.IsMatch(configKey) && c.ConnectorClassSelector.IsMatch(connectorClass)
+}
+
+// PatchDefinition implements the ConfigPatch.PatchDefinition interface.
+func (c *ConfigPatch) PatchDefinition(d model.ConfigDefinition, _ string) model.ConfigDefinition {
+	def := c.definition
+
+	if def.DisplayName != nil {
+		d.SetDisplayName(*def.DisplayName)
+	}
+	if def.Documentation != nil {
+		d.SetDocumentation(*def.Documentation)
+	}
+	if def.Importance != "" {
+		d.SetImportance(def.Importance)
+	}
+	if def.Group != nil {
+		group := *def.Group
+		d.Definition.Group = &group
+	}
+	if def.DefaultValue != nil {
+		d.SetDefaultValue(*def.DefaultValue)
+	}
+	if def.Required != nil {
+		d.SetRequired(*def.Required)
+	}
+	if def.Visible != nil {
+		d.SetVisible(*def.Visible)
+	}
+	if def.ComponentType != "" {
+		d.SetComponentType(def.ComponentType)
+	}
+	if def.RecommendedValues != nil {
+		d.ClearRecommendedValuesWithMetadata()
+		for _, recommended := range def.RecommendedValues {
+			displayName := recommended.DisplayName
+			if displayName == "" {
+				displayName = recommended.Value
+			}
+			d.AddRecommendedValueWithMetadata(recommended.Value, displayName)
+		}
+	}
+
+	return d
+}
@@ -45,17 +45,19 @@ type Option = func(*Options)
 // WithInjectedValues instruct the guide to include the key value pairs to the connector
 // configuration when validating and submitting the connector configuration. Set isAuthoritative
 // to true to overwrite user provided configurations for the respective config keys.
+// The option can be passed multiple times, e.g. to inject authoritative and
+// non-authoritative values into the same guide.
 func WithInjectedValues(keyVals map[string]string, isAuthoritative bool) Option {
-	injectedValues := make(map[string]injectedValue, len(keyVals))
-	for key, val := range keyVals {
-		injectedValues[key] = injectedValue{
-			Value:           val,
-			IsAuthoritative: isAuthoritative,
-		}
-	}
-
 	return func(o *Options) {
-		o.injectedValues = injectedValues
+		if o.injectedValues == nil {
+			o.injectedValues = make(map[string]injectedValue, len(keyVals))
+		}
+		for key, val := range keyVals {
+			o.injectedValues[key] = injectedValue{
+				Value:           val,
+				IsAuthoritative: isAuthoritative,
+			}
+		}
 	}
 }
 
@@ -29,6 +29,23 @@ type WizardGuide struct {
 	wizardSteps []model.ValidationResponseStep
 }
 
+// NewWizardGuide returns a guide for the given connector class that renders the
+// given wizard steps.
+func NewWizardGuide(className string, wizardSteps []model.ValidationResponseStep, opts ...Option) Guide {
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &WizardGuide{
+		DefaultGuide: DefaultGuide{
+			options: o,
+		},
+		className:   className,
+		wizardSteps: wizardSteps,
+	}
+}
+
 // ClassName implements Guide.ClassName.
 func (g *WizardGuide) ClassName() string {
 	return g.className
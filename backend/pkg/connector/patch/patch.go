@@ -43,7 +43,7 @@ type IncludeExcludeSelector struct {
 // IsMatch returns true if the Include regex matches the string and the Exclude
 // regex does not match the given string.
 func (i *IncludeExcludeSelector) IsMatch(s string) bool {
-	if i.Exclude != nil && !i.Exclude.MatchString(s) {
+	if i.Exclude != nil && i.Exclude.MatchString(s) {
 		return false
 	}
 	return i.Include.MatchString(s)
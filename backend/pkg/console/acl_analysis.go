@@ -0,0 +1,370 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ACLFindingType is the type of problem found by the ACL analysis.
+type ACLFindingType string
+
+const (
+	// ACLFindingUnusedResourcePattern is reported for ACLs whose resource pattern
+	// does not match any existing topic, consumer group or transactional ID.
+	ACLFindingUnusedResourcePattern ACLFindingType = "UNUSED_RESOURCE_PATTERN"
+	// ACLFindingUnknownPrincipal is reported for ACLs of users or Redpanda roles
+	// that do not exist.
+	ACLFindingUnknownPrincipal ACLFindingType = "UNKNOWN_PRINCIPAL"
+	// ACLFindingDuplicate is reported for ACLs that are fully covered by another
+	// ACL with the same permission type on the same resource pattern.
+	ACLFindingDuplicate ACLFindingType = "DUPLICATE"
+	// ACLFindingShadowedByDeny is reported for ALLOW ACLs that never take effect
+	// because a DENY ACL covers everything they allow.
+	ACLFindingShadowedByDeny ACLFindingType = "SHADOWED_BY_DENY"
+)
+
+// ACLFinding is a single problem of an ACL.
+type ACLFinding struct {
+	Type   ACLFindingType `json:"type"`
+	ACL    ACLBinding     `json:"acl"`
+	Reason string         `json:"reason"`
+	// RelatedACLs are the ACLs that cover a duplicate or shadowed ACL.
+	RelatedACLs []ACLBinding `json:"relatedAcls,omitempty"`
+}
+
+// ACLDeleteFilter is a DeleteACLs filter that matches exactly one flagged ACL. It
+// uses the same format as the request to delete ACLs.
+type ACLDeleteFilter struct {
+	ResourceType        kmsg.ACLResourceType        `json:"resourceType"`
+	ResourceName        string                      `json:"resourceName"`
+	ResourcePatternType kmsg.ACLResourcePatternType `json:"resourcePatternType"`
+	Principal           string                      `json:"principal"`
+	Host                string                      `json:"host"`
+	Operation           kmsg.ACLOperation           `json:"operation"`
+	PermissionType      kmsg.ACLPermissionType      `json:"permissionType"`
+	// Findings are the reasons why the ACL is proposed for deletion.
+	Findings []ACLFindingType `json:"findings"`
+}
+
+// ACLAnalysis is the result of analyzing all ACLs of the cluster.
+type ACLAnalysis struct {
+	IsAuthorizerEnabled bool         `json:"isAuthorizerEnabled"`
+	TotalACLs           int          `json:"totalAcls"`
+	Findings            []ACLFinding `json:"findings"`
+	// DeletePlan contains one filter for each ACL with at least one finding that
+	// can be deleted on its own. ACLs whose only finding is an unused resource
+	// pattern are only included if requested, as they are commonly created
+	// before the resource.
+	DeletePlan []ACLDeleteFilter `json:"deletePlan"`
+	Warnings   []string          `json:"warnings"`
+}
+
+// aclAnalysisInput contains the state of the cluster the ACLs are analyzed against.
+// Nil sets are not known and the corresponding checks are skipped.
+type aclAnalysisInput struct {
+	bindings         []aclBinding
+	topicNames       map[string]struct{}
+	groupIDs         map[string]struct{}
+	transactionalIDs map[string]struct{}
+	users            map[string]struct{}
+	roles            map[string]struct{}
+}
+
+// AnalyzeACLs lists all ACLs and reports unused, orphaned, duplicate and shadowed
+// ACLs along with a plan to delete them. ACLs that are only unused are added to
+// the plan if includeUnused is true.
+func (s *Service) AnalyzeACLs(ctx context.Context, includeUnused bool) (*ACLAnalysis, *rest.Error) {
+	aclOverview, err := s.ListAllACLs(ctx, kmsg.DescribeACLsRequest{
+		ResourceType:        kmsg.ACLResourceTypeAny,
+		ResourcePatternType: kmsg.ACLResourcePatternTypeAny,
+		Operation:           kmsg.ACLOperationAny,
+		PermissionType:      kmsg.ACLPermissionTypeAny,
+	})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Could not list ACLs: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	if !aclOverview.IsAuthorizerEnabled {
+		return &ACLAnalysis{
+			IsAuthorizerEnabled: false,
+			Findings:            make([]ACLFinding, 0),
+			DeletePlan:          make([]ACLDeleteFilter, 0),
+			Warnings:            make([]string, 0),
+		}, nil
+	}
+
+	input := aclAnalysisInput{bindings: aclBindingsFromResponse(aclOverview.KafkaResponse)}
+	warnings := make([]string, 0)
+
+	topicNames, err := s.GetAllTopicNames(ctx, nil)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to list topics: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to list topics: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	input.topicNames = toSet(topicNames)
+
+	groups, err := s.kafkaSvc.ListConsumerGroups(ctx)
+	switch {
+	case err != nil:
+		warnings = append(warnings, fmt.Sprintf("Unused consumer group ACLs are not reported because the consumer groups could not be listed: %v", err.Error()))
+	case groups.RequestsFailed > 0:
+		warnings = append(warnings, fmt.Sprintf("Unused consumer group ACLs are not reported because %d of %d brokers failed to list their consumer groups",
+			groups.RequestsFailed, groups.RequestsSent))
+	default:
+		input.groupIDs = toSet(groups.GetGroupIDs())
+	}
+
+	var transactions kadm.ListedTransactions
+	adminClient, err := s.kafkaSvc.AdminClient(ctx)
+	if err == nil {
+		transactions, err = adminClient.ListTransactions(ctx, nil, nil)
+	}
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Unused transactional ID ACLs are not reported because the transactions could not be listed: %v", err.Error()))
+	} else {
+		input.transactionalIDs = toSet(transactions.TransactionalIDs())
+	}
+
+	if s.redpandaSvc == nil {
+		warnings = append(warnings, "ACLs of unknown principals are not reported because the Redpanda admin API is not configured")
+	} else {
+		users, err := s.redpandaSvc.ListUsers(ctx)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("ACLs of unknown users are not reported because the users could not be listed: %v", err.Error()))
+		} else {
+			input.users = toSet(users)
+			warnings = append(warnings, "Users are only known if they have SCRAM credentials. Principals of mTLS, OIDC or Kerberos users are reported as unknown")
+		}
+		roles, err := s.redpandaSvc.ListRoles(ctx, "", "", "")
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("ACLs of unknown Redpanda roles are not reported because the roles could not be listed: %v", err.Error()))
+		} else {
+			input.roles = make(map[string]struct{}, len(roles.Roles))
+			for _, role := range roles.Roles {
+				input.roles[role.Name] = struct{}{}
+			}
+		}
+	}
+
+	analysis := analyzeACLs(input, includeUnused)
+	analysis.Warnings = warnings
+	return analysis, nil
+}
+
+// analyzeACLs reports the findings for all bindings and builds the delete plan.
+func analyzeACLs(input aclAnalysisInput, includeUnused bool) *ACLAnalysis {
+	analysis := &ACLAnalysis{
+		IsAuthorizerEnabled: true,
+		TotalACLs:           len(input.bindings),
+		Findings:            make([]ACLFinding, 0),
+		DeletePlan:          make([]ACLDeleteFilter, 0),
+	}
+
+	for i := range input.bindings {
+		binding := &input.bindings[i]
+		var findings []ACLFinding
+		isExactDuplicate := false
+
+		if reason, isUnused := input.isUnusedResourcePattern(binding); isUnused {
+			findings = append(findings, ACLFinding{Type: ACLFindingUnusedResourcePattern, Reason: reason})
+		}
+		if reason, isUnknown := input.isUnknownPrincipal(binding); isUnknown {
+			findings = append(findings, ACLFinding{Type: ACLFindingUnknownPrincipal, Reason: reason})
+		}
+
+		var coveringACLs, shadowingACLs []ACLBinding
+		for j := range input.bindings {
+			other := &input.bindings[j]
+			if i == j {
+				continue
+			}
+			if *other == *binding {
+				isExactDuplicate = true
+				coveringACLs = append(coveringACLs, other.toACLBinding())
+				continue
+			}
+			if binding.isCoveredBy(other) {
+				coveringACLs = append(coveringACLs, other.toACLBinding())
+			}
+			if binding.isShadowedBy(other) {
+				shadowingACLs = append(shadowingACLs, other.toACLBinding())
+			}
+		}
+		if len(coveringACLs) > 0 {
+			reason := "ACL grants nothing beyond the related ACLs of the same permission type"
+			if isExactDuplicate {
+				reason = "ACL is listed more than once. Deleting it removes all copies, so it is not part of the delete plan"
+			}
+			findings = append(findings, ACLFinding{Type: ACLFindingDuplicate, Reason: reason, RelatedACLs: coveringACLs})
+		}
+		if len(shadowingACLs) > 0 {
+			findings = append(findings, ACLFinding{
+				Type:        ACLFindingShadowedByDeny,
+				Reason:      "DENY ACLs take precedence over everything this ALLOW ACL grants",
+				RelatedACLs: shadowingACLs,
+			})
+		}
+
+		if len(findings) == 0 {
+			continue
+		}
+		filter := ACLDeleteFilter{
+			ResourceType:        binding.resourceType,
+			ResourceName:        binding.resourceName,
+			ResourcePatternType: binding.patternType,
+			Principal:           binding.principal,
+			Host:                binding.host,
+			Operation:           binding.operation,
+			PermissionType:      binding.permissionType,
+			Findings:            make([]ACLFindingType, 0, len(findings)),
+		}
+		isOnlyUnused := true
+		for _, finding := range findings {
+			finding.ACL = binding.toACLBinding()
+			analysis.Findings = append(analysis.Findings, finding)
+			filter.Findings = append(filter.Findings, finding.Type)
+			if finding.Type != ACLFindingUnusedResourcePattern {
+				isOnlyUnused = false
+			}
+		}
+		if !isExactDuplicate && (!isOnlyUnused || includeUnused) {
+			analysis.DeletePlan = append(analysis.DeletePlan, filter)
+		}
+	}
+
+	return analysis
+}
+
+// isUnusedResourcePattern returns true if the resource pattern of the binding matches
+// no existing resource.
+func (in *aclAnalysisInput) isUnusedResourcePattern(binding *aclBinding) (string, bool) {
+	var existing map[string]struct{}
+	var kind string
+	switch binding.resourceType {
+	case kmsg.ACLResourceTypeTopic:
+		existing, kind = in.topicNames, "topic"
+	case kmsg.ACLResourceTypeGroup:
+		existing, kind = in.groupIDs, "consumer group"
+	case kmsg.ACLResourceTypeTransactionalId:
+		existing, kind = in.transactionalIDs, "transactional ID"
+	default:
+		return "", false
+	}
+	if existing == nil {
+		return "", false
+	}
+	if binding.patternType == kmsg.ACLResourcePatternTypeLiteral && binding.resourceName == aclWildcard {
+		return "", false
+	}
+
+	for name := range existing {
+		if binding.matchesResource(binding.resourceType, name) {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("no %v matches the %v resource name %q", kind, strings.ToLower(binding.patternType.String()), binding.resourceName), true
+}
+
+// isUnknownPrincipal returns true if the principal of the binding is a user or a
+// Redpanda role that does not exist.
+func (in *aclAnalysisInput) isUnknownPrincipal(binding *aclBinding) (string, bool) {
+	principalType, principalName, _ := strings.Cut(binding.principal, ":")
+	if principalName == aclWildcard {
+		return "", false
+	}
+	switch {
+	case principalType == "User" && in.users != nil:
+		if _, exists := in.users[principalName]; !exists {
+			return fmt.Sprintf("user %q does not exist", principalName), true
+		}
+	case principalType == "RedpandaRole" && in.roles != nil:
+		if _, exists := in.roles[principalName]; !exists {
+			return fmt.Sprintf("Redpanda role %q does not exist", principalName), true
+		}
+	default:
+	}
+	return "", false
+}
+
+// coversPattern returns true if every resource matched by the resource pattern of
+// the binding is also matched by the pattern of the other binding.
+func (b *aclBinding) coversPattern(other *aclBinding) bool {
+	if b.resourceType != other.resourceType {
+		return false
+	}
+	if b.patternType == kmsg.ACLResourcePatternTypeLiteral && b.resourceName == aclWildcard {
+		return true
+	}
+	switch other.patternType {
+	case kmsg.ACLResourcePatternTypeLiteral:
+		if other.resourceName == aclWildcard {
+			return false
+		}
+		return b.matchesResource(other.resourceType, other.resourceName)
+	case kmsg.ACLResourcePatternTypePrefixed:
+		return b.patternType == kmsg.ACLResourcePatternTypePrefixed && strings.HasPrefix(other.resourceName, b.resourceName)
+	default:
+		return false
+	}
+}
+
+// coversPrincipalAndHost returns true if the binding applies to every principal
+// and host the other binding applies to.
+func (b *aclBinding) coversPrincipalAndHost(other *aclBinding) bool {
+	if b.host != aclWildcard && b.host != other.host {
+		return false
+	}
+	if b.principal == other.principal {
+		return true
+	}
+	principalType, principalName, _ := strings.Cut(b.principal, ":")
+	otherPrincipalType, _, _ := strings.Cut(other.principal, ":")
+	return principalName == aclWildcard && principalType == otherPrincipalType
+}
+
+// isCoveredBy returns true if the other binding has the same permission type and
+// applies to everything this binding applies to.
+func (b *aclBinding) isCoveredBy(other *aclBinding) bool {
+	return b.permissionType == other.permissionType &&
+		other.coversPattern(b) && other.coversPrincipalAndHost(b) && other.appliesTo(b.operation)
+}
+
+// isShadowedBy returns true if this is an ALLOW binding and the other binding
+// denies everything this binding allows.
+func (b *aclBinding) isShadowedBy(other *aclBinding) bool {
+	if b.permissionType != kmsg.ACLPermissionTypeAllow || other.permissionType != kmsg.ACLPermissionTypeDeny {
+		return false
+	}
+	return other.coversPattern(b) && other.coversPrincipalAndHost(b) && other.appliesTo(b.operation)
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
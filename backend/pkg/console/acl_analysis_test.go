@@ -0,0 +1,108 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestAnalyzeACLs(t *testing.T) {
+	const (
+		literal  = kmsg.ACLResourcePatternTypeLiteral
+		prefixed = kmsg.ACLResourcePatternTypePrefixed
+		allow    = kmsg.ACLPermissionTypeAllow
+		deny     = kmsg.ACLPermissionTypeDeny
+		topic    = kmsg.ACLResourceTypeTopic
+		group    = kmsg.ACLResourceTypeGroup
+	)
+	bindings := []aclBinding{
+		// 0: valid
+		testACLBinding(topic, "orders-", prefixed, "User:alice", "*", kmsg.ACLOperationRead, allow),
+		// 1: covered by 2
+		testACLBinding(topic, "orders-v1", literal, "User:alice", "10.0.0.1", kmsg.ACLOperationDescribe, allow),
+		// 2: valid
+		testACLBinding(topic, "orders-v1", literal, "User:alice", "*", kmsg.ACLOperationAll, allow),
+		// 3: no topic matches
+		testACLBinding(topic, "payments-", prefixed, "User:alice", "*", kmsg.ACLOperationWrite, allow),
+		// 4: unknown user
+		testACLBinding(group, "billing", literal, "User:mallory", "*", kmsg.ACLOperationRead, allow),
+		// 5: shadowed by 6
+		testACLBinding(topic, "audit", literal, "User:bob", "*", kmsg.ACLOperationWrite, allow),
+		// 6: valid
+		testACLBinding(topic, "*", literal, "User:*", "*", kmsg.ACLOperationWrite, deny),
+		// 7: unknown role
+		testACLBinding(topic, "audit", literal, "RedpandaRole:auditors", "*", kmsg.ACLOperationRead, allow),
+	}
+
+	analysis := analyzeACLs(aclAnalysisInput{
+		bindings:         bindings,
+		topicNames:       toSet([]string{"orders-v1", "audit"}),
+		groupIDs:         toSet([]string{"billing"}),
+		transactionalIDs: nil,
+		users:            toSet([]string{"alice", "bob"}),
+		roles:            toSet([]string{"operators"}),
+	}, false)
+	assert.Equal(t, 8, analysis.TotalACLs)
+
+	findingsByACL := make(map[ACLBinding][]ACLFindingType)
+	for _, finding := range analysis.Findings {
+		findingsByACL[finding.ACL] = append(findingsByACL[finding.ACL], finding.Type)
+	}
+	assert.Equal(t, map[ACLBinding][]ACLFindingType{
+		bindings[1].toACLBinding(): {ACLFindingDuplicate},
+		bindings[3].toACLBinding(): {ACLFindingUnusedResourcePattern, ACLFindingShadowedByDeny},
+		bindings[4].toACLBinding(): {ACLFindingUnknownPrincipal},
+		bindings[5].toACLBinding(): {ACLFindingShadowedByDeny},
+		bindings[7].toACLBinding(): {ACLFindingUnknownPrincipal},
+	}, findingsByACL)
+
+	require.Len(t, analysis.DeletePlan, 5)
+	assert.Equal(t, ACLDeleteFilter{
+		ResourceType:        topic,
+		ResourceName:        "payments-",
+		ResourcePatternType: prefixed,
+		Principal:           "User:alice",
+		Host:                "*",
+		Operation:           kmsg.ACLOperationWrite,
+		PermissionType:      allow,
+		Findings:            []ACLFindingType{ACLFindingUnusedResourcePattern, ACLFindingShadowedByDeny},
+	}, analysis.DeletePlan[1])
+}
+
+func TestAnalyzeACLsUnusedOptIn(t *testing.T) {
+	binding := testACLBinding(kmsg.ACLResourceTypeTopic, "payments", kmsg.ACLResourcePatternTypeLiteral,
+		"User:alice", "*", kmsg.ACLOperationRead, kmsg.ACLPermissionTypeAllow)
+	input := aclAnalysisInput{bindings: []aclBinding{binding}, topicNames: toSet([]string{"orders"})}
+
+	// The topic may be created after its ACLs, so the ACL is reported but not deleted by default
+	analysis := analyzeACLs(input, false)
+	require.Len(t, analysis.Findings, 1)
+	assert.Equal(t, ACLFindingUnusedResourcePattern, analysis.Findings[0].Type)
+	assert.Empty(t, analysis.DeletePlan)
+
+	analysis = analyzeACLs(input, true)
+	require.Len(t, analysis.DeletePlan, 1)
+	assert.Equal(t, []ACLFindingType{ACLFindingUnusedResourcePattern}, analysis.DeletePlan[0].Findings)
+}
+
+func TestAnalyzeACLsExactDuplicates(t *testing.T) {
+	binding := testACLBinding(kmsg.ACLResourceTypeTopic, "orders", kmsg.ACLResourcePatternTypeLiteral,
+		"User:alice", "*", kmsg.ACLOperationRead, kmsg.ACLPermissionTypeAllow)
+
+	analysis := analyzeACLs(aclAnalysisInput{bindings: []aclBinding{binding, binding}}, true)
+	require.Len(t, analysis.Findings, 2)
+	assert.Equal(t, ACLFindingDuplicate, analysis.Findings[0].Type)
+	// Deleting an exact duplicate would delete all copies
+	assert.Empty(t, analysis.DeletePlan)
+}
@@ -0,0 +1,234 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+const (
+	// aclClusterResourceName is the name of the only cluster resource.
+	aclClusterResourceName = "kafka-cluster"
+	// aclWildcard matches any resource name in literal patterns, any host and,
+	// prefixed with the principal type, any principal of that type.
+	aclWildcard = "*"
+)
+
+// aclOperationsByResourceType are the operations that are authorized per resource type.
+var aclOperationsByResourceType = map[kmsg.ACLResourceType][]kmsg.ACLOperation{
+	kmsg.ACLResourceTypeTopic: {
+		kmsg.ACLOperationRead, kmsg.ACLOperationWrite, kmsg.ACLOperationCreate, kmsg.ACLOperationDelete,
+		kmsg.ACLOperationAlter, kmsg.ACLOperationDescribe, kmsg.ACLOperationDescribeConfigs, kmsg.ACLOperationAlterConfigs,
+	},
+	kmsg.ACLResourceTypeGroup: {kmsg.ACLOperationRead, kmsg.ACLOperationDelete, kmsg.ACLOperationDescribe},
+	kmsg.ACLResourceTypeCluster: {
+		kmsg.ACLOperationCreate, kmsg.ACLOperationClusterAction, kmsg.ACLOperationDescribe, kmsg.ACLOperationAlter,
+		kmsg.ACLOperationDescribeConfigs, kmsg.ACLOperationAlterConfigs, kmsg.ACLOperationIdempotentWrite,
+	},
+	kmsg.ACLResourceTypeTransactionalId: {kmsg.ACLOperationDescribe, kmsg.ACLOperationWrite},
+	kmsg.ACLResourceTypeDelegationToken: {kmsg.ACLOperationDescribe},
+}
+
+// aclImpliedOperations are operations that are allowed if any of the listed
+// operations is allowed. Implications do not apply to DENY ACLs.
+var aclImpliedOperations = map[kmsg.ACLOperation][]kmsg.ACLOperation{
+	kmsg.ACLOperationDescribe: {
+		kmsg.ACLOperationRead, kmsg.ACLOperationWrite, kmsg.ACLOperationDelete, kmsg.ACLOperationAlter,
+	},
+	kmsg.ACLOperationDescribeConfigs: {kmsg.ACLOperationAlterConfigs},
+}
+
+// ACLBinding is a single ACL along with the resource pattern it is bound to.
+type ACLBinding struct {
+	ResourceType        string `json:"resourceType"`
+	ResourceName        string `json:"resourceName"`
+	ResourcePatternType string `json:"resourcePatternType"`
+	Principal           string `json:"principal"`
+	Host                string `json:"host"`
+	Operation           string `json:"operation"`
+	PermissionType      string `json:"permissionType"`
+}
+
+// aclBinding is the typed representation of an ACLBinding that is used for the evaluation.
+type aclBinding struct {
+	resourceType   kmsg.ACLResourceType
+	resourceName   string
+	patternType    kmsg.ACLResourcePatternType
+	principal      string
+	host           string
+	operation      kmsg.ACLOperation
+	permissionType kmsg.ACLPermissionType
+}
+
+func (b *aclBinding) toACLBinding() ACLBinding {
+	return ACLBinding{
+		ResourceType:        b.resourceType.String(),
+		ResourceName:        b.resourceName,
+		ResourcePatternType: b.patternType.String(),
+		Principal:           b.principal,
+		Host:                b.host,
+		Operation:           b.operation.String(),
+		PermissionType:      b.permissionType.String(),
+	}
+}
+
+// matchesResource returns true if the resource pattern of the binding matches the resource.
+func (b *aclBinding) matchesResource(resourceType kmsg.ACLResourceType, resourceName string) bool {
+	if b.resourceType != resourceType {
+		return false
+	}
+	switch b.patternType {
+	case kmsg.ACLResourcePatternTypeLiteral:
+		return b.resourceName == aclWildcard || b.resourceName == resourceName
+	case kmsg.ACLResourcePatternTypePrefixed:
+		return strings.HasPrefix(resourceName, b.resourceName)
+	default:
+		return false
+	}
+}
+
+// matchesHost returns true if the binding applies to connections from the given host.
+func (b *aclBinding) matchesHost(host string) bool {
+	return b.host == aclWildcard || b.host == host
+}
+
+// appliesTo returns true if the binding's permission applies to the requested
+// operation. ALLOW bindings also apply to operations that are implied by the
+// granted operation.
+func (b *aclBinding) appliesTo(operation kmsg.ACLOperation) bool {
+	if b.operation == kmsg.ACLOperationAll || b.operation == operation {
+		return true
+	}
+	if b.permissionType != kmsg.ACLPermissionTypeAllow {
+		return false
+	}
+	return slices.Contains(aclImpliedOperations[operation], b.operation)
+}
+
+// aclBindingsFromResponse flattens all ACLs of a describe ACLs response.
+func aclBindingsFromResponse(res *kmsg.DescribeACLsResponse) []aclBinding {
+	if res == nil {
+		return nil
+	}
+	bindings := make([]aclBinding, 0, len(res.Resources))
+	for _, resource := range res.Resources {
+		for _, acl := range resource.ACLs {
+			bindings = append(bindings, aclBinding{
+				resourceType:   resource.ResourceType,
+				resourceName:   resource.ResourceName,
+				patternType:    resource.ResourcePatternType,
+				principal:      acl.Principal,
+				host:           acl.Host,
+				operation:      acl.Operation,
+				permissionType: acl.PermissionType,
+			})
+		}
+	}
+	return bindings
+}
+
+// aclEffectivePrincipals returns the principals whose ACLs apply to the given
+// principal: the principal itself, the wildcard principal of its type and the
+// Redpanda roles the principal is a member of.
+func aclEffectivePrincipals(principal string, roleNames []string) []string {
+	principals := []string{principal}
+	if principalType, _, found := strings.Cut(principal, ":"); found {
+		principals = append(principals, principalType+":"+aclWildcard)
+	}
+	for _, roleName := range roleNames {
+		principals = append(principals, "RedpandaRole:"+roleName)
+	}
+	return principals
+}
+
+// aclEvaluator decides whether a set of effective principals is authorized by the ACLs
+// with the same semantics as the Kafka authorizer: DENY takes precedence over ALLOW
+// and without a matching ALLOW ACL access is denied.
+type aclEvaluator struct {
+	bindings []aclBinding
+}
+
+// newACLEvaluator creates an evaluator that only considers the ACLs of the given
+// effective principals that apply to connections from the given host.
+func newACLEvaluator(bindings []aclBinding, principals []string, host string) *aclEvaluator {
+	applicable := make([]aclBinding, 0)
+	for _, binding := range bindings {
+		if slices.Contains(principals, binding.principal) && binding.matchesHost(host) {
+			applicable = append(applicable, binding)
+		}
+	}
+	return &aclEvaluator{bindings: applicable}
+}
+
+// ACLAccessDecision is the result of evaluating the ACLs for one operation on a resource.
+type ACLAccessDecision struct {
+	ResourceType string       `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	Operation    string       `json:"operation"`
+	Allowed      bool         `json:"allowed"`
+	Reason       string       `json:"reason"`
+	DenyingACLs  []ACLBinding `json:"denyingAcls"`
+	AllowingACLs []ACLBinding `json:"allowingAcls"`
+}
+
+func (e *aclEvaluator) evaluate(resourceType kmsg.ACLResourceType, resourceName string, operation kmsg.ACLOperation) ACLAccessDecision {
+	if resourceType == kmsg.ACLResourceTypeCluster {
+		resourceName = aclClusterResourceName
+	}
+	decision := ACLAccessDecision{
+		ResourceType: resourceType.String(),
+		ResourceName: resourceName,
+		Operation:    operation.String(),
+		DenyingACLs:  make([]ACLBinding, 0),
+		AllowingACLs: make([]ACLBinding, 0),
+	}
+
+	for i := range e.bindings {
+		binding := &e.bindings[i]
+		if !binding.matchesResource(resourceType, resourceName) || !binding.appliesTo(operation) {
+			continue
+		}
+		switch binding.permissionType {
+		case kmsg.ACLPermissionTypeDeny:
+			decision.DenyingACLs = append(decision.DenyingACLs, binding.toACLBinding())
+		case kmsg.ACLPermissionTypeAllow:
+			decision.AllowingACLs = append(decision.AllowingACLs, binding.toACLBinding())
+		default:
+		}
+	}
+
+	switch {
+	case len(decision.DenyingACLs) > 0:
+		decision.Reason = fmt.Sprintf("%d DENY ACLs match, DENY takes precedence over %d matching ALLOW ACLs",
+			len(decision.DenyingACLs), len(decision.AllowingACLs))
+	case len(decision.AllowingACLs) > 0:
+		decision.Allowed = true
+		decision.Reason = fmt.Sprintf("%d ALLOW ACLs match and no DENY ACL matches", len(decision.AllowingACLs))
+	default:
+		decision.Reason = "no ALLOW ACL matches, access is denied by default"
+	}
+
+	return decision
+}
+
+// allowedOperations returns all operations on the resource that are allowed.
+func (e *aclEvaluator) allowedOperations(resourceType kmsg.ACLResourceType, resourceName string) []string {
+	operations := make([]string, 0)
+	for _, operation := range aclOperationsByResourceType[resourceType] {
+		if e.evaluate(resourceType, resourceName, operation).Allowed {
+			operations = append(operations, operation.String())
+		}
+	}
+	return operations
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func testACLBinding(resourceType kmsg.ACLResourceType, name string, patternType kmsg.ACLResourcePatternType, principal, host string, operation kmsg.ACLOperation, permissionType kmsg.ACLPermissionType) aclBinding {
+	return aclBinding{
+		resourceType:   resourceType,
+		resourceName:   name,
+		patternType:    patternType,
+		principal:      principal,
+		host:           host,
+		operation:      operation,
+		permissionType: permissionType,
+	}
+}
+
+func TestACLEvaluator(t *testing.T) {
+	const (
+		literal  = kmsg.ACLResourcePatternTypeLiteral
+		prefixed = kmsg.ACLResourcePatternTypePrefixed
+		allow    = kmsg.ACLPermissionTypeAllow
+		deny     = kmsg.ACLPermissionTypeDeny
+		topic    = kmsg.ACLResourceTypeTopic
+		group    = kmsg.ACLResourceTypeGroup
+	)
+	bindings := []aclBinding{
+		testACLBinding(topic, "orders-", prefixed, "User:alice", "*", kmsg.ACLOperationRead, allow),
+		testACLBinding(topic, "orders-secret", literal, "User:alice", "*", kmsg.ACLOperationAll, deny),
+		testACLBinding(topic, "orders-v2", literal, "User:alice", "10.0.0.1", kmsg.ACLOperationWrite, allow),
+		testACLBinding(topic, "*", literal, "User:*", "*", kmsg.ACLOperationDescribeConfigs, allow),
+		testACLBinding(group, "billing", literal, "RedpandaRole:consumers", "*", kmsg.ACLOperationRead, allow),
+		testACLBinding(kmsg.ACLResourceTypeCluster, aclClusterResourceName, literal, "User:alice", "*", kmsg.ACLOperationIdempotentWrite, allow),
+		testACLBinding(topic, "orders-", prefixed, "User:bob", "*", kmsg.ACLOperationAll, allow),
+	}
+	principals := aclEffectivePrincipals("User:alice", []string{"consumers"})
+	assert.Equal(t, []string{"User:alice", "User:*", "RedpandaRole:consumers"}, principals)
+
+	tests := []struct {
+		name         string
+		host         string
+		resourceType kmsg.ACLResourceType
+		resourceName string
+		operation    kmsg.ACLOperation
+		allowed      bool
+		allowingACLs int
+		denyingACLs  int
+	}{
+		{
+			name:         "prefixed allow",
+			resourceType: topic,
+			resourceName: "orders-v1",
+			operation:    kmsg.ACLOperationRead,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+		{
+			name:         "describe is implied by read",
+			resourceType: topic,
+			resourceName: "orders-v1",
+			operation:    kmsg.ACLOperationDescribe,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+		{
+			name:         "deny takes precedence",
+			resourceType: topic,
+			resourceName: "orders-secret",
+			operation:    kmsg.ACLOperationRead,
+			allowingACLs: 1,
+			denyingACLs:  1,
+		},
+		{
+			name:         "no matching acl",
+			resourceType: topic,
+			resourceName: "payments",
+			operation:    kmsg.ACLOperationRead,
+		},
+		{
+			name:         "wildcard principal and resource",
+			resourceType: topic,
+			resourceName: "payments",
+			operation:    kmsg.ACLOperationDescribeConfigs,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+		{
+			name:         "host specific acl does not match other hosts",
+			host:         "10.0.0.2",
+			resourceType: topic,
+			resourceName: "orders-v2",
+			operation:    kmsg.ACLOperationWrite,
+		},
+		{
+			name:         "host specific acl",
+			host:         "10.0.0.1",
+			resourceType: topic,
+			resourceName: "orders-v2",
+			operation:    kmsg.ACLOperationWrite,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+		{
+			name:         "redpanda role",
+			resourceType: group,
+			resourceName: "billing",
+			operation:    kmsg.ACLOperationRead,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+		{
+			name:         "cluster name is implicit",
+			resourceType: kmsg.ACLResourceTypeCluster,
+			operation:    kmsg.ACLOperationIdempotentWrite,
+			allowed:      true,
+			allowingACLs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluator := newACLEvaluator(bindings, principals, tt.host)
+			decision := evaluator.evaluate(tt.resourceType, tt.resourceName, tt.operation)
+			assert.Equal(t, tt.allowed, decision.Allowed, decision.Reason)
+			assert.Len(t, decision.AllowingACLs, tt.allowingACLs)
+			assert.Len(t, decision.DenyingACLs, tt.denyingACLs)
+		})
+	}
+
+	t.Run("allowed operations and patterns", func(t *testing.T) {
+		evaluator := newACLEvaluator(bindings, principals, "")
+		assert.Equal(t, []string{"READ", "DESCRIBE", "DESCRIBE_CONFIGS"}, evaluator.allowedOperations(topic, "orders-v1"))
+		assert.Empty(t, evaluator.allowedOperations(topic, "orders-secret"))
+
+		patterns := evaluator.patterns()
+		assert.Len(t, patterns, 5)
+		assert.Equal(t, PrincipalAccessPattern{
+			ResourceType:        "TOPIC",
+			ResourceName:        "orders-secret",
+			ResourcePatternType: "LITERAL",
+			AllowedOperations:   []string{},
+			DeniedOperations:    []string{"READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER_CONFIGS"},
+		}, patterns[4])
+	})
+}
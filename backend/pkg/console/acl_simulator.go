@@ -0,0 +1,281 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+)
+
+// ACLAccessCheck is a single operation on a resource whose authorization is simulated.
+type ACLAccessCheck struct {
+	ResourceType kmsg.ACLResourceType `json:"resourceType"`
+	ResourceName string               `json:"resourceName"`
+	Operation    kmsg.ACLOperation    `json:"operation"`
+}
+
+// SimulateACLAccessRequest asks whether a principal connecting from a host is allowed
+// to perform all the given operations, e.g. reading a topic and its consumer group.
+type SimulateACLAccessRequest struct {
+	Principal string           `json:"principal"`
+	Host      string           `json:"host"`
+	Checks    []ACLAccessCheck `json:"checks"`
+}
+
+// SimulateACLAccessResponse is the result of an ACL access simulation.
+type SimulateACLAccessResponse struct {
+	Principal string `json:"principal"`
+	Host      string `json:"host"`
+	// EffectivePrincipals are the principals whose ACLs apply to the principal,
+	// including the wildcard principal and the Redpanda roles it is a member of.
+	EffectivePrincipals []string `json:"effectivePrincipals"`
+	// Allowed is true if all checks are allowed.
+	Allowed  bool                `json:"allowed"`
+	Checks   []ACLAccessDecision `json:"checks"`
+	Warnings []string            `json:"warnings"`
+}
+
+// PrincipalAccessPattern summarizes the operations that ACLs of a principal allow and
+// deny on one resource pattern.
+type PrincipalAccessPattern struct {
+	ResourceType        string   `json:"resourceType"`
+	ResourceName        string   `json:"resourceName"`
+	ResourcePatternType string   `json:"resourcePatternType"`
+	AllowedOperations   []string `json:"allowedOperations"`
+	DeniedOperations    []string `json:"deniedOperations"`
+}
+
+// PrincipalResourceAccess lists the effective operations a principal can perform on
+// an existing resource.
+type PrincipalResourceAccess struct {
+	ResourceType string   `json:"resourceType"`
+	ResourceName string   `json:"resourceName"`
+	Operations   []string `json:"operations"`
+}
+
+// PrincipalAccessReport lists everything a principal can access.
+type PrincipalAccessReport struct {
+	Principal           string   `json:"principal"`
+	Host                string   `json:"host"`
+	EffectivePrincipals []string `json:"effectivePrincipals"`
+	// Patterns contains all resource patterns with ACLs for the effective principals.
+	// Resources that can not be listed, such as transactional IDs, are only
+	// covered here.
+	Patterns []PrincipalAccessPattern `json:"patterns"`
+	// Resources contains the cluster and every existing topic and consumer group
+	// on which at least one operation is allowed.
+	Resources []PrincipalResourceAccess `json:"resources"`
+	Warnings  []string                  `json:"warnings"`
+}
+
+// SimulateACLAccess evaluates the ACLs of the principal and returns whether it is
+// allowed to perform the requested operations, along with the ACLs that matched.
+func (s *Service) SimulateACLAccess(ctx context.Context, req SimulateACLAccessRequest) (*SimulateACLAccessResponse, *rest.Error) {
+	bindings, principals, warnings, restErr := s.loadACLsOfPrincipal(ctx, req.Principal)
+	if restErr != nil {
+		return nil, restErr
+	}
+	evaluator := newACLEvaluator(bindings, principals, req.Host)
+
+	res := &SimulateACLAccessResponse{
+		Principal:           req.Principal,
+		Host:                req.Host,
+		EffectivePrincipals: principals,
+		Allowed:             true,
+		Checks:              make([]ACLAccessDecision, len(req.Checks)),
+		Warnings:            warnings,
+	}
+	for i, check := range req.Checks {
+		res.Checks[i] = evaluator.evaluate(check.ResourceType, check.ResourceName, check.Operation)
+		res.Allowed = res.Allowed && res.Checks[i].Allowed
+	}
+
+	return res, nil
+}
+
+// GetPrincipalAccessReport returns all resource patterns with ACLs for the principal
+// and the effective operations on the cluster and all existing topics and consumer groups.
+func (s *Service) GetPrincipalAccessReport(ctx context.Context, principal, host string) (*PrincipalAccessReport, *rest.Error) {
+	bindings, principals, warnings, restErr := s.loadACLsOfPrincipal(ctx, principal)
+	if restErr != nil {
+		return nil, restErr
+	}
+	evaluator := newACLEvaluator(bindings, principals, host)
+
+	topicNames, err := s.GetAllTopicNames(ctx, nil)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to list topics: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to list topics: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	groups, err := s.kafkaSvc.ListConsumerGroups(ctx)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("failed to list consumer groups: %w", err),
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to list consumer groups: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	if groups.RequestsFailed > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d of %d brokers failed to list their consumer groups, the report may be incomplete",
+			groups.RequestsFailed, groups.RequestsSent))
+	}
+
+	report := &PrincipalAccessReport{
+		Principal:           principal,
+		Host:                host,
+		EffectivePrincipals: principals,
+		Patterns:            evaluator.patterns(),
+		Resources:           make([]PrincipalResourceAccess, 0),
+		Warnings:            warnings,
+	}
+	addResource := func(resourceType kmsg.ACLResourceType, resourceName string) {
+		operations := evaluator.allowedOperations(resourceType, resourceName)
+		if len(operations) > 0 {
+			report.Resources = append(report.Resources, PrincipalResourceAccess{
+				ResourceType: resourceType.String(),
+				ResourceName: resourceName,
+				Operations:   operations,
+			})
+		}
+	}
+	addResource(kmsg.ACLResourceTypeCluster, aclClusterResourceName)
+	slices.Sort(topicNames)
+	for _, topicName := range topicNames {
+		addResource(kmsg.ACLResourceTypeTopic, topicName)
+	}
+	groupIDs := groups.GetGroupIDs()
+	slices.Sort(groupIDs)
+	for _, groupID := range slices.Compact(groupIDs) {
+		addResource(kmsg.ACLResourceTypeGroup, groupID)
+	}
+
+	return report, nil
+}
+
+// loadACLsOfPrincipal lists all ACLs and resolves the effective principals of the
+// given principal. Problems that make the result less accurate are returned as warnings.
+func (s *Service) loadACLsOfPrincipal(ctx context.Context, principal string) ([]aclBinding, []string, []string, *rest.Error) {
+	principalType, principalName, found := strings.Cut(principal, ":")
+	if !found || principalType == "" || principalName == "" {
+		return nil, nil, nil, &rest.Error{
+			Err:      fmt.Errorf("principal %q has no type prefix", principal),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Principal %q must be prefixed with its type, e.g. User:alice", principal),
+			IsSilent: false,
+		}
+	}
+
+	warnings := []string{"Superusers are authorized without ACLs and are not considered"}
+
+	aclOverview, err := s.ListAllACLs(ctx, kmsg.DescribeACLsRequest{
+		ResourceType:        kmsg.ACLResourceTypeAny,
+		ResourcePatternType: kmsg.ACLResourcePatternTypeAny,
+		Operation:           kmsg.ACLOperationAny,
+		PermissionType:      kmsg.ACLPermissionTypeAny,
+	})
+	if err != nil {
+		return nil, nil, nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Could not list ACLs: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	if !aclOverview.IsAuthorizerEnabled {
+		warnings = append(warnings, "The cluster has no authorizer enabled, so all operations are allowed regardless of the ACLs")
+	}
+
+	var roleNames []string
+	switch {
+	case principalType == "RedpandaRole":
+		// ACLs of roles only apply to the role itself
+	case s.redpandaSvc == nil:
+		warnings = append(warnings, "Redpanda roles are not considered because the Redpanda admin API is not configured")
+	default:
+		roles, err := s.redpandaSvc.ListRoles(ctx, "", principalName, principalType)
+		if err != nil {
+			s.logger.Debug("failed to list Redpanda roles of principal", zap.String("principal", principal), zap.Error(err))
+			warnings = append(warnings, fmt.Sprintf("Redpanda roles are not considered because they could not be listed: %v", err.Error()))
+			break
+		}
+		for _, role := range roles.Roles {
+			roleNames = append(roleNames, role.Name)
+		}
+	}
+
+	return aclBindingsFromResponse(aclOverview.KafkaResponse), aclEffectivePrincipals(principal, roleNames), warnings, nil
+}
+
+// patterns returns the allowed and denied operations per resource pattern, sorted
+// by resource type and name.
+func (e *aclEvaluator) patterns() []PrincipalAccessPattern {
+	type patternKey struct {
+		resourceType kmsg.ACLResourceType
+		resourceName string
+		patternType  kmsg.ACLResourcePatternType
+	}
+	bindingsByPattern := make(map[patternKey][]*aclBinding)
+	for i := range e.bindings {
+		binding := &e.bindings[i]
+		key := patternKey{binding.resourceType, binding.resourceName, binding.patternType}
+		bindingsByPattern[key] = append(bindingsByPattern[key], binding)
+	}
+
+	patterns := make([]PrincipalAccessPattern, 0, len(bindingsByPattern))
+	for key, bindings := range bindingsByPattern {
+		pattern := PrincipalAccessPattern{
+			ResourceType:        key.resourceType.String(),
+			ResourceName:        key.resourceName,
+			ResourcePatternType: key.patternType.String(),
+			AllowedOperations:   make([]string, 0),
+			DeniedOperations:    make([]string, 0),
+		}
+		for _, operation := range aclOperationsByResourceType[key.resourceType] {
+			isAllowed, isDenied := false, false
+			for _, binding := range bindings {
+				if binding.appliesTo(operation) {
+					isAllowed = isAllowed || binding.permissionType == kmsg.ACLPermissionTypeAllow
+					isDenied = isDenied || binding.permissionType == kmsg.ACLPermissionTypeDeny
+				}
+			}
+			if isAllowed {
+				pattern.AllowedOperations = append(pattern.AllowedOperations, operation.String())
+			}
+			if isDenied {
+				pattern.DeniedOperations = append(pattern.DeniedOperations, operation.String())
+			}
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	sort.Slice(patterns, func(i, j int) bool {
+		if patterns[i].ResourceType != patterns[j].ResourceType {
+			return patterns[i].ResourceType < patterns[j].ResourceType
+		}
+		if patterns[i].ResourceName != patterns[j].ResourceName {
+			return patterns[i].ResourceName < patterns[j].ResourceName
+		}
+		return patterns[i].ResourcePatternType < patterns[j].ResourcePatternType
+	})
+	return patterns
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kerr"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+// RecordReference identifies a single Kafka record.
+type RecordReference struct {
+	TopicName   string `json:"topicName"`
+	PartitionID int32  `json:"partitionId"`
+	Offset      int64  `json:"offset"`
+}
+
+// CompareRecordsRequest is the request to compare two Kafka records.
+type CompareRecordsRequest struct {
+	Left  RecordReference
+	Right RecordReference
+
+	KeyDeserializer   serde.PayloadEncoding
+	ValueDeserializer serde.PayloadEncoding
+
+	// IgnoredFields are the field paths that shall be excluded from the comparison.
+	// If nil, the configured default ignored fields are used.
+	IgnoredFields []string
+}
+
+// CompareRecordsResponse contains both deserialized records along with
+// all differences between them.
+type CompareRecordsResponse struct {
+	Left  *kafka.TopicMessage `json:"left"`
+	Right *kafka.TopicMessage `json:"right"`
+
+	// IsEqual is true if there are no differences apart from the ignored fields.
+	IsEqual       bool               `json:"isEqual"`
+	Differences   []RecordDifference `json:"differences"`
+	IgnoredFields []string           `json:"ignoredFields"`
+}
+
+// CompareRecords fetches and deserializes the two referenced records and returns
+// a field level diff of their timestamps, keys, values and headers.
+func (s *Service) CompareRecords(ctx context.Context, req CompareRecordsRequest) (*CompareRecordsResponse, *rest.Error) {
+	left, restErr := s.fetchSingleMessage(ctx, req.Left, req.KeyDeserializer, req.ValueDeserializer)
+	if restErr != nil {
+		return nil, restErr
+	}
+	right, restErr := s.fetchSingleMessage(ctx, req.Right, req.KeyDeserializer, req.ValueDeserializer)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	ignoredFields := req.IgnoredFields
+	if ignoredFields == nil {
+		ignoredFields = s.kafkaSvc.Config.Console.RecordDiff.IgnoredFields
+	}
+
+	differences := diffRecords(recordDiffDocument(left), recordDiffDocument(right), ignoredFields)
+
+	return &CompareRecordsResponse{
+		Left:          left,
+		Right:         right,
+		IsEqual:       len(differences) == 0,
+		Differences:   differences,
+		IgnoredFields: ignoredFields,
+	}, nil
+}
+
+// fetchSingleMessage consumes and deserializes the record at the referenced offset.
+//
+//nolint:cyclop // many early returns for the different reasons why a record can not be found
+func (s *Service) fetchSingleMessage(ctx context.Context, ref RecordReference, keyDeserializer, valueDeserializer serde.PayloadEncoding) (*kafka.TopicMessage, *rest.Error) {
+	notFoundErr := func(reason string) *rest.Error {
+		return &rest.Error{
+			Err:      fmt.Errorf("record at topic %q, partition %d, offset %d not found: %v", ref.TopicName, ref.PartitionID, ref.Offset, reason),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("Record at topic '%v', partition '%d', offset '%d' not found: %v", ref.TopicName, ref.PartitionID, ref.Offset, reason),
+			IsSilent: false,
+		}
+	}
+	internalErr := func(err error) *rest.Error {
+		return &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to fetch record at topic '%v', partition '%d', offset '%d': %v", ref.TopicName, ref.PartitionID, ref.Offset, err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	metadata, restErr := s.kafkaSvc.GetSingleTopicMetadata(ctx, ref.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	partitionExists := false
+	for _, partition := range metadata.Partitions {
+		if partition.Partition != ref.PartitionID {
+			continue
+		}
+		if err := kerr.ErrorForCode(partition.ErrorCode); err != nil {
+			return nil, internalErr(fmt.Errorf("partition is not available: %w", err))
+		}
+		partitionExists = true
+	}
+	if !partitionExists {
+		return nil, notFoundErr("partition does not exist")
+	}
+
+	marks, err := s.kafkaSvc.GetPartitionMarks(ctx, ref.TopicName, []int32{ref.PartitionID})
+	if err != nil {
+		return nil, internalErr(fmt.Errorf("failed to get watermarks: %w", err))
+	}
+	mark, exists := marks[ref.PartitionID]
+	if !exists {
+		return nil, internalErr(errors.New("no watermarks returned for partition"))
+	}
+	if mark.Error != nil {
+		return nil, internalErr(fmt.Errorf("failed to get partition offsets: %w", mark.Error))
+	}
+	if ref.Offset < mark.Low || ref.Offset >= mark.High {
+		return nil, notFoundErr(fmt.Sprintf("offset is outside of the available range [%d, %d)", mark.Low, mark.High))
+	}
+
+	progress := &singleMessageProgress{}
+	err = s.kafkaSvc.FetchMessages(ctx, progress, kafka.TopicConsumeRequest{
+		TopicName:       ref.TopicName,
+		MaxMessageCount: 1,
+		Partitions: map[int32]*kafka.PartitionConsumeRequest{
+			ref.PartitionID: {
+				PartitionID:     ref.PartitionID,
+				LowWaterMark:    mark.Low,
+				HighWaterMark:   mark.High,
+				StartOffset:     ref.Offset,
+				EndOffset:       ref.Offset,
+				MaxMessageCount: 1,
+			},
+		},
+		KeyDeserializer:   keyDeserializer,
+		ValueDeserializer: valueDeserializer,
+	})
+	if err != nil {
+		return nil, internalErr(fmt.Errorf("failed to consume record: %w", err))
+	}
+	if progress.err != "" {
+		return nil, internalErr(errors.New(progress.err))
+	}
+
+	// The record may have been removed by compaction or the offset may belong
+	// to a control record. In both cases the consumer returns the next record.
+	if progress.message == nil || progress.message.Offset != ref.Offset {
+		return nil, notFoundErr("the record may have been compacted or is a control record")
+	}
+
+	return progress.message, nil
+}
+
+// singleMessageProgress collects the first consumed message.
+type singleMessageProgress struct {
+	message *kafka.TopicMessage
+	err     string
+}
+
+func (*singleMessageProgress) OnPhase(string) {}
+
+func (p *singleMessageProgress) OnMessage(message *kafka.TopicMessage) {
+	if p.message == nil {
+		p.message = message
+	}
+}
+
+func (*singleMessageProgress) OnMessageConsumed(int64) {}
+
+func (*singleMessageProgress) OnComplete(int64, bool) {}
+
+func (p *singleMessageProgress) OnError(msg string) {
+	p.err = msg
+}
@@ -45,7 +45,11 @@ type PartitionOffsets struct {
 //nolint:gocognit,cyclop // Consider using kadm's CalculateGroupLag. Works slightly different, required DescribedGroup.
 func (s *Service) getConsumerGroupOffsets(ctx context.Context, groups []string) (map[string][]GroupTopicOffsets, error) {
 	// 1. Fetch all Consumer Group Offsets for each Topic
-	fetchOffsetResponses := s.kafkaSvc.KafkaAdmClient.FetchManyOffsets(ctx, groups...)
+	adminClient, err := s.kafkaSvc.AdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fetchOffsetResponses := adminClient.FetchManyOffsets(ctx, groups...)
 	var lastErr error
 	fetchOffsetResponses.EachError(func(shardRes kadm.FetchOffsetsResponse) {
 		s.logger.Warn("failed to fetch group offset",
@@ -89,7 +93,7 @@ func (s *Service) getConsumerGroupOffsets(ctx context.Context, groups []string)
 	// Fetch all consumed topics and their partitions so that we know whose partitions we want the high watermarks for.
 	topicsWithOffsets := fetchOffsetResponses.CommittedPartitions().Topics()
 
-	metadata, err := s.kafkaSvc.KafkaAdmClient.Metadata(ctx, topicsWithOffsets...)
+	metadata, err := adminClient.Metadata(ctx, topicsWithOffsets...)
 	if err != nil {
 		s.logger.Error("failed to get topic metadata", zap.Strings("topics", topicsWithOffsets), zap.Error(err))
 		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
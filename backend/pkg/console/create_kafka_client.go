@@ -16,6 +16,7 @@ import (
 )
 
 // CreateKafkaClient returns a new Kafka client based on the existing Kafka configuration.
-func (s *Service) CreateKafkaClient(_ context.Context, additionalOpts ...kgo.Opt) (*kgo.Client, error) {
-	return s.kafkaSvc.NewKgoClient(additionalOpts...)
+// If impersonation is enabled, the client authenticates as the user of the request.
+func (s *Service) CreateKafkaClient(ctx context.Context, additionalOpts ...kgo.Opt) (*kgo.Client, error) {
+	return s.kafkaSvc.NewKgoClientForRequest(ctx, additionalOpts...)
 }
@@ -0,0 +1,303 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+)
+
+// Headers that Kafka connect adds to records in the dead letter queue, if
+// errors.deadletterqueue.context.headers.enable is set for the connector.
+const (
+	deadLetterHeaderPrefix           = "__connect.errors."
+	deadLetterHeaderTopic            = deadLetterHeaderPrefix + "topic"
+	deadLetterHeaderPartition        = deadLetterHeaderPrefix + "partition"
+	deadLetterHeaderOffset           = deadLetterHeaderPrefix + "offset"
+	deadLetterHeaderConnectorName    = deadLetterHeaderPrefix + "connector.name"
+	deadLetterHeaderTaskID           = deadLetterHeaderPrefix + "task.id"
+	deadLetterHeaderStage            = deadLetterHeaderPrefix + "stage"
+	deadLetterHeaderClassName        = deadLetterHeaderPrefix + "class.name"
+	deadLetterHeaderExceptionClass   = deadLetterHeaderPrefix + "exception.class.name"
+	deadLetterHeaderExceptionMessage = deadLetterHeaderPrefix + "exception.message"
+	deadLetterHeaderStacktrace       = deadLetterHeaderPrefix + "exception.stacktrace"
+)
+
+// DeadLetterError is the error context that Kafka connect attaches to a record
+// in the dead letter queue.
+type DeadLetterError struct {
+	OriginalTopic     string `json:"originalTopic,omitempty"`
+	OriginalPartition *int32 `json:"originalPartition,omitempty"`
+	OriginalOffset    *int64 `json:"originalOffset,omitempty"`
+	ConnectorName     string `json:"connectorName,omitempty"`
+	TaskID            *int32 `json:"taskId,omitempty"`
+	// Stage is the stage in which the record failed, e.g. VALUE_CONVERTER.
+	Stage            string `json:"stage,omitempty"`
+	ClassName        string `json:"className,omitempty"`
+	ExceptionClass   string `json:"exceptionClass,omitempty"`
+	ExceptionMessage string `json:"exceptionMessage,omitempty"`
+	Stacktrace       string `json:"stacktrace,omitempty"`
+
+	// HasContext is false if the record has no error context headers.
+	HasContext bool `json:"hasContext"`
+}
+
+// DeadLetterRecord is a record in the dead letter queue along with its error context.
+type DeadLetterRecord struct {
+	Message *kafka.TopicMessage `json:"message"`
+	Error   DeadLetterError     `json:"error"`
+}
+
+// DeadLetterErrorGroup groups the records of a dead letter queue that failed with
+// the same exception.
+type DeadLetterErrorGroup struct {
+	// ExceptionClass is empty for records without error context headers.
+	ExceptionClass string `json:"exceptionClass"`
+	// ExceptionMessage is the message of the most recent failure in this group.
+	ExceptionMessage string `json:"exceptionMessage"`
+	Count            int    `json:"count"`
+	// FirstTimestamp and LastTimestamp are the record timestamps in unix milliseconds.
+	FirstTimestamp int64                `json:"firstTimestamp"`
+	LastTimestamp  int64                `json:"lastTimestamp"`
+	Records        []DeadLetterRecordID `json:"records"`
+}
+
+// DeadLetterRecordID identifies a record in the dead letter queue topic.
+type DeadLetterRecordID struct {
+	PartitionID int32 `json:"partitionId"`
+	Offset      int64 `json:"offset"`
+}
+
+// ListDeadLetterRecordsRequest is the request to inspect the newest records of a
+// dead letter queue topic.
+type ListDeadLetterRecordsRequest struct {
+	TopicName  string
+	MaxResults int
+
+	Deserialization kafka.RecordDeserializationRequest
+}
+
+// ListDeadLetterRecordsResponse contains the newest records of a dead letter queue
+// topic along with the failures grouped by exception.
+type ListDeadLetterRecordsResponse struct {
+	TopicName string             `json:"topicName"`
+	Records   []DeadLetterRecord `json:"records"`
+	// Groups are sorted by the number of records, largest group first.
+	Groups []DeadLetterErrorGroup `json:"groups"`
+	// RecordsWithoutContext is the number of records without error context
+	// headers. These can not be replayed, because the original topic is unknown.
+	RecordsWithoutContext int `json:"recordsWithoutContext"`
+}
+
+// DeadLetterReplayRequest is the request to prepare records from the dead letter
+// queue for being produced to the topics they have originally been consumed from.
+type DeadLetterReplayRequest struct {
+	TopicName string
+	Records   []DeadLetterRecordID
+}
+
+// ListDeadLetterRecords reads the newest records of a dead letter queue topic and
+// decodes the error context headers that Kafka connect has added.
+func (s *Service) ListDeadLetterRecords(ctx context.Context, req ListDeadLetterRecordsRequest) (*ListDeadLetterRecordsResponse, *rest.Error) {
+	metadata, restErr := s.kafkaSvc.GetSingleTopicMetadata(ctx, req.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	partitionIDs := make([]int32, len(metadata.Partitions))
+	for i, partition := range metadata.Partitions {
+		partitionIDs[i] = partition.Partition
+	}
+
+	records, err := s.kafkaSvc.FetchNewestRecords(ctx, req.TopicName, partitionIDs, req.MaxResults)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to read dead letter queue topic: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	res := &ListDeadLetterRecordsResponse{
+		TopicName: req.TopicName,
+		Records:   make([]DeadLetterRecord, len(records)),
+	}
+	for i, record := range records {
+		dlqErr := parseDeadLetterHeaders(record.Headers)
+		if !dlqErr.HasContext {
+			res.RecordsWithoutContext++
+		}
+		res.Records[i] = DeadLetterRecord{
+			Message: s.kafkaSvc.DeserializeTopicMessage(ctx, record, req.Deserialization),
+			Error:   dlqErr,
+		}
+	}
+	res.Groups = groupDeadLetterRecords(res.Records)
+
+	return res, nil
+}
+
+// GetDeadLetterReplayRecords reads the selected records of a dead letter queue and
+// returns the records to replay them to their original topic and partition, with
+// their original key, value and headers. The error context headers are removed.
+// The records can be produced with ProduceRecords once the requester has been
+// authorized to publish to the original topics.
+func (s *Service) GetDeadLetterReplayRecords(ctx context.Context, req DeadLetterReplayRequest) ([]*kgo.Record, *rest.Error) {
+	records := make([]*kgo.Record, len(req.Records))
+	for i, id := range req.Records {
+		record, err := s.kafkaSvc.FetchRecordAtOffset(ctx, req.TopicName, id.PartitionID, id.Offset)
+		if err != nil {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to read record at partition %d offset %d: %v", id.PartitionID, id.Offset, err.Error()),
+				IsSilent: false,
+			}
+		}
+		if record == nil {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("record at partition %d offset %d does not exist", id.PartitionID, id.Offset),
+				Status:   http.StatusNotFound,
+				Message:  fmt.Sprintf("Record at partition %d offset %d does not exist in topic '%v'", id.PartitionID, id.Offset, req.TopicName),
+				IsSilent: false,
+			}
+		}
+
+		replay, err := deadLetterRecordToReplay(record)
+		if err != nil {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusBadRequest,
+				Message:  fmt.Sprintf("Record at partition %d offset %d can not be replayed: %v", id.PartitionID, id.Offset, err.Error()),
+				IsSilent: false,
+			}
+		}
+		records[i] = replay
+	}
+
+	return records, nil
+}
+
+// deadLetterRecordToReplay creates the record that is produced to the original topic.
+func deadLetterRecordToReplay(record *kgo.Record) (*kgo.Record, error) {
+	dlqErr := parseDeadLetterHeaders(record.Headers)
+	if dlqErr.OriginalTopic == "" {
+		return nil, fmt.Errorf("the original topic is unknown, because the record has no %q header", deadLetterHeaderTopic)
+	}
+
+	partition := int32(-1)
+	if dlqErr.OriginalPartition != nil {
+		partition = *dlqErr.OriginalPartition
+	}
+	headers := make([]kgo.RecordHeader, 0, len(record.Headers))
+	for _, header := range record.Headers {
+		if !strings.HasPrefix(header.Key, deadLetterHeaderPrefix) {
+			headers = append(headers, header)
+		}
+	}
+
+	return &kgo.Record{
+		Topic:     dlqErr.OriginalTopic,
+		Partition: partition,
+		Key:       record.Key,
+		Value:     record.Value,
+		Headers:   headers,
+	}, nil
+}
+
+func parseDeadLetterHeaders(headers []kgo.RecordHeader) DeadLetterError {
+	var dlqErr DeadLetterError
+	for _, header := range headers {
+		if !strings.HasPrefix(header.Key, deadLetterHeaderPrefix) {
+			continue
+		}
+		dlqErr.HasContext = true
+
+		value := string(header.Value)
+		switch header.Key {
+		case deadLetterHeaderTopic:
+			dlqErr.OriginalTopic = value
+		case deadLetterHeaderPartition:
+			if partition, err := strconv.ParseInt(value, 10, 32); err == nil {
+				p := int32(partition)
+				dlqErr.OriginalPartition = &p
+			}
+		case deadLetterHeaderOffset:
+			if offset, err := strconv.ParseInt(value, 10, 64); err == nil {
+				dlqErr.OriginalOffset = &offset
+			}
+		case deadLetterHeaderConnectorName:
+			dlqErr.ConnectorName = value
+		case deadLetterHeaderTaskID:
+			if taskID, err := strconv.ParseInt(value, 10, 32); err == nil {
+				id := int32(taskID)
+				dlqErr.TaskID = &id
+			}
+		case deadLetterHeaderStage:
+			dlqErr.Stage = value
+		case deadLetterHeaderClassName:
+			dlqErr.ClassName = value
+		case deadLetterHeaderExceptionClass:
+			dlqErr.ExceptionClass = value
+		case deadLetterHeaderExceptionMessage:
+			dlqErr.ExceptionMessage = value
+		case deadLetterHeaderStacktrace:
+			dlqErr.Stacktrace = value
+		}
+	}
+	return dlqErr
+}
+
+// groupDeadLetterRecords groups the records by exception class. The records must
+// be sorted newest first.
+func groupDeadLetterRecords(records []DeadLetterRecord) []DeadLetterErrorGroup {
+	groupsByException := make(map[string]*DeadLetterErrorGroup)
+	for _, record := range records {
+		group, exists := groupsByException[record.Error.ExceptionClass]
+		if !exists {
+			group = &DeadLetterErrorGroup{
+				ExceptionClass:   record.Error.ExceptionClass,
+				ExceptionMessage: record.Error.ExceptionMessage,
+				FirstTimestamp:   record.Message.Timestamp,
+				LastTimestamp:    record.Message.Timestamp,
+			}
+			groupsByException[record.Error.ExceptionClass] = group
+		}
+		group.Count++
+		group.FirstTimestamp = min(group.FirstTimestamp, record.Message.Timestamp)
+		group.LastTimestamp = max(group.LastTimestamp, record.Message.Timestamp)
+		group.Records = append(group.Records, DeadLetterRecordID{
+			PartitionID: record.Message.PartitionID,
+			Offset:      record.Message.Offset,
+		})
+	}
+
+	groups := make([]DeadLetterErrorGroup, 0, len(groupsByException))
+	for _, group := range groupsByException {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].ExceptionClass < groups[j].ExceptionClass
+	})
+
+	return groups
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+)
+
+func deadLetterHeaders(topic, partition, offset, exceptionClass, exceptionMessage string) []kgo.RecordHeader {
+	return []kgo.RecordHeader{
+		{Key: "trace-id", Value: []byte("abc")},
+		{Key: deadLetterHeaderTopic, Value: []byte(topic)},
+		{Key: deadLetterHeaderPartition, Value: []byte(partition)},
+		{Key: deadLetterHeaderOffset, Value: []byte(offset)},
+		{Key: deadLetterHeaderConnectorName, Value: []byte("orders-sink")},
+		{Key: deadLetterHeaderTaskID, Value: []byte("0")},
+		{Key: deadLetterHeaderStage, Value: []byte("VALUE_CONVERTER")},
+		{Key: deadLetterHeaderExceptionClass, Value: []byte(exceptionClass)},
+		{Key: deadLetterHeaderExceptionMessage, Value: []byte(exceptionMessage)},
+		{Key: deadLetterHeaderStacktrace, Value: []byte("org.apache.kafka.connect.errors.DataException: ...")},
+	}
+}
+
+func TestParseDeadLetterHeaders(t *testing.T) {
+	dlqErr := parseDeadLetterHeaders(deadLetterHeaders("orders", "3", "1234", "org.apache.kafka.connect.errors.DataException", "Unknown magic byte!"))
+	require.True(t, dlqErr.HasContext)
+	assert.Equal(t, "orders", dlqErr.OriginalTopic)
+	require.NotNil(t, dlqErr.OriginalPartition)
+	assert.Equal(t, int32(3), *dlqErr.OriginalPartition)
+	require.NotNil(t, dlqErr.OriginalOffset)
+	assert.Equal(t, int64(1234), *dlqErr.OriginalOffset)
+	assert.Equal(t, "orders-sink", dlqErr.ConnectorName)
+	require.NotNil(t, dlqErr.TaskID)
+	assert.Equal(t, int32(0), *dlqErr.TaskID)
+	assert.Equal(t, "VALUE_CONVERTER", dlqErr.Stage)
+	assert.Equal(t, "org.apache.kafka.connect.errors.DataException", dlqErr.ExceptionClass)
+	assert.Equal(t, "Unknown magic byte!", dlqErr.ExceptionMessage)
+	assert.NotEmpty(t, dlqErr.Stacktrace)
+
+	assert.False(t, parseDeadLetterHeaders([]kgo.RecordHeader{{Key: "trace-id", Value: []byte("abc")}}).HasContext)
+}
+
+func TestGroupDeadLetterRecords(t *testing.T) {
+	newRecord := func(offset, timestamp int64, exceptionClass, exceptionMessage string) DeadLetterRecord {
+		return DeadLetterRecord{
+			Message: &kafka.TopicMessage{PartitionID: 0, Offset: offset, Timestamp: timestamp},
+			Error:   DeadLetterError{ExceptionClass: exceptionClass, ExceptionMessage: exceptionMessage},
+		}
+	}
+
+	// Records are sorted newest first
+	groups := groupDeadLetterRecords([]DeadLetterRecord{
+		newRecord(4, 400, "DataException", "Unknown magic byte!"),
+		newRecord(3, 300, "NullPointerException", ""),
+		newRecord(2, 200, "DataException", "Failed to deserialize"),
+		newRecord(1, 100, "DataException", "Failed to deserialize"),
+	})
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, DeadLetterErrorGroup{
+		ExceptionClass:   "DataException",
+		ExceptionMessage: "Unknown magic byte!",
+		Count:            3,
+		FirstTimestamp:   100,
+		LastTimestamp:    400,
+		Records:          []DeadLetterRecordID{{Offset: 4}, {Offset: 2}, {Offset: 1}},
+	}, groups[0])
+	assert.Equal(t, "NullPointerException", groups[1].ExceptionClass)
+	assert.Equal(t, 1, groups[1].Count)
+}
+
+func TestDeadLetterRecordToReplay(t *testing.T) {
+	record := &kgo.Record{
+		Topic:   "orders-dlq",
+		Key:     []byte("order-1"),
+		Value:   []byte(`{"id":1}`),
+		Headers: deadLetterHeaders("orders", "3", "1234", "DataException", ""),
+	}
+
+	replay, err := deadLetterRecordToReplay(record)
+	require.NoError(t, err)
+	assert.Equal(t, &kgo.Record{
+		Topic:     "orders",
+		Partition: 3,
+		Key:       []byte("order-1"),
+		Value:     []byte(`{"id":1}`),
+		Headers:   []kgo.RecordHeader{{Key: "trace-id", Value: []byte("abc")}},
+	}, replay)
+
+	_, err = deadLetterRecordToReplay(&kgo.Record{Topic: "orders-dlq", Value: []byte("x")})
+	assert.Error(t, err)
+}
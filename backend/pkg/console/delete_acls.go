@@ -29,8 +29,14 @@ type DeleteACLsResponse struct {
 
 // DeleteACLs deletes Kafka ACLs based on a given filter.
 func (s *Service) DeleteACLs(ctx context.Context, filter kmsg.DeleteACLsRequestFilter) (DeleteACLsResponse, *rest.Error) {
+	return s.DeleteACLsWithFilters(ctx, []kmsg.DeleteACLsRequestFilter{filter})
+}
+
+// DeleteACLsWithFilters deletes all Kafka ACLs that match any of the given filters
+// with a single request.
+func (s *Service) DeleteACLsWithFilters(ctx context.Context, filters []kmsg.DeleteACLsRequestFilter) (DeleteACLsResponse, *rest.Error) {
 	req := kmsg.NewDeleteACLsRequest()
-	req.Filters = []kmsg.DeleteACLsRequestFilter{filter}
+	req.Filters = filters
 
 	res, err := s.kafkaSvc.DeleteACLs(ctx, &req)
 	if err != nil {
@@ -38,7 +44,7 @@ func (s *Service) DeleteACLs(ctx context.Context, filter kmsg.DeleteACLsRequestF
 			Err:          err,
 			Status:       http.StatusServiceUnavailable,
 			Message:      fmt.Sprintf("Failed to execute delete topic command: %v", err.Error()),
-			InternalLogs: []zapcore.Field{zap.Any("delete_acl_req", filter)},
+			InternalLogs: []zapcore.Field{zap.Any("delete_acl_req", filters)},
 			IsSilent:     false,
 		}
 	}
@@ -55,7 +61,7 @@ func (s *Service) DeleteACLs(ctx context.Context, filter kmsg.DeleteACLsRequestF
 				Err:          err,
 				Status:       http.StatusServiceUnavailable,
 				Message:      fmt.Sprintf("Failed to delete Kafka ACL: %v", err.Error()),
-				InternalLogs: []zapcore.Field{zap.Any("delete_acl_req", filter)},
+				InternalLogs: []zapcore.Field{zap.Any("delete_acl_req", filters)},
 				IsSilent:     false,
 			}
 		}
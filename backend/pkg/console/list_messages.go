@@ -345,7 +345,11 @@ func (s *Service) requestOffsetsByTimestamp(ctx context.Context, topicName strin
 	topicReq.Partitions = partitionReqs
 	req.Topics = []kmsg.ListOffsetsRequestTopic{topicReq}
 
-	kres, err := req.RequestWith(ctx, s.kafkaSvc.KafkaClient)
+	client, err := s.kafkaSvc.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kres, err := req.RequestWith(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -138,14 +138,18 @@ func (p partitionInfo) Size() int64 {
 //nolint:gocognit,cyclop // Complexity is indeed high, but ideally this will be solved by changing the API response
 func (s *Service) logDirsByTopic(ctx context.Context) (map[string]TopicLogDirSummary, error) {
 	// 1. Retrieve metadata to know brokers hosting each replica.
-	metadata, err := s.kafkaSvc.KafkaAdmClient.Metadata(ctx)
+	adminClient, err := s.kafkaSvc.AdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := adminClient.Metadata(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve metadata: %w", err)
 	}
 
 	// 2. Request log dirs from all brokers and deduplicate shared log dirs.
 	shardErrors := make(map[int32]kadm.ShardError)
-	describedLogDirs, err := s.kafkaSvc.KafkaAdmClient.DescribeAllLogDirs(ctx, nil)
+	describedLogDirs, err := adminClient.DescribeAllLogDirs(ctx, nil)
 	if err != nil {
 		var se *kadm.ShardErrors
 		if !errors.As(err, &se) {
@@ -0,0 +1,302 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kerr"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+// LookupRecordsByKeyRequest is the request to find the latest record and the history
+// of a given record key.
+type LookupRecordsByKeyRequest struct {
+	TopicName string
+
+	// Key is serialized with the given encoding to the bytes that are compared
+	// with the record keys.
+	Key serde.RecordPayloadInput
+
+	// Partitioner that has been used to produce the records. If empty, the
+	// configured partitioner for the topic is used.
+	Partitioner string
+
+	// MaxResults is the maximum number of records (latest value plus history) that
+	// shall be returned.
+	MaxResults int
+
+	Deserialization kafka.RecordDeserializationRequest
+}
+
+// LookupRecordsByKeyResponse contains the latest record along with the history for a given key.
+type LookupRecordsByKeyResponse struct {
+	TopicName   string `json:"topicName"`
+	PartitionID int32  `json:"partitionId"`
+	Partitioner string `json:"partitioner"`
+
+	// SerializedKey is the key that has been searched for.
+	SerializedKey []byte `json:"serializedKey"`
+
+	// LatestMessage is the most recent record with the given key. It is nil if
+	// no record with the given key has been found.
+	LatestMessage *kafka.TopicMessage `json:"latestMessage"`
+
+	// IsDeleted is true if the latest record is a tombstone.
+	IsDeleted bool `json:"isDeleted"`
+
+	// History contains all found records with the given key, newest first. The
+	// first entry is the latest message.
+	History []*kafka.TopicMessage `json:"history"`
+
+	// ScannedOffsets is the number of offsets that have been scanned.
+	ScannedOffsets int64 `json:"scannedOffsets"`
+
+	// IsHistoryComplete is true if the whole partition has been scanned, and therefore
+	// there are no older records with the given key.
+	IsHistoryComplete bool `json:"isHistoryComplete"`
+}
+
+// LookupRecordsByKey serializes the key, computes the partition the key is produced to
+// and scans only that partition, newest first, for records with the same key.
+func (s *Service) LookupRecordsByKey(ctx context.Context, req LookupRecordsByKeyRequest) (*LookupRecordsByKeyResponse, *rest.Error) {
+	serializedKey, restErr := s.serializeLookupKey(ctx, req.TopicName, req.Key)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	metadata, restErr := s.kafkaSvc.GetSingleTopicMetadata(ctx, req.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	partitioner := req.Partitioner
+	if partitioner == "" {
+		partitioner = s.kafkaSvc.Config.Kafka.KeyLookup.PartitionerForTopic(req.TopicName)
+	}
+	partitionID, err := kafka.PartitionForKey(partitioner, serializedKey, len(metadata.Partitions))
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to compute the partition for the given key: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	for _, partition := range metadata.Partitions {
+		if partition.Partition != partitionID {
+			continue
+		}
+		if err := kerr.ErrorForCode(partition.ErrorCode); err != nil {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusServiceUnavailable,
+				Message:  fmt.Sprintf("Partition '%d' of topic '%v' is not available: %v", partitionID, req.TopicName, err.Error()),
+				IsSilent: false,
+			}
+		}
+	}
+
+	res, err := s.kafkaSvc.FetchMessagesByKey(ctx, kafka.KeyLookupRequest{
+		TopicName:       req.TopicName,
+		PartitionID:     partitionID,
+		Key:             serializedKey,
+		MaxResults:      req.MaxResults,
+		MaxScanOffsets:  s.kafkaSvc.Config.Kafka.KeyLookup.MaxScanRecords,
+		Deserialization: req.Deserialization,
+	})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to look up records by key: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	response := &LookupRecordsByKeyResponse{
+		TopicName:         req.TopicName,
+		PartitionID:       partitionID,
+		Partitioner:       partitioner,
+		SerializedKey:     serializedKey,
+		History:           res.Messages,
+		ScannedOffsets:    res.ScannedOffsets,
+		IsHistoryComplete: res.IsScanComplete,
+	}
+	if len(res.Messages) > 0 {
+		response.LatestMessage = res.Messages[0]
+		response.IsDeleted = res.Messages[0].Value == nil || res.Messages[0].Value.IsPayloadNull
+	}
+
+	return response, nil
+}
+
+// ListLatestValuesRequest is the request to browse the latest value of each key
+// of a compacted topic.
+type ListLatestValuesRequest struct {
+	TopicName string
+	PageSize  int
+
+	// PageToken is the NextPageToken of the previous page. Empty for the first page.
+	PageToken string
+
+	Deserialization kafka.RecordDeserializationRequest
+}
+
+// ListLatestValuesResponse is one page of the materialized key/value view of a compacted topic.
+type ListLatestValuesResponse struct {
+	TopicName string `json:"topicName"`
+
+	// Messages are ordered by partition and from the newest to the oldest record.
+	Messages []*kafka.TopicMessage `json:"messages"`
+
+	// NextPageToken must be passed to request the next page. It is empty if this is
+	// the last page.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+
+	// ScannedOffsets is the number of offsets that have been scanned for this page.
+	ScannedOffsets int64 `json:"scannedOffsets"`
+
+	// IsComplete is false if the scan limit has been reached in one of the partitions
+	// so far. In that case keys that have not been written to recently may be missing.
+	IsComplete bool `json:"isComplete"`
+}
+
+// ListLatestValues materializes the latest value of each key of a compacted topic,
+// as a consumer would after reading the whole topic, and returns one page of it.
+// Each page continues scanning where the previous page stopped, the position is
+// encoded in the page token.
+func (s *Service) ListLatestValues(ctx context.Context, req ListLatestValuesRequest) (*ListLatestValuesResponse, *rest.Error) {
+	topicConfig, restErr := s.GetTopicConfigs(ctx, req.TopicName, []string{"cleanup.policy"})
+	if restErr != nil {
+		return nil, restErr
+	}
+	var cleanupPolicy *TopicConfigEntry
+	if topicConfig != nil {
+		cleanupPolicy = topicConfig.GetConfigEntryByName("cleanup.policy")
+	}
+	if cleanupPolicy == nil || cleanupPolicy.Value == nil || !strings.Contains(*cleanupPolicy.Value, "compact") {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("topic %q is not compacted", req.TopicName),
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("The latest value view is only available for compacted topics, but topic '%v' is not compacted", req.TopicName),
+			IsSilent: false,
+		}
+	}
+
+	var cursor *kafka.LatestValuesCursor
+	if req.PageToken != "" {
+		token, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+		if err == nil {
+			err = json.Unmarshal(token, &cursor)
+		}
+		if err == nil && (cursor == nil || cursor.PartitionIndex < 0 || cursor.PartitionIndex >= len(cursor.Partitions)) {
+			err = errors.New("page token refers to an unknown partition")
+		}
+		if err != nil {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("failed to decode page token: %w", err),
+				Status:   http.StatusBadRequest,
+				Message:  "The given page token is invalid",
+				IsSilent: false,
+			}
+		}
+	}
+
+	metadata, restErr := s.kafkaSvc.GetSingleTopicMetadata(ctx, req.TopicName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	partitionIDs := make([]int32, 0, len(metadata.Partitions))
+	for _, partition := range metadata.Partitions {
+		if err := kerr.ErrorForCode(partition.ErrorCode); err != nil {
+			return nil, &rest.Error{
+				Err:      err,
+				Status:   http.StatusServiceUnavailable,
+				Message:  fmt.Sprintf("Partition '%d' of topic '%v' is not available: %v", partition.Partition, req.TopicName, err.Error()),
+				IsSilent: false,
+			}
+		}
+		partitionIDs = append(partitionIDs, partition.Partition)
+	}
+
+	res, err := s.kafkaSvc.FetchLatestValues(ctx, kafka.LatestValuesRequest{
+		TopicName:       req.TopicName,
+		PartitionIDs:    partitionIDs,
+		PageSize:        req.PageSize,
+		Cursor:          cursor,
+		MaxScanOffsets:  s.kafkaSvc.Config.Kafka.KeyLookup.MaxScanRecords,
+		Deserialization: req.Deserialization,
+	})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to list latest values: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	response := &ListLatestValuesResponse{
+		TopicName:      req.TopicName,
+		Messages:       res.Messages,
+		ScannedOffsets: res.ScannedOffsets,
+		IsComplete:     res.IsScanComplete,
+	}
+	if res.NextCursor != nil {
+		token, err := json.Marshal(res.NextCursor)
+		if err != nil {
+			return nil, &rest.Error{
+				Err:      fmt.Errorf("failed to encode page token: %w", err),
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to encode page token: %v", err.Error()),
+				IsSilent: false,
+			}
+		}
+		response.NextPageToken = base64.RawURLEncoding.EncodeToString(token)
+	}
+
+	return response, nil
+}
+
+func (s *Service) serializeLookupKey(ctx context.Context, topicName string, key serde.RecordPayloadInput) ([]byte, *rest.Error) {
+	serialized, err := s.kafkaSvc.SerdeService.SerializeRecord(ctx, serde.SerializeInput{
+		Topic: topicName,
+		Key:   key,
+		Value: serde.RecordPayloadInput{Encoding: serde.PayloadEncodingNull},
+	})
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusBadRequest,
+			Message:  fmt.Sprintf("Failed to serialize the given key: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	if serialized.Key.Payload == nil {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("key serialized to null"),
+			Status:   http.StatusBadRequest,
+			Message:  "Records with a null key can not be looked up by key",
+			IsSilent: false,
+		}
+	}
+
+	return serialized.Key.Payload, nil
+}
@@ -0,0 +1,474 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+)
+
+// MirrorMakerOverview describes the MirrorMaker 2 replication into the connected
+// cluster, based on its heartbeat and checkpoint topics.
+type MirrorMakerOverview struct {
+	// ReplicationFlows are sorted by source cluster alias.
+	ReplicationFlows []MirrorMakerReplicationFlow `json:"replicationFlows"`
+	// Heartbeats contains the latest heartbeat of each source and target cluster
+	// alias pair in each heartbeats topic.
+	Heartbeats []MirrorMakerHeartbeat `json:"heartbeats"`
+	// TopicMappings are sorted by source cluster alias and topic name.
+	TopicMappings []MirrorMakerTopicMapping `json:"topicMappings"`
+
+	// IsComplete is false if the scan limit has been reached before all heartbeat
+	// and checkpoint topics have been scanned completely.
+	IsComplete bool `json:"isComplete"`
+}
+
+// MirrorMakerReplicationFlow is the replication from a source cluster into the
+// connected cluster.
+type MirrorMakerReplicationFlow struct {
+	SourceClusterAlias string `json:"sourceClusterAlias"`
+
+	// HeartbeatsTopic is the replicated heartbeats topic of the source cluster. It is
+	// empty if the heartbeats topic is not replicated.
+	HeartbeatsTopic string `json:"heartbeatsTopic,omitempty"`
+	// LastHeartbeatTimestamp is the timestamp of the newest replicated heartbeat in
+	// unix milliseconds.
+	LastHeartbeatTimestamp *int64 `json:"lastHeartbeatTimestamp,omitempty"`
+	// LatencyMs is the time that has passed since the newest replicated heartbeat
+	// has been emitted in the source cluster. It includes the heartbeat interval
+	// (emit.heartbeats.interval.seconds) on top of the replication latency.
+	LatencyMs *int64 `json:"latencyMs,omitempty"`
+
+	// CheckpointsTopic is empty if MirrorCheckpointConnector does not write
+	// checkpoints for this source cluster.
+	CheckpointsTopic     string   `json:"checkpointsTopic,omitempty"`
+	CheckpointedGroups   []string `json:"checkpointedGroups"`
+	ReplicatedTopicCount int      `json:"replicatedTopicCount"`
+}
+
+// MirrorMakerHeartbeat is the latest heartbeat that has been read from a heartbeats topic.
+type MirrorMakerHeartbeat struct {
+	TopicName          string `json:"topicName"`
+	SourceClusterAlias string `json:"sourceClusterAlias"`
+	TargetClusterAlias string `json:"targetClusterAlias"`
+	// Timestamp is the time the heartbeat has been emitted in unix milliseconds.
+	Timestamp int64 `json:"timestamp"`
+	LatencyMs int64 `json:"latencyMs"`
+}
+
+// MirrorMakerTopicMapping maps a topic of a source cluster to the replicated topic
+// in the connected cluster.
+type MirrorMakerTopicMapping struct {
+	SourceClusterAlias string `json:"sourceClusterAlias"`
+	SourceTopicName    string `json:"sourceTopicName"`
+	TargetTopicName    string `json:"targetTopicName"`
+}
+
+// TranslateConsumerGroupOffsetsRequest is the request to translate the offsets that a
+// consumer group has committed in a source cluster to the offsets of the replicated
+// topics in the connected cluster.
+type TranslateConsumerGroupOffsetsRequest struct {
+	SourceClusterAlias string
+	GroupID            string
+
+	// TopicNames restricts the translation to the given replicated topics. All
+	// checkpointed topics are translated if empty.
+	TopicNames []string
+}
+
+// TranslateConsumerGroupOffsetsResponse contains the translated offsets of a consumer group.
+type TranslateConsumerGroupOffsetsResponse struct {
+	SourceClusterAlias string `json:"sourceClusterAlias"`
+	GroupID            string `json:"groupId"`
+	CheckpointsTopic   string `json:"checkpointsTopic"`
+	// Topics are sorted by name.
+	Topics []TranslatedConsumerGroupOffsetsTopic `json:"topics"`
+
+	// IsComplete is false if the scan limit has been reached before the checkpoints
+	// topic has been scanned completely. Partitions that have not been checkpointed
+	// recently may be missing.
+	IsComplete bool `json:"isComplete"`
+}
+
+// TranslatedConsumerGroupOffsetsTopic contains the translated offsets of a replicated topic.
+type TranslatedConsumerGroupOffsetsTopic struct {
+	// TopicName is the name of the replicated topic in the connected cluster.
+	TopicName string `json:"topicName"`
+	// Partitions are sorted by partition ID.
+	Partitions []TranslatedConsumerGroupOffsetsPartition `json:"partitions"`
+}
+
+// TranslatedConsumerGroupOffsetsPartition is the translated offset of a single partition.
+type TranslatedConsumerGroupOffsetsPartition struct {
+	PartitionID int32 `json:"partitionId"`
+	// UpstreamOffset is the offset the group has committed in the source cluster.
+	UpstreamOffset int64 `json:"upstreamOffset"`
+	// DownstreamOffset is the translated offset in the connected cluster.
+	DownstreamOffset int64 `json:"downstreamOffset"`
+	// CurrentOffset is the offset the group has currently committed in the connected
+	// cluster. It is nil if the group has not committed an offset for this partition.
+	CurrentOffset *int64 `json:"currentOffset,omitempty"`
+}
+
+// mirrorMakerTopics are the MirrorMaker 2 topics of the connected cluster.
+type mirrorMakerTopics struct {
+	heartbeats  []mirrorMakerTopicPartitions
+	checkpoints []mirrorMakerTopicPartitions
+	mappings    []MirrorMakerTopicMapping
+}
+
+type mirrorMakerTopicPartitions struct {
+	name         string
+	sourceAlias  string
+	partitionIDs []int32
+}
+
+// GetMirrorMakerOverview reads the heartbeat and checkpoint topics of the connected
+// cluster to describe all MirrorMaker 2 replication flows into this cluster.
+//
+//nolint:gocognit,cyclop // merging heartbeats, checkpoints and topic mappings per flow
+func (s *Service) GetMirrorMakerOverview(ctx context.Context) (*MirrorMakerOverview, *rest.Error) {
+	topics, restErr := s.getMirrorMakerTopics(ctx)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	res := &MirrorMakerOverview{
+		ReplicationFlows: make([]MirrorMakerReplicationFlow, 0),
+		Heartbeats:       make([]MirrorMakerHeartbeat, 0),
+		TopicMappings:    topics.mappings,
+		IsComplete:       true,
+	}
+	flowsByAlias := make(map[string]*MirrorMakerReplicationFlow)
+	getFlow := func(alias string) *MirrorMakerReplicationFlow {
+		flow, exists := flowsByAlias[alias]
+		if !exists {
+			flow = &MirrorMakerReplicationFlow{SourceClusterAlias: alias, CheckpointedGroups: make([]string, 0)}
+			flowsByAlias[alias] = flow
+		}
+		return flow
+	}
+
+	now := time.Now().UnixMilli()
+	for _, topic := range topics.heartbeats {
+		var flow *MirrorMakerReplicationFlow
+		if topic.sourceAlias != "" {
+			flow = getFlow(topic.sourceAlias)
+			flow.HeartbeatsTopic = topic.name
+		}
+		isComplete, restErr := s.scanMirrorMakerRecords(ctx, topic, func(record *kgo.Record) *rest.Error {
+			heartbeat, err := decodeMirrorMakerHeartbeat(record.Key, record.Value)
+			if err != nil {
+				s.logger.Debug("skipping undecodable mirror maker heartbeat",
+					zap.String("topic_name", topic.name),
+					zap.Int32("partition_id", record.Partition),
+					zap.Int64("offset", record.Offset),
+					zap.Error(err))
+				return nil
+			}
+			latency := max(now-heartbeat.Timestamp, 0)
+			res.Heartbeats = append(res.Heartbeats, MirrorMakerHeartbeat{
+				TopicName:          topic.name,
+				SourceClusterAlias: heartbeat.SourceClusterAlias,
+				TargetClusterAlias: heartbeat.TargetClusterAlias,
+				Timestamp:          heartbeat.Timestamp,
+				LatencyMs:          latency,
+			})
+
+			if flow == nil {
+				// Local heartbeats are emitted by the heartbeat connector of the flow
+				// into this cluster, but they are not replicated from the source cluster.
+				getFlow(heartbeat.SourceClusterAlias)
+				return nil
+			}
+			if flow.LastHeartbeatTimestamp == nil || *flow.LastHeartbeatTimestamp < heartbeat.Timestamp {
+				timestamp := heartbeat.Timestamp
+				flow.LastHeartbeatTimestamp = &timestamp
+				flow.LatencyMs = &latency
+			}
+			return nil
+		})
+		if restErr != nil {
+			return nil, restErr
+		}
+		res.IsComplete = res.IsComplete && isComplete
+	}
+
+	for _, topic := range topics.checkpoints {
+		flow := getFlow(topic.sourceAlias)
+		flow.CheckpointsTopic = topic.name
+		isComplete, restErr := s.scanMirrorMakerRecords(ctx, topic, func(record *kgo.Record) *rest.Error {
+			checkpoint, err := decodeMirrorMakerCheckpoint(record.Key, record.Value)
+			if err != nil {
+				s.logger.Debug("skipping undecodable mirror maker checkpoint",
+					zap.String("topic_name", topic.name),
+					zap.Int32("partition_id", record.Partition),
+					zap.Int64("offset", record.Offset),
+					zap.Error(err))
+				return nil
+			}
+			if !slices.Contains(flow.CheckpointedGroups, checkpoint.GroupID) {
+				flow.CheckpointedGroups = append(flow.CheckpointedGroups, checkpoint.GroupID)
+			}
+			return nil
+		})
+		if restErr != nil {
+			return nil, restErr
+		}
+		res.IsComplete = res.IsComplete && isComplete
+		sort.Strings(flow.CheckpointedGroups)
+	}
+
+	for _, mapping := range topics.mappings {
+		getFlow(mapping.SourceClusterAlias).ReplicatedTopicCount++
+	}
+	for _, flow := range flowsByAlias {
+		res.ReplicationFlows = append(res.ReplicationFlows, *flow)
+	}
+	sort.Slice(res.ReplicationFlows, func(i, j int) bool {
+		return res.ReplicationFlows[i].SourceClusterAlias < res.ReplicationFlows[j].SourceClusterAlias
+	})
+	sort.Slice(res.Heartbeats, func(i, j int) bool {
+		if res.Heartbeats[i].TopicName != res.Heartbeats[j].TopicName {
+			return res.Heartbeats[i].TopicName < res.Heartbeats[j].TopicName
+		}
+		if res.Heartbeats[i].SourceClusterAlias != res.Heartbeats[j].SourceClusterAlias {
+			return res.Heartbeats[i].SourceClusterAlias < res.Heartbeats[j].SourceClusterAlias
+		}
+		return res.Heartbeats[i].TargetClusterAlias < res.Heartbeats[j].TargetClusterAlias
+	})
+
+	return res, nil
+}
+
+// TranslateConsumerGroupOffsets translates the offsets of a consumer group in the source
+// cluster to the offsets of the replicated topics in the connected cluster, based on the
+// latest checkpoints that MirrorCheckpointConnector has written.
+func (s *Service) TranslateConsumerGroupOffsets(ctx context.Context, req TranslateConsumerGroupOffsetsRequest) (*TranslateConsumerGroupOffsetsResponse, *rest.Error) {
+	checkpointsTopic := req.SourceClusterAlias + mirrorMakerCheckpointsTopicSuffix
+	metadata, restErr := s.kafkaSvc.GetSingleTopicMetadata(ctx, checkpointsTopic)
+	if restErr != nil {
+		return nil, restErr
+	}
+	partitionIDs := make([]int32, len(metadata.Partitions))
+	for i, partition := range metadata.Partitions {
+		partitionIDs[i] = partition.Partition
+	}
+
+	partitionsByTopic := make(map[string][]TranslatedConsumerGroupOffsetsPartition)
+	isComplete, restErr := s.scanMirrorMakerRecords(ctx, mirrorMakerTopicPartitions{
+		name:         checkpointsTopic,
+		sourceAlias:  req.SourceClusterAlias,
+		partitionIDs: partitionIDs,
+	}, func(record *kgo.Record) *rest.Error {
+		checkpoint, err := decodeMirrorMakerCheckpoint(record.Key, record.Value)
+		if err != nil {
+			return &rest.Error{
+				Err:      err,
+				Status:   http.StatusInternalServerError,
+				Message:  fmt.Sprintf("Failed to decode checkpoint at partition %d offset %d: %v", record.Partition, record.Offset, err.Error()),
+				IsSilent: false,
+			}
+		}
+		if checkpoint.GroupID != req.GroupID {
+			return nil
+		}
+		if len(req.TopicNames) > 0 && !slices.Contains(req.TopicNames, checkpoint.TopicName) {
+			return nil
+		}
+		partitionsByTopic[checkpoint.TopicName] = append(partitionsByTopic[checkpoint.TopicName], TranslatedConsumerGroupOffsetsPartition{
+			PartitionID:      checkpoint.PartitionID,
+			UpstreamOffset:   checkpoint.UpstreamOffset,
+			DownstreamOffset: checkpoint.DownstreamOffset,
+		})
+		return nil
+	})
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	// Attach the offsets that the group has currently committed in this cluster
+	var committed map[string]map[int32]int64
+	groupOffsets := s.kafkaSvc.ListConsumerGroupOffsetsBulk(ctx, []string{req.GroupID})
+	if groupRes, exists := groupOffsets[req.GroupID]; exists && groupRes.Err == nil {
+		committed = make(map[string]map[int32]int64)
+		groupRes.Fetched.Each(func(offset kadm.OffsetResponse) {
+			if offset.Err != nil {
+				return
+			}
+			if _, exists := committed[offset.Topic]; !exists {
+				committed[offset.Topic] = make(map[int32]int64)
+			}
+			committed[offset.Topic][offset.Partition] = offset.At
+		})
+	}
+
+	res := &TranslateConsumerGroupOffsetsResponse{
+		SourceClusterAlias: req.SourceClusterAlias,
+		GroupID:            req.GroupID,
+		CheckpointsTopic:   checkpointsTopic,
+		Topics:             make([]TranslatedConsumerGroupOffsetsTopic, 0, len(partitionsByTopic)),
+		IsComplete:         isComplete,
+	}
+	for topicName, partitions := range partitionsByTopic {
+		for i, partition := range partitions {
+			if offset, exists := committed[topicName][partition.PartitionID]; exists {
+				partitions[i].CurrentOffset = &offset
+			}
+		}
+		sort.Slice(partitions, func(i, j int) bool { return partitions[i].PartitionID < partitions[j].PartitionID })
+		res.Topics = append(res.Topics, TranslatedConsumerGroupOffsetsTopic{TopicName: topicName, Partitions: partitions})
+	}
+	sort.Slice(res.Topics, func(i, j int) bool { return res.Topics[i].TopicName < res.Topics[j].TopicName })
+
+	return res, nil
+}
+
+// ApplyTranslatedConsumerGroupOffsets translates the offsets of a consumer group from the
+// source cluster and commits the translated offsets for the group in the connected cluster.
+// This is used to fail over consumers to the connected cluster. Like any offset edit, this
+// is only possible if the group has no active members.
+func (s *Service) ApplyTranslatedConsumerGroupOffsets(ctx context.Context, req TranslateConsumerGroupOffsetsRequest) (*EditConsumerGroupOffsetsResponse, *rest.Error) {
+	translated, restErr := s.TranslateConsumerGroupOffsets(ctx, req)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if len(translated.Topics) == 0 {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("no checkpoints found for group %q in topic %q", req.GroupID, translated.CheckpointsTopic),
+			Status:   http.StatusNotFound,
+			Message:  fmt.Sprintf("There are no checkpoints for consumer group '%v' from source cluster '%v'", req.GroupID, req.SourceClusterAlias),
+			IsSilent: false,
+		}
+	}
+
+	topics := make([]kmsg.OffsetCommitRequestTopic, len(translated.Topics))
+	for i, topic := range translated.Topics {
+		topicReq := kmsg.NewOffsetCommitRequestTopic()
+		topicReq.Topic = topic.TopicName
+		topicReq.Partitions = make([]kmsg.OffsetCommitRequestTopicPartition, len(topic.Partitions))
+		for j, partition := range topic.Partitions {
+			partitionReq := kmsg.NewOffsetCommitRequestTopicPartition()
+			partitionReq.Partition = partition.PartitionID
+			partitionReq.Offset = partition.DownstreamOffset
+			topicReq.Partitions[j] = partitionReq
+		}
+		topics[i] = topicReq
+	}
+
+	return s.EditConsumerGroupOffsets(ctx, req.GroupID, topics)
+}
+
+// getMirrorMakerTopics classifies all topics of the connected cluster by the MirrorMaker 2
+// replication policy. Source cluster aliases are learned from the heartbeat and checkpoint
+// topics, so that replicated topics can be told apart from topics that contain the separator.
+func (s *Service) getMirrorMakerTopics(ctx context.Context) (*mirrorMakerTopics, *rest.Error) {
+	metadata, err := s.kafkaSvc.GetMetadataTopics(ctx, nil)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to get topic metadata: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	cfg := s.kafkaSvc.Config.Kafka.MirrorMaker
+	partitionIDsByTopic := make(map[string][]int32, len(metadata.Topics))
+	for _, topic := range metadata.Topics {
+		if topic.Topic == nil || kerr.ErrorForCode(topic.ErrorCode) != nil {
+			continue
+		}
+		partitionIDs := make([]int32, len(topic.Partitions))
+		for i, partition := range topic.Partitions {
+			partitionIDs[i] = partition.Partition
+		}
+		partitionIDsByTopic[*topic.Topic] = partitionIDs
+	}
+
+	knownAliases := make(map[string]struct{})
+	topics := &mirrorMakerTopics{mappings: make([]MirrorMakerTopicMapping, 0)}
+	for topicName, partitionIDs := range partitionIDsByTopic {
+		parsed := parseMirrorMakerTopic(topicName, cfg.ReplicationPolicySeparator, cfg.HeartbeatsTopic, nil)
+		topic := mirrorMakerTopicPartitions{name: topicName, sourceAlias: parsed.SourceClusterAlias, partitionIDs: partitionIDs}
+		switch parsed.Kind {
+		case mirrorMakerTopicHeartbeats:
+			topics.heartbeats = append(topics.heartbeats, topic)
+		case mirrorMakerTopicCheckpoints:
+			topics.checkpoints = append(topics.checkpoints, topic)
+		default:
+			continue
+		}
+		if parsed.SourceClusterAlias != "" {
+			knownAliases[parsed.SourceClusterAlias] = struct{}{}
+		}
+	}
+
+	for topicName := range partitionIDsByTopic {
+		parsed := parseMirrorMakerTopic(topicName, cfg.ReplicationPolicySeparator, cfg.HeartbeatsTopic, knownAliases)
+		if parsed.Kind != mirrorMakerTopicRegular || parsed.SourceClusterAlias == "" {
+			continue
+		}
+		topics.mappings = append(topics.mappings, MirrorMakerTopicMapping{
+			SourceClusterAlias: parsed.SourceClusterAlias,
+			SourceTopicName:    parsed.UpstreamTopic,
+			TargetTopicName:    topicName,
+		})
+	}
+	sort.Slice(topics.mappings, func(i, j int) bool {
+		if topics.mappings[i].SourceClusterAlias != topics.mappings[j].SourceClusterAlias {
+			return topics.mappings[i].SourceClusterAlias < topics.mappings[j].SourceClusterAlias
+		}
+		return topics.mappings[i].SourceTopicName < topics.mappings[j].SourceTopicName
+	})
+
+	return topics, nil
+}
+
+// scanMirrorMakerRecords calls onRecord with the latest record of each key of a compacted
+// MirrorMaker 2 topic. Tombstones are omitted. It returns whether the topic has been
+// scanned completely.
+func (s *Service) scanMirrorMakerRecords(ctx context.Context, topic mirrorMakerTopicPartitions, onRecord func(*kgo.Record) *rest.Error) (bool, *rest.Error) {
+	var restErr *rest.Error
+	_, isComplete, err := s.kafkaSvc.ScanLatestRecords(ctx, topic.name, topic.partitionIDs, s.kafkaSvc.Config.Kafka.MirrorMaker.MaxScanRecords,
+		func(record *kgo.Record) error {
+			if record.Value == nil {
+				return nil
+			}
+			if restErr = onRecord(record); restErr != nil {
+				return restErr.Err
+			}
+			return nil
+		})
+	if restErr != nil {
+		return false, restErr
+	}
+	if err != nil {
+		return false, &rest.Error{
+			Err:      err,
+			Status:   http.StatusInternalServerError,
+			Message:  fmt.Sprintf("Failed to read topic '%v': %v", topic.name, err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	return isComplete, nil
+}
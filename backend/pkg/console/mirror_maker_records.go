@@ -0,0 +1,195 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// mirrorMakerCheckpointsTopicSuffix is appended to the source cluster alias to build
+// the name of the checkpoints topic that MirrorCheckpointConnector writes to.
+const mirrorMakerCheckpointsTopicSuffix = ".checkpoints.internal"
+
+// mirrorMakerHeartbeat is a record of a MirrorMaker 2 heartbeats topic. The key is
+// the struct (sourceClusterAlias STRING, targetClusterAlias STRING), the value is
+// the struct (version INT16, timestamp INT64), both in the Kafka protocol encoding.
+type mirrorMakerHeartbeat struct {
+	SourceClusterAlias string
+	TargetClusterAlias string
+	// Timestamp is the time the heartbeat has been emitted in unix milliseconds.
+	Timestamp int64
+}
+
+// mirrorMakerCheckpoint is a record of a MirrorMaker 2 checkpoints topic. The key is
+// the struct (group STRING, topic STRING, partition INT32), the value is the struct
+// (version INT16, upstreamOffset INT64, downstreamOffset INT64, metadata STRING).
+// The topic is the name of the replicated topic in the target cluster.
+type mirrorMakerCheckpoint struct {
+	GroupID          string
+	TopicName        string
+	PartitionID      int32
+	UpstreamOffset   int64
+	DownstreamOffset int64
+	Metadata         string
+}
+
+func decodeMirrorMakerHeartbeat(key, value []byte) (mirrorMakerHeartbeat, error) {
+	var heartbeat mirrorMakerHeartbeat
+
+	keyReader := mirrorMakerStructReader{buf: key}
+	heartbeat.SourceClusterAlias = keyReader.readString()
+	heartbeat.TargetClusterAlias = keyReader.readString()
+	if err := keyReader.err; err != nil {
+		return heartbeat, fmt.Errorf("failed to decode heartbeat key: %w", err)
+	}
+
+	valueReader := mirrorMakerStructReader{buf: value}
+	if version := valueReader.readInt16(); valueReader.err == nil && version != 0 {
+		return heartbeat, fmt.Errorf("unsupported heartbeat version %d", version)
+	}
+	heartbeat.Timestamp = valueReader.readInt64()
+	if err := valueReader.err; err != nil {
+		return heartbeat, fmt.Errorf("failed to decode heartbeat value: %w", err)
+	}
+
+	return heartbeat, nil
+}
+
+func decodeMirrorMakerCheckpoint(key, value []byte) (mirrorMakerCheckpoint, error) {
+	var checkpoint mirrorMakerCheckpoint
+
+	keyReader := mirrorMakerStructReader{buf: key}
+	checkpoint.GroupID = keyReader.readString()
+	checkpoint.TopicName = keyReader.readString()
+	checkpoint.PartitionID = keyReader.readInt32()
+	if err := keyReader.err; err != nil {
+		return checkpoint, fmt.Errorf("failed to decode checkpoint key: %w", err)
+	}
+
+	valueReader := mirrorMakerStructReader{buf: value}
+	if version := valueReader.readInt16(); valueReader.err == nil && version != 0 {
+		return checkpoint, fmt.Errorf("unsupported checkpoint version %d", version)
+	}
+	checkpoint.UpstreamOffset = valueReader.readInt64()
+	checkpoint.DownstreamOffset = valueReader.readInt64()
+	checkpoint.Metadata = valueReader.readString()
+	if err := valueReader.err; err != nil {
+		return checkpoint, fmt.Errorf("failed to decode checkpoint value: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+// mirrorMakerTopicKind classifies a topic of the target cluster by the MirrorMaker 2
+// default replication policy.
+type mirrorMakerTopicKind int
+
+const (
+	mirrorMakerTopicRegular mirrorMakerTopicKind = iota
+	mirrorMakerTopicHeartbeats
+	mirrorMakerTopicCheckpoints
+)
+
+// mirrorMakerTopic is a topic name parsed by the MirrorMaker 2 default replication policy.
+type mirrorMakerTopic struct {
+	Kind mirrorMakerTopicKind
+	// SourceClusterAlias is the alias of the cluster the topic has been replicated
+	// from, or the alias of the source cluster of the checkpoints. It is empty for
+	// local topics.
+	SourceClusterAlias string
+	// UpstreamTopic is the topic name without the source cluster alias prefix.
+	UpstreamTopic string
+}
+
+// parseMirrorMakerTopic parses a topic name of the target cluster. Replicated topics
+// are prefixed with the source cluster alias and the separator. Whether a prefix is a
+// source cluster alias can not be told from the topic name alone, hence replicated
+// topics are only detected for the given known aliases.
+func parseMirrorMakerTopic(topicName, separator, heartbeatsTopic string, knownAliases map[string]struct{}) mirrorMakerTopic {
+	if alias, isCheckpoints := strings.CutSuffix(topicName, mirrorMakerCheckpointsTopicSuffix); isCheckpoints && alias != "" {
+		return mirrorMakerTopic{Kind: mirrorMakerTopicCheckpoints, SourceClusterAlias: alias, UpstreamTopic: topicName}
+	}
+	if topicName == heartbeatsTopic {
+		return mirrorMakerTopic{Kind: mirrorMakerTopicHeartbeats, UpstreamTopic: topicName}
+	}
+
+	alias, upstreamTopic, found := strings.Cut(topicName, separator)
+	if !found || alias == "" || upstreamTopic == "" {
+		return mirrorMakerTopic{Kind: mirrorMakerTopicRegular, UpstreamTopic: topicName}
+	}
+	if strings.HasSuffix(topicName, separator+heartbeatsTopic) {
+		return mirrorMakerTopic{Kind: mirrorMakerTopicHeartbeats, SourceClusterAlias: alias, UpstreamTopic: upstreamTopic}
+	}
+	if _, isKnown := knownAliases[alias]; !isKnown {
+		return mirrorMakerTopic{Kind: mirrorMakerTopicRegular, UpstreamTopic: topicName}
+	}
+
+	return mirrorMakerTopic{Kind: mirrorMakerTopicRegular, SourceClusterAlias: alias, UpstreamTopic: upstreamTopic}
+}
+
+// mirrorMakerStructReader reads the fields of a struct that has been serialized
+// with the Kafka protocol types, as MirrorMaker 2 does for its internal records.
+// Once a read fails, all subsequent reads return zero values.
+type mirrorMakerStructReader struct {
+	buf []byte
+	err error
+}
+
+func (r *mirrorMakerStructReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.buf) < n {
+		r.err = errors.New("unexpected end of data")
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *mirrorMakerStructReader) readInt16() int16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (r *mirrorMakerStructReader) readInt32() int32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (r *mirrorMakerStructReader) readInt64() int64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (r *mirrorMakerStructReader) readString() string {
+	length := r.readInt16()
+	if r.err != nil {
+		return ""
+	}
+	if length < 0 {
+		r.err = errors.New("unexpected null string")
+		return ""
+	}
+	return string(r.next(int(length)))
+}
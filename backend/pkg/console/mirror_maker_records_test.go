@@ -0,0 +1,98 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mirrorMakerStruct serializes the given fields with the Kafka protocol types.
+func mirrorMakerStruct(fields ...any) []byte {
+	var buf []byte
+	for _, field := range fields {
+		switch v := field.(type) {
+		case string:
+			buf = binary.BigEndian.AppendUint16(buf, uint16(len(v)))
+			buf = append(buf, v...)
+		case int16:
+			buf = binary.BigEndian.AppendUint16(buf, uint16(v))
+		case int32:
+			buf = binary.BigEndian.AppendUint32(buf, uint32(v))
+		case int64:
+			buf = binary.BigEndian.AppendUint64(buf, uint64(v))
+		}
+	}
+	return buf
+}
+
+func TestDecodeMirrorMakerHeartbeat(t *testing.T) {
+	heartbeat, err := decodeMirrorMakerHeartbeat(
+		mirrorMakerStruct("us-east", "eu-west"),
+		mirrorMakerStruct(int16(0), int64(1718000000000)),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, mirrorMakerHeartbeat{
+		SourceClusterAlias: "us-east",
+		TargetClusterAlias: "eu-west",
+		Timestamp:          1718000000000,
+	}, heartbeat)
+
+	_, err = decodeMirrorMakerHeartbeat(mirrorMakerStruct("us-east"), mirrorMakerStruct(int16(0), int64(1)))
+	assert.Error(t, err)
+	_, err = decodeMirrorMakerHeartbeat(mirrorMakerStruct("us-east", "eu-west"), mirrorMakerStruct(int16(1), int64(1)))
+	assert.ErrorContains(t, err, "unsupported heartbeat version")
+}
+
+func TestDecodeMirrorMakerCheckpoint(t *testing.T) {
+	checkpoint, err := decodeMirrorMakerCheckpoint(
+		mirrorMakerStruct("orders-consumer", "us-east.orders", int32(3)),
+		mirrorMakerStruct(int16(0), int64(1500), int64(1420), ""),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, mirrorMakerCheckpoint{
+		GroupID:          "orders-consumer",
+		TopicName:        "us-east.orders",
+		PartitionID:      3,
+		UpstreamOffset:   1500,
+		DownstreamOffset: 1420,
+	}, checkpoint)
+
+	_, err = decodeMirrorMakerCheckpoint(
+		mirrorMakerStruct("orders-consumer", "us-east.orders", int32(3)),
+		mirrorMakerStruct(int16(0), int64(1500)),
+	)
+	assert.Error(t, err)
+}
+
+func TestParseMirrorMakerTopic(t *testing.T) {
+	knownAliases := map[string]struct{}{"us-east": {}}
+
+	tests := []struct {
+		topicName string
+		expected  mirrorMakerTopic
+	}{
+		{"heartbeats", mirrorMakerTopic{Kind: mirrorMakerTopicHeartbeats, UpstreamTopic: "heartbeats"}},
+		{"us-east.heartbeats", mirrorMakerTopic{Kind: mirrorMakerTopicHeartbeats, SourceClusterAlias: "us-east", UpstreamTopic: "heartbeats"}},
+		{"us-east.checkpoints.internal", mirrorMakerTopic{Kind: mirrorMakerTopicCheckpoints, SourceClusterAlias: "us-east", UpstreamTopic: "us-east.checkpoints.internal"}},
+		{"us-east.orders", mirrorMakerTopic{Kind: mirrorMakerTopicRegular, SourceClusterAlias: "us-east", UpstreamTopic: "orders"}},
+		{"us-east.orders.v2", mirrorMakerTopic{Kind: mirrorMakerTopicRegular, SourceClusterAlias: "us-east", UpstreamTopic: "orders.v2"}},
+		{"payments.v2", mirrorMakerTopic{Kind: mirrorMakerTopicRegular, UpstreamTopic: "payments.v2"}},
+		{"orders", mirrorMakerTopic{Kind: mirrorMakerTopicRegular, UpstreamTopic: "orders"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.topicName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseMirrorMakerTopic(tt.topicName, ".", "heartbeats", knownAliases))
+		})
+	}
+}
@@ -70,8 +70,11 @@ func (s *Service) getKafkaOverview(ctx context.Context) OverviewKafka {
 	// Fetch cluster metadata
 	var metadata kadm.Metadata
 	grp.Go(func() error {
-		var err error
-		metadata, err = s.kafkaSvc.KafkaAdmClient.Metadata(grpCtx)
+		adminClient, err := s.kafkaSvc.AdminClient(grpCtx)
+		if err != nil {
+			return err
+		}
+		metadata, err = adminClient.Metadata(grpCtx)
 		return err
 	})
 
@@ -0,0 +1,221 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+// RecordDifferenceType describes how a field differs between two records.
+type RecordDifferenceType string
+
+const (
+	// RecordDifferenceTypeAdded means the field only exists in the right record.
+	RecordDifferenceTypeAdded RecordDifferenceType = "added"
+	// RecordDifferenceTypeRemoved means the field only exists in the left record.
+	RecordDifferenceTypeRemoved RecordDifferenceType = "removed"
+	// RecordDifferenceTypeChanged means the field exists in both records with different values.
+	RecordDifferenceTypeChanged RecordDifferenceType = "changed"
+)
+
+// RecordDifference is a single field that differs between two records.
+type RecordDifference struct {
+	// Path is the dot separated path of the field, for example "value.customer.id"
+	// or "value.items.2.price" for array elements.
+	Path  string               `json:"path"`
+	Type  RecordDifferenceType `json:"type"`
+	Left  any                  `json:"left,omitempty"`
+	Right any                  `json:"right,omitempty"`
+}
+
+// recordDiffDocument converts a deserialized message into a generic document
+// that can be compared field by field. Key and value are compared using their
+// deserialized representation, so that two records are considered equal if they
+// carry the same data, even if they have been serialized with different schemas.
+func recordDiffDocument(msg *kafka.TopicMessage) map[string]any {
+	// Header keys are not unique. Repeated headers are compared as array.
+	valuesByKey := make(map[string][]any, len(msg.Headers))
+	for _, header := range msg.Headers {
+		value := toComparableValue(kafka.DecodedHeaderValue(serde.RecordHeader(header)))
+		valuesByKey[header.Key] = append(valuesByKey[header.Key], value)
+	}
+	headers := make(map[string]any, len(valuesByKey))
+	for key, values := range valuesByKey {
+		if len(values) == 1 {
+			headers[key] = values[0]
+			continue
+		}
+		headers[key] = values
+	}
+
+	return map[string]any{
+		"timestamp": json.Number(strconv.FormatInt(msg.Timestamp, 10)),
+		"key":       payloadDiffValue(msg.Key),
+		"value":     payloadDiffValue(msg.Value),
+		"headers":   headers,
+	}
+}
+
+func payloadDiffValue(payload *serde.RecordPayload) any {
+	if payload == nil || payload.IsPayloadNull {
+		return nil
+	}
+
+	return toComparableValue(payload.DeserializedPayload)
+}
+
+// toComparableValue converts the given value into its generic JSON representation
+// (maps, slices, strings, json.Number, bools and nil) so that values which have
+// been deserialized by different serdes can be compared. Binary values are
+// represented as base64 encoded string.
+func toComparableValue(v any) any {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return val
+	}
+
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+	decoder.UseNumber()
+	var native any
+	if err := decoder.Decode(&native); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return native
+}
+
+// diffRecords returns all differences between the two record documents that are
+// not excluded by the ignored field paths. Object keys are compared in sorted order.
+func diffRecords(left, right map[string]any, ignoredFields []string) []RecordDifference {
+	ignoredPaths := make([][]string, len(ignoredFields))
+	for i, field := range ignoredFields {
+		ignoredPaths[i] = strings.Split(field, ".")
+	}
+
+	differences := make([]RecordDifference, 0)
+	diffValues(nil, left, right, ignoredPaths, &differences)
+
+	return differences
+}
+
+func diffValues(path []string, left, right any, ignoredPaths [][]string, differences *[]RecordDifference) {
+	if isIgnoredPath(path, ignoredPaths) {
+		return
+	}
+
+	leftMap, leftIsMap := left.(map[string]any)
+	rightMap, rightIsMap := right.(map[string]any)
+	if leftIsMap && rightIsMap {
+		keys := make([]string, 0, len(leftMap)+len(rightMap))
+		for key := range leftMap {
+			keys = append(keys, key)
+		}
+		for key := range rightMap {
+			if _, exists := leftMap[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			childPath := appendPath(path, key)
+			leftValue, inLeft := leftMap[key]
+			rightValue, inRight := rightMap[key]
+			switch {
+			case !inLeft:
+				addDifference(childPath, RecordDifferenceTypeAdded, nil, rightValue, ignoredPaths, differences)
+			case !inRight:
+				addDifference(childPath, RecordDifferenceTypeRemoved, leftValue, nil, ignoredPaths, differences)
+			default:
+				diffValues(childPath, leftValue, rightValue, ignoredPaths, differences)
+			}
+		}
+		return
+	}
+
+	leftSlice, leftIsSlice := left.([]any)
+	rightSlice, rightIsSlice := right.([]any)
+	if leftIsSlice && rightIsSlice {
+		for i := 0; i < max(len(leftSlice), len(rightSlice)); i++ {
+			childPath := appendPath(path, strconv.Itoa(i))
+			switch {
+			case i >= len(leftSlice):
+				addDifference(childPath, RecordDifferenceTypeAdded, nil, rightSlice[i], ignoredPaths, differences)
+			case i >= len(rightSlice):
+				addDifference(childPath, RecordDifferenceTypeRemoved, leftSlice[i], nil, ignoredPaths, differences)
+			default:
+				diffValues(childPath, leftSlice[i], rightSlice[i], ignoredPaths, differences)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(left, right) {
+		addDifference(path, RecordDifferenceTypeChanged, left, right, ignoredPaths, differences)
+	}
+}
+
+func addDifference(path []string, diffType RecordDifferenceType, left, right any, ignoredPaths [][]string, differences *[]RecordDifference) {
+	if isIgnoredPath(path, ignoredPaths) {
+		return
+	}
+
+	*differences = append(*differences, RecordDifference{
+		Path:  strings.Join(path, "."),
+		Type:  diffType,
+		Left:  left,
+		Right: right,
+	})
+}
+
+// isIgnoredPath returns true if any of the ignored paths is a prefix of the given
+// path. A "*" segment in an ignored path matches any segment.
+func isIgnoredPath(path []string, ignoredPaths [][]string) bool {
+	for _, ignoredPath := range ignoredPaths {
+		if len(ignoredPath) > len(path) {
+			continue
+		}
+
+		matches := true
+		for i, segment := range ignoredPath {
+			if segment != "*" && segment != path[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+func appendPath(path []string, segment string) []string {
+	childPath := make([]string, len(path), len(path)+1)
+	copy(childPath, path)
+	return append(childPath, segment)
+}
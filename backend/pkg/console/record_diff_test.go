@@ -0,0 +1,118 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/redpanda-data/console/backend/pkg/kafka"
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+func TestDiffRecords(t *testing.T) {
+	newMessage := func(timestamp int64, value any, headers ...kafka.MessageHeader) *kafka.TopicMessage {
+		return &kafka.TopicMessage{
+			Timestamp: timestamp,
+			Key:       &serde.RecordPayload{DeserializedPayload: "order-1", Encoding: serde.PayloadEncodingText},
+			Value:     &serde.RecordPayload{DeserializedPayload: value, Encoding: serde.PayloadEncodingJSON},
+			Headers:   headers,
+		}
+	}
+	textHeader := func(key, value string) kafka.MessageHeader {
+		return kafka.MessageHeader{Key: key, Value: []byte(value), Encoding: serde.HeaderEncodingUTF8}
+	}
+
+	tests := []struct {
+		name          string
+		left          *kafka.TopicMessage
+		right         *kafka.TopicMessage
+		ignoredFields []string
+		expected      []RecordDifference
+	}{
+		{
+			name:          "equal records with ignored timestamp",
+			left:          newMessage(1000, map[string]any{"id": 1, "status": "created"}),
+			right:         newMessage(2000, map[string]any{"id": 1, "status": "created"}),
+			ignoredFields: []string{"timestamp"},
+			expected:      []RecordDifference{},
+		},
+		{
+			name:  "timestamp is compared if not ignored",
+			left:  newMessage(1000, nil),
+			right: newMessage(2000, nil),
+			expected: []RecordDifference{
+				{Path: "timestamp", Type: RecordDifferenceTypeChanged, Left: json.Number("1000"), Right: json.Number("2000")},
+			},
+		},
+		{
+			name: "changed, added and removed value fields",
+			left: newMessage(1000, map[string]any{
+				"status":   "created",
+				"customer": map[string]any{"id": 5, "name": "alice"},
+				"items":    []any{"a", "b"},
+			}),
+			right: newMessage(1000, map[string]any{
+				"status":   "shipped",
+				"customer": map[string]any{"id": 5},
+				"items":    []any{"a", "b", "c"},
+				"carrier":  "dhl",
+			}),
+			expected: []RecordDifference{
+				{Path: "value.carrier", Type: RecordDifferenceTypeAdded, Right: "dhl"},
+				{Path: "value.customer.name", Type: RecordDifferenceTypeRemoved, Left: "alice"},
+				{Path: "value.items.2", Type: RecordDifferenceTypeAdded, Right: "c"},
+				{Path: "value.status", Type: RecordDifferenceTypeChanged, Left: "created", Right: "shipped"},
+			},
+		},
+		{
+			name:          "wildcard ignores fields in all array elements",
+			left:          newMessage(1000, map[string]any{"items": []any{map[string]any{"sku": "a", "updatedAt": 1}}}),
+			right:         newMessage(1000, map[string]any{"items": []any{map[string]any{"sku": "a", "updatedAt": 2}}}),
+			ignoredFields: []string{"value.items.*.updatedAt"},
+			expected:      []RecordDifference{},
+		},
+		{
+			name:     "numbers of different types are compared by value",
+			left:     newMessage(1000, map[string]any{"amount": int64(10)}),
+			right:    newMessage(1000, map[string]any{"amount": float64(10)}),
+			expected: []RecordDifference{},
+		},
+		{
+			name:  "headers",
+			left:  newMessage(1000, nil, textHeader("trace-id", "abc"), textHeader("tag", "a")),
+			right: newMessage(1000, nil, textHeader("trace-id", "def"), textHeader("tag", "a"), textHeader("tag", "b")),
+			expected: []RecordDifference{
+				{Path: "headers.tag", Type: RecordDifferenceTypeChanged, Left: "a", Right: []any{"a", "b"}},
+				{Path: "headers.trace-id", Type: RecordDifferenceTypeChanged, Left: "abc", Right: "def"},
+			},
+		},
+		{
+			name:          "ignored header",
+			left:          newMessage(1000, nil, textHeader("trace-id", "abc")),
+			right:         newMessage(1000, nil, textHeader("trace-id", "def")),
+			ignoredFields: []string{"headers.trace-id"},
+			expected:      []RecordDifference{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ignoredFields := tt.ignoredFields
+			if ignoredFields == nil {
+				ignoredFields = []string{}
+			}
+			differences := diffRecords(recordDiffDocument(tt.left), recordDiffDocument(tt.right), ignoredFields)
+			assert.Equal(t, tt.expected, differences)
+		})
+	}
+}
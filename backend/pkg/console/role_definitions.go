@@ -0,0 +1,643 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cloudhut/common/rest"
+	adminapi "github.com/redpanda-data/common-go/rpadmin"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// redpandaRolePrincipalPrefix is the prefix of ACL principals that refer to a
+// Redpanda role.
+const redpandaRolePrincipalPrefix = "RedpandaRole:"
+
+// roleNamePattern matches valid Redpanda role names.
+var roleNamePattern = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
+
+// RoleACL is an ACL that is bound to a Redpanda role. The principal is always
+// the role itself. The enum values use the names of the Kafka protocol, e.g.
+// "TOPIC", "PREFIXED", "READ" and "ALLOW".
+type RoleACL struct {
+	ResourceType        string `json:"resourceType" yaml:"resourceType"`
+	ResourceName        string `json:"resourceName" yaml:"resourceName"`
+	ResourcePatternType string `json:"resourcePatternType" yaml:"resourcePatternType"`
+	Host                string `json:"host" yaml:"host"`
+	Operation           string `json:"operation" yaml:"operation"`
+	PermissionType      string `json:"permissionType" yaml:"permissionType"`
+}
+
+// RoleDefinition is a Redpanda role together with its members and ACLs. Roles
+// are created, cloned, compared, exported and imported as a whole.
+type RoleDefinition struct {
+	Name string `json:"name" yaml:"name"`
+	// Members are the names of the users that are assigned to the role.
+	Members []string  `json:"members" yaml:"members,omitempty"`
+	ACLs    []RoleACL `json:"acls" yaml:"acls"`
+}
+
+// RoleDefinitionsDocument is the YAML document that roles are exported to and
+// imported from.
+type RoleDefinitionsDocument struct {
+	Roles []RoleDefinition `yaml:"roles"`
+}
+
+// RoleDiff compares the members and permissions of two roles.
+type RoleDiff struct {
+	RoleA string `json:"roleA"`
+	RoleB string `json:"roleB"`
+	// OnlyInA are the ACLs that only role A has.
+	OnlyInA []RoleACL `json:"onlyInA"`
+	// OnlyInB are the ACLs that only role B has.
+	OnlyInB []RoleACL `json:"onlyInB"`
+	Common  []RoleACL `json:"common"`
+
+	MembersOnlyInA []string `json:"membersOnlyInA"`
+	MembersOnlyInB []string `json:"membersOnlyInB"`
+	CommonMembers  []string `json:"commonMembers"`
+}
+
+// RoleImportStatus is the outcome of importing a single role.
+type RoleImportStatus string
+
+const (
+	// RoleImportStatusCreated is reported for roles that have been created along
+	// with their members and ACLs.
+	RoleImportStatusCreated RoleImportStatus = "CREATED"
+	// RoleImportStatusSkipped is reported for roles that already exist. Existing
+	// roles are never modified by an import.
+	RoleImportStatusSkipped RoleImportStatus = "SKIPPED"
+	// RoleImportStatusFailed is reported for roles that could not be created. A
+	// partially created role is deleted again.
+	RoleImportStatusFailed RoleImportStatus = "FAILED"
+)
+
+// RoleImportResult is the outcome of importing a single role.
+type RoleImportResult struct {
+	Name   string           `json:"name"`
+	Status RoleImportStatus `json:"status"`
+	Error  string           `json:"error,omitempty"`
+}
+
+// GetRoleDefinition returns the role with its members and ACLs.
+func (s *Service) GetRoleDefinition(ctx context.Context, roleName string) (*RoleDefinition, *rest.Error) {
+	if restErr := s.requireRedpandaAdminAPI(); restErr != nil {
+		return nil, restErr
+	}
+	existing, restErr := s.listRoleNames(ctx)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if _, exists := existing[roleName]; !exists {
+		return nil, roleNotFoundError(roleName)
+	}
+
+	return s.describeRole(ctx, roleName)
+}
+
+// CreateRoleWithACLs creates the role, assigns its members and creates its ACLs
+// in one operation. If any step fails, the role and its ACLs are deleted again.
+func (s *Service) CreateRoleWithACLs(ctx context.Context, definition RoleDefinition) (*RoleDefinition, *rest.Error) {
+	if err := definition.normalize(); err != nil {
+		return nil, invalidRoleDefinitionError(err)
+	}
+	if restErr := s.requireRedpandaAdminAPI(); restErr != nil {
+		return nil, restErr
+	}
+	existing, restErr := s.listRoleNames(ctx)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if _, exists := existing[definition.Name]; exists {
+		return nil, &rest.Error{
+			Err:      fmt.Errorf("role %q already exists", definition.Name),
+			Status:   http.StatusConflict,
+			Message:  fmt.Sprintf("Role %q already exists", definition.Name),
+			IsSilent: false,
+		}
+	}
+
+	if err := s.createRole(ctx, definition); err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to create role %q: %v", definition.Name, err.Error()),
+			IsSilent: false,
+		}
+	}
+	return &definition, nil
+}
+
+// CloneRole creates a new role with the ACLs of the source role. Members are
+// only copied if includeMembers is true.
+func (s *Service) CloneRole(ctx context.Context, sourceRoleName, targetRoleName string, includeMembers bool) (*RoleDefinition, *rest.Error) {
+	definition, restErr := s.GetRoleDefinition(ctx, sourceRoleName)
+	if restErr != nil {
+		return nil, restErr
+	}
+	definition.Name = targetRoleName
+	if !includeMembers {
+		definition.Members = nil
+	}
+	return s.CreateRoleWithACLs(ctx, *definition)
+}
+
+// DiffRoles compares the members and ACLs of two roles.
+func (s *Service) DiffRoles(ctx context.Context, roleNameA, roleNameB string) (*RoleDiff, *rest.Error) {
+	a, restErr := s.GetRoleDefinition(ctx, roleNameA)
+	if restErr != nil {
+		return nil, restErr
+	}
+	b, restErr := s.GetRoleDefinition(ctx, roleNameB)
+	if restErr != nil {
+		return nil, restErr
+	}
+	return diffRoleDefinitions(a, b), nil
+}
+
+// ExportRoleDefinitions returns the definitions of the given roles, or of all
+// roles if no role name is given.
+func (s *Service) ExportRoleDefinitions(ctx context.Context, roleNames []string) ([]RoleDefinition, *rest.Error) {
+	if restErr := s.requireRedpandaAdminAPI(); restErr != nil {
+		return nil, restErr
+	}
+	existing, restErr := s.listRoleNames(ctx)
+	if restErr != nil {
+		return nil, restErr
+	}
+	if len(roleNames) == 0 {
+		roleNames = make([]string, 0, len(existing))
+		for roleName := range existing {
+			roleNames = append(roleNames, roleName)
+		}
+	}
+	sort.Strings(roleNames)
+	roleNames = slices.Compact(roleNames)
+
+	definitions := make([]RoleDefinition, 0, len(roleNames))
+	for _, roleName := range roleNames {
+		if _, exists := existing[roleName]; !exists {
+			return nil, roleNotFoundError(roleName)
+		}
+		definition, restErr := s.describeRole(ctx, roleName)
+		if restErr != nil {
+			return nil, restErr
+		}
+		definitions = append(definitions, *definition)
+	}
+	return definitions, nil
+}
+
+// ImportRoleDefinitions creates all roles that do not exist yet. All definitions
+// are validated before any role is created. Existing roles are skipped and
+// failures of single roles do not stop the import.
+func (s *Service) ImportRoleDefinitions(ctx context.Context, definitions []RoleDefinition) ([]RoleImportResult, *rest.Error) {
+	seen := make(map[string]struct{}, len(definitions))
+	for i := range definitions {
+		if err := definitions[i].normalize(); err != nil {
+			return nil, invalidRoleDefinitionError(fmt.Errorf("role %d: %w", i+1, err))
+		}
+		if _, duplicate := seen[definitions[i].Name]; duplicate {
+			return nil, invalidRoleDefinitionError(fmt.Errorf("role %q is defined more than once", definitions[i].Name))
+		}
+		seen[definitions[i].Name] = struct{}{}
+	}
+	if restErr := s.requireRedpandaAdminAPI(); restErr != nil {
+		return nil, restErr
+	}
+	existing, restErr := s.listRoleNames(ctx)
+	if restErr != nil {
+		return nil, restErr
+	}
+
+	results := make([]RoleImportResult, 0, len(definitions))
+	for _, definition := range definitions {
+		result := RoleImportResult{Name: definition.Name, Status: RoleImportStatusCreated}
+		if _, exists := existing[definition.Name]; exists {
+			result.Status = RoleImportStatusSkipped
+			result.Error = "role already exists"
+		} else if err := s.createRole(ctx, definition); err != nil {
+			result.Status = RoleImportStatusFailed
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// createRole creates the role, its members and its ACLs. It deletes the role and
+// the ACLs it has created if a later step fails. ACLs of the role principal that
+// existed before, e.g. left behind by a deleted role of the same name, are kept.
+func (s *Service) createRole(ctx context.Context, definition RoleDefinition) error {
+	existingACLs, err := s.listRoleACLs(ctx, definition.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list existing acls of role: %w", err)
+	}
+	if _, err := s.redpandaSvc.CreateRole(ctx, definition.Name); err != nil {
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+
+	err = s.assignRoleMembersAndACLs(ctx, definition)
+	if err == nil {
+		s.logger.Info("created role with acls",
+			zap.String("role_name", definition.Name),
+			zap.Int("members", len(definition.Members)),
+			zap.Int("acls", len(definition.ACLs)))
+		return nil
+	}
+
+	// Do not leave a role with only a part of its permissions behind
+	if deleteErr := s.deletePartiallyCreatedRole(ctx, definition.Name, newRoleACLs(definition.ACLs, existingACLs)); deleteErr != nil {
+		s.logger.Warn("failed to delete partially created role", zap.String("role_name", definition.Name), zap.Error(deleteErr))
+		return fmt.Errorf("%w, deleting the partially created role failed as well: %v", err, deleteErr)
+	}
+	return err
+}
+
+// deletePartiallyCreatedRole deletes the role and the given ACLs of the role.
+func (s *Service) deletePartiallyCreatedRole(ctx context.Context, roleName string, acls []RoleACL) error {
+	if err := s.redpandaSvc.DeleteRole(ctx, roleName, false); err != nil {
+		return err
+	}
+	if len(acls) == 0 {
+		return nil
+	}
+	filters := make([]kmsg.DeleteACLsRequestFilter, 0, len(acls))
+	for _, acl := range acls {
+		filter, err := acl.toDeleteFilter(roleName)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, filter)
+	}
+	res, restErr := s.DeleteACLsWithFilters(ctx, filters)
+	if restErr != nil {
+		return restErr.Err
+	}
+	if len(res.ErrorMessages) > 0 {
+		return fmt.Errorf("failed to delete acls: %v", strings.Join(res.ErrorMessages, "; "))
+	}
+	return nil
+}
+
+// newRoleACLs returns the ACLs of the definition that do not exist yet.
+func newRoleACLs(acls, existing []RoleACL) []RoleACL {
+	created := make([]RoleACL, 0, len(acls))
+	for _, acl := range acls {
+		if !slices.Contains(existing, acl) {
+			created = append(created, acl)
+		}
+	}
+	return created
+}
+
+func (s *Service) assignRoleMembersAndACLs(ctx context.Context, definition RoleDefinition) error {
+	if len(definition.Members) > 0 {
+		members := make([]adminapi.RoleMember, len(definition.Members))
+		for i, member := range definition.Members {
+			members[i] = adminapi.RoleMember{Name: member, PrincipalType: "User"}
+		}
+		if _, err := s.redpandaSvc.AssignRole(ctx, definition.Name, members); err != nil {
+			return fmt.Errorf("failed to assign members: %w", err)
+		}
+	}
+
+	if len(definition.ACLs) == 0 {
+		return nil
+	}
+	req := kmsg.NewCreateACLsRequest()
+	req.Creations = make([]kmsg.CreateACLsRequestCreation, 0, len(definition.ACLs))
+	for _, acl := range definition.ACLs {
+		creation, err := acl.toCreation(definition.Name)
+		if err != nil {
+			return err
+		}
+		req.Creations = append(req.Creations, creation)
+	}
+	res, err := s.kafkaSvc.CreateACLs(ctx, &req)
+	if err != nil {
+		return fmt.Errorf("failed to create acls: %w", err)
+	}
+	for i, result := range res.Results {
+		if err := newKafkaErrorWithDynamicMessage(result.ErrorCode, result.ErrorMessage); err != nil {
+			return fmt.Errorf("failed to create acl %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// describeRole returns the members and ACLs of an existing role.
+func (s *Service) describeRole(ctx context.Context, roleName string) (*RoleDefinition, *rest.Error) {
+	members, err := s.redpandaSvc.RoleMembers(ctx, roleName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to list members of role %q: %v", roleName, err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	acls, err := s.listRoleACLs(ctx, roleName)
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to list ACLs of role %q: %v", roleName, err.Error()),
+			IsSilent: false,
+		}
+	}
+
+	definition := &RoleDefinition{
+		Name:    roleName,
+		Members: make([]string, 0, len(members.Members)),
+		ACLs:    acls,
+	}
+	for _, member := range members.Members {
+		definition.Members = append(definition.Members, member.Name)
+	}
+	definition.sort()
+	return definition, nil
+}
+
+// listRoleACLs returns the ACLs whose principal is the role.
+func (s *Service) listRoleACLs(ctx context.Context, roleName string) ([]RoleACL, error) {
+	principal := redpandaRolePrincipalPrefix + roleName
+	aclOverview, err := s.ListAllACLs(ctx, kmsg.DescribeACLsRequest{
+		ResourceType:        kmsg.ACLResourceTypeAny,
+		ResourcePatternType: kmsg.ACLResourcePatternTypeAny,
+		Principal:           &principal,
+		Operation:           kmsg.ACLOperationAny,
+		PermissionType:      kmsg.ACLPermissionTypeAny,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	acls := make([]RoleACL, 0)
+	for _, binding := range aclBindingsFromResponse(aclOverview.KafkaResponse) {
+		if binding.principal != principal {
+			continue
+		}
+		acls = append(acls, RoleACL{
+			ResourceType:        binding.resourceType.String(),
+			ResourceName:        binding.resourceName,
+			ResourcePatternType: binding.patternType.String(),
+			Host:                binding.host,
+			Operation:           binding.operation.String(),
+			PermissionType:      binding.permissionType.String(),
+		})
+	}
+	return acls, nil
+}
+
+// listRoleNames returns the names of all roles.
+func (s *Service) listRoleNames(ctx context.Context) (map[string]struct{}, *rest.Error) {
+	roles, err := s.redpandaSvc.ListRoles(ctx, "", "", "")
+	if err != nil {
+		return nil, &rest.Error{
+			Err:      err,
+			Status:   http.StatusServiceUnavailable,
+			Message:  fmt.Sprintf("Failed to list roles via Redpanda Admin API: %v", err.Error()),
+			IsSilent: false,
+		}
+	}
+	names := make(map[string]struct{}, len(roles.Roles))
+	for _, role := range roles.Roles {
+		names[role.Name] = struct{}{}
+	}
+	return names, nil
+}
+
+func (s *Service) requireRedpandaAdminAPI() *rest.Error {
+	if s.redpandaSvc != nil {
+		return nil
+	}
+	return &rest.Error{
+		Err:      errors.New("redpanda Admin API is not enabled"),
+		Status:   http.StatusServiceUnavailable,
+		Message:  "Managing roles requires the Redpanda Admin API to be configured",
+		IsSilent: false,
+	}
+}
+
+func roleNotFoundError(roleName string) *rest.Error {
+	return &rest.Error{
+		Err:      fmt.Errorf("role %q does not exist", roleName),
+		Status:   http.StatusNotFound,
+		Message:  fmt.Sprintf("Role %q does not exist", roleName),
+		IsSilent: false,
+	}
+}
+
+func invalidRoleDefinitionError(err error) *rest.Error {
+	return &rest.Error{
+		Err:      err,
+		Status:   http.StatusBadRequest,
+		Message:  fmt.Sprintf("Invalid role definition: %v", err.Error()),
+		IsSilent: false,
+	}
+}
+
+// normalize validates the definition, converts all enum values to their
+// canonical names, sets defaults and sorts members and ACLs.
+func (d *RoleDefinition) normalize() error {
+	if !roleNamePattern.MatchString(d.Name) || len(d.Name) > 128 {
+		return fmt.Errorf("role name %q must consist of 1 to 128 letters, digits, dashes or underscores", d.Name)
+	}
+	for _, member := range d.Members {
+		if member == "" || strings.Contains(member, ":") {
+			return fmt.Errorf("member %q must be a user name without principal type", member)
+		}
+	}
+	for i := range d.ACLs {
+		if err := d.ACLs[i].normalize(); err != nil {
+			return fmt.Errorf("acl %d: %w", i+1, err)
+		}
+	}
+	d.sort()
+	return nil
+}
+
+// sort orders and deduplicates members and ACLs, so that definitions are stable
+// and can be compared.
+func (d *RoleDefinition) sort() {
+	if d.Members == nil {
+		d.Members = make([]string, 0)
+	}
+	if d.ACLs == nil {
+		d.ACLs = make([]RoleACL, 0)
+	}
+	sort.Strings(d.Members)
+	d.Members = slices.Compact(d.Members)
+	sort.Slice(d.ACLs, func(i, j int) bool {
+		return d.ACLs[i].sortKey() < d.ACLs[j].sortKey()
+	})
+	d.ACLs = slices.Compact(d.ACLs)
+}
+
+func (a *RoleACL) normalize() error {
+	resourceType, err := kmsg.ParseACLResourceType(a.ResourceType)
+	if err != nil || resourceType == kmsg.ACLResourceTypeAny {
+		return fmt.Errorf("invalid resource type %q", a.ResourceType)
+	}
+	if a.ResourcePatternType == "" {
+		a.ResourcePatternType = kmsg.ACLResourcePatternTypeLiteral.String()
+	}
+	patternType, err := kmsg.ParseACLResourcePatternType(a.ResourcePatternType)
+	if err != nil || (patternType != kmsg.ACLResourcePatternTypeLiteral && patternType != kmsg.ACLResourcePatternTypePrefixed) {
+		return fmt.Errorf("resource pattern type %q must be either LITERAL or PREFIXED", a.ResourcePatternType)
+	}
+	operation, err := kmsg.ParseACLOperation(a.Operation)
+	if err != nil || operation == kmsg.ACLOperationAny {
+		return fmt.Errorf("invalid operation %q", a.Operation)
+	}
+	if a.PermissionType == "" {
+		a.PermissionType = kmsg.ACLPermissionTypeAllow.String()
+	}
+	permissionType, err := kmsg.ParseACLPermissionType(a.PermissionType)
+	if err != nil || (permissionType != kmsg.ACLPermissionTypeAllow && permissionType != kmsg.ACLPermissionTypeDeny) {
+		return fmt.Errorf("permission type %q must be either ALLOW or DENY", a.PermissionType)
+	}
+	if resourceType == kmsg.ACLResourceTypeCluster {
+		a.ResourceName = "kafka-cluster"
+	}
+	if a.ResourceName == "" {
+		return errors.New("resource name must be set")
+	}
+	if a.Host == "" {
+		a.Host = "*"
+	}
+
+	a.ResourceType = resourceType.String()
+	a.ResourcePatternType = patternType.String()
+	a.Operation = operation.String()
+	a.PermissionType = permissionType.String()
+	return nil
+}
+
+func (a *RoleACL) sortKey() string {
+	return strings.Join([]string{a.ResourceType, a.ResourceName, a.ResourcePatternType, a.Operation, a.PermissionType, a.Host}, "\x00")
+}
+
+// toCreation returns the request to create the ACL for the role. The ACL must
+// have been normalized.
+func (a *RoleACL) toCreation(roleName string) (kmsg.CreateACLsRequestCreation, error) {
+	creation := kmsg.NewCreateACLsRequestCreation()
+	var err error
+	if creation.ResourceType, err = kmsg.ParseACLResourceType(a.ResourceType); err != nil {
+		return creation, err
+	}
+	if creation.ResourcePatternType, err = kmsg.ParseACLResourcePatternType(a.ResourcePatternType); err != nil {
+		return creation, err
+	}
+	if creation.Operation, err = kmsg.ParseACLOperation(a.Operation); err != nil {
+		return creation, err
+	}
+	if creation.PermissionType, err = kmsg.ParseACLPermissionType(a.PermissionType); err != nil {
+		return creation, err
+	}
+	creation.ResourceName = a.ResourceName
+	creation.Principal = redpandaRolePrincipalPrefix + roleName
+	creation.Host = a.Host
+	return creation, nil
+}
+
+// toDeleteFilter returns the filter that exactly matches the ACL of the role. The
+// ACL must have been normalized.
+func (a *RoleACL) toDeleteFilter(roleName string) (kmsg.DeleteACLsRequestFilter, error) {
+	creation, err := a.toCreation(roleName)
+	if err != nil {
+		return kmsg.DeleteACLsRequestFilter{}, err
+	}
+	filter := kmsg.NewDeleteACLsRequestFilter()
+	filter.ResourceType = creation.ResourceType
+	filter.ResourceName = &creation.ResourceName
+	filter.ResourcePatternType = creation.ResourcePatternType
+	filter.Principal = &creation.Principal
+	filter.Host = &creation.Host
+	filter.Operation = creation.Operation
+	filter.PermissionType = creation.PermissionType
+	return filter, nil
+}
+
+// diffRoleDefinitions compares the members and ACLs of two sorted definitions.
+// The principal is not part of the comparison, because it differs by role.
+func diffRoleDefinitions(a, b *RoleDefinition) *RoleDiff {
+	diff := &RoleDiff{
+		RoleA:          a.Name,
+		RoleB:          b.Name,
+		OnlyInA:        make([]RoleACL, 0),
+		OnlyInB:        make([]RoleACL, 0),
+		Common:         make([]RoleACL, 0),
+		MembersOnlyInA: make([]string, 0),
+		MembersOnlyInB: make([]string, 0),
+		CommonMembers:  make([]string, 0),
+	}
+
+	for _, acl := range a.ACLs {
+		if slices.Contains(b.ACLs, acl) {
+			diff.Common = append(diff.Common, acl)
+		} else {
+			diff.OnlyInA = append(diff.OnlyInA, acl)
+		}
+	}
+	for _, acl := range b.ACLs {
+		if !slices.Contains(a.ACLs, acl) {
+			diff.OnlyInB = append(diff.OnlyInB, acl)
+		}
+	}
+
+	for _, member := range a.Members {
+		if slices.Contains(b.Members, member) {
+			diff.CommonMembers = append(diff.CommonMembers, member)
+		} else {
+			diff.MembersOnlyInA = append(diff.MembersOnlyInA, member)
+		}
+	}
+	for _, member := range b.Members {
+		if !slices.Contains(a.Members, member) {
+			diff.MembersOnlyInB = append(diff.MembersOnlyInB, member)
+		}
+	}
+	return diff
+}
+
+// MarshalRoleDefinitionsYAML encodes the definitions as RoleDefinitionsDocument.
+func MarshalRoleDefinitionsYAML(definitions []RoleDefinition) ([]byte, error) {
+	return yaml.Marshal(RoleDefinitionsDocument{Roles: definitions})
+}
+
+// ParseRoleDefinitionsYAML decodes a RoleDefinitionsDocument. Unknown fields are
+// rejected, so that typos do not silently drop permissions.
+func ParseRoleDefinitionsYAML(r io.Reader) ([]RoleDefinition, error) {
+	var document RoleDefinitionsDocument
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&document); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode role definitions: %w", err)
+	}
+	if len(document.Roles) == 0 {
+		return nil, errors.New("document does not contain any roles")
+	}
+	return document.Roles, nil
+}
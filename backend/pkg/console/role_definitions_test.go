@@ -0,0 +1,155 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestRoleDefinition_Normalize(t *testing.T) {
+	definition := RoleDefinition{
+		Name:    "team-orders",
+		Members: []string{"bob", "alice", "bob"},
+		ACLs: []RoleACL{
+			{ResourceType: "topic", ResourceName: "orders-", ResourcePatternType: "prefixed", Operation: "write"},
+			{ResourceType: "group", ResourceName: "orders-app", Operation: "read", PermissionType: "allow", Host: "*"},
+			{ResourceType: "Topic", ResourceName: "orders-", ResourcePatternType: "PREFIXED", Operation: "WRITE"},
+			{ResourceType: "cluster", Operation: "describe"},
+		},
+	}
+	require.NoError(t, definition.normalize())
+
+	assert.Equal(t, []string{"alice", "bob"}, definition.Members)
+	assert.Equal(t, []RoleACL{
+		{ResourceType: "CLUSTER", ResourceName: "kafka-cluster", ResourcePatternType: "LITERAL", Host: "*", Operation: "DESCRIBE", PermissionType: "ALLOW"},
+		{ResourceType: "GROUP", ResourceName: "orders-app", ResourcePatternType: "LITERAL", Host: "*", Operation: "READ", PermissionType: "ALLOW"},
+		{ResourceType: "TOPIC", ResourceName: "orders-", ResourcePatternType: "PREFIXED", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"},
+	}, definition.ACLs)
+
+	creation, err := definition.ACLs[2].toCreation(definition.Name)
+	require.NoError(t, err)
+	assert.Equal(t, kmsg.ACLResourceTypeTopic, creation.ResourceType)
+	assert.Equal(t, kmsg.ACLResourcePatternTypePrefixed, creation.ResourcePatternType)
+	assert.Equal(t, kmsg.ACLOperationWrite, creation.Operation)
+	assert.Equal(t, kmsg.ACLPermissionTypeAllow, creation.PermissionType)
+	assert.Equal(t, "RedpandaRole:team-orders", creation.Principal)
+}
+
+func TestRoleDefinition_NormalizeInvalid(t *testing.T) {
+	tt := []struct {
+		name       string
+		definition RoleDefinition
+		wantErr    string
+	}{
+		{
+			name:       "invalid role name",
+			definition: RoleDefinition{Name: "team orders"},
+			wantErr:    "role name",
+		},
+		{
+			name:       "member with principal type",
+			definition: RoleDefinition{Name: "team-orders", Members: []string{"User:alice"}},
+			wantErr:    "member",
+		},
+		{
+			name:       "any resource type",
+			definition: RoleDefinition{Name: "team-orders", ACLs: []RoleACL{{ResourceType: "any", ResourceName: "orders", Operation: "read"}}},
+			wantErr:    "resource type",
+		},
+		{
+			name:       "match pattern type",
+			definition: RoleDefinition{Name: "team-orders", ACLs: []RoleACL{{ResourceType: "topic", ResourceName: "orders", ResourcePatternType: "match", Operation: "read"}}},
+			wantErr:    "resource pattern type",
+		},
+		{
+			name:       "unknown operation",
+			definition: RoleDefinition{Name: "team-orders", ACLs: []RoleACL{{ResourceType: "topic", ResourceName: "orders", Operation: "consume"}}},
+			wantErr:    "operation",
+		},
+		{
+			name:       "missing resource name",
+			definition: RoleDefinition{Name: "team-orders", ACLs: []RoleACL{{ResourceType: "topic", Operation: "read"}}},
+			wantErr:    "resource name",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.definition.normalize()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestDiffRoleDefinitions(t *testing.T) {
+	readOrders := RoleACL{ResourceType: "TOPIC", ResourceName: "orders", ResourcePatternType: "LITERAL", Host: "*", Operation: "READ", PermissionType: "ALLOW"}
+	writeOrders := RoleACL{ResourceType: "TOPIC", ResourceName: "orders", ResourcePatternType: "LITERAL", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"}
+	readPayments := RoleACL{ResourceType: "TOPIC", ResourceName: "payments", ResourcePatternType: "LITERAL", Host: "*", Operation: "READ", PermissionType: "ALLOW"}
+
+	diff := diffRoleDefinitions(
+		&RoleDefinition{Name: "producers", Members: []string{"alice", "bob"}, ACLs: []RoleACL{readOrders, writeOrders}},
+		&RoleDefinition{Name: "consumers", Members: []string{"bob", "carol"}, ACLs: []RoleACL{readOrders, readPayments}},
+	)
+
+	assert.Equal(t, &RoleDiff{
+		RoleA:          "producers",
+		RoleB:          "consumers",
+		OnlyInA:        []RoleACL{writeOrders},
+		OnlyInB:        []RoleACL{readPayments},
+		Common:         []RoleACL{readOrders},
+		MembersOnlyInA: []string{"alice"},
+		MembersOnlyInB: []string{"carol"},
+		CommonMembers:  []string{"bob"},
+	}, diff)
+}
+
+func TestNewRoleACLs(t *testing.T) {
+	readOrders := RoleACL{ResourceType: "TOPIC", ResourceName: "orders", ResourcePatternType: "LITERAL", Host: "*", Operation: "READ", PermissionType: "ALLOW"}
+	writeOrders := RoleACL{ResourceType: "TOPIC", ResourceName: "orders", ResourcePatternType: "LITERAL", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"}
+
+	// ACLs left behind by a deleted role of the same name are not deleted on rollback
+	assert.Equal(t, []RoleACL{writeOrders}, newRoleACLs([]RoleACL{readOrders, writeOrders}, []RoleACL{readOrders}))
+	assert.Equal(t, []RoleACL{readOrders, writeOrders}, newRoleACLs([]RoleACL{readOrders, writeOrders}, nil))
+
+	filter, err := writeOrders.toDeleteFilter("producers")
+	require.NoError(t, err)
+	assert.Equal(t, "RedpandaRole:producers", *filter.Principal)
+	assert.Equal(t, "orders", *filter.ResourceName)
+	assert.Equal(t, kmsg.ACLOperationWrite, filter.Operation)
+	assert.Equal(t, kmsg.ACLResourcePatternTypeLiteral, filter.ResourcePatternType)
+}
+
+func TestRoleDefinitionsYAML(t *testing.T) {
+	definitions := []RoleDefinition{{
+		Name:    "team-orders",
+		Members: []string{"alice"},
+		ACLs: []RoleACL{
+			{ResourceType: "TOPIC", ResourceName: "orders-", ResourcePatternType: "PREFIXED", Host: "*", Operation: "ALL", PermissionType: "ALLOW"},
+		},
+	}}
+
+	encoded, err := MarshalRoleDefinitionsYAML(definitions)
+	require.NoError(t, err)
+	parsed, err := ParseRoleDefinitionsYAML(strings.NewReader(string(encoded)))
+	require.NoError(t, err)
+	assert.Equal(t, definitions, parsed)
+
+	_, err = ParseRoleDefinitionsYAML(strings.NewReader("roles:\n  - name: team-orders\n    acl: []\n"))
+	assert.Error(t, err, "unknown fields must be rejected")
+
+	_, err = ParseRoleDefinitionsYAML(strings.NewReader(""))
+	assert.Error(t, err)
+}
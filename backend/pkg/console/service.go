@@ -94,7 +94,13 @@ func (s *Service) Start() error {
 
 // Stop stops running go routines and releases allocated resources.
 func (s *Service) Stop() {
-	s.kafkaSvc.KafkaClient.Close()
+	s.kafkaSvc.Stop()
+}
+
+// KafkaService returns the Kafka service whose clients are used by the console
+// service, so that other services can share them.
+func (s *Service) KafkaService() *kafka.Service {
+	return s.kafkaSvc
 }
 
 // IsHealthy checks if the Kafka service is reachable and therefore
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cloudhut/common/rest"
+	"github.com/twmb/franz-go/pkg/kadm"
 	"github.com/twmb/franz-go/pkg/kgo"
 	"github.com/twmb/franz-go/pkg/kmsg"
 
@@ -26,6 +27,7 @@ type Servicer interface {
 	CreateKafkaClient(_ context.Context, additionalOpts ...kgo.Opt) (*kgo.Client, error)
 	CreateTopic(ctx context.Context, createTopicReq kmsg.CreateTopicsRequestTopic) (CreateTopicResponse, *rest.Error)
 	DeleteACLs(ctx context.Context, filter kmsg.DeleteACLsRequestFilter) (DeleteACLsResponse, *rest.Error)
+	DeleteACLsWithFilters(ctx context.Context, filters []kmsg.DeleteACLsRequestFilter) (DeleteACLsResponse, *rest.Error)
 	DeleteConsumerGroupOffsets(ctx context.Context, groupID string, topics []kmsg.OffsetDeleteRequestTopic) ([]DeleteConsumerGroupOffsetsResponseTopic, error)
 	DeleteTopic(ctx context.Context, topicName string) *rest.Error
 	DeleteTopicRecords(ctx context.Context, deleteReq kmsg.DeleteRecordsRequestTopic) (DeleteTopicRecordsResponse, *rest.Error)
@@ -35,7 +37,24 @@ type Servicer interface {
 	GetEndpointCompatibility(ctx context.Context) (EndpointCompatibility, error)
 	IncrementalAlterConfigs(ctx context.Context, alterConfigs []kmsg.IncrementalAlterConfigsRequestResource) ([]IncrementalAlterConfigsResourceResponse, *rest.Error)
 	ListAllACLs(ctx context.Context, req kmsg.DescribeACLsRequest) (*ACLOverview, error)
+	SimulateACLAccess(ctx context.Context, req SimulateACLAccessRequest) (*SimulateACLAccessResponse, *rest.Error)
+	GetPrincipalAccessReport(ctx context.Context, principal, host string) (*PrincipalAccessReport, *rest.Error)
+	AnalyzeACLs(ctx context.Context, includeUnused bool) (*ACLAnalysis, *rest.Error)
+	GetRoleDefinition(ctx context.Context, roleName string) (*RoleDefinition, *rest.Error)
+	CreateRoleWithACLs(ctx context.Context, definition RoleDefinition) (*RoleDefinition, *rest.Error)
+	CloneRole(ctx context.Context, sourceRoleName, targetRoleName string, includeMembers bool) (*RoleDefinition, *rest.Error)
+	DiffRoles(ctx context.Context, roleNameA, roleNameB string) (*RoleDiff, *rest.Error)
+	ExportRoleDefinitions(ctx context.Context, roleNames []string) ([]RoleDefinition, *rest.Error)
+	ImportRoleDefinitions(ctx context.Context, definitions []RoleDefinition) ([]RoleImportResult, *rest.Error)
 	ListMessages(ctx context.Context, listReq ListMessageRequest, progress kafka.IListMessagesProgress) error
+	CompareRecords(ctx context.Context, req CompareRecordsRequest) (*CompareRecordsResponse, *rest.Error)
+	LookupRecordsByKey(ctx context.Context, req LookupRecordsByKeyRequest) (*LookupRecordsByKeyResponse, *rest.Error)
+	ListLatestValues(ctx context.Context, req ListLatestValuesRequest) (*ListLatestValuesResponse, *rest.Error)
+	ListDeadLetterRecords(ctx context.Context, req ListDeadLetterRecordsRequest) (*ListDeadLetterRecordsResponse, *rest.Error)
+	GetDeadLetterReplayRecords(ctx context.Context, req DeadLetterReplayRequest) ([]*kgo.Record, *rest.Error)
+	GetMirrorMakerOverview(ctx context.Context) (*MirrorMakerOverview, *rest.Error)
+	TranslateConsumerGroupOffsets(ctx context.Context, req TranslateConsumerGroupOffsetsRequest) (*TranslateConsumerGroupOffsetsResponse, *rest.Error)
+	ApplyTranslatedConsumerGroupOffsets(ctx context.Context, req TranslateConsumerGroupOffsetsRequest) (*EditConsumerGroupOffsetsResponse, *rest.Error)
 	ListOffsets(ctx context.Context, topicNames []string, timestamp int64) ([]TopicOffset, error)
 	GetOverview(ctx context.Context) Overview
 	GetKafkaVersion(ctx context.Context) (string, error)
@@ -93,4 +112,8 @@ type Servicer interface {
 	IncrementalAlterConfigsKafka(ctx context.Context, req *kmsg.IncrementalAlterConfigsRequest) (*kmsg.IncrementalAlterConfigsResponse, error)
 	// AlterConfigs proxies the request/response to set configs (not incrementally) via the Kafka API.
 	AlterConfigs(ctx context.Context, req *kmsg.AlterConfigsRequest) (*kmsg.AlterConfigsResponse, error)
+	// DescribeUserSCRAMs proxies the request to describe SCRAM credentials via the Kafka API.
+	DescribeUserSCRAMs(ctx context.Context, users ...string) (kadm.DescribedUserSCRAMs, error)
+	// AlterUserSCRAMs proxies the request to delete and upsert SCRAM credentials via the Kafka API.
+	AlterUserSCRAMs(ctx context.Context, del []kadm.DeleteSCRAM, upsert []kadm.UpsertSCRAM) (kadm.AlteredUserSCRAMs, error)
 }
@@ -0,0 +1,26 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package console
+
+import (
+	"context"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// DescribeUserSCRAMs proxies the request to describe SCRAM credentials via the Kafka API.
+func (s *Service) DescribeUserSCRAMs(ctx context.Context, users ...string) (kadm.DescribedUserSCRAMs, error) {
+	return s.kafkaSvc.DescribeUserSCRAMs(ctx, users...)
+}
+
+// AlterUserSCRAMs proxies the request to delete and upsert SCRAM credentials via the Kafka API.
+func (s *Service) AlterUserSCRAMs(ctx context.Context, del []kadm.DeleteSCRAM, upsert []kadm.UpsertSCRAM) (kadm.AlteredUserSCRAMs, error) {
+	return s.kafkaSvc.AlterUserSCRAMs(ctx, del, upsert)
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package credential
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Character classes of generated passwords. The symbols are limited to characters
+// that need no quoting in JAAS configs, properties files and shells.
+const (
+	lowercaseLetters = "abcdefghijklmnopqrstuvwxyz"
+	uppercaseLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digits           = "0123456789"
+	symbols          = "-_.+%@"
+)
+
+// minPasswordLength is the shortest password that GeneratePassword creates.
+const minPasswordLength = 16
+
+// GeneratePassword returns a random password of the given length from a
+// cryptographically secure source. It contains at least one character of each
+// class: lowercase and uppercase letters, digits and symbols.
+func GeneratePassword(length int) (string, error) {
+	if length < minPasswordLength {
+		return "", fmt.Errorf("password length must be at least %d", minPasswordLength)
+	}
+
+	alphabet := lowercaseLetters + uppercaseLetters + digits + symbols
+	maxIndex := big.NewInt(int64(len(alphabet)))
+
+	// Drawing again instead of enforcing the classes at fixed positions keeps the
+	// characters uniformly distributed. Even with 16 characters, 100 attempts all
+	// missing a class is practically impossible.
+	for attempt := 0; attempt < 100; attempt++ {
+		password := make([]byte, length)
+		for i := range password {
+			index, err := rand.Int(rand.Reader, maxIndex)
+			if err != nil {
+				return "", fmt.Errorf("failed to generate password: %w", err)
+			}
+			password[i] = alphabet[index.Int64()]
+		}
+		if containsAllClasses(string(password)) {
+			return string(password), nil
+		}
+	}
+	return "", errors.New("failed to generate password with all character classes")
+}
+
+func containsAllClasses(password string) bool {
+	for _, class := range []string{lowercaseLetters, uppercaseLetters, digits, symbols} {
+		if !strings.ContainsAny(password, class) {
+			return false
+		}
+	}
+	return true
+}
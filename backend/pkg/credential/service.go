@@ -0,0 +1,623 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package credential manages the lifecycle of SCRAM credentials. It tracks when
+// the password of a user has been created and rotated, generates strong
+// passwords and rotates passwords with a grace period, during which the old and
+// the new password are both valid.
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var (
+	// ErrInvalidRequest is returned if a request can not be performed as requested.
+	ErrInvalidRequest = errors.New("invalid request")
+	// ErrAlreadyExists is returned if a user that should be created already has
+	// SCRAM credentials.
+	ErrAlreadyExists = errors.New("user already exists")
+	// ErrRotationPending is returned if a password is rotated while the grace
+	// period of a previous rotation has not ended yet.
+	ErrRotationPending = errors.New("password rotation is pending")
+	// ErrNoRotationPending is returned if a rotation is completed that has not
+	// been started.
+	ErrNoRotationPending = errors.New("no password rotation is pending")
+)
+
+// SCRAM mechanisms, named like in the Kafka and Redpanda APIs.
+const (
+	MechanismScramSha256 = "SCRAM-SHA-256"
+	MechanismScramSha512 = "SCRAM-SHA-512"
+)
+
+// Finding is a problem of a user that is reported by Report.
+type Finding string
+
+const (
+	// FindingNoACLs is reported for users without ACLs, neither directly nor via
+	// a Redpanda role.
+	FindingNoACLs Finding = "NO_ACLS"
+	// FindingStalePassword is reported for users whose password is older than the
+	// configured max password age.
+	FindingStalePassword Finding = "STALE_PASSWORD"
+	// FindingUntracked is reported for users that have neither been created nor
+	// rotated via Console, so that the age of their password is unknown.
+	FindingUntracked Finding = "UNTRACKED"
+	// FindingRotationPending is reported for users whose old password is still
+	// valid because the grace period of a rotation has not ended.
+	FindingRotationPending Finding = "ROTATION_PENDING"
+)
+
+// Metadata is the lifecycle information that is tracked for a user.
+type Metadata struct {
+	Username string `json:"username"`
+
+	// CreatedAt is only known for users that have been created via Console.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	CreatedBy string     `json:"createdBy,omitempty"`
+
+	// PasswordChangedAt is the time of the creation or the start of the last
+	// rotation, which is when the current password has been issued.
+	PasswordChangedAt time.Time `json:"passwordChangedAt"`
+	PasswordChangedBy string    `json:"passwordChangedBy,omitempty"`
+	Rotations         int       `json:"rotations"`
+
+	// PendingRotation is set during the grace period of a rotation.
+	PendingRotation *Rotation `json:"pendingRotation,omitempty"`
+}
+
+// Rotation is a password rotation whose grace period has not ended yet. The new
+// password is stored with the other SCRAM mechanism, so that clients can switch
+// to it while the old password is still valid.
+type Rotation struct {
+	OldMechanism      string    `json:"oldMechanism"`
+	NewMechanism      string    `json:"newMechanism"`
+	StartedAt         time.Time `json:"startedAt"`
+	StartedBy         string    `json:"startedBy,omitempty"`
+	GracePeriodEndsAt time.Time `json:"gracePeriodEndsAt"`
+}
+
+// IssuedCredential is the result of creating a user or rotating its password.
+type IssuedCredential struct {
+	Metadata  Metadata `json:"metadata"`
+	Mechanism string   `json:"mechanism"`
+	// Password is only set if it has been generated. It is only returned once.
+	Password string `json:"password,omitempty"`
+}
+
+// CreateUserRequest is the request to create a user with SCRAM credentials.
+type CreateUserRequest struct {
+	Username  string `json:"username"`
+	Mechanism string `json:"mechanism"`
+	// Password is optional. If unset, a password is generated.
+	Password string `json:"password"`
+}
+
+// RotateRequest is the request to rotate the password of a user.
+type RotateRequest struct {
+	// Password is optional. If unset, a password is generated.
+	Password string `json:"password"`
+	// Immediate replaces the password right away instead of keeping the old
+	// password valid during a grace period.
+	Immediate bool `json:"immediate"`
+	// GracePeriodEndsAt is optional. If unset, the configured default grace
+	// period is used.
+	GracePeriodEndsAt time.Time `json:"gracePeriodEndsAt"`
+}
+
+// UserReport is the lifecycle state of a user with SCRAM credentials.
+type UserReport struct {
+	Username   string   `json:"username"`
+	Mechanisms []string `json:"mechanisms"`
+	// Metadata is nil for untracked users.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	Findings []Finding `json:"findings"`
+}
+
+// Report is the lifecycle state of all users with SCRAM credentials.
+type Report struct {
+	Users []UserReport `json:"users"`
+	// Warnings contain the checks that could not be performed.
+	Warnings []string `json:"warnings"`
+}
+
+// Client manages the SCRAM credentials and lists the ACLs of the cluster.
+type Client interface {
+	// DescribeCredentials returns the SCRAM mechanisms of all users that have
+	// SCRAM credentials.
+	DescribeCredentials(ctx context.Context) (map[string][]string, error)
+
+	// UpsertCredential creates or replaces the credential of the user for the
+	// mechanism.
+	UpsertCredential(ctx context.Context, username, mechanism, password string) error
+
+	// DeleteCredential deletes the credential of the user for the mechanism.
+	DeleteCredential(ctx context.Context, username, mechanism string) error
+
+	// ListACLPrincipals returns the principals of all ACLs, e.g. "User:alice".
+	// Members of Redpanda roles with ACLs are returned as users.
+	ListACLPrincipals(ctx context.Context) (map[string]struct{}, error)
+}
+
+// Service creates, rotates and deletes SCRAM credentials and tracks their
+// lifecycle.
+type Service struct {
+	cfg    config.KafkaCredentials
+	logger *zap.Logger
+	store  Store
+	client Client
+
+	// mutex serializes changes of credentials, so that rotations of the same
+	// user can not interleave.
+	mutex sync.Mutex
+}
+
+// NewService creates the credential service with the given store and client.
+func NewService(cfg config.KafkaCredentials, logger *zap.Logger, store Store, client Client) *Service {
+	return &Service{
+		cfg:    cfg,
+		logger: logger,
+		store:  store,
+		client: client,
+	}
+}
+
+// NewStore creates the configured metadata store.
+func NewStore(cfg config.KafkaCredentials) (Store, error) {
+	switch cfg.Storage {
+	case config.KafkaCredentialsStorageFile:
+		return NewFileStore(cfg.FilePath)
+	default:
+		return NewMemoryStore(), nil
+	}
+}
+
+// Start periodically completes the rotations whose grace period has ended.
+func (s *Service) Start() {
+	go func(checkInterval time.Duration) {
+		// Stop checking when we receive a signal
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				s.logger.Info("stopped completing password rotations", zap.String("reason", "received signal"))
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), checkInterval)
+				s.CompleteDueRotations(ctx)
+				cancel()
+			}
+		}
+	}(s.cfg.CheckInterval)
+}
+
+// CreateUser creates the SCRAM credential of a new user on behalf of the user of
+// the context.
+func (s *Service) CreateUser(ctx context.Context, req CreateUserRequest) (IssuedCredential, error) {
+	if req.Username == "" {
+		return IssuedCredential{}, fmt.Errorf("%w: username must be set", ErrInvalidRequest)
+	}
+	if err := validateMechanism(req.Mechanism); err != nil {
+		return IssuedCredential{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	mechanismsByUser, err := s.client.DescribeCredentials(ctx)
+	if err != nil {
+		return IssuedCredential{}, err
+	}
+	if _, exists := mechanismsByUser[req.Username]; exists {
+		return IssuedCredential{}, fmt.Errorf("%w: %q already has SCRAM credentials", ErrAlreadyExists, req.Username)
+	}
+
+	password, generated, err := s.passwordOrGenerate(req.Password)
+	if err != nil {
+		return IssuedCredential{}, err
+	}
+
+	now := time.Now()
+	actor := actorFromContext(ctx)
+	metadata := Metadata{
+		Username:          req.Username,
+		CreatedAt:         &now,
+		CreatedBy:         actor,
+		PasswordChangedAt: now,
+		PasswordChangedBy: actor,
+	}
+	if err := s.putMetadata(ctx, metadata); err != nil {
+		return IssuedCredential{}, err
+	}
+	if err := s.client.UpsertCredential(ctx, req.Username, req.Mechanism, password); err != nil {
+		s.restoreMetadata(ctx, Metadata{Username: req.Username}, false)
+		return IssuedCredential{}, err
+	}
+	s.logger.Info("created user",
+		zap.String("username", req.Username),
+		zap.String("mechanism", req.Mechanism),
+		zap.String("created_by", actor))
+
+	return issued(metadata, req.Mechanism, password, generated), nil
+}
+
+// RotatePassword rotates the password of the user on behalf of the user of the
+// context. Unless the rotation is immediate, the new password is stored with the
+// other SCRAM mechanism and the old credential is deleted once the grace period
+// has ended. This requires that the user only has a credential for one mechanism.
+func (s *Service) RotatePassword(ctx context.Context, username string, req RotateRequest) (IssuedCredential, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	mechanismsByUser, err := s.client.DescribeCredentials(ctx)
+	if err != nil {
+		return IssuedCredential{}, err
+	}
+	mechanisms := mechanismsByUser[username]
+	if len(mechanisms) == 0 {
+		return IssuedCredential{}, ErrNotFound
+	}
+	previous, tracked, err := s.getOrUntracked(ctx, username)
+	if err != nil {
+		return IssuedCredential{}, err
+	}
+	metadata := previous
+	if metadata.PendingRotation != nil {
+		return IssuedCredential{}, fmt.Errorf("%w: the grace period ends at %v", ErrRotationPending, metadata.PendingRotation.GracePeriodEndsAt.Format(time.RFC3339))
+	}
+
+	now := time.Now()
+	var rotation *Rotation
+	if !req.Immediate {
+		gracePeriodEndsAt := req.GracePeriodEndsAt
+		if gracePeriodEndsAt.IsZero() {
+			gracePeriodEndsAt = now.Add(s.cfg.DefaultGracePeriod)
+		}
+		if !gracePeriodEndsAt.After(now) {
+			return IssuedCredential{}, fmt.Errorf("%w: grace period must end in the future", ErrInvalidRequest)
+		}
+		if len(mechanisms) != 1 {
+			return IssuedCredential{}, fmt.Errorf("%w: a rotation with grace period requires that the user only has a credential for one SCRAM mechanism, rotate immediately instead", ErrInvalidRequest)
+		}
+		if s.cfg.Storage != config.KafkaCredentialsStorageFile {
+			// The pending rotation would be lost on restart, so that the old
+			// credential would never be deleted.
+			return IssuedCredential{}, fmt.Errorf("%w: a rotation with grace period requires the file storage, rotate immediately instead", ErrInvalidRequest)
+		}
+		rotation = &Rotation{
+			OldMechanism:      mechanisms[0],
+			NewMechanism:      otherMechanism(mechanisms[0]),
+			StartedAt:         now,
+			StartedBy:         actorFromContext(ctx),
+			GracePeriodEndsAt: gracePeriodEndsAt,
+		}
+	}
+
+	password, generated, err := s.passwordOrGenerate(req.Password)
+	if err != nil {
+		return IssuedCredential{}, err
+	}
+
+	// The metadata is stored first, so that a pending rotation is never missing
+	// for a credential that has been changed.
+	metadata.PasswordChangedAt = now
+	metadata.PasswordChangedBy = actorFromContext(ctx)
+	metadata.Rotations++
+	metadata.PendingRotation = rotation
+	if err := s.putMetadata(ctx, metadata); err != nil {
+		return IssuedCredential{}, err
+	}
+
+	var mechanism string
+	if rotation != nil {
+		mechanism = rotation.NewMechanism
+		if err := s.client.UpsertCredential(ctx, username, mechanism, password); err != nil {
+			s.restoreMetadata(ctx, previous, tracked)
+			return IssuedCredential{}, err
+		}
+	} else {
+		// Kafka accepts each user only once per request, so that multiple
+		// mechanisms have to be replaced one after another.
+		for _, m := range mechanisms {
+			if err := s.client.UpsertCredential(ctx, username, m, password); err != nil {
+				s.restoreMetadata(ctx, previous, tracked)
+				return IssuedCredential{}, err
+			}
+		}
+		mechanism = mechanisms[0]
+	}
+
+	s.logger.Info("rotated password of user",
+		zap.String("username", username),
+		zap.String("mechanism", mechanism),
+		zap.Bool("immediate", rotation == nil),
+		zap.String("rotated_by", metadata.PasswordChangedBy))
+
+	return issued(metadata, mechanism, password, generated), nil
+}
+
+// CompleteRotation deletes the old credential of a pending rotation before its
+// grace period has ended.
+func (s *Service) CompleteRotation(ctx context.Context, username string) (Metadata, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.completeRotation(ctx, username)
+}
+
+// CompleteDueRotations completes all pending rotations whose grace period has
+// ended. Failed rotations are logged and retried on the next call.
+func (s *Service) CompleteDueRotations(ctx context.Context) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		s.logger.Warn("failed to list credential metadata", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for _, metadata := range stored {
+		if metadata.PendingRotation == nil || now.Before(metadata.PendingRotation.GracePeriodEndsAt) {
+			continue
+		}
+		s.mutex.Lock()
+		_, err := s.completeRotation(ctx, metadata.Username)
+		s.mutex.Unlock()
+		if err != nil && !errors.Is(err, ErrNoRotationPending) {
+			s.logger.Warn("failed to complete password rotation", zap.String("username", metadata.Username), zap.Error(err))
+		}
+	}
+}
+
+// completeRotation deletes the old credential of the pending rotation. The
+// caller must hold the mutex.
+func (s *Service) completeRotation(ctx context.Context, username string) (Metadata, error) {
+	metadata, err := s.store.Get(ctx, username)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Metadata{}, ErrNoRotationPending
+		}
+		return Metadata{}, err
+	}
+	rotation := metadata.PendingRotation
+	if rotation == nil {
+		return Metadata{}, ErrNoRotationPending
+	}
+
+	mechanismsByUser, err := s.client.DescribeCredentials(ctx)
+	if err != nil {
+		return Metadata{}, err
+	}
+	mechanisms, exists := mechanismsByUser[username]
+	if !exists {
+		// The user has been deleted outside of Console
+		if err := s.store.Delete(ctx, username); err != nil && !errors.Is(err, ErrNotFound) {
+			return Metadata{}, fmt.Errorf("failed to delete credential metadata: %w", err)
+		}
+		return Metadata{}, ErrNotFound
+	}
+	if slices.Contains(mechanisms, rotation.OldMechanism) {
+		if err := s.client.DeleteCredential(ctx, username, rotation.OldMechanism); err != nil {
+			return Metadata{}, err
+		}
+	}
+
+	metadata.PendingRotation = nil
+	if err := s.store.Put(ctx, metadata); err != nil {
+		return Metadata{}, fmt.Errorf("failed to store credential metadata: %w", err)
+	}
+	s.logger.Info("completed password rotation",
+		zap.String("username", username),
+		zap.String("deleted_mechanism", rotation.OldMechanism))
+
+	return metadata, nil
+}
+
+// DeleteUser deletes all SCRAM credentials and the metadata of the user.
+func (s *Service) DeleteUser(ctx context.Context, username string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	mechanismsByUser, err := s.client.DescribeCredentials(ctx)
+	if err != nil {
+		return err
+	}
+	for _, mechanism := range mechanismsByUser[username] {
+		if err := s.client.DeleteCredential(ctx, username, mechanism); err != nil {
+			return err
+		}
+	}
+
+	err = s.store.Delete(ctx, username)
+	if errors.Is(err, ErrNotFound) && len(mechanismsByUser[username]) > 0 {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	s.logger.Info("deleted user", zap.String("username", username), zap.String("deleted_by", actorFromContext(ctx)))
+
+	return nil
+}
+
+// Report returns the lifecycle state of all users with SCRAM credentials and
+// flags users without ACLs, with stale passwords and with unknown password age.
+func (s *Service) Report(ctx context.Context) (Report, error) {
+	mechanismsByUser, err := s.client.DescribeCredentials(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to list credential metadata: %w", err)
+	}
+
+	warnings := make([]string, 0)
+	principals, err := s.client.ListACLPrincipals(ctx)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Failed to list ACLs, users without ACLs are not reported: %v", err))
+		principals = nil
+	}
+
+	return analyzeUsers(mechanismsByUser, stored, principals, s.cfg.MaxPasswordAge, time.Now(), warnings), nil
+}
+
+// analyzeUsers creates the report of the given users. A nil principal set is not
+// known and the ACL check is skipped.
+func analyzeUsers(
+	mechanismsByUser map[string][]string,
+	stored []Metadata,
+	principals map[string]struct{},
+	maxPasswordAge time.Duration,
+	now time.Time,
+	warnings []string,
+) Report {
+	metadataByUsername := make(map[string]Metadata, len(stored))
+	for _, metadata := range stored {
+		metadataByUsername[metadata.Username] = metadata
+	}
+	_, wildcardACL := principals["User:*"]
+
+	users := make([]UserReport, 0, len(mechanismsByUser))
+	for username, mechanisms := range mechanismsByUser {
+		mechanisms = slices.Clone(mechanisms)
+		sort.Strings(mechanisms)
+		report := UserReport{
+			Username:   username,
+			Mechanisms: mechanisms,
+			Findings:   make([]Finding, 0),
+		}
+
+		if principals != nil && !wildcardACL {
+			if _, exists := principals["User:"+username]; !exists {
+				report.Findings = append(report.Findings, FindingNoACLs)
+			}
+		}
+
+		metadata, tracked := metadataByUsername[username]
+		if !tracked {
+			report.Findings = append(report.Findings, FindingUntracked)
+			users = append(users, report)
+			continue
+		}
+		report.Metadata = &metadata
+		if maxPasswordAge > 0 && now.Sub(metadata.PasswordChangedAt) > maxPasswordAge {
+			report.Findings = append(report.Findings, FindingStalePassword)
+		}
+		if metadata.PendingRotation != nil {
+			report.Findings = append(report.Findings, FindingRotationPending)
+		}
+		users = append(users, report)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+
+	return Report{Users: users, Warnings: warnings}
+}
+
+// getOrUntracked returns the stored metadata or empty metadata for users that
+// have been created outside of Console. The returned bool is false for the latter.
+func (s *Service) getOrUntracked(ctx context.Context, username string) (Metadata, bool, error) {
+	metadata, err := s.store.Get(ctx, username)
+	if errors.Is(err, ErrNotFound) {
+		return Metadata{Username: username}, false, nil
+	}
+	if err != nil {
+		return Metadata{}, false, fmt.Errorf("failed to get credential metadata: %w", err)
+	}
+	return metadata, true, nil
+}
+
+// putMetadata stores the metadata before the credential is changed, so that a
+// failure can be returned without having changed the credential.
+func (s *Service) putMetadata(ctx context.Context, metadata Metadata) error {
+	if err := s.store.Put(ctx, metadata); err != nil {
+		return fmt.Errorf("failed to store credential metadata: %w", err)
+	}
+	return nil
+}
+
+// restoreMetadata restores the metadata of a user after changing the credential
+// has failed. Untracked users have their metadata removed. A failure is only
+// logged, because the error of the credential change is returned to the caller.
+func (s *Service) restoreMetadata(ctx context.Context, previous Metadata, tracked bool) {
+	var err error
+	if tracked {
+		err = s.store.Put(ctx, previous)
+	} else if err = s.store.Delete(ctx, previous.Username); errors.Is(err, ErrNotFound) {
+		err = nil
+	}
+	if err != nil {
+		s.logger.Error("failed to restore credential metadata", zap.String("username", previous.Username), zap.Error(err))
+	}
+}
+
+// passwordOrGenerate returns the given password or generates one if it is empty.
+func (s *Service) passwordOrGenerate(password string) (string, bool, error) {
+	if password != "" {
+		return password, false, nil
+	}
+	generated, err := GeneratePassword(s.cfg.PasswordLength)
+	if err != nil {
+		return "", false, err
+	}
+	return generated, true, nil
+}
+
+func issued(metadata Metadata, mechanism, password string, generated bool) IssuedCredential {
+	credential := IssuedCredential{Metadata: metadata, Mechanism: mechanism}
+	if generated {
+		credential.Password = password
+	}
+	return credential
+}
+
+func validateMechanism(mechanism string) error {
+	switch mechanism {
+	case MechanismScramSha256, MechanismScramSha512:
+		return nil
+	default:
+		return fmt.Errorf("%w: mechanism must be either %s or %s", ErrInvalidRequest, MechanismScramSha256, MechanismScramSha512)
+	}
+}
+
+func otherMechanism(mechanism string) string {
+	if mechanism == MechanismScramSha256 {
+		return MechanismScramSha512
+	}
+	return MechanismScramSha256
+}
+
+// actorFromContext returns the name of the user of the context, which is empty if
+// authentication is disabled.
+func actorFromContext(ctx context.Context) string {
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		return principal.Name
+	}
+	return ""
+}
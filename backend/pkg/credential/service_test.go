@@ -0,0 +1,356 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package credential
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// testClient keeps the passwords of all SCRAM credentials by user and mechanism.
+type testClient struct {
+	passwords     map[string]map[string]string
+	principals    map[string]struct{}
+	principalsErr error
+}
+
+func newTestClient() *testClient {
+	return &testClient{
+		passwords:  make(map[string]map[string]string),
+		principals: make(map[string]struct{}),
+	}
+}
+
+func (c *testClient) DescribeCredentials(_ context.Context) (map[string][]string, error) {
+	mechanismsByUser := make(map[string][]string, len(c.passwords))
+	for username, passwordsByMechanism := range c.passwords {
+		for mechanism := range passwordsByMechanism {
+			mechanismsByUser[username] = append(mechanismsByUser[username], mechanism)
+		}
+	}
+	return mechanismsByUser, nil
+}
+
+func (c *testClient) UpsertCredential(_ context.Context, username, mechanism, password string) error {
+	if c.passwords[username] == nil {
+		c.passwords[username] = make(map[string]string)
+	}
+	c.passwords[username][mechanism] = password
+	return nil
+}
+
+func (c *testClient) DeleteCredential(_ context.Context, username, mechanism string) error {
+	if _, exists := c.passwords[username][mechanism]; !exists {
+		return errors.New("credential does not exist")
+	}
+	delete(c.passwords[username], mechanism)
+	if len(c.passwords[username]) == 0 {
+		delete(c.passwords, username)
+	}
+	return nil
+}
+
+func (c *testClient) ListACLPrincipals(_ context.Context) (map[string]struct{}, error) {
+	return c.principals, c.principalsErr
+}
+
+func newTestService(t *testing.T, store Store) (*Service, *testClient) {
+	t.Helper()
+	cfg := config.KafkaCredentials{Enabled: true}
+	cfg.SetDefaults()
+	if _, persistent := store.(*FileStore); persistent {
+		cfg.Storage = config.KafkaCredentialsStorageFile
+	}
+	client := newTestClient()
+	return NewService(cfg, zap.NewNop(), store, client), client
+}
+
+func newTestFileStore(t *testing.T) *FileStore {
+	t.Helper()
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "metadata.json"))
+	require.NoError(t, err)
+	return store
+}
+
+// failingStore fails to store metadata.
+type failingStore struct {
+	*MemoryStore
+}
+
+func (*failingStore) Put(context.Context, Metadata) error {
+	return errors.New("disk full")
+}
+
+func contextFor(name string) context.Context {
+	return auth.ContextWithPrincipal(context.Background(), &auth.Principal{Name: name, Provider: auth.ProviderBasic})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	seen := make(map[string]struct{})
+	for i := 0; i < 50; i++ {
+		password, err := GeneratePassword(16)
+		require.NoError(t, err)
+		assert.Len(t, password, 16)
+		assert.True(t, containsAllClasses(password), "password %q misses a character class", password)
+		seen[password] = struct{}{}
+	}
+	assert.Len(t, seen, 50)
+
+	_, err := GeneratePassword(8)
+	assert.Error(t, err)
+}
+
+func TestService_CreateUser(t *testing.T) {
+	svc, client := newTestService(t, NewMemoryStore())
+
+	created, err := svc.CreateUser(contextFor("alice"), CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+	assert.Len(t, created.Password, 32)
+	assert.Equal(t, created.Password, client.passwords["orders-app"][MechanismScramSha256])
+	assert.Equal(t, "alice", created.Metadata.CreatedBy)
+	require.NotNil(t, created.Metadata.CreatedAt)
+	assert.Equal(t, *created.Metadata.CreatedAt, created.Metadata.PasswordChangedAt)
+
+	// Passwords that are set by the requester are not returned
+	provided, err := svc.CreateUser(contextFor("alice"), CreateUserRequest{Username: "payments-app", Mechanism: MechanismScramSha512, Password: "chosen-by-alice"})
+	require.NoError(t, err)
+	assert.Empty(t, provided.Password)
+	assert.Equal(t, "chosen-by-alice", client.passwords["payments-app"][MechanismScramSha512])
+
+	_, err = svc.CreateUser(contextFor("alice"), CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha512})
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	_, err = svc.CreateUser(contextFor("alice"), CreateUserRequest{Username: "billing-app", Mechanism: "PLAIN"})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestService_RotateWithGracePeriod(t *testing.T) {
+	svc, client := newTestService(t, newTestFileStore(t))
+	ctx := contextFor("alice")
+
+	created, err := svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+
+	rotated, err := svc.RotatePassword(contextFor("bob"), "orders-app", RotateRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, MechanismScramSha512, rotated.Mechanism)
+	assert.NotEqual(t, created.Password, rotated.Password)
+	assert.Equal(t, "bob", rotated.Metadata.PasswordChangedBy)
+	assert.Equal(t, 1, rotated.Metadata.Rotations)
+	require.NotNil(t, rotated.Metadata.PendingRotation)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), rotated.Metadata.PendingRotation.GracePeriodEndsAt, time.Minute)
+
+	// Both passwords are valid during the grace period
+	assert.Equal(t, map[string]string{
+		MechanismScramSha256: created.Password,
+		MechanismScramSha512: rotated.Password,
+	}, client.passwords["orders-app"])
+
+	_, err = svc.RotatePassword(ctx, "orders-app", RotateRequest{})
+	assert.ErrorIs(t, err, ErrRotationPending)
+
+	// Rotations are only completed once their grace period has ended
+	svc.CompleteDueRotations(context.Background())
+	assert.Len(t, client.passwords["orders-app"], 2)
+
+	completed, err := svc.CompleteRotation(ctx, "orders-app")
+	require.NoError(t, err)
+	assert.Nil(t, completed.PendingRotation)
+	assert.Equal(t, map[string]string{MechanismScramSha512: rotated.Password}, client.passwords["orders-app"])
+
+	_, err = svc.CompleteRotation(ctx, "orders-app")
+	assert.ErrorIs(t, err, ErrNoRotationPending)
+}
+
+func TestService_CompleteDueRotations(t *testing.T) {
+	svc, client := newTestService(t, newTestFileStore(t))
+	ctx := contextFor("alice")
+
+	_, err := svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha512})
+	require.NoError(t, err)
+	rotated, err := svc.RotatePassword(ctx, "orders-app", RotateRequest{GracePeriodEndsAt: time.Now().Add(5 * time.Millisecond)})
+	require.NoError(t, err)
+	assert.Equal(t, MechanismScramSha256, rotated.Mechanism)
+
+	time.Sleep(10 * time.Millisecond)
+	svc.CompleteDueRotations(context.Background())
+
+	assert.Equal(t, map[string]string{MechanismScramSha256: rotated.Password}, client.passwords["orders-app"])
+	stored, err := svc.store.Get(ctx, "orders-app")
+	require.NoError(t, err)
+	assert.Nil(t, stored.PendingRotation)
+}
+
+func TestService_RotateImmediately(t *testing.T) {
+	svc, client := newTestService(t, NewMemoryStore())
+	ctx := contextFor("alice")
+
+	// Users created outside of Console can be rotated as well
+	require.NoError(t, client.UpsertCredential(ctx, "legacy-app", MechanismScramSha256, "old"))
+	require.NoError(t, client.UpsertCredential(ctx, "legacy-app", MechanismScramSha512, "old"))
+
+	_, err := svc.RotatePassword(ctx, "legacy-app", RotateRequest{})
+	assert.ErrorIs(t, err, ErrInvalidRequest, "grace period requires a free mechanism")
+
+	rotated, err := svc.RotatePassword(ctx, "legacy-app", RotateRequest{Immediate: true})
+	require.NoError(t, err)
+	assert.Nil(t, rotated.Metadata.PendingRotation)
+	assert.Nil(t, rotated.Metadata.CreatedAt)
+	assert.Equal(t, map[string]string{
+		MechanismScramSha256: rotated.Password,
+		MechanismScramSha512: rotated.Password,
+	}, client.passwords["legacy-app"])
+
+	_, err = svc.RotatePassword(ctx, "unknown", RotateRequest{Immediate: true})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestService_RotateWithGracePeriodRequiresFileStorage(t *testing.T) {
+	svc, client := newTestService(t, NewMemoryStore())
+	ctx := contextFor("alice")
+
+	created, err := svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+
+	// The pending rotation would be lost on restart
+	_, err = svc.RotatePassword(ctx, "orders-app", RotateRequest{})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	assert.Equal(t, map[string]string{MechanismScramSha256: created.Password}, client.passwords["orders-app"])
+
+	_, err = svc.RotatePassword(ctx, "orders-app", RotateRequest{Immediate: true})
+	require.NoError(t, err)
+}
+
+func TestService_StoreFailure(t *testing.T) {
+	svc, client := newTestService(t, &failingStore{NewMemoryStore()})
+	ctx := contextFor("alice")
+
+	// Credentials are not changed if their metadata can not be stored
+	_, err := svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	assert.ErrorContains(t, err, "disk full")
+	assert.Empty(t, client.passwords)
+
+	require.NoError(t, client.UpsertCredential(ctx, "legacy-app", MechanismScramSha256, "old"))
+	_, err = svc.RotatePassword(ctx, "legacy-app", RotateRequest{Immediate: true})
+	assert.ErrorContains(t, err, "disk full")
+	assert.Equal(t, map[string]string{MechanismScramSha256: "old"}, client.passwords["legacy-app"])
+}
+
+func TestService_DeleteUser(t *testing.T) {
+	svc, client := newTestService(t, newTestFileStore(t))
+	ctx := contextFor("alice")
+
+	_, err := svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+	_, err = svc.RotatePassword(ctx, "orders-app", RotateRequest{})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.DeleteUser(ctx, "orders-app"))
+	assert.Empty(t, client.passwords)
+	_, err = svc.store.Get(ctx, "orders-app")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.ErrorIs(t, svc.DeleteUser(ctx, "orders-app"), ErrNotFound)
+}
+
+func TestAnalyzeUsers(t *testing.T) {
+	now := time.Now()
+	mechanismsByUser := map[string][]string{
+		"fresh":    {MechanismScramSha256},
+		"stale":    {MechanismScramSha512},
+		"rotating": {MechanismScramSha512, MechanismScramSha256},
+		"legacy":   {MechanismScramSha256},
+	}
+	stored := []Metadata{
+		{Username: "fresh", PasswordChangedAt: now.Add(-time.Hour)},
+		{Username: "stale", PasswordChangedAt: now.Add(-100 * 24 * time.Hour)},
+		{Username: "rotating", PasswordChangedAt: now, PendingRotation: &Rotation{OldMechanism: MechanismScramSha256, NewMechanism: MechanismScramSha512}},
+		{Username: "deleted", PasswordChangedAt: now.Add(-100 * 24 * time.Hour)},
+	}
+	principals := map[string]struct{}{
+		"User:fresh":    {},
+		"User:rotating": {},
+		"User:legacy":   {},
+	}
+
+	report := analyzeUsers(mechanismsByUser, stored, principals, 90*24*time.Hour, now, nil)
+
+	findingsByUser := make(map[string][]Finding)
+	for _, user := range report.Users {
+		findingsByUser[user.Username] = user.Findings
+	}
+	assert.Equal(t, map[string][]Finding{
+		"fresh":    {},
+		"legacy":   {FindingUntracked},
+		"rotating": {FindingRotationPending},
+		"stale":    {FindingNoACLs, FindingStalePassword},
+	}, findingsByUser)
+	assert.Equal(t, []string{MechanismScramSha256, MechanismScramSha512}, report.Users[2].Mechanisms)
+
+	// A wildcard ACL applies to all users
+	report = analyzeUsers(mechanismsByUser, stored, map[string]struct{}{"User:*": {}}, 90*24*time.Hour, now, nil)
+	for _, user := range report.Users {
+		assert.NotContains(t, user.Findings, FindingNoACLs)
+	}
+}
+
+func TestService_ReportWithoutACLs(t *testing.T) {
+	svc, client := newTestService(t, NewMemoryStore())
+	client.principalsErr = errors.New("cluster authorization failed")
+
+	_, err := svc.CreateUser(contextFor("alice"), CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+
+	report, err := svc.Report(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Users, 1)
+	assert.Empty(t, report.Users[0].Findings)
+	require.Len(t, report.Warnings, 1)
+	assert.True(t, strings.Contains(report.Warnings[0], "cluster authorization failed"))
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := contextFor("alice")
+	path := filepath.Join(t.TempDir(), "credentials", "metadata.json")
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	svc, _ := newTestService(t, store)
+
+	_, err = svc.CreateUser(ctx, CreateUserRequest{Username: "orders-app", Mechanism: MechanismScramSha256})
+	require.NoError(t, err)
+	_, err = svc.RotatePassword(ctx, "orders-app", RotateRequest{})
+	require.NoError(t, err)
+
+	// Metadata survives a restart
+	reloaded, err := NewFileStore(path)
+	require.NoError(t, err)
+	stored, err := reloaded.Get(ctx, "orders-app")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", stored.CreatedBy)
+	assert.Equal(t, 1, stored.Rotations)
+	require.NotNil(t, stored.PendingRotation)
+	assert.Equal(t, MechanismScramSha256, stored.PendingRotation.OldMechanism)
+
+	require.NoError(t, reloaded.Delete(ctx, "orders-app"))
+	_, err = reloaded.Get(ctx, "orders-app")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redpanda-data/console/backend/pkg/jsonstore"
+)
+
+// ErrNotFound is returned if a user does not exist or no metadata is tracked for it.
+var ErrNotFound = errors.New("user not found")
+
+// Store persists the metadata of users. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Get returns the metadata of the user or ErrNotFound.
+	Get(ctx context.Context, username string) (Metadata, error)
+
+	// List returns the metadata of all users, sorted by username.
+	List(ctx context.Context) ([]Metadata, error)
+
+	// Put creates or replaces the metadata of a user.
+	Put(ctx context.Context, metadata Metadata) error
+
+	// Delete removes the metadata of a user or returns ErrNotFound.
+	Delete(ctx context.Context, username string) error
+}
+
+func metadataUsername(metadata Metadata) string {
+	return metadata.Username
+}
+
+// store implements Store on top of a JSON store that is keyed by username.
+type store struct {
+	metadata jsonstore.Store[Metadata]
+}
+
+// Get returns the metadata of the user.
+func (s *store) Get(_ context.Context, username string) (Metadata, error) {
+	metadata, err := s.metadata.Get(username)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return Metadata{}, ErrNotFound
+	}
+	return metadata, err
+}
+
+// List returns the metadata of all users, sorted by username.
+func (s *store) List(_ context.Context) ([]Metadata, error) {
+	return s.metadata.List()
+}
+
+// Put creates or replaces the metadata of a user.
+func (s *store) Put(_ context.Context, metadata Metadata) error {
+	return s.metadata.Put(metadata)
+}
+
+// Delete removes the metadata of a user.
+func (s *store) Delete(_ context.Context, username string) error {
+	err := s.metadata.Delete(username)
+	if errors.Is(err, jsonstore.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// MemoryStore keeps the metadata in memory. All metadata is lost when the process
+// exits.
+type MemoryStore struct {
+	store
+}
+
+// NewMemoryStore creates an empty in-memory metadata store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{store{metadata: jsonstore.NewMemoryStore(metadataUsername)}}
+}
+
+// FileStore keeps the metadata of all users in a single JSON file and serves
+// reads from memory. The file is rewritten on every change.
+type FileStore struct {
+	store
+}
+
+// NewFileStore loads the metadata from the file at the given path. The file is
+// created on the first change if it does not exist yet.
+func NewFileStore(path string) (*FileStore, error) {
+	metadata, err := jsonstore.NewFileStore(path, metadataUsername)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credential metadata: %w", err)
+	}
+	return &FileStore{store{metadata: metadata}}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+var _ KEKProvider = (*LocalKEKProvider)(nil)
+
+// LocalKEKProvider wraps data encryption keys with AES-256-GCM using key
+// encryption keys that are loaded from a local keyfile.
+type LocalKEKProvider struct {
+	keks map[string][]byte
+}
+
+// NewLocalKEKProvider loads all KEKs from the given keyfile. The keyfile is a
+// YAML (or JSON) document that maps KEK names to base64 encoded 256-bit keys:
+//
+//	customer-kek: "q83vEjRWeJCrze8SNFZ4kKvN7xI0VniQq83vEjRWeJA="
+func NewLocalKEKProvider(keyFilepath string) (*LocalKEKProvider, error) {
+	content, err := os.ReadFile(keyFilepath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyfile: %w", err)
+	}
+
+	var encodedKeys map[string]string
+	if err := yaml.Unmarshal(content, &encodedKeys); err != nil {
+		return nil, fmt.Errorf("failed to parse keyfile: %w", err)
+	}
+
+	keks := make(map[string][]byte, len(encodedKeys))
+	for name, encoded := range encodedKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode kek %q: %w", name, err)
+		}
+		if len(key) != dekSize {
+			return nil, fmt.Errorf("kek %q must be %d bytes long, but it is %d bytes", name, dekSize, len(key))
+		}
+		keks[name] = key
+	}
+
+	return &LocalKEKProvider{keks: keks}, nil
+}
+
+// NewLocalKEKProviderFromKeys creates a local KEK provider from the given keys.
+func NewLocalKEKProviderFromKeys(keks map[string][]byte) *LocalKEKProvider {
+	return &LocalKEKProvider{keks: keks}
+}
+
+// WrapDEK encrypts the given data encryption key using the named KEK.
+func (p *LocalKEKProvider) WrapDEK(_ context.Context, kekName string, dek []byte) ([]byte, error) {
+	kek, ok := p.keks[kekName]
+	if !ok {
+		return nil, fmt.Errorf("kek %q not found in keyfile", kekName)
+	}
+
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, dek, nil), nil
+}
+
+// UnwrapDEK decrypts the given wrapped data encryption key using the named KEK.
+func (p *LocalKEKProvider) UnwrapDEK(_ context.Context, kekName string, wrappedDEK []byte) ([]byte, error) {
+	kek, ok := p.keks[kekName]
+	if !ok {
+		return nil, fmt.Errorf("kek %q not found in keyfile", kekName)
+	}
+
+	aead, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedDEK) < aead.NonceSize() {
+		return nil, errors.New("wrapped data encryption key is too short")
+	}
+
+	nonce, ciphertext := wrappedDEK[:aead.NonceSize()], wrappedDEK[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
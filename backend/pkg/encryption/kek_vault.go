@@ -0,0 +1,135 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+var _ KEKProvider = (*VaultKEKProvider)(nil)
+
+// VaultKEKProvider wraps data encryption keys using the encrypt and decrypt
+// endpoints of a Vault transit compatible HTTP API. The KEK name is used as
+// the transit key name.
+type VaultKEKProvider struct {
+	cfg        config.EncryptionVault
+	httpClient *http.Client
+}
+
+// NewVaultKEKProvider creates a new KEK provider for a Vault transit compatible endpoint.
+func NewVaultKEKProvider(cfg config.EncryptionVault) *VaultKEKProvider {
+	return &VaultKEKProvider{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type vaultTransitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultTransitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// WrapDEK encrypts the given data encryption key using the named transit key.
+// The returned bytes are the Vault ciphertext string (e.g. "vault:v1:...").
+func (p *VaultKEKProvider) WrapDEK(ctx context.Context, kekName string, dek []byte) ([]byte, error) {
+	res, err := p.do(ctx, "encrypt", kekName, vaultTransitRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dek),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Data.Ciphertext == "" {
+		return nil, fmt.Errorf("vault response did not contain a ciphertext")
+	}
+
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// UnwrapDEK decrypts the given wrapped data encryption key using the named transit key.
+func (p *VaultKEKProvider) UnwrapDEK(ctx context.Context, kekName string, wrappedDEK []byte) ([]byte, error) {
+	res, err := p.do(ctx, "decrypt", kekName, vaultTransitRequest{
+		Ciphertext: string(wrappedDEK),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dek, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode plaintext returned by vault: %w", err)
+	}
+	return dek, nil
+}
+
+func (p *VaultKEKProvider) do(ctx context.Context, operation, kekName string, body vaultTransitRequest) (*vaultTransitResponse, error) {
+	endpoint, err := url.JoinPath(p.cfg.URL, "v1", strings.Trim(p.cfg.MountPath, "/"), operation, url.PathEscape(kekName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build vault url: %w", err)
+	}
+
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.cfg.Token != "" {
+		req.Header.Set("X-Vault-Token", p.cfg.Token)
+	}
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault %s request failed: %w", operation, err)
+	}
+	defer resp.Body.Close()
+
+	resBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault response: %w", err)
+	}
+
+	var res vaultTransitResponse
+	if err := json.Unmarshal(resBody, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse vault response (status code %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault %s request failed with status code %d: %s",
+			operation, resp.StatusCode, strings.Join(res.Errors, ", "))
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package encryption provides envelope encryption for record payloads and fields.
+// Data is encrypted with a random data encryption key (DEK), which itself is
+// wrapped by a key encryption key (KEK) that is managed by a pluggable KEK provider.
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/twmb/go-cache/cache"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+const (
+	// envelopeMagicByte is the first byte of every envelope that has been
+	// encrypted by this package.
+	envelopeMagicByte byte = 0x01
+
+	dekSize = 32
+)
+
+// KEKProvider wraps and unwraps data encryption keys with a key encryption key
+// that is identified by its name.
+type KEKProvider interface {
+	// WrapDEK encrypts the given data encryption key using the named KEK.
+	WrapDEK(ctx context.Context, kekName string, dek []byte) ([]byte, error)
+
+	// UnwrapDEK decrypts the given wrapped data encryption key using the named KEK.
+	UnwrapDEK(ctx context.Context, kekName string, wrappedDEK []byte) ([]byte, error)
+}
+
+// dataKey is a plaintext data encryption key alongside its wrapped representation.
+type dataKey struct {
+	plaintext []byte
+	wrapped   []byte
+}
+
+// Service encrypts and decrypts envelopes. Envelopes have the following format:
+//
+//	[magic byte][uint16 wrapped DEK length][wrapped DEK][12 byte nonce][AES-256-GCM ciphertext]
+//
+// Unwrapped DEKs are cached, so that the KEK provider is not called for every record.
+type Service struct {
+	cfg      config.Encryption
+	logger   *zap.Logger
+	provider KEKProvider
+
+	// dekByWrapped caches unwrapped DEKs by the wrapped DEK's bytes.
+	dekByWrapped *cache.Cache[string, []byte]
+	// dekByKEKName caches the DEK that is used for encrypting new envelopes.
+	dekByKEKName *cache.Cache[string, dataKey]
+}
+
+// NewService creates a new encryption service with the KEK provider that is
+// configured in the given config.
+func NewService(cfg config.Encryption, logger *zap.Logger) (*Service, error) {
+	var provider KEKProvider
+	switch cfg.KEKProvider {
+	case config.EncryptionKEKProviderLocal:
+		localProvider, err := NewLocalKEKProvider(cfg.Local.KeyFilepath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create local kek provider: %w", err)
+		}
+		provider = localProvider
+	case config.EncryptionKEKProviderVault:
+		provider = NewVaultKEKProvider(cfg.Vault)
+	default:
+		return nil, fmt.Errorf("unknown kek provider %q", cfg.KEKProvider)
+	}
+
+	return NewServiceWithProvider(cfg, logger, provider), nil
+}
+
+// NewServiceWithProvider creates a new encryption service that uses the given KEK provider.
+func NewServiceWithProvider(cfg config.Encryption, logger *zap.Logger, provider KEKProvider) *Service {
+	ttl := cfg.DEKCacheTTL
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+
+	return &Service{
+		cfg:          cfg,
+		logger:       logger,
+		provider:     provider,
+		dekByWrapped: cache.New[string, []byte](cache.MaxAge(ttl), cache.MaxErrorAge(time.Second)),
+		dekByKEKName: cache.New[string, dataKey](cache.MaxAge(ttl), cache.MaxErrorAge(time.Second)),
+	}
+}
+
+// IsEnvelope returns true if the given bytes look like an envelope that has
+// been created by Encrypt.
+func IsEnvelope(b []byte) bool {
+	if len(b) < 3 || b[0] != envelopeMagicByte {
+		return false
+	}
+	wrappedLen := int(binary.BigEndian.Uint16(b[1:3]))
+	return len(b) >= 3+wrappedLen+12
+}
+
+// Encrypt encrypts the plaintext with a DEK that is wrapped by the named KEK and
+// returns the envelope.
+func (s *Service) Encrypt(ctx context.Context, kekName string, plaintext []byte) ([]byte, error) {
+	key, err, _ := s.dekByKEKName.Get(kekName, func() (dataKey, error) {
+		dek := make([]byte, dekSize)
+		if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+			return dataKey{}, fmt.Errorf("failed to generate data encryption key: %w", err)
+		}
+		wrapped, err := s.provider.WrapDEK(ctx, kekName, dek)
+		if err != nil {
+			return dataKey{}, fmt.Errorf("failed to wrap data encryption key with kek %q: %w", kekName, err)
+		}
+		if len(wrapped) > 0xFFFF {
+			return dataKey{}, fmt.Errorf("wrapped data encryption key is too large (%d bytes)", len(wrapped))
+		}
+		return dataKey{plaintext: dek, wrapped: wrapped}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(key.plaintext)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	envelope := make([]byte, 0, 3+len(key.wrapped)+len(nonce)+len(plaintext)+aead.Overhead())
+	envelope = append(envelope, envelopeMagicByte)
+	envelope = binary.BigEndian.AppendUint16(envelope, uint16(len(key.wrapped)))
+	envelope = append(envelope, key.wrapped...)
+	envelope = append(envelope, nonce...)
+	return aead.Seal(envelope, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts the given envelope. The wrapped DEK that is part of the envelope
+// is unwrapped using the named KEK.
+func (s *Service) Decrypt(ctx context.Context, kekName string, envelope []byte) ([]byte, error) {
+	if !IsEnvelope(envelope) {
+		return nil, errors.New("payload is not an encryption envelope")
+	}
+
+	wrappedLen := int(binary.BigEndian.Uint16(envelope[1:3]))
+	wrapped := envelope[3 : 3+wrappedLen]
+	rest := envelope[3+wrappedLen:]
+
+	dek, err, _ := s.dekByWrapped.Get(string(wrapped), func() ([]byte, error) {
+		dek, err := s.provider.UnwrapDEK(ctx, kekName, wrapped)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unwrap data encryption key with kek %q: %w", kekName, err)
+		}
+		return dek, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("envelope is too short")
+	}
+	nonce, ciphertext := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt envelope: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aes cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm cipher: %w", err)
+	}
+	return aead, nil
+}
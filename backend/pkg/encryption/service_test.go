@@ -0,0 +1,116 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestService_LocalKEKRoundTrip(t *testing.T) {
+	kek := bytes.Repeat([]byte{0x42}, 32)
+	keyFilepath := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(keyFilepath, []byte("customer-kek: "+base64.StdEncoding.EncodeToString(kek)+"\n"), 0o600))
+
+	cfg := config.Encryption{
+		Enabled:     true,
+		KEKProvider: config.EncryptionKEKProviderLocal,
+		Local:       config.EncryptionLocal{KeyFilepath: keyFilepath},
+		DEKCacheTTL: time.Minute,
+	}
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	envelope, err := svc.Encrypt(ctx, "customer-kek", []byte("123-45-6789"))
+	require.NoError(t, err)
+	assert.True(t, IsEnvelope(envelope))
+	assert.NotContains(t, string(envelope), "123-45-6789")
+
+	plaintext, err := svc.Decrypt(ctx, "customer-kek", envelope)
+	require.NoError(t, err)
+	assert.Equal(t, "123-45-6789", string(plaintext))
+
+	_, err = svc.Encrypt(ctx, "unknown-kek", []byte("data"))
+	assert.Error(t, err)
+
+	// Tampering with the ciphertext must be detected
+	envelope[len(envelope)-1] ^= 0xFF
+	_, err = svc.Decrypt(ctx, "customer-kek", envelope)
+	assert.Error(t, err)
+}
+
+func TestService_VaultKEKRoundTrip(t *testing.T) {
+	// Stub of the Vault transit engine that "encrypts" by prefixing the base64 plaintext.
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+
+		var req vaultTransitRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		res := vaultTransitResponse{}
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/orders":
+			res.Data.Ciphertext = "vault:v1:" + req.Plaintext
+		case "/v1/transit/decrypt/orders":
+			res.Data.Plaintext = strings.TrimPrefix(req.Ciphertext, "vault:v1:")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			res.Errors = []string{"no handler for route"}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer server.Close()
+
+	cfg := config.Encryption{
+		Enabled:     true,
+		KEKProvider: config.EncryptionKEKProviderVault,
+		Vault:       config.EncryptionVault{URL: server.URL, Token: "test-token", MountPath: "transit"},
+		DEKCacheTTL: time.Minute,
+	}
+	svc, err := NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	envelope, err := svc.Encrypt(ctx, "orders", []byte(`{"amount":42}`))
+	require.NoError(t, err)
+	// Second encryption must reuse the cached DEK
+	_, err = svc.Encrypt(ctx, "orders", []byte(`{"amount":43}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// A fresh service has no cached DEKs and must unwrap via Vault
+	svc, err = NewService(cfg, zap.NewNop())
+	require.NoError(t, err)
+	plaintext, err := svc.Decrypt(ctx, "orders", envelope)
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":42}`, string(plaintext))
+	assert.Equal(t, 2, requests)
+
+	_, err = svc.Encrypt(ctx, "unknown", []byte("data"))
+	assert.ErrorContains(t, err, "status code 404")
+}
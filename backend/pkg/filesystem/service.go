@@ -58,7 +58,7 @@ func (c *Service) Start() error {
 
 	// Initially do it once to ensure there's no error. Afterwards we'll do that periodically and only print errors
 	// instead of propagating them back.
-	loadedFiles, err := c.loadFilesIntoCache()
+	loadedFiles, err := c.LoadFilesIntoCache()
 	if err != nil {
 		return err
 	}
@@ -76,7 +76,7 @@ func (c *Service) Start() error {
 				c.logger.Info("stopped sync", zap.String("reason", "received signal"))
 				return
 			case <-ticker.C:
-				loadedFiles, err := c.loadFilesIntoCache()
+				loadedFiles, err := c.LoadFilesIntoCache()
 				if err != nil {
 					c.logger.Warn("failed to read files in file provider", zap.Error(err))
 					break
@@ -93,7 +93,9 @@ func (c *Service) Start() error {
 	return nil
 }
 
-func (c *Service) loadFilesIntoCache() (int, error) {
+// LoadFilesIntoCache reads all files from the configured paths once and returns the number of
+// loaded files. Use this instead of Start if the files are not supposed to be refreshed.
+func (c *Service) LoadFilesIntoCache() (int, error) {
 	filesByName, err := c.readFiles()
 	if err != nil {
 		return 0, fmt.Errorf("failed to read files in file provider: %w", err)
@@ -0,0 +1,277 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package jsonstore provides small key-value stores for values that are
+// persisted as JSON. Reads are served from memory, the file based store
+// rewrites its file on every change.
+package jsonstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrNotFound is returned if no value with the requested key exists.
+	ErrNotFound = errors.New("not found")
+
+	// ErrAlreadyExists is returned if a value with the same key is created twice.
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// KeyFunc returns the key that a value is stored under.
+type KeyFunc[V any] func(value V) string
+
+// Store keeps values of type V under the key that is derived from each value.
+// Implementations are safe for concurrent use.
+type Store[V any] interface {
+	// Get returns the value with the given key or ErrNotFound.
+	Get(key string) (V, error)
+
+	// List returns all values, sorted by key.
+	List() ([]V, error)
+
+	// Create stores a new value or returns ErrAlreadyExists.
+	Create(value V) error
+
+	// Put creates or replaces the value with the same key.
+	Put(value V) error
+
+	// Update replaces an existing value or returns ErrNotFound.
+	Update(value V) error
+
+	// Delete removes the value with the given key or returns ErrNotFound.
+	Delete(key string) error
+}
+
+// MemoryStore keeps values in memory. All values are lost when the process
+// exits.
+type MemoryStore[V any] struct {
+	keyFn KeyFunc[V]
+
+	mutex       sync.RWMutex
+	valuesByKey map[string]V
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore[V any](keyFn KeyFunc[V]) *MemoryStore[V] {
+	return &MemoryStore[V]{
+		keyFn:       keyFn,
+		valuesByKey: make(map[string]V),
+	}
+}
+
+// Get returns the value with the given key.
+func (s *MemoryStore[V]) Get(key string) (V, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	value, exists := s.valuesByKey[key]
+	if !exists {
+		return value, ErrNotFound
+	}
+	return value, nil
+}
+
+// List returns all values, sorted by key.
+func (s *MemoryStore[V]) List() ([]V, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	keys := make([]string, 0, len(s.valuesByKey))
+	for key := range s.valuesByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	values := make([]V, len(keys))
+	for i, key := range keys {
+		values[i] = s.valuesByKey[key]
+	}
+	return values, nil
+}
+
+// Create stores a new value.
+func (s *MemoryStore[V]) Create(value V) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := s.keyFn(value)
+	if _, exists := s.valuesByKey[key]; exists {
+		return ErrAlreadyExists
+	}
+	s.valuesByKey[key] = value
+	return nil
+}
+
+// Put creates or replaces the value.
+func (s *MemoryStore[V]) Put(value V) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.valuesByKey[s.keyFn(value)] = value
+	return nil
+}
+
+// Update replaces an existing value.
+func (s *MemoryStore[V]) Update(value V) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := s.keyFn(value)
+	if _, exists := s.valuesByKey[key]; !exists {
+		return ErrNotFound
+	}
+	s.valuesByKey[key] = value
+	return nil
+}
+
+// Delete removes the value with the given key.
+func (s *MemoryStore[V]) Delete(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.valuesByKey[key]; !exists {
+		return ErrNotFound
+	}
+	delete(s.valuesByKey, key)
+	return nil
+}
+
+// restore sets the value of the key to its state before a failed change.
+func (s *MemoryStore[V]) restore(key string, previous V, existed bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existed {
+		s.valuesByKey[key] = previous
+	} else {
+		delete(s.valuesByKey, key)
+	}
+}
+
+// FileStore keeps all values in a single JSON file and serves reads from
+// memory. The file is rewritten on every change.
+type FileStore[V any] struct {
+	path   string
+	memory *MemoryStore[V]
+
+	// mutex serializes writes, so that the file always reflects the latest change.
+	mutex sync.Mutex
+}
+
+// NewFileStore loads all values from the file at the given path. The file is
+// created on the first change if it does not exist yet.
+func NewFileStore[V any](path string, keyFn KeyFunc[V]) (*FileStore[V], error) {
+	s := &FileStore[V]{
+		path:   path,
+		memory: NewMemoryStore(keyFn),
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+	if len(content) == 0 {
+		return s, nil
+	}
+
+	var values []V
+	if err := json.Unmarshal(content, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode file %q: %w", path, err)
+	}
+	for _, value := range values {
+		s.memory.valuesByKey[keyFn(value)] = value
+	}
+
+	return s, nil
+}
+
+// Get returns the value with the given key.
+func (s *FileStore[V]) Get(key string) (V, error) {
+	return s.memory.Get(key)
+}
+
+// List returns all values, sorted by key.
+func (s *FileStore[V]) List() ([]V, error) {
+	return s.memory.List()
+}
+
+// Create stores a new value and rewrites the file.
+func (s *FileStore[V]) Create(value V) error {
+	return s.change(s.memory.keyFn(value), func() error { return s.memory.Create(value) })
+}
+
+// Put creates or replaces the value and rewrites the file.
+func (s *FileStore[V]) Put(value V) error {
+	return s.change(s.memory.keyFn(value), func() error { return s.memory.Put(value) })
+}
+
+// Update replaces an existing value and rewrites the file.
+func (s *FileStore[V]) Update(value V) error {
+	return s.change(s.memory.keyFn(value), func() error { return s.memory.Update(value) })
+}
+
+// Delete removes the value with the given key and rewrites the file.
+func (s *FileStore[V]) Delete(key string) error {
+	return s.change(key, func() error { return s.memory.Delete(key) })
+}
+
+// change applies the change of a single key in memory and rewrites the file.
+// If the file cannot be written, the previous value of the key is restored, so
+// that memory and file do not diverge.
+func (s *FileStore[V]) change(key string, apply func() error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous, err := s.memory.Get(key)
+	existed := err == nil
+	if err := apply(); err != nil {
+		return err
+	}
+	if err := s.write(); err != nil {
+		s.memory.restore(key, previous, existed)
+		return err
+	}
+	return nil
+}
+
+// write stores all values in a temporary file and replaces the existing file
+// with it, so that a failed write does not corrupt the stored values.
+func (s *FileStore[V]) write() error {
+	values, err := s.memory.List()
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode values: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory for file %q: %w", s.path, err)
+	}
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, encoded, 0o600); err != nil {
+		return fmt.Errorf("failed to write temporary file %q: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace file %q: %w", s.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package jsonstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func itemName(i item) string { return i.Name }
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore(itemName)
+
+	require.NoError(t, s.Create(item{Name: "b", Value: 1}))
+	assert.ErrorIs(t, s.Create(item{Name: "b", Value: 2}), ErrAlreadyExists)
+	require.NoError(t, s.Put(item{Name: "a", Value: 1}))
+	require.NoError(t, s.Update(item{Name: "a", Value: 2}))
+	assert.ErrorIs(t, s.Update(item{Name: "c"}), ErrNotFound)
+
+	got, err := s.Get("a")
+	require.NoError(t, err)
+	assert.Equal(t, item{Name: "a", Value: 2}, got)
+
+	items, err := s.List()
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "a", Value: 2}, {Name: "b", Value: 1}}, items)
+
+	require.NoError(t, s.Delete("a"))
+	assert.ErrorIs(t, s.Delete("a"), ErrNotFound)
+	_, err = s.Get("a")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "items.json")
+
+	s, err := NewFileStore(path, itemName)
+	require.NoError(t, err)
+	require.NoError(t, s.Create(item{Name: "a", Value: 1}))
+	require.NoError(t, s.Put(item{Name: "b", Value: 2}))
+	require.NoError(t, s.Delete("a"))
+
+	reopened, err := NewFileStore(path, itemName)
+	require.NoError(t, err)
+	items, err := reopened.List()
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "b", Value: 2}}, items)
+}
+
+func TestFileStore_RollbackOnWriteFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "items.json")
+
+	s, err := NewFileStore(path, itemName)
+	require.NoError(t, err)
+	require.NoError(t, s.Put(item{Name: "a", Value: 1}))
+
+	// A directory in place of the temporary file makes every write fail
+	require.NoError(t, os.Mkdir(path+".tmp", 0o750))
+
+	assert.Error(t, s.Put(item{Name: "a", Value: 2}))
+	assert.Error(t, s.Create(item{Name: "b"}))
+	assert.Error(t, s.Delete("a"))
+
+	items, err := s.List()
+	require.NoError(t, err)
+	assert.Equal(t, []item{{Name: "a", Value: 1}}, items)
+}
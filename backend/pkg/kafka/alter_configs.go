@@ -17,10 +17,10 @@ import (
 
 // IncrementalAlterConfigs sends a request to alter a Kafka resource's (broker, topics, ...) configuration.
 func (s *Service) IncrementalAlterConfigs(ctx context.Context, req *kmsg.IncrementalAlterConfigsRequest) (*kmsg.IncrementalAlterConfigsResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
 
 // AlterConfigs sends a request to set a Kafka resource's (broker, topics, ...) configuration.
 func (s *Service) AlterConfigs(ctx context.Context, req *kmsg.AlterConfigsRequest) (*kmsg.AlterConfigsResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
@@ -23,5 +23,5 @@ func (s *Service) GetAPIVersions(ctx context.Context) (*kmsg.ApiVersionsResponse
 	req.ClientSoftwareVersion = version.Version
 	req.ClientSoftwareName = "RPConsole"
 
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
@@ -85,14 +85,15 @@ type TopicConsumeRequest struct {
 }
 
 type interpreterArguments struct {
-	PartitionID   int32
-	Offset        int64
-	Timestamp     time.Time
-	Key           any
-	Value         any
-	HeadersByKey  map[string][]byte
-	KeySchemaID   *uint32
-	ValueSchemaID *uint32
+	PartitionID         int32
+	Offset              int64
+	Timestamp           time.Time
+	Key                 any
+	Value               any
+	HeadersByKey        map[string][]byte
+	DecodedHeadersByKey map[string]any
+	KeySchemaID         *uint32
+	ValueSchemaID       *uint32
 }
 
 // FetchMessages is in charge of fulfilling the topic consume request. This is tricky
@@ -107,7 +108,7 @@ func (s *Service) FetchMessages(ctx context.Context, progress IListMessagesProgr
 		partitionOffsets[consumeReq.TopicName][req.PartitionID] = offset
 	}
 
-	client, err := s.NewKgoClient(kgo.ConsumePartitions(partitionOffsets))
+	client, err := s.NewKgoClientForRequest(ctx, kgo.ConsumePartitions(partitionOffsets))
 	if err != nil {
 		return fmt.Errorf("failed to create new kafka client: %w", err)
 	}
@@ -286,6 +287,7 @@ func (*Service) setupInterpreter(interpreterCode string) (isMessageOkFunc, error
 		vm.Set("key", args.Key)
 		vm.Set("value", args.Value)
 		vm.Set("headers", args.HeadersByKey)
+		vm.Set("decodedHeaders", args.DecodedHeadersByKey)
 
 		if args.KeySchemaID != nil {
 			vm.Set("keySchemaID", *args.KeySchemaID)
@@ -0,0 +1,648 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/redpanda-data/console/backend/pkg/serde"
+)
+
+const (
+	// backwardScanInitialWindow is the number of offsets that are consumed in the first
+	// window when scanning a partition backwards. Each subsequent window doubles in size
+	// until it reaches backwardScanMaxWindow.
+	backwardScanInitialWindow = 500
+	backwardScanMaxWindow     = 20_000
+
+	// consumeRangeIdleTimeout is the duration after which we stop waiting for further
+	// records of a range. Ranges usually end once the fetched partition reaches the end
+	// of the range or the high watermark, this is only a safeguard.
+	consumeRangeIdleTimeout = 10 * time.Second
+)
+
+// RecordDeserializationRequest contains the deserialization parameters for
+// requests that return deserialized records.
+type RecordDeserializationRequest struct {
+	Troubleshoot       bool
+	IncludeRawPayload  bool
+	IgnoreMaxSizeLimit bool
+	KeyDeserializer    serde.PayloadEncoding
+	ValueDeserializer  serde.PayloadEncoding
+}
+
+// KeyLookupRequest is the request to find all records with the given key in a partition.
+type KeyLookupRequest struct {
+	TopicName   string
+	PartitionID int32
+
+	// Key is the serialized record key that must match exactly.
+	Key []byte
+
+	// MaxResults is the maximum number of records that shall be returned.
+	MaxResults int
+
+	// MaxScanOffsets is the maximum number of offsets that are scanned.
+	MaxScanOffsets int64
+
+	Deserialization RecordDeserializationRequest
+}
+
+// KeyLookupResult contains the records with the requested key, newest first.
+type KeyLookupResult struct {
+	Messages []*TopicMessage
+
+	// ScannedOffsets is the number of offsets that have been scanned.
+	ScannedOffsets int64
+
+	// IsScanComplete is true if the partition has been scanned down to the low watermark.
+	IsScanComplete bool
+}
+
+// FetchMessagesByKey scans the partition from the newest to the oldest record and returns
+// the records whose key equals the requested key. Only matching records are deserialized.
+func (s *Service) FetchMessagesByKey(ctx context.Context, req KeyLookupRequest) (*KeyLookupResult, error) {
+	marks, err := s.GetPartitionMarks(ctx, req.TopicName, []int32{req.PartitionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	mark, exists := marks[req.PartitionID]
+	if !exists {
+		return nil, fmt.Errorf("no watermarks returned for partition %d", req.PartitionID)
+	}
+	if mark.Error != nil {
+		return nil, fmt.Errorf("failed to get partition offset for partition %d: %w", req.PartitionID, mark.Error)
+	}
+
+	matches := make([]*kgo.Record, 0)
+	scanned, isComplete, err := s.scanPartitionBackwards(ctx, req.TopicName, req.PartitionID, mark.Low, mark.High, req.MaxScanOffsets,
+		func(records []*kgo.Record) bool {
+			for _, record := range records {
+				if !bytes.Equal(record.Key, req.Key) {
+					continue
+				}
+				matches = append(matches, record)
+				if len(matches) >= req.MaxResults {
+					return false
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*TopicMessage, len(matches))
+	for i, record := range matches {
+		messages[i] = s.DeserializeTopicMessage(ctx, record, req.Deserialization)
+	}
+
+	return &KeyLookupResult{
+		Messages:       messages,
+		ScannedOffsets: scanned,
+		IsScanComplete: isComplete,
+	}, nil
+}
+
+// LatestValuesRequest is the request to materialize the latest value of each key
+// in a topic, as a compacted topic would after compaction.
+type LatestValuesRequest struct {
+	TopicName    string
+	PartitionIDs []int32
+
+	// PageSize is the maximum number of keys that shall be returned.
+	PageSize int
+
+	// Cursor is the NextCursor of the previous page. If nil, the first page is
+	// returned.
+	Cursor *LatestValuesCursor
+
+	// MaxScanOffsets is the maximum number of offsets that are scanned per partition.
+	MaxScanOffsets int64
+
+	Deserialization RecordDeserializationRequest
+}
+
+// LatestValuesCursor is the position after a page of the latest value view. The
+// partitions are paged through one after another, each from the newest to the
+// oldest record, so that a page only scans the offsets it returns.
+type LatestValuesCursor struct {
+	// ID refers to the keys that have been seen in the current partition on
+	// previous pages. Older records of these keys are skipped.
+	ID string `json:"id"`
+
+	// Partitions are the partitions in the order they are paged through. Records
+	// that have been produced after the first page are not part of the view.
+	Partitions []LatestValuesCursorPartition `json:"partitions"`
+
+	// PartitionIndex is the index of the partition the next page starts in.
+	PartitionIndex int `json:"partitionIndex"`
+
+	// NextOffset is the exclusive end of the offsets of the current partition
+	// that have not been scanned yet.
+	NextOffset int64 `json:"nextOffset"`
+
+	// ScannedOffsets is the number of offsets that have been scanned in the
+	// current partition.
+	ScannedOffsets int64 `json:"scannedOffsets"`
+
+	// IsTruncated is true if the scan limit has been reached in a previous partition.
+	IsTruncated bool `json:"isTruncated"`
+}
+
+// LatestValuesCursorPartition is a partition of the latest value view along
+// with its high watermark when the first page has been requested.
+type LatestValuesCursorPartition struct {
+	PartitionID   int32 `json:"partitionId"`
+	HighWatermark int64 `json:"highWatermark"`
+}
+
+// LatestValuesResult contains one page of the latest record of each key.
+type LatestValuesResult struct {
+	// Messages contains the latest record of each key on this page, ordered by
+	// partition and from the newest to the oldest record.
+	Messages []*TopicMessage
+
+	// NextCursor is the cursor to request the next page. It is nil if this is
+	// the last page.
+	NextCursor *LatestValuesCursor
+
+	// ScannedOffsets is the number of offsets that have been scanned for this page.
+	ScannedOffsets int64
+
+	// IsScanComplete is false if the scan limit has been reached in one of the
+	// partitions so far. In that case, keys that have not been written to
+	// recently may be missing.
+	IsScanComplete bool
+}
+
+const (
+	// latestValuesCursorMaxAge is the duration the seen keys of a cursor are kept.
+	// Afterwards they are rebuilt from the offsets in the cursor.
+	latestValuesCursorMaxAge = 10 * time.Minute
+
+	// seenKeysMaxDepth is the number of pages after which the seen keys of the
+	// previous pages are merged into a single set.
+	seenKeysMaxDepth = 16
+)
+
+// seenKeys is the set of keys that have been seen on a page and on all previous
+// pages. Sets are never modified once a page has been returned, so that a page
+// can be requested again with the same cursor.
+type seenKeys struct {
+	parent *seenKeys
+	depth  int
+	keys   map[string]struct{}
+}
+
+func newSeenKeys(parent *seenKeys) *seenKeys {
+	if parent != nil && parent.depth >= seenKeysMaxDepth {
+		merged := &seenKeys{keys: make(map[string]struct{})}
+		for n := parent; n != nil; n = n.parent {
+			for key := range n.keys {
+				merged.keys[key] = struct{}{}
+			}
+		}
+		parent = merged
+	}
+
+	depth := 0
+	if parent != nil {
+		depth = parent.depth + 1
+	}
+	return &seenKeys{parent: parent, depth: depth, keys: make(map[string]struct{})}
+}
+
+// add adds the key and returns false if it has been seen before.
+func (s *seenKeys) add(key []byte) bool {
+	for n := s; n != nil; n = n.parent {
+		if _, exists := n.keys[string(key)]; exists {
+			return false
+		}
+	}
+	s.keys[string(key)] = struct{}{}
+	return true
+}
+
+// collectLatestValues appends the records of a window, in descending offset
+// order, whose key has not been seen before and which are not tombstones. It
+// stops once the page is full and returns the offset of the last record that
+// has been looked at in that case, or -1 otherwise.
+func collectLatestValues(records []*kgo.Record, seen *seenKeys, page []*kgo.Record, pageSize int) ([]*kgo.Record, int64) {
+	for _, record := range records {
+		if record.Key == nil || !seen.add(record.Key) {
+			continue
+		}
+		if record.Value == nil {
+			// Tombstones mark the key as deleted
+			continue
+		}
+		page = append(page, record)
+		if len(page) >= pageSize {
+			return page, record.Offset
+		}
+	}
+	return page, -1
+}
+
+// FetchLatestValues returns the next page of the latest record of each key. The
+// partitions are scanned from the newest to the oldest record, starting where
+// the previous page ended. Keys whose latest record is a tombstone are omitted.
+//
+//nolint:gocognit,cyclop // paging through multiple partitions
+func (s *Service) FetchLatestValues(ctx context.Context, req LatestValuesRequest) (*LatestValuesResult, error) {
+	partitionIDs := req.PartitionIDs
+	if req.Cursor != nil {
+		partitionIDs = make([]int32, len(req.Cursor.Partitions))
+		for i, partition := range req.Cursor.Partitions {
+			partitionIDs[i] = partition.PartitionID
+		}
+	}
+	marks, err := s.GetPartitionMarks(ctx, req.TopicName, partitionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return nil, fmt.Errorf("failed to get partition offset for partition %d: %w", mark.PartitionID, mark.Error)
+		}
+	}
+
+	cursor := req.Cursor
+	var seen *seenKeys
+	if cursor == nil {
+		cursor = &LatestValuesCursor{Partitions: make([]LatestValuesCursorPartition, 0, len(partitionIDs))}
+		for _, partitionID := range partitionIDs {
+			if mark, exists := marks[partitionID]; exists {
+				cursor.Partitions = append(cursor.Partitions, LatestValuesCursorPartition{PartitionID: partitionID, HighWatermark: mark.High})
+			}
+		}
+		slices.SortFunc(cursor.Partitions, func(a, b LatestValuesCursorPartition) int { return cmp.Compare(a.PartitionID, b.PartitionID) })
+		if len(cursor.Partitions) > 0 {
+			cursor.NextOffset = cursor.Partitions[0].HighWatermark
+		}
+	} else {
+		copied := *cursor
+		cursor = &copied
+		if cursor.PartitionIndex < 0 || cursor.PartitionIndex >= len(cursor.Partitions) {
+			return nil, errors.New("cursor refers to an unknown partition")
+		}
+		seen, err = s.seenKeysOfCursor(ctx, req.TopicName, cursor, marks, req.MaxScanOffsets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	page := make([]*kgo.Record, 0, req.PageSize)
+	pageSeen := newSeenKeys(seen)
+	scannedOffsets := int64(0)
+	for cursor.PartitionIndex < len(cursor.Partitions) && len(page) < req.PageSize {
+		partition := cursor.Partitions[cursor.PartitionIndex]
+		mark, exists := marks[partition.PartitionID]
+		if !exists {
+			return nil, fmt.Errorf("no watermarks returned for partition %d", partition.PartitionID)
+		}
+		end := min(cursor.NextOffset, partition.HighWatermark, mark.High)
+
+		stoppedAt := int64(-1)
+		scanned, isComplete, err := s.scanPartitionBackwards(ctx, req.TopicName, partition.PartitionID, mark.Low, end, req.MaxScanOffsets-cursor.ScannedOffsets,
+			func(records []*kgo.Record) bool {
+				page, stoppedAt = collectLatestValues(records, pageSeen, page, req.PageSize)
+				return stoppedAt < 0
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan partition %d: %w", partition.PartitionID, err)
+		}
+
+		if stoppedAt >= 0 {
+			// The page is full, the next page continues with the older records of this partition
+			scannedOffsets += end - stoppedAt
+			cursor.ScannedOffsets += end - stoppedAt
+			cursor.NextOffset = stoppedAt
+			break
+		}
+
+		scannedOffsets += scanned
+		cursor.IsTruncated = cursor.IsTruncated || !isComplete
+		cursor.PartitionIndex++
+		cursor.ScannedOffsets = 0
+		if cursor.PartitionIndex < len(cursor.Partitions) {
+			cursor.NextOffset = cursor.Partitions[cursor.PartitionIndex].HighWatermark
+		}
+		// Keys are produced to a single partition, keys of other partitions have not been seen yet
+		pageSeen = newSeenKeys(nil)
+	}
+
+	res := &LatestValuesResult{
+		Messages:       make([]*TopicMessage, len(page)),
+		ScannedOffsets: scannedOffsets,
+		IsScanComplete: !cursor.IsTruncated,
+	}
+	for i, record := range page {
+		res.Messages[i] = s.DeserializeTopicMessage(ctx, record, req.Deserialization)
+	}
+	if cursor.PartitionIndex < len(cursor.Partitions) {
+		cursor.ID = uuid.NewString()
+		if s.latestValuesCursors != nil {
+			s.latestValuesCursors.Set(cursor.ID, pageSeen)
+		}
+		res.NextCursor = cursor
+	}
+
+	return res, nil
+}
+
+// seenKeysOfCursor returns the keys that have been seen in the current partition
+// of the cursor on previous pages. If they are no longer cached, e.g. because
+// the cursor has been created by another Console instance, they are rebuilt by
+// scanning the offsets that have been returned already.
+func (s *Service) seenKeysOfCursor(ctx context.Context, topicName string, cursor *LatestValuesCursor, marks map[int32]*PartitionMarks, maxScanOffsets int64) (*seenKeys, error) {
+	rebuild := func() (*seenKeys, error) {
+		partition := cursor.Partitions[cursor.PartitionIndex]
+		mark, exists := marks[partition.PartitionID]
+		if !exists {
+			return nil, fmt.Errorf("no watermarks returned for partition %d", partition.PartitionID)
+		}
+		end := min(partition.HighWatermark, mark.High)
+		start := max(cursor.NextOffset, end-min(cursor.ScannedOffsets, maxScanOffsets), mark.Low)
+		seen := newSeenKeys(nil)
+		_, _, err := s.scanPartitionBackwards(ctx, topicName, partition.PartitionID, start, end, end-start,
+			func(records []*kgo.Record) bool {
+				for _, record := range records {
+					if record.Key != nil {
+						seen.add(record.Key)
+					}
+				}
+				return true
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan partition %d: %w", partition.PartitionID, err)
+		}
+		return seen, nil
+	}
+
+	if s.latestValuesCursors == nil {
+		return rebuild()
+	}
+	seen, err, _ := s.latestValuesCursors.Get(cursor.ID, rebuild)
+	return seen, err
+}
+
+// ScanLatestRecords scans the given partitions from the newest to the oldest record,
+// up to maxScanOffsets per partition, and calls onRecord with the latest record of
+// each key, including tombstones. Keys are expected to be produced to a single
+// partition. onRecord is not called concurrently, scanning stops if it returns an
+// error. It returns the number of scanned offsets and whether all partitions have
+// been scanned completely.
+func (s *Service) ScanLatestRecords(ctx context.Context, topicName string, partitionIDs []int32, maxScanOffsets int64, onRecord func(*kgo.Record) error) (int64, bool, error) {
+	marks, err := s.GetPartitionMarks(ctx, topicName, partitionIDs)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return 0, false, fmt.Errorf("failed to get partition offset for partition %d: %w", mark.PartitionID, mark.Error)
+		}
+	}
+
+	var (
+		mutex          sync.Mutex
+		scannedOffsets int64
+		isComplete     = true
+	)
+	g, grpCtx := errgroup.WithContext(ctx)
+	for _, mark := range marks {
+		mark := mark
+		g.Go(func() error {
+			// The first record we see for each key is the latest one, because we scan backwards.
+			seen := newSeenKeys(nil)
+			var callbackErr error
+			scanned, partitionComplete, err := s.scanPartitionBackwards(grpCtx, topicName, mark.PartitionID, mark.Low, mark.High, maxScanOffsets,
+				func(records []*kgo.Record) bool {
+					mutex.Lock()
+					defer mutex.Unlock()
+					for _, record := range records {
+						if record.Key == nil || !seen.add(record.Key) {
+							continue
+						}
+						if callbackErr = onRecord(record); callbackErr != nil {
+							return false
+						}
+					}
+					return true
+				})
+			if err != nil {
+				return fmt.Errorf("failed to scan partition %d: %w", mark.PartitionID, err)
+			}
+			if callbackErr != nil {
+				return callbackErr
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			scannedOffsets += scanned
+			isComplete = isComplete && partitionComplete
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, false, err
+	}
+
+	return scannedOffsets, isComplete, nil
+}
+
+// scanPartitionBackwards consumes the partition in windows, starting at the high watermark
+// and moving towards the low watermark. onWindow is called with the records of each window
+// in descending offset order and returns false once no further records are needed. Control
+// records are skipped. It returns the number of scanned offsets and whether the low watermark
+// has been reached.
+func (s *Service) scanPartitionBackwards(
+	ctx context.Context,
+	topicName string,
+	partitionID int32,
+	lowWaterMark, highWaterMark int64,
+	maxScanOffsets int64,
+	onWindow func(records []*kgo.Record) bool,
+) (int64, bool, error) {
+	consumer := s.newPartitionRangeConsumer(topicName, partitionID)
+	defer consumer.close()
+
+	scanned := int64(0)
+	windowEnd := highWaterMark // exclusive
+	windowSize := int64(backwardScanInitialWindow)
+
+	for windowEnd > lowWaterMark {
+		if scanned >= maxScanOffsets {
+			return scanned, false, nil
+		}
+
+		windowStart := max(lowWaterMark, windowEnd-min(windowSize, maxScanOffsets-scanned))
+		records, err := consumer.consume(ctx, windowStart, windowEnd)
+		if err != nil {
+			return scanned, false, err
+		}
+		scanned += windowEnd - windowStart
+		windowEnd = windowStart
+
+		slices.Reverse(records)
+		if !onWindow(records) {
+			return scanned, windowEnd <= lowWaterMark, nil
+		}
+
+		windowSize = min(windowSize*2, backwardScanMaxWindow)
+	}
+
+	return scanned, true, nil
+}
+
+// consumePartitionRange returns all records from the start offset (inclusive) until
+// the end offset (exclusive) in ascending order. Control records are omitted.
+func (s *Service) consumePartitionRange(ctx context.Context, topicName string, partitionID int32, startOffset, endOffset int64) ([]*kgo.Record, error) {
+	consumer := s.newPartitionRangeConsumer(topicName, partitionID)
+	defer consumer.close()
+
+	return consumer.consume(ctx, startOffset, endOffset)
+}
+
+// partitionRangeConsumer consumes ranges of offsets of a single partition, one
+// after another, with the same Kafka client.
+type partitionRangeConsumer struct {
+	svc         *Service
+	topicName   string
+	partitionID int32
+
+	// client is created when the first range is consumed, because a client that
+	// consumes partitions directly requires the partition with its offset.
+	client *kgo.Client
+}
+
+func (s *Service) newPartitionRangeConsumer(topicName string, partitionID int32) *partitionRangeConsumer {
+	return &partitionRangeConsumer{svc: s, topicName: topicName, partitionID: partitionID}
+}
+
+// close closes the client of the consumer.
+func (c *partitionRangeConsumer) close() {
+	if c.client != nil {
+		c.client.Close()
+	}
+}
+
+// consume returns all records from the start offset (inclusive) until the end
+// offset (exclusive) in ascending order. Control records are omitted. The range
+// ends once a record at or after the last offset of the range or the high
+// watermark of the partition has been fetched, so that missing offsets at the
+// end of the range, e.g. removed by compaction, do not have to be waited for.
+func (c *partitionRangeConsumer) consume(ctx context.Context, startOffset, endOffset int64) ([]*kgo.Record, error) {
+	offsets := map[string]map[int32]kgo.Offset{c.topicName: {c.partitionID: kgo.NewOffset().At(startOffset)}}
+	if c.client == nil {
+		// Control records are kept, so that a commit marker at the end of the
+		// range is fetched as well.
+		client, err := c.svc.NewKgoClientForRequest(ctx, kgo.ConsumePartitions(offsets), kgo.KeepControlRecords())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create new kafka client: %w", err)
+		}
+		c.client = client
+	} else {
+		// Removing the partition drops buffered records of the previous range
+		c.client.RemoveConsumePartitions(map[string][]int32{c.topicName: {c.partitionID}})
+		c.client.AddConsumePartitions(offsets)
+	}
+
+	records := make([]*kgo.Record, 0, endOffset-startOffset)
+	nextOffset := startOffset
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, consumeRangeIdleTimeout)
+		fetches := c.client.PollFetches(pollCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		for _, fetchErr := range fetches.Errors() {
+			if errors.Is(fetchErr.Err, context.DeadlineExceeded) || errors.Is(fetchErr.Err, context.Canceled) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to fetch records: %w", fetchErr.Err)
+		}
+
+		if fetches.NumRecords() == 0 && pollCtx.Err() != nil {
+			// No further records arrived, the remaining offsets no longer exist.
+			return records, nil
+		}
+
+		isComplete := false
+		fetches.EachPartition(func(p kgo.FetchTopicPartition) {
+			if isComplete || p.Err != nil || p.Topic != c.topicName || p.Partition != c.partitionID {
+				return
+			}
+			for _, record := range p.Records {
+				if record.Offset >= endOffset {
+					isComplete = true
+					return
+				}
+				if !record.Attrs.IsControl() {
+					records = append(records, record)
+				}
+				nextOffset = record.Offset + 1
+			}
+			// Offsets at and after the high watermark do not exist yet
+			isComplete = nextOffset >= min(endOffset, p.HighWatermark)
+		})
+		if isComplete {
+			return records, nil
+		}
+	}
+}
+
+// DeserializeTopicMessage deserializes the given record into a TopicMessage.
+func (s *Service) DeserializeTopicMessage(ctx context.Context, record *kgo.Record, req RecordDeserializationRequest) *TopicMessage {
+	deserializedRec := s.SerdeService.DeserializeRecord(
+		ctx,
+		record,
+		serde.DeserializationOptions{
+			MaxPayloadSize:     s.Config.Console.MaxDeserializationPayloadSize,
+			Troubleshoot:       req.Troubleshoot,
+			IncludeRawData:     req.IncludeRawPayload,
+			IgnoreMaxSizeLimit: req.IgnoreMaxSizeLimit,
+			KeyEncoding:        req.KeyDeserializer,
+			ValueEncoding:      req.ValueDeserializer,
+			HeaderRules:        s.Config.Kafka.Headers.Rules,
+		})
+
+	headers := make([]MessageHeader, 0, len(deserializedRec.Headers))
+	for _, header := range deserializedRec.Headers {
+		headers = append(headers, MessageHeader(header))
+	}
+
+	return &TopicMessage{
+		PartitionID:     record.Partition,
+		Offset:          record.Offset,
+		Timestamp:       record.Timestamp.UnixNano() / int64(time.Millisecond),
+		Headers:         headers,
+		Compression:     compressionTypeDisplayname(record.Attrs.CompressionType()),
+		IsTransactional: record.Attrs.IsTransactional(),
+		Key:             deserializedRec.Key,
+		Value:           deserializedRec.Value,
+		IsMessageOk:     true,
+		MessageSize:     int64(len(record.Key) + len(record.Value)),
+	}
+}
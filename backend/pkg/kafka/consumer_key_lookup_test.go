@@ -0,0 +1,133 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestCollectLatestValues(t *testing.T) {
+	// Records of a single partition in descending offset order
+	records := []*kgo.Record{
+		{Key: []byte("a"), Value: []byte("a2"), Offset: 9},
+		{Key: []byte("b"), Value: nil, Offset: 8}, // tombstone
+		{Key: []byte("c"), Value: []byte("c2"), Offset: 7},
+		{Key: nil, Value: []byte("no key"), Offset: 6},
+		{Key: []byte("a"), Value: []byte("a1"), Offset: 5},
+		{Key: []byte("b"), Value: []byte("b1"), Offset: 4},
+		{Key: []byte("d"), Value: []byte(""), Offset: 3},
+		{Key: []byte("c"), Value: []byte("c1"), Offset: 2},
+		{Key: []byte("e"), Value: []byte("e1"), Offset: 1},
+	}
+	values := func(page []*kgo.Record) []string {
+		out := make([]string, len(page))
+		for i, record := range page {
+			out[i] = string(record.Value)
+		}
+		return out
+	}
+
+	// First page stops at the record that fills the page
+	seen := newSeenKeys(nil)
+	page, stoppedAt := collectLatestValues(records, seen, nil, 2)
+	assert.Equal(t, []string{"a2", "c2"}, values(page))
+	assert.EqualValues(t, 7, stoppedAt)
+
+	// The second page resumes below the stop offset and skips older records of
+	// keys that have been seen on the first page, including the tombstone.
+	pageSeen := newSeenKeys(seen)
+	page, stoppedAt = collectLatestValues(records[3:], pageSeen, nil, 10)
+	assert.Equal(t, []string{"", "e1"}, values(page))
+	assert.EqualValues(t, -1, stoppedAt)
+
+	// The seen keys of the first page are not modified by the second page, so
+	// that the second page can be requested again.
+	page, _ = collectLatestValues(records[3:], newSeenKeys(seen), nil, 10)
+	assert.Equal(t, []string{"", "e1"}, values(page))
+}
+
+func TestSeenKeys_MergesDeepChains(t *testing.T) {
+	seen := newSeenKeys(nil)
+	for i := 0; i < 3*seenKeysMaxDepth; i++ {
+		assert.True(t, seen.add([]byte(fmt.Sprintf("key-%d", i))))
+		seen = newSeenKeys(seen)
+	}
+
+	assert.LessOrEqual(t, seen.depth, seenKeysMaxDepth)
+	for i := 0; i < 3*seenKeysMaxDepth; i++ {
+		assert.False(t, seen.add([]byte(fmt.Sprintf("key-%d", i))))
+	}
+}
+
+// nopClientHook satisfies the client hooks of the service without metrics.
+type nopClientHook struct{}
+
+func (nopClientHook) OnNewClient(*kgo.Client) {}
+
+func TestConsumePartitionRange_TailWithoutRecord(t *testing.T) {
+	fakeCluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, "orders"))
+	require.NoError(t, err)
+	defer fakeCluster.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	producer, err := kgo.NewClient(kgo.SeedBrokers(fakeCluster.ListenAddrs()...), kgo.DefaultProduceTopic("orders"))
+	require.NoError(t, err)
+	defer producer.Close()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, producer.ProduceSync(ctx, &kgo.Record{Key: []byte(fmt.Sprintf("key-%d", i))}).FirstErr())
+	}
+
+	svc := &Service{
+		Config: &config.Config{Kafka: config.Kafka{Brokers: fakeCluster.ListenAddrs()}},
+		Logger: zap.NewNop(),
+
+		KafkaClientHooks: nopClientHook{},
+	}
+	svc.KafkaClient, err = svc.NewKgoClient()
+	require.NoError(t, err)
+	defer svc.KafkaClient.Close()
+	marks, err := svc.GetPartitionMarks(ctx, "orders", []int32{0})
+	require.NoError(t, err)
+	require.NoError(t, marks[0].Error)
+	require.EqualValues(t, 10, marks[0].High)
+
+	// The offsets at the end of the range do not exist, e.g. because they are
+	// control records or have been removed by compaction. The range must end at
+	// the high watermark instead of waiting for the idle timeout.
+	started := time.Now()
+	records, err := svc.consumePartitionRange(ctx, "orders", 0, 5, 12)
+	require.NoError(t, err)
+	assert.Len(t, records, 5)
+
+	var scanned []int64
+	_, isComplete, err := svc.scanPartitionBackwards(ctx, "orders", 0, marks[0].Low, 12, 100, func(records []*kgo.Record) bool {
+		for _, record := range records {
+			scanned = append(scanned, record.Offset)
+		}
+		return true
+	})
+	require.NoError(t, err)
+	assert.True(t, isComplete)
+	assert.Equal(t, []int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, scanned)
+	assert.Less(t, time.Since(started), consumeRangeIdleTimeout)
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"golang.org/x/sync/errgroup"
+)
+
+// FetchNewestRecords returns up to maxRecords of the newest records across the given
+// partitions, newest first. Unlike FetchMessages the records are returned as they have
+// been consumed, so that they can be inspected or produced again without any loss.
+func (s *Service) FetchNewestRecords(ctx context.Context, topicName string, partitionIDs []int32, maxRecords int) ([]*kgo.Record, error) {
+	marks, err := s.GetPartitionMarks(ctx, topicName, partitionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return nil, fmt.Errorf("failed to get partition offset for partition %d: %w", mark.PartitionID, mark.Error)
+		}
+	}
+
+	var (
+		mutex   sync.Mutex
+		records = make([]*kgo.Record, 0)
+	)
+	g, grpCtx := errgroup.WithContext(ctx)
+	for _, mark := range marks {
+		mark := mark
+		g.Go(func() error {
+			// Each partition can contribute at most maxRecords records, so we never
+			// have to scan further back than that.
+			partitionRecords := make([]*kgo.Record, 0)
+			_, _, err := s.scanPartitionBackwards(grpCtx, topicName, mark.PartitionID, mark.Low, mark.High, int64(maxRecords),
+				func(window []*kgo.Record) bool {
+					partitionRecords = append(partitionRecords, window...)
+					return len(partitionRecords) < maxRecords
+				})
+			if err != nil {
+				return fmt.Errorf("failed to scan partition %d: %w", mark.PartitionID, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			records = append(records, partitionRecords...)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Timestamp.After(records[j].Timestamp) })
+	if len(records) > maxRecords {
+		records = records[:maxRecords]
+	}
+
+	return records, nil
+}
+
+// FetchRecordAtOffset returns the record at the given offset. It returns nil if the
+// offset does not exist, e.g. because it has been deleted by retention.
+func (s *Service) FetchRecordAtOffset(ctx context.Context, topicName string, partitionID int32, offset int64) (*kgo.Record, error) {
+	marks, err := s.GetPartitionMarks(ctx, topicName, []int32{partitionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	mark, exists := marks[partitionID]
+	if !exists {
+		return nil, fmt.Errorf("no watermarks returned for partition %d", partitionID)
+	}
+	if mark.Error != nil {
+		return nil, fmt.Errorf("failed to get partition offset for partition %d: %w", partitionID, mark.Error)
+	}
+	if offset < mark.Low || offset >= mark.High {
+		return nil, nil
+	}
+
+	records, err := s.consumePartitionRange(ctx, topicName, partitionID, offset, offset+1)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if record.Offset == offset {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+// ScanNewestRecords consumes the given partitions from the newest towards the oldest
+// record, scanning at most maxScanOffsets offsets per partition. The partitions are
+// scanned concurrently. onRecord is called for each record of a partition in descending
+// offset order and returns false once no further records of that partition are needed.
+// It is never called concurrently. The returned bool is false if any partition has
+// not been scanned to its low watermark, because maxScanOffsets has been reached.
+func (s *Service) ScanNewestRecords(
+	ctx context.Context,
+	topicName string,
+	partitionIDs []int32,
+	maxScanOffsets int64,
+	onRecord func(record *kgo.Record) bool,
+) (bool, error) {
+	marks, err := s.GetPartitionMarks(ctx, topicName, partitionIDs)
+	if err != nil {
+		return false, fmt.Errorf("failed to get watermarks: %w", err)
+	}
+	for _, mark := range marks {
+		if mark.Error != nil {
+			return false, fmt.Errorf("failed to get partition offset for partition %d: %w", mark.PartitionID, mark.Error)
+		}
+	}
+
+	var (
+		mutex      sync.Mutex
+		isComplete = true
+	)
+	g, grpCtx := errgroup.WithContext(ctx)
+	for _, mark := range marks {
+		mark := mark
+		g.Go(func() error {
+			stopped := false
+			_, reachedLowWaterMark, err := s.scanPartitionBackwards(grpCtx, topicName, mark.PartitionID, mark.Low, mark.High, maxScanOffsets,
+				func(window []*kgo.Record) bool {
+					mutex.Lock()
+					defer mutex.Unlock()
+					for _, record := range window {
+						if !onRecord(record) {
+							stopped = true
+							return false
+						}
+					}
+					return true
+				})
+			if err != nil {
+				return fmt.Errorf("failed to scan partition %d: %w", mark.PartitionID, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if !stopped && !reachedLowWaterMark {
+				isComplete = false
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return false, err
+	}
+
+	return isComplete, nil
+}
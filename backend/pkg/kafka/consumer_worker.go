@@ -65,25 +65,29 @@ func (s *Service) startMessageWorker(ctx context.Context, wg *sync.WaitGroup,
 				IgnoreMaxSizeLimit: consumeReq.IgnoreMaxSizeLimit,
 				KeyEncoding:        consumeReq.KeyDeserializer,
 				ValueEncoding:      consumeReq.ValueDeserializer,
+				HeaderRules:        s.Config.Kafka.Headers.Rules,
 			})
 
 		headersByKey := make(map[string][]byte, len(deserializedRec.Headers))
+		decodedHeadersByKey := make(map[string]any, len(deserializedRec.Headers))
 		headers := make([]MessageHeader, 0)
 		for _, header := range deserializedRec.Headers {
 			headersByKey[header.Key] = header.Value
+			decodedHeadersByKey[header.Key] = DecodedHeaderValue(header)
 			headers = append(headers, MessageHeader(header))
 		}
 
 		// Check if message passes filter code
 		args := interpreterArguments{
-			PartitionID:   record.Partition,
-			Offset:        record.Offset,
-			Timestamp:     record.Timestamp,
-			Key:           deserializedRec.Key.DeserializedPayload,
-			Value:         deserializedRec.Value.DeserializedPayload,
-			HeadersByKey:  headersByKey,
-			KeySchemaID:   deserializedRec.Key.SchemaID,
-			ValueSchemaID: deserializedRec.Value.SchemaID,
+			PartitionID:         record.Partition,
+			Offset:              record.Offset,
+			Timestamp:           record.Timestamp,
+			Key:                 deserializedRec.Key.DeserializedPayload,
+			Value:               deserializedRec.Value.DeserializedPayload,
+			HeadersByKey:        headersByKey,
+			DecodedHeadersByKey: decodedHeadersByKey,
+			KeySchemaID:         deserializedRec.Key.SchemaID,
+			ValueSchemaID:       deserializedRec.Value.SchemaID,
 		}
 
 		isOK, err := isMessageOK(args)
@@ -114,3 +118,18 @@ func (s *Service) startMessageWorker(ctx context.Context, wg *sync.WaitGroup,
 		}
 	}
 }
+
+// DecodedHeaderValue returns the header value that shall be passed to the JavaScript
+// interpreter. Headers that have been decoded by a header rule are passed as deserialized
+// object, all other header values are passed as string or byte array.
+func DecodedHeaderValue(header serde.RecordHeader) any {
+	if header.DecodedValue != nil && header.DecodedValue.Encoding != serde.PayloadEncodingBinary {
+		return header.DecodedValue.DeserializedPayload
+	}
+
+	if header.Encoding == serde.HeaderEncodingUTF8 {
+		return string(header.Value)
+	}
+
+	return header.Value
+}
@@ -18,7 +18,7 @@ import (
 
 // CreateTopics creates new Kafka topic.
 func (s *Service) CreateTopics(ctx context.Context, req *kmsg.CreateTopicsRequest) (*kmsg.CreateTopicsResponse, error) {
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, fmt.Errorf("request has failed: %w", err)
 	}
@@ -18,7 +18,7 @@ import (
 
 // DeleteACLs deletes all Kafka ACLs in the target cluster that match the provided filter.
 func (s *Service) DeleteACLs(ctx context.Context, req *kmsg.DeleteACLsRequest) (*kmsg.DeleteACLsResponse, error) {
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete acls: %w", err)
 	}
@@ -22,7 +22,7 @@ func (s *Service) DeleteConsumerGroup(ctx context.Context, groupID string) (*kms
 	req := kmsg.NewDeleteGroupsRequest()
 	req.Groups = []string{groupID}
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, err
 	}
@@ -22,7 +22,7 @@ func (s *Service) DeleteRecords(ctx context.Context, deleteReq kmsg.DeleteRecord
 	req := kmsg.NewDeleteRecordsRequest()
 	req.Topics = []kmsg.DeleteRecordsRequestTopic{deleteReq}
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete records: %w", err)
 	}
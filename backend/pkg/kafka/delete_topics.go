@@ -17,5 +17,5 @@ import (
 
 // DeleteTopics requests deletion for one or more kafka topics via the Kafka API.
 func (s *Service) DeleteTopics(ctx context.Context, req *kmsg.DeleteTopicsRequest) (*kmsg.DeleteTopicsResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
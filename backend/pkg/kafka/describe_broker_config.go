@@ -31,5 +31,5 @@ func (s *Service) DescribeBrokerConfig(ctx context.Context, brokerID int32, conf
 	req.IncludeSynonyms = true
 	req.IncludeDocumentation = true
 
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
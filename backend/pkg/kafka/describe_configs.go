@@ -17,5 +17,5 @@ import (
 
 // DescribeConfigs describes topic or broker configs.
 func (s *Service) DescribeConfigs(ctx context.Context, req *kmsg.DescribeConfigsRequest) (*kmsg.DescribeConfigsResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
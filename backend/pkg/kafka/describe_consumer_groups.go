@@ -73,7 +73,7 @@ func (s *Service) DescribeConsumerGroups(ctx context.Context, groups []string) (
 		Groups:                      groups,
 		IncludeAuthorizedOperations: false,
 	}
-	shardedResp := s.KafkaClient.RequestSharded(ctx, &req)
+	shardedResp := s.requestSharded(ctx, &req)
 
 	result := &DescribeConsumerGroupsResponseSharded{
 		Groups:         make([]DescribeConsumerGroupsResponse, 0),
@@ -113,7 +113,7 @@ func (s *Service) DescribeConsumerGroup(ctx context.Context, groupID string) (km
 	req := kmsg.NewDescribeGroupsRequest()
 	req.Groups = []string{groupID}
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return kmsg.DescribeGroupsResponseGroup{}, err
 	}
@@ -18,5 +18,5 @@ import (
 // DescribeQuotas requests a list of configured Quota rules via the Kafka API.
 func (s *Service) DescribeQuotas(ctx context.Context) (*kmsg.DescribeClientQuotasResponse, error) {
 	r := kmsg.NewDescribeClientQuotasRequest()
-	return r.RequestWith(ctx, s.KafkaClient)
+	return r.RequestWith(ctx, s.requestor())
 }
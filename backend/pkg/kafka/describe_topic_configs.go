@@ -35,7 +35,7 @@ func (s *Service) DescribeTopicsConfigs(ctx context.Context, topicNames []string
 	req.IncludeDocumentation = true
 	req.IncludeSynonyms = true
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		s.Logger.Error("could not describe topic configs", zap.Error(err))
 		return nil, fmt.Errorf("failed to request topic configs: %w", err)
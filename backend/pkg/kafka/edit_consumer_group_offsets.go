@@ -22,7 +22,7 @@ func (s *Service) EditConsumerGroupOffsets(ctx context.Context, groupID string,
 	req.Group = groupID
 	req.Topics = topics
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit group offsets for group '%v': %w", groupID, err)
 	}
@@ -38,7 +38,7 @@ func (s *Service) DeleteConsumerGroupOffsets(ctx context.Context, groupID string
 	req.Group = groupID
 	req.Topics = topics
 
-	res, err := req.RequestWith(ctx, s.KafkaClient)
+	res, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit group offset delete request for group '%v': %w", groupID, err)
 	}
@@ -29,7 +29,7 @@ func (s *Service) EditTopicConfig(ctx context.Context, topicName string, configs
 	req := kmsg.NewIncrementalAlterConfigsRequest()
 	req.Resources = []kmsg.IncrementalAlterConfigsRequestResource{alterResource}
 
-	response, err := req.RequestWith(ctx, s.KafkaClient)
+	response, err := req.RequestWith(ctx, s.requestor())
 	if err != nil {
 		return fmt.Errorf("failed to request alter configs: %w", err)
 	}
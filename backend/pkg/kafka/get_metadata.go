@@ -37,7 +37,7 @@ func (s *Service) GetMetadataTopics(ctx context.Context, topics []string) (*kmsg
 	req := kmsg.NewMetadataRequest()
 	req.Topics = metadataRequestTopics
 
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
 
 // GetSingleTopicMetadata returns metadata for a single topic.
@@ -83,5 +83,5 @@ func (s *Service) GetSingleTopicMetadata(ctx context.Context, topic string) (kms
 
 // GetMetadata executes the metadata request.
 func (s *Service) GetMetadata(ctx context.Context, req *kmsg.MetadataRequest) (*kmsg.MetadataResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
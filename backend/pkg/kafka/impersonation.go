@@ -0,0 +1,293 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file https://github.com/redpanda-data/redpanda/blob/dev/licenses/bsl.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// Impersonation identifies the user on whose behalf Kafka requests are made.
+type Impersonation struct {
+	// Principal is the name of the user. It is used as key for the client pool.
+	Principal string
+	// Token is the OAUTHBEARER token that is used to authenticate as the user.
+	Token string
+}
+
+type impersonationCtxKey struct{}
+
+// ContextWithImpersonation returns a copy of ctx, so that all Kafka requests that
+// are made with it authenticate as the given user if impersonation is enabled.
+func ContextWithImpersonation(ctx context.Context, impersonation Impersonation) context.Context {
+	return context.WithValue(ctx, impersonationCtxKey{}, impersonation)
+}
+
+func impersonationFromContext(ctx context.Context) (Impersonation, bool) {
+	impersonation, ok := ctx.Value(impersonationCtxKey{}).(Impersonation)
+	return impersonation, ok
+}
+
+// Client returns the Kafka client for the user of the request if impersonation is
+// enabled and the context carries an impersonation. Otherwise, the client with the
+// configured credentials is returned.
+func (s *Service) Client(ctx context.Context) (*kgo.Client, error) {
+	if s.clientPool == nil {
+		return s.KafkaClient, nil
+	}
+	impersonation, ok := impersonationFromContext(ctx)
+	if !ok {
+		return s.KafkaClient, nil
+	}
+	return s.clientPool.get(ctx, impersonation)
+}
+
+// AdminClient returns the Kafka admin client for the user of the request, see Client.
+func (s *Service) AdminClient(ctx context.Context) (*kadm.Client, error) {
+	if s.clientPool == nil {
+		return s.KafkaAdmClient, nil
+	}
+	client, err := s.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return kadm.NewClient(client), nil
+}
+
+// userRequestor issues each request with the Kafka client of the user in the
+// request context, see Client.
+type userRequestor struct {
+	s *Service
+}
+
+// Request implements kmsg.Requestor.
+func (r userRequestor) Request(ctx context.Context, req kmsg.Request) (kmsg.Response, error) {
+	client, err := r.s.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Request(ctx, req)
+}
+
+// requestor returns a kmsg.Requestor that authenticates as the user of the request.
+func (s *Service) requestor() kmsg.Requestor {
+	return userRequestor{s: s}
+}
+
+// requestSharded is like kgo.Client.RequestSharded with the client of the user of the
+// request. If no client could be created, a single shard with the error is returned.
+func (s *Service) requestSharded(ctx context.Context, req kmsg.Request) []kgo.ResponseShard {
+	client, err := s.Client(ctx)
+	if err != nil {
+		return []kgo.ResponseShard{{Req: req, Err: err}}
+	}
+	return client.RequestSharded(ctx, req)
+}
+
+// NewKgoClientForRequest creates a new Kafka client with the credentials of the user of the
+// request if impersonation is enabled. The caller must close the client.
+func (s *Service) NewKgoClientForRequest(ctx context.Context, additionalOpts ...kgo.Opt) (*kgo.Client, error) {
+	impersonation, ok := impersonationFromContext(ctx)
+	if s.clientPool == nil || !ok {
+		return s.NewKgoClient(additionalOpts...)
+	}
+
+	kgoOpts, err := s.impersonatedKgoConfig(func(context.Context) (oauth.Auth, error) {
+		return oauth.Auth{Token: impersonation.Token}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	kgoOpts = append(kgoOpts, additionalOpts...)
+	kafkaClient, err := kgo.NewClient(kgoOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	return kafkaClient, nil
+}
+
+// impersonatedKgoConfig returns the Kafka client options with the configured SASL
+// credentials replaced by an OAUTHBEARER mechanism that uses the token of the user.
+func (s *Service) impersonatedKgoConfig(tokenFn func(context.Context) (oauth.Auth, error)) ([]kgo.Opt, error) {
+	kafkaCfg := s.Config.Kafka
+	kafkaCfg.SASL.Enabled = false
+	kgoOpts, err := NewKgoConfig(&kafkaCfg, s.Logger, s.KafkaClientHooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a valid kafka client config: %w", err)
+	}
+	return append(kgoOpts, kgo.SASL(oauth.Oauth(tokenFn))), nil
+}
+
+// pooledClient is a Kafka client that authenticates as a single user.
+type pooledClient struct {
+	client   *kgo.Client
+	token    string
+	lastUsed time.Time
+	// refs is the number of requests that are still using the client. Clients
+	// that are removed from the pool are only closed once it drops to zero.
+	refs int
+	// removed is true once the client has been removed from the pool.
+	removed bool
+	closed  bool
+}
+
+// clientPool keeps a bounded number of Kafka clients, one per impersonated user.
+// Clients that have not been used for the idle timeout, or the least recently used
+// client once the pool is full, are removed from the pool. Removed clients are
+// closed as soon as no request is using them anymore.
+type clientPool struct {
+	cfg       config.AuthImpersonation
+	logger    *zap.Logger
+	newClient func(tokenFn func(context.Context) (oauth.Auth, error)) (*kgo.Client, error)
+
+	mutex   sync.Mutex
+	clients map[string]*pooledClient
+}
+
+func newClientPool(cfg config.AuthImpersonation, logger *zap.Logger, newClient func(func(context.Context) (oauth.Auth, error)) (*kgo.Client, error)) *clientPool {
+	return &clientPool{
+		cfg:       cfg,
+		logger:    logger,
+		newClient: newClient,
+		clients:   make(map[string]*pooledClient),
+	}
+}
+
+// get returns the client of the user. The token of an existing client is replaced,
+// so that new connections authenticate with the most recent token of the user.
+// The client is in use until ctx is done and is not closed before that.
+func (p *clientPool) get(ctx context.Context, impersonation Impersonation) (*kgo.Client, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	now := time.Now()
+	p.removeIdleClients(now)
+
+	if pooled, exists := p.clients[impersonation.Principal]; exists {
+		pooled.token = impersonation.Token
+		pooled.lastUsed = now
+		p.acquire(ctx, pooled)
+		return pooled.client, nil
+	}
+
+	if len(p.clients) >= p.cfg.MaxClients {
+		p.removeLeastRecentlyUsedClient()
+	}
+
+	pooled := &pooledClient{token: impersonation.Token, lastUsed: now}
+	client, err := p.newClient(func(context.Context) (oauth.Auth, error) {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		return oauth.Auth{Token: pooled.token}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client for user %q: %w", impersonation.Principal, err)
+	}
+	pooled.client = client
+	p.clients[impersonation.Principal] = pooled
+	p.acquire(ctx, pooled)
+	p.logger.Debug("created kafka client for impersonated user",
+		zap.String("principal", impersonation.Principal),
+		zap.Int("pool_size", len(p.clients)))
+
+	return client, nil
+}
+
+// acquire marks the client as used until ctx is done. Contexts that are never
+// done, such as context.Background(), do not hold a reference. The mutex must be
+// held.
+func (p *clientPool) acquire(ctx context.Context, pooled *pooledClient) {
+	if ctx.Done() == nil {
+		return
+	}
+	pooled.refs++
+	context.AfterFunc(ctx, func() {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		pooled.refs--
+		pooled.lastUsed = time.Now()
+		if pooled.removed && pooled.refs == 0 {
+			p.closeClient(pooled)
+		}
+	})
+}
+
+// removeIdleClients removes all clients that are not in use and have not been used
+// for the idle timeout. The mutex must be held.
+func (p *clientPool) removeIdleClients(now time.Time) {
+	for principal, pooled := range p.clients {
+		if pooled.refs == 0 && now.Sub(pooled.lastUsed) > p.cfg.IdleTimeout {
+			p.removeClient(principal, pooled)
+		}
+	}
+}
+
+// removeLeastRecentlyUsedClient removes the client that has not been used for the
+// longest time, preferring clients that are not in use. The mutex must be held.
+func (p *clientPool) removeLeastRecentlyUsedClient() {
+	var oldestPrincipal string
+	var oldest *pooledClient
+	for principal, pooled := range p.clients {
+		if oldest == nil {
+			oldestPrincipal, oldest = principal, pooled
+			continue
+		}
+		isInUse, isOldestInUse := pooled.refs > 0, oldest.refs > 0
+		if (isOldestInUse && !isInUse) || (isInUse == isOldestInUse && pooled.lastUsed.Before(oldest.lastUsed)) {
+			oldestPrincipal, oldest = principal, pooled
+		}
+	}
+	if oldest != nil {
+		p.removeClient(oldestPrincipal, oldest)
+	}
+}
+
+// removeClient removes the client from the pool and closes it, unless it is still
+// in use. The mutex must be held.
+func (p *clientPool) removeClient(principal string, pooled *pooledClient) {
+	delete(p.clients, principal)
+	pooled.removed = true
+	if pooled.refs == 0 {
+		p.closeClient(pooled)
+	}
+}
+
+// closeClient closes the client once. The mutex must be held.
+func (p *clientPool) closeClient(pooled *pooledClient) {
+	if pooled.closed {
+		return
+	}
+	pooled.closed = true
+	// Closing may block until in-flight requests are done, which would block the
+	// pool if done with the mutex held.
+	go pooled.client.Close()
+}
+
+// close closes all clients of the pool, including clients that are still in use.
+func (p *clientPool) close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for principal, pooled := range p.clients {
+		delete(p.clients, principal)
+		pooled.removed = true
+		p.closeClient(pooled)
+	}
+}
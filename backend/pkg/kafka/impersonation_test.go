@@ -0,0 +1,103 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+func TestClientPool(t *testing.T) {
+	tokenFnsByClient := make(map[*kgo.Client]func(context.Context) (oauth.Auth, error))
+	pool := newClientPool(
+		config.AuthImpersonation{MaxClients: 2, IdleTimeout: time.Minute},
+		zap.NewNop(),
+		func(tokenFn func(context.Context) (oauth.Auth, error)) (*kgo.Client, error) {
+			client, err := kgo.NewClient(kgo.SeedBrokers("localhost:9092"))
+			if err == nil {
+				tokenFnsByClient[client] = tokenFn
+			}
+			return client, err
+		})
+	t.Cleanup(pool.close)
+	tokenOf := func(client *kgo.Client) string {
+		auth, err := tokenFnsByClient[client](context.Background())
+		require.NoError(t, err)
+		return auth.Token
+	}
+
+	alice, err := pool.get(context.Background(), Impersonation{Principal: "oidc:alice", Token: "token-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", tokenOf(alice))
+
+	// Clients are reused per user and authenticate with the most recent token
+	aliceAgain, err := pool.get(context.Background(), Impersonation{Principal: "oidc:alice", Token: "token-2"})
+	require.NoError(t, err)
+	assert.Same(t, alice, aliceAgain)
+	assert.Equal(t, "token-2", tokenOf(alice))
+
+	bob, err := pool.get(context.Background(), Impersonation{Principal: "oidc:bob", Token: "token-3"})
+	require.NoError(t, err)
+	assert.NotSame(t, alice, bob)
+
+	// Once the pool is full, the least recently used client is closed
+	pool.clients["oidc:alice"].lastUsed = time.Now().Add(-30 * time.Second)
+	_, err = pool.get(context.Background(), Impersonation{Principal: "oidc:carol", Token: "token-4"})
+	require.NoError(t, err)
+	assert.Len(t, pool.clients, 2)
+	assert.NotContains(t, pool.clients, "oidc:alice")
+
+	// Idle clients are closed
+	pool.clients["oidc:bob"].lastUsed = time.Now().Add(-2 * time.Minute)
+	_, err = pool.get(context.Background(), Impersonation{Principal: "oidc:carol", Token: "token-4"})
+	require.NoError(t, err)
+	assert.Len(t, pool.clients, 1)
+	assert.Contains(t, pool.clients, "oidc:carol")
+}
+
+func TestClientPoolKeepsClientsInUse(t *testing.T) {
+	pool := newClientPool(
+		config.AuthImpersonation{MaxClients: 1, IdleTimeout: time.Minute},
+		zap.NewNop(),
+		func(func(context.Context) (oauth.Auth, error)) (*kgo.Client, error) {
+			return kgo.NewClient(kgo.SeedBrokers("localhost:9092"))
+		})
+	t.Cleanup(pool.close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := pool.get(ctx, Impersonation{Principal: "oidc:alice", Token: "token-1"})
+	require.NoError(t, err)
+	alice := pool.clients["oidc:alice"]
+
+	// The client of alice is removed from the full pool, but not closed while its request is running
+	_, err = pool.get(context.Background(), Impersonation{Principal: "oidc:bob", Token: "token-2"})
+	require.NoError(t, err)
+	assert.NotContains(t, pool.clients, "oidc:alice")
+	pool.mutex.Lock()
+	assert.True(t, alice.removed)
+	assert.False(t, alice.closed)
+	pool.mutex.Unlock()
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		pool.mutex.Lock()
+		defer pool.mutex.Unlock()
+		return alice.closed
+	}, time.Second, 10*time.Millisecond)
+}
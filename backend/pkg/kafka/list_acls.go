@@ -24,5 +24,5 @@ import (
 // filter, with entries corresponding to users. The first three fields form the
 // resource filter, the last four the entry filter.
 func (s *Service) ListACLs(ctx context.Context, req kmsg.DescribeACLsRequest) (*kmsg.DescribeACLsResponse, error) {
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
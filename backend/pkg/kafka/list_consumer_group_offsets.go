@@ -18,7 +18,15 @@ import (
 
 // ListConsumerGroupOffsetsBulk returns the committed group offsets for one or more consumer groups.
 func (s *Service) ListConsumerGroupOffsetsBulk(ctx context.Context, groups []string) kadm.FetchOffsetsResponses {
-	res := s.KafkaAdmClient.FetchManyOffsets(ctx, groups...)
+	adminClient, err := s.AdminClient(ctx)
+	if err != nil {
+		res := make(kadm.FetchOffsetsResponses, len(groups))
+		for _, group := range groups {
+			res[group] = kadm.FetchOffsetsResponse{Group: group, Err: err}
+		}
+		return res
+	}
+	res := adminClient.FetchManyOffsets(ctx, groups...)
 	res.EachError(func(shardRes kadm.FetchOffsetsResponse) {
 		s.Logger.Warn("failed to fetch group offset",
 			zap.String("group", shardRes.Group),
@@ -51,7 +51,7 @@ type ListConsumerGroupsResponse struct {
 // If all broker requests fail an error will be returned.
 func (s *Service) ListConsumerGroups(ctx context.Context) (*ListConsumerGroupsResponseSharded, error) {
 	req := kmsg.ListGroupsRequest{}
-	shardedResp := s.KafkaClient.RequestSharded(ctx, &req)
+	shardedResp := s.requestSharded(ctx, &req)
 
 	result := &ListConsumerGroupsResponseSharded{
 		Groups:         make([]ListConsumerGroupsResponse, len(shardedResp)),
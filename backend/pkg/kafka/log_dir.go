@@ -40,7 +40,7 @@ type LogDirResponse struct {
 func (s *Service) DescribeLogDirs(ctx context.Context, topicPartitions []kmsg.DescribeLogDirsRequestTopic) []LogDirResponse {
 	req := kmsg.NewDescribeLogDirsRequest()
 	req.Topics = topicPartitions
-	shardedResp := s.KafkaClient.RequestSharded(ctx, &req)
+	shardedResp := s.requestSharded(ctx, &req)
 
 	result := make([]LogDirResponse, 0, len(shardedResp))
 	sharedLogDirs := make([]kmsg.DescribeLogDirsResponseDir, 0)
@@ -20,7 +20,7 @@ func (s *Service) ListPartitionReassignments(ctx context.Context) (*kmsg.ListPar
 	req := kmsg.NewListPartitionReassignmentsRequest()
 	req.Topics = nil // List for all topics
 
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
 
 // AlterPartitionAssignments allows to change what brokers topic partitions are assigned to.
@@ -28,5 +28,5 @@ func (s *Service) AlterPartitionAssignments(ctx context.Context, topics []kmsg.A
 	req := kmsg.NewAlterPartitionAssignmentsRequest()
 	req.Topics = topics
 
-	return req.RequestWith(ctx, s.KafkaClient)
+	return req.RequestWith(ctx, s.requestor())
 }
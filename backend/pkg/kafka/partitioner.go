@@ -0,0 +1,52 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// PartitionForKey returns the partition a record with the given key is produced to
+// by the given partitioner. See config.KeyPartitioners for the supported partitioners.
+func PartitionForKey(partitioner string, key []byte, partitionCount int) (int32, error) {
+	if key == nil {
+		return 0, errors.New("records without a key are not partitioned by key")
+	}
+	if partitionCount <= 0 {
+		return 0, fmt.Errorf("invalid partition count %d", partitionCount)
+	}
+
+	var hasher kgo.PartitionerHasher
+	switch partitioner {
+	case "", "murmur2":
+		// A nil hasher uses murmur2, exactly like the Java client does.
+		hasher = nil
+	case "crc32":
+		hasher = kgo.SaramaHasher(crc32.ChecksumIEEE)
+	case "fnv1a":
+		hasher = kgo.SaramaCompatHasher(fnv32a)
+	default:
+		return 0, fmt.Errorf("unknown partitioner %q", partitioner)
+	}
+
+	p := kgo.StickyKeyPartitioner(hasher).ForTopic("")
+	return int32(p.Partition(&kgo.Record{Key: key}, partitionCount)), nil
+}
+
+func fnv32a(b []byte) uint32 {
+	h := fnv.New32a()
+	h.Write(b)
+	return h.Sum32()
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionForKey(t *testing.T) {
+	// Murmur2 hashes as asserted in Kafka's UtilsTest (org.apache.kafka.common.utils).
+	murmur2Hashes := map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+	}
+
+	for key, hash := range murmur2Hashes {
+		for _, partitionCount := range []int{1, 3, 12, 100} {
+			expected := int32((uint32(hash) & 0x7fffffff) % uint32(partitionCount))
+
+			partition, err := PartitionForKey("murmur2", []byte(key), partitionCount)
+			require.NoError(t, err)
+			assert.Equal(t, expected, partition, "key %q with %d partitions", key, partitionCount)
+
+			// The murmur2 partitioner is the default
+			partition, err = PartitionForKey("", []byte(key), partitionCount)
+			require.NoError(t, err)
+			assert.Equal(t, expected, partition)
+		}
+	}
+
+	t.Run("crc32", func(t *testing.T) {
+		key := []byte("order-42")
+		partition, err := PartitionForKey("crc32", key, 7)
+		require.NoError(t, err)
+		assert.Equal(t, int32(crc32.ChecksumIEEE(key)%7), partition)
+	})
+
+	t.Run("fnv1a", func(t *testing.T) {
+		// Partitions of Sarama's NewHashPartitioner with 7 partitions, which takes
+		// the absolute value of the FNV-1a hash as int32 modulo the partition count.
+		// The hashes of "21", "foobar" and "a" are negative as int32.
+		expected := map[string]int32{
+			"order-42":   4,
+			"customer-7": 5,
+			"21":         3,
+			"foobar":     4,
+			"a":          6,
+		}
+		for key, expectedPartition := range expected {
+			partition, err := PartitionForKey("fnv1a", []byte(key), 7)
+			require.NoError(t, err)
+			assert.Equal(t, expectedPartition, partition, "key %q", key)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := PartitionForKey("murmur2", nil, 3)
+		assert.Error(t, err)
+
+		_, err = PartitionForKey("murmur2", []byte("a"), 0)
+		assert.Error(t, err)
+
+		_, err = PartitionForKey("random", []byte("a"), 3)
+		assert.Error(t, err)
+	})
+}
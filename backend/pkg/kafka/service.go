@@ -19,10 +19,13 @@ import (
 	"github.com/twmb/franz-go/pkg/kgo"
 	"github.com/twmb/franz-go/pkg/kmsg"
 	"github.com/twmb/franz-go/pkg/kversion"
+	"github.com/twmb/franz-go/pkg/sasl/oauth"
+	"github.com/twmb/go-cache/cache"
 	"go.uber.org/zap"
 
 	"github.com/redpanda-data/console/backend/pkg/backoff"
 	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/encryption"
 	"github.com/redpanda-data/console/backend/pkg/msgpack"
 	"github.com/redpanda-data/console/backend/pkg/proto"
 	"github.com/redpanda-data/console/backend/pkg/schema"
@@ -41,6 +44,14 @@ type Service struct {
 	ProtoService     *proto.Service
 	SerdeService     *serde.Service
 	MetricsNamespace string
+
+	// clientPool holds the Kafka clients of impersonated users. It is nil if
+	// impersonation is disabled.
+	clientPool *clientPool
+
+	// latestValuesCursors holds the keys that have been seen on the previous pages
+	// of the latest value view by cursor ID.
+	latestValuesCursors *cache.Cache[string, *seenKeys]
 }
 
 // NewService creates a new Kafka service and immediately checks connectivity to all components. If any of these external
@@ -121,9 +132,21 @@ func NewService(cfg *config.Config, logger *zap.Logger, metricsNamespace string)
 		}
 	}
 
-	serdeSvc := serde.NewService(schemaSvc, protoSvc, msgPackSvc)
+	// Encryption service
+	var encryptionSvc *encryption.Service
+	if cfg.Kafka.Encryption.Enabled {
+		if schemaSvc == nil {
+			return nil, fmt.Errorf("encryption requires the schema registry to be enabled")
+		}
+		encryptionSvc, err = encryption.NewService(cfg.Kafka.Encryption, logger.Named("encryption"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create encryption service: %w", err)
+		}
+	}
+
+	serdeSvc := serde.NewService(schemaSvc, protoSvc, msgPackSvc, encryptionSvc)
 
-	return &Service{
+	svc := &Service{
 		Config:           cfg,
 		Logger:           logger,
 		KafkaClientHooks: kgoHooks,
@@ -133,7 +156,27 @@ func NewService(cfg *config.Config, logger *zap.Logger, metricsNamespace string)
 		ProtoService:     protoSvc,
 		SerdeService:     serdeSvc,
 		MetricsNamespace: metricsNamespace,
-	}, nil
+
+		latestValuesCursors: cache.New[string, *seenKeys](
+			cache.MaxAge(latestValuesCursorMaxAge),
+			cache.AutoCleanInterval(time.Minute)),
+	}
+
+	if cfg.Auth.Impersonation.Enabled {
+		logger.Info("kafka requests of users will be authenticated with their own OAUTHBEARER token",
+			zap.Int("max_clients", cfg.Auth.Impersonation.MaxClients),
+			zap.Duration("idle_timeout", cfg.Auth.Impersonation.IdleTimeout))
+		svc.clientPool = newClientPool(cfg.Auth.Impersonation, logger.Named("kafka_client_pool"),
+			func(tokenFn func(context.Context) (oauth.Auth, error)) (*kgo.Client, error) {
+				kgoOpts, err := svc.impersonatedKgoConfig(tokenFn)
+				if err != nil {
+					return nil, err
+				}
+				return kgo.NewClient(kgoOpts...)
+			})
+	}
+
+	return svc, nil
 }
 
 // Start starts all the (background) tasks which are required for this service to work properly. If any of these
@@ -145,6 +188,17 @@ func (s *Service) Start() error {
 	return s.ProtoService.Start()
 }
 
+// Stop closes the Kafka client and all clients of impersonated users.
+func (s *Service) Stop() {
+	if s.clientPool != nil {
+		s.clientPool.close()
+	}
+	if s.latestValuesCursors != nil {
+		s.latestValuesCursors.StopAutoClean()
+	}
+	s.KafkaClient.Close()
+}
+
 // NewKgoClient creates a new Kafka client based on the stored Kafka configuration.
 func (s *Service) NewKgoClient(additionalOpts ...kgo.Opt) (*kgo.Client, error) {
 	kgoOpts, err := NewKgoConfig(&s.Config.Kafka, s.Logger, s.KafkaClientHooks)
@@ -0,0 +1,47 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// DescribeUserSCRAMs describes the SCRAM credentials of the given users. All
+// users with SCRAM credentials are described if no user is given.
+func (s *Service) DescribeUserSCRAMs(ctx context.Context, users ...string) (kadm.DescribedUserSCRAMs, error) {
+	adminClient, err := s.AdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	described, err := adminClient.DescribeUserSCRAMs(ctx, users...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe user scram credentials: %w", err)
+	}
+
+	return described, nil
+}
+
+// AlterUserSCRAMs deletes and upserts SCRAM credentials. A user must not be part
+// of both, the deletions and the upserts, of the same request.
+func (s *Service) AlterUserSCRAMs(ctx context.Context, del []kadm.DeleteSCRAM, upsert []kadm.UpsertSCRAM) (kadm.AlteredUserSCRAMs, error) {
+	adminClient, err := s.AdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	altered, err := adminClient.AlterUserSCRAMs(ctx, del, upsert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to alter user scram credentials: %w", err)
+	}
+
+	return altered, nil
+}
@@ -142,7 +142,7 @@ func (s *Service) ListOffsets(ctx context.Context, topicPartitions map[string][]
 	req := kmsg.ListOffsetsRequest{
 		Topics: topicRequests,
 	}
-	resShards := s.KafkaClient.RequestSharded(ctx, &req)
+	resShards := s.requestSharded(ctx, &req)
 
 	partitionsByTopic := make(map[string]map[int32]ListOffsetsResponseTopicPartition)
 	for _, shard := range resShards {
@@ -352,6 +352,12 @@ func (s *Service) GetMessageDescriptor(topicName string, property RecordProperty
 		protoTypeURL = mapping.ValueProtoType
 	}
 
+	return s.GetMessageDescriptorByType(protoTypeURL)
+}
+
+// GetMessageDescriptorByType returns the message descriptor for the given fully qualified proto type
+// from the local proto registry.
+func (s *Service) GetMessageDescriptorByType(protoTypeURL string) (*desc.MessageDescriptor, error) {
 	s.registryMutex.RLock()
 	defer s.registryMutex.RUnlock()
 	messageDescriptor, err := s.registry.FindMessageTypeByUrl(protoTypeURL)
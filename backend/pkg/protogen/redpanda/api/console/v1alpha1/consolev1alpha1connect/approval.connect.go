@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: redpanda/api/console/v1alpha1/approval.proto
+
+package consolev1alpha1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/console/v1alpha1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ApprovalServiceName is the fully-qualified name of the ApprovalService service.
+	ApprovalServiceName = "redpanda.api.console.v1alpha1.ApprovalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ApprovalServiceListChangeRequestsProcedure is the fully-qualified name of the ApprovalService's
+	// ListChangeRequests RPC.
+	ApprovalServiceListChangeRequestsProcedure = "/redpanda.api.console.v1alpha1.ApprovalService/ListChangeRequests"
+	// ApprovalServiceGetChangeRequestProcedure is the fully-qualified name of the ApprovalService's
+	// GetChangeRequest RPC.
+	ApprovalServiceGetChangeRequestProcedure = "/redpanda.api.console.v1alpha1.ApprovalService/GetChangeRequest"
+	// ApprovalServiceCreateChangeRequestProcedure is the fully-qualified name of the ApprovalService's
+	// CreateChangeRequest RPC.
+	ApprovalServiceCreateChangeRequestProcedure = "/redpanda.api.console.v1alpha1.ApprovalService/CreateChangeRequest"
+	// ApprovalServiceApproveChangeRequestProcedure is the fully-qualified name of the ApprovalService's
+	// ApproveChangeRequest RPC.
+	ApprovalServiceApproveChangeRequestProcedure = "/redpanda.api.console.v1alpha1.ApprovalService/ApproveChangeRequest"
+	// ApprovalServiceRejectChangeRequestProcedure is the fully-qualified name of the ApprovalService's
+	// RejectChangeRequest RPC.
+	ApprovalServiceRejectChangeRequestProcedure = "/redpanda.api.console.v1alpha1.ApprovalService/RejectChangeRequest"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	approvalServiceServiceDescriptor                    = v1alpha1.File_redpanda_api_console_v1alpha1_approval_proto.Services().ByName("ApprovalService")
+	approvalServiceListChangeRequestsMethodDescriptor   = approvalServiceServiceDescriptor.Methods().ByName("ListChangeRequests")
+	approvalServiceGetChangeRequestMethodDescriptor     = approvalServiceServiceDescriptor.Methods().ByName("GetChangeRequest")
+	approvalServiceCreateChangeRequestMethodDescriptor  = approvalServiceServiceDescriptor.Methods().ByName("CreateChangeRequest")
+	approvalServiceApproveChangeRequestMethodDescriptor = approvalServiceServiceDescriptor.Methods().ByName("ApproveChangeRequest")
+	approvalServiceRejectChangeRequestMethodDescriptor  = approvalServiceServiceDescriptor.Methods().ByName("RejectChangeRequest")
+)
+
+// ApprovalServiceClient is a client for the redpanda.api.console.v1alpha1.ApprovalService service.
+type ApprovalServiceClient interface {
+	// ListChangeRequests lists change requests. Users without the approveChanges
+	// permission only see their own change requests.
+	ListChangeRequests(context.Context, *connect.Request[v1alpha1.ListChangeRequestsRequest]) (*connect.Response[v1alpha1.ListChangeRequestsResponse], error)
+	// GetChangeRequest retrieves the specific change request.
+	GetChangeRequest(context.Context, *connect.Request[v1alpha1.GetChangeRequestRequest]) (*connect.Response[v1alpha1.GetChangeRequestResponse], error)
+	// CreateChangeRequest requests an operation that requires an approval.
+	CreateChangeRequest(context.Context, *connect.Request[v1alpha1.CreateChangeRequestRequest]) (*connect.Response[v1alpha1.CreateChangeRequestResponse], error)
+	// ApproveChangeRequest approves a pending change request of another user and
+	// executes its operation with the permissions of the approver.
+	ApproveChangeRequest(context.Context, *connect.Request[v1alpha1.ApproveChangeRequestRequest]) (*connect.Response[v1alpha1.ApproveChangeRequestResponse], error)
+	// RejectChangeRequest rejects a pending change request. Requesters can
+	// reject their own change requests to withdraw them.
+	RejectChangeRequest(context.Context, *connect.Request[v1alpha1.RejectChangeRequestRequest]) (*connect.Response[v1alpha1.RejectChangeRequestResponse], error)
+}
+
+// NewApprovalServiceClient constructs a client for the
+// redpanda.api.console.v1alpha1.ApprovalService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewApprovalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ApprovalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &approvalServiceClient{
+		listChangeRequests: connect.NewClient[v1alpha1.ListChangeRequestsRequest, v1alpha1.ListChangeRequestsResponse](
+			httpClient,
+			baseURL+ApprovalServiceListChangeRequestsProcedure,
+			connect.WithSchema(approvalServiceListChangeRequestsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getChangeRequest: connect.NewClient[v1alpha1.GetChangeRequestRequest, v1alpha1.GetChangeRequestResponse](
+			httpClient,
+			baseURL+ApprovalServiceGetChangeRequestProcedure,
+			connect.WithSchema(approvalServiceGetChangeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createChangeRequest: connect.NewClient[v1alpha1.CreateChangeRequestRequest, v1alpha1.CreateChangeRequestResponse](
+			httpClient,
+			baseURL+ApprovalServiceCreateChangeRequestProcedure,
+			connect.WithSchema(approvalServiceCreateChangeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		approveChangeRequest: connect.NewClient[v1alpha1.ApproveChangeRequestRequest, v1alpha1.ApproveChangeRequestResponse](
+			httpClient,
+			baseURL+ApprovalServiceApproveChangeRequestProcedure,
+			connect.WithSchema(approvalServiceApproveChangeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rejectChangeRequest: connect.NewClient[v1alpha1.RejectChangeRequestRequest, v1alpha1.RejectChangeRequestResponse](
+			httpClient,
+			baseURL+ApprovalServiceRejectChangeRequestProcedure,
+			connect.WithSchema(approvalServiceRejectChangeRequestMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// approvalServiceClient implements ApprovalServiceClient.
+type approvalServiceClient struct {
+	listChangeRequests   *connect.Client[v1alpha1.ListChangeRequestsRequest, v1alpha1.ListChangeRequestsResponse]
+	getChangeRequest     *connect.Client[v1alpha1.GetChangeRequestRequest, v1alpha1.GetChangeRequestResponse]
+	createChangeRequest  *connect.Client[v1alpha1.CreateChangeRequestRequest, v1alpha1.CreateChangeRequestResponse]
+	approveChangeRequest *connect.Client[v1alpha1.ApproveChangeRequestRequest, v1alpha1.ApproveChangeRequestResponse]
+	rejectChangeRequest  *connect.Client[v1alpha1.RejectChangeRequestRequest, v1alpha1.RejectChangeRequestResponse]
+}
+
+// ListChangeRequests calls redpanda.api.console.v1alpha1.ApprovalService.ListChangeRequests.
+func (c *approvalServiceClient) ListChangeRequests(ctx context.Context, req *connect.Request[v1alpha1.ListChangeRequestsRequest]) (*connect.Response[v1alpha1.ListChangeRequestsResponse], error) {
+	return c.listChangeRequests.CallUnary(ctx, req)
+}
+
+// GetChangeRequest calls redpanda.api.console.v1alpha1.ApprovalService.GetChangeRequest.
+func (c *approvalServiceClient) GetChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.GetChangeRequestRequest]) (*connect.Response[v1alpha1.GetChangeRequestResponse], error) {
+	return c.getChangeRequest.CallUnary(ctx, req)
+}
+
+// CreateChangeRequest calls redpanda.api.console.v1alpha1.ApprovalService.CreateChangeRequest.
+func (c *approvalServiceClient) CreateChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.CreateChangeRequestRequest]) (*connect.Response[v1alpha1.CreateChangeRequestResponse], error) {
+	return c.createChangeRequest.CallUnary(ctx, req)
+}
+
+// ApproveChangeRequest calls redpanda.api.console.v1alpha1.ApprovalService.ApproveChangeRequest.
+func (c *approvalServiceClient) ApproveChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.ApproveChangeRequestRequest]) (*connect.Response[v1alpha1.ApproveChangeRequestResponse], error) {
+	return c.approveChangeRequest.CallUnary(ctx, req)
+}
+
+// RejectChangeRequest calls redpanda.api.console.v1alpha1.ApprovalService.RejectChangeRequest.
+func (c *approvalServiceClient) RejectChangeRequest(ctx context.Context, req *connect.Request[v1alpha1.RejectChangeRequestRequest]) (*connect.Response[v1alpha1.RejectChangeRequestResponse], error) {
+	return c.rejectChangeRequest.CallUnary(ctx, req)
+}
+
+// ApprovalServiceHandler is an implementation of the redpanda.api.console.v1alpha1.ApprovalService
+// service.
+type ApprovalServiceHandler interface {
+	// ListChangeRequests lists change requests. Users without the approveChanges
+	// permission only see their own change requests.
+	ListChangeRequests(context.Context, *connect.Request[v1alpha1.ListChangeRequestsRequest]) (*connect.Response[v1alpha1.ListChangeRequestsResponse], error)
+	// GetChangeRequest retrieves the specific change request.
+	GetChangeRequest(context.Context, *connect.Request[v1alpha1.GetChangeRequestRequest]) (*connect.Response[v1alpha1.GetChangeRequestResponse], error)
+	// CreateChangeRequest requests an operation that requires an approval.
+	CreateChangeRequest(context.Context, *connect.Request[v1alpha1.CreateChangeRequestRequest]) (*connect.Response[v1alpha1.CreateChangeRequestResponse], error)
+	// ApproveChangeRequest approves a pending change request of another user and
+	// executes its operation with the permissions of the approver.
+	ApproveChangeRequest(context.Context, *connect.Request[v1alpha1.ApproveChangeRequestRequest]) (*connect.Response[v1alpha1.ApproveChangeRequestResponse], error)
+	// RejectChangeRequest rejects a pending change request. Requesters can
+	// reject their own change requests to withdraw them.
+	RejectChangeRequest(context.Context, *connect.Request[v1alpha1.RejectChangeRequestRequest]) (*connect.Response[v1alpha1.RejectChangeRequestResponse], error)
+}
+
+// NewApprovalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewApprovalServiceHandler(svc ApprovalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	approvalServiceListChangeRequestsHandler := connect.NewUnaryHandler(
+		ApprovalServiceListChangeRequestsProcedure,
+		svc.ListChangeRequests,
+		connect.WithSchema(approvalServiceListChangeRequestsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceGetChangeRequestHandler := connect.NewUnaryHandler(
+		ApprovalServiceGetChangeRequestProcedure,
+		svc.GetChangeRequest,
+		connect.WithSchema(approvalServiceGetChangeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceCreateChangeRequestHandler := connect.NewUnaryHandler(
+		ApprovalServiceCreateChangeRequestProcedure,
+		svc.CreateChangeRequest,
+		connect.WithSchema(approvalServiceCreateChangeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceApproveChangeRequestHandler := connect.NewUnaryHandler(
+		ApprovalServiceApproveChangeRequestProcedure,
+		svc.ApproveChangeRequest,
+		connect.WithSchema(approvalServiceApproveChangeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	approvalServiceRejectChangeRequestHandler := connect.NewUnaryHandler(
+		ApprovalServiceRejectChangeRequestProcedure,
+		svc.RejectChangeRequest,
+		connect.WithSchema(approvalServiceRejectChangeRequestMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/redpanda.api.console.v1alpha1.ApprovalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ApprovalServiceListChangeRequestsProcedure:
+			approvalServiceListChangeRequestsHandler.ServeHTTP(w, r)
+		case ApprovalServiceGetChangeRequestProcedure:
+			approvalServiceGetChangeRequestHandler.ServeHTTP(w, r)
+		case ApprovalServiceCreateChangeRequestProcedure:
+			approvalServiceCreateChangeRequestHandler.ServeHTTP(w, r)
+		case ApprovalServiceApproveChangeRequestProcedure:
+			approvalServiceApproveChangeRequestHandler.ServeHTTP(w, r)
+		case ApprovalServiceRejectChangeRequestProcedure:
+			approvalServiceRejectChangeRequestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedApprovalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedApprovalServiceHandler struct{}
+
+func (UnimplementedApprovalServiceHandler) ListChangeRequests(context.Context, *connect.Request[v1alpha1.ListChangeRequestsRequest]) (*connect.Response[v1alpha1.ListChangeRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.console.v1alpha1.ApprovalService.ListChangeRequests is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) GetChangeRequest(context.Context, *connect.Request[v1alpha1.GetChangeRequestRequest]) (*connect.Response[v1alpha1.GetChangeRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.console.v1alpha1.ApprovalService.GetChangeRequest is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) CreateChangeRequest(context.Context, *connect.Request[v1alpha1.CreateChangeRequestRequest]) (*connect.Response[v1alpha1.CreateChangeRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.console.v1alpha1.ApprovalService.CreateChangeRequest is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) ApproveChangeRequest(context.Context, *connect.Request[v1alpha1.ApproveChangeRequestRequest]) (*connect.Response[v1alpha1.ApproveChangeRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.console.v1alpha1.ApprovalService.ApproveChangeRequest is not implemented"))
+}
+
+func (UnimplementedApprovalServiceHandler) RejectChangeRequest(context.Context, *connect.Request[v1alpha1.RejectChangeRequestRequest]) (*connect.Response[v1alpha1.RejectChangeRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.console.v1alpha1.ApprovalService.RejectChangeRequest is not implemented"))
+}
@@ -5,15 +5,13 @@
 package dataplanev1alpha1connect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	errors "errors"
+	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
-
-	connect "connectrpc.com/connect"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-
-	v1alpha1 "github.com/redpanda-data/console/backend/pkg/protogen/redpanda/api/dataplane/v1alpha1"
 )
 
 // This is a compile-time assertion to ensure that this generated file and the connect package are
@@ -81,26 +79,54 @@ const (
 	// KafkaConnectServiceResetConnectorTopicsProcedure is the fully-qualified name of the
 	// KafkaConnectService's ResetConnectorTopics RPC.
 	KafkaConnectServiceResetConnectorTopicsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	// KafkaConnectServiceGetConnectorRemediationProcedure is the fully-qualified name of the
+	// KafkaConnectService's GetConnectorRemediation RPC.
+	KafkaConnectServiceGetConnectorRemediationProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorRemediation"
+	// KafkaConnectServiceListConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's ListConnectorOffsets RPC.
+	KafkaConnectServiceListConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorOffsets"
+	// KafkaConnectServiceAlterConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's AlterConnectorOffsets RPC.
+	KafkaConnectServiceAlterConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/AlterConnectorOffsets"
+	// KafkaConnectServiceResetConnectorOffsetsProcedure is the fully-qualified name of the
+	// KafkaConnectService's ResetConnectorOffsets RPC.
+	KafkaConnectServiceResetConnectorOffsetsProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorOffsets"
+	// KafkaConnectServiceListConnectWorkersProcedure is the fully-qualified name of the
+	// KafkaConnectService's ListConnectWorkers RPC.
+	KafkaConnectServiceListConnectWorkersProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectWorkers"
+	// KafkaConnectServiceRestartWorkerTasksProcedure is the fully-qualified name of the
+	// KafkaConnectService's RestartWorkerTasks RPC.
+	KafkaConnectServiceRestartWorkerTasksProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartWorkerTasks"
+	// KafkaConnectServiceRestartFailedTasksProcedure is the fully-qualified name of the
+	// KafkaConnectService's RestartFailedTasks RPC.
+	KafkaConnectServiceRestartFailedTasksProcedure = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartFailedTasks"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	kafkaConnectServiceServiceDescriptor                    = v1alpha1.File_redpanda_api_dataplane_v1alpha1_kafka_connect_proto.Services().ByName("KafkaConnectService")
-	kafkaConnectServiceListConnectClustersMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
-	kafkaConnectServiceGetConnectClusterMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
-	kafkaConnectServiceListConnectorsMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
-	kafkaConnectServiceCreateConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
-	kafkaConnectServiceRestartConnectorMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
-	kafkaConnectServiceGetConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
-	kafkaConnectServiceGetConnectorStatusMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
-	kafkaConnectServicePauseConnectorMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
-	kafkaConnectServiceResumeConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
-	kafkaConnectServiceStopConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
-	kafkaConnectServiceDeleteConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
-	kafkaConnectServiceUpsertConnectorMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
-	kafkaConnectServiceGetConnectorConfigMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
-	kafkaConnectServiceListConnectorTopicsMethodDescriptor  = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
-	kafkaConnectServiceResetConnectorTopicsMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceServiceDescriptor                       = v1alpha1.File_redpanda_api_dataplane_v1alpha1_kafka_connect_proto.Services().ByName("KafkaConnectService")
+	kafkaConnectServiceListConnectClustersMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectClusters")
+	kafkaConnectServiceGetConnectClusterMethodDescriptor       = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectCluster")
+	kafkaConnectServiceListConnectorsMethodDescriptor          = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectors")
+	kafkaConnectServiceCreateConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("CreateConnector")
+	kafkaConnectServiceRestartConnectorMethodDescriptor        = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartConnector")
+	kafkaConnectServiceGetConnectorMethodDescriptor            = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnector")
+	kafkaConnectServiceGetConnectorStatusMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorStatus")
+	kafkaConnectServicePauseConnectorMethodDescriptor          = kafkaConnectServiceServiceDescriptor.Methods().ByName("PauseConnector")
+	kafkaConnectServiceResumeConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResumeConnector")
+	kafkaConnectServiceStopConnectorMethodDescriptor           = kafkaConnectServiceServiceDescriptor.Methods().ByName("StopConnector")
+	kafkaConnectServiceDeleteConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("DeleteConnector")
+	kafkaConnectServiceUpsertConnectorMethodDescriptor         = kafkaConnectServiceServiceDescriptor.Methods().ByName("UpsertConnector")
+	kafkaConnectServiceGetConnectorConfigMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorConfig")
+	kafkaConnectServiceListConnectorTopicsMethodDescriptor     = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorTopics")
+	kafkaConnectServiceResetConnectorTopicsMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorTopics")
+	kafkaConnectServiceGetConnectorRemediationMethodDescriptor = kafkaConnectServiceServiceDescriptor.Methods().ByName("GetConnectorRemediation")
+	kafkaConnectServiceListConnectorOffsetsMethodDescriptor    = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectorOffsets")
+	kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("AlterConnectorOffsets")
+	kafkaConnectServiceResetConnectorOffsetsMethodDescriptor   = kafkaConnectServiceServiceDescriptor.Methods().ByName("ResetConnectorOffsets")
+	kafkaConnectServiceListConnectWorkersMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("ListConnectWorkers")
+	kafkaConnectServiceRestartWorkerTasksMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartWorkerTasks")
+	kafkaConnectServiceRestartFailedTasksMethodDescriptor      = kafkaConnectServiceServiceDescriptor.Methods().ByName("RestartFailedTasks")
 )
 
 // KafkaConnectServiceClient is a client for the redpanda.api.dataplane.v1alpha1.KafkaConnectService
@@ -153,6 +179,26 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetConnectorRemediation returns the auto remediation policy of a connector,
+	// the state of its failed tasks and the history of restarts.
+	GetConnectorRemediation(context.Context, *connect.Request[v1alpha1.GetConnectorRemediationRequest]) (*connect.Response[v1alpha1.GetConnectorRemediationResponse], error)
+	// ListConnectorOffsets implements the list connector offsets method, exposes
+	// a Kafka Connect equivalent REST endpoint (KIP-875).
+	ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets implements the alter connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error)
+	// ResetConnectorOffsets implements the reset connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error)
+	// ListConnectWorkers lists the workers of a Kafka Connect cluster along with
+	// the connectors and tasks that are assigned to each of them.
+	ListConnectWorkers(context.Context, *connect.Request[v1alpha1.ListConnectWorkersRequest]) (*connect.Response[v1alpha1.ListConnectWorkersResponse], error)
+	// RestartWorkerTasks restarts all tasks that are assigned to a worker.
+	RestartWorkerTasks(context.Context, *connect.Request[v1alpha1.RestartWorkerTasksRequest]) (*connect.Response[v1alpha1.RestartWorkerTasksResponse], error)
+	// RestartFailedTasks restarts all failed tasks of all connectors in a
+	// Kafka Connect cluster.
+	RestartFailedTasks(context.Context, *connect.Request[v1alpha1.RestartFailedTasksRequest]) (*connect.Response[v1alpha1.RestartFailedTasksResponse], error)
 }
 
 // NewKafkaConnectServiceClient constructs a client for the
@@ -256,26 +302,75 @@ func NewKafkaConnectServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getConnectorRemediation: connect.NewClient[v1alpha1.GetConnectorRemediationRequest, v1alpha1.GetConnectorRemediationResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceGetConnectorRemediationProcedure,
+			connect.WithSchema(kafkaConnectServiceGetConnectorRemediationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectorOffsets: connect.NewClient[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceListConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceListConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		alterConnectorOffsets: connect.NewClient[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceAlterConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resetConnectorOffsets: connect.NewClient[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceResetConnectorOffsetsProcedure,
+			connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listConnectWorkers: connect.NewClient[v1alpha1.ListConnectWorkersRequest, v1alpha1.ListConnectWorkersResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceListConnectWorkersProcedure,
+			connect.WithSchema(kafkaConnectServiceListConnectWorkersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restartWorkerTasks: connect.NewClient[v1alpha1.RestartWorkerTasksRequest, v1alpha1.RestartWorkerTasksResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceRestartWorkerTasksProcedure,
+			connect.WithSchema(kafkaConnectServiceRestartWorkerTasksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restartFailedTasks: connect.NewClient[v1alpha1.RestartFailedTasksRequest, v1alpha1.RestartFailedTasksResponse](
+			httpClient,
+			baseURL+KafkaConnectServiceRestartFailedTasksProcedure,
+			connect.WithSchema(kafkaConnectServiceRestartFailedTasksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // kafkaConnectServiceClient implements KafkaConnectServiceClient.
 type kafkaConnectServiceClient struct {
-	listConnectClusters  *connect.Client[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
-	getConnectCluster    *connect.Client[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
-	listConnectors       *connect.Client[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
-	createConnector      *connect.Client[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
-	restartConnector     *connect.Client[v1alpha1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         *connect.Client[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
-	getConnectorStatus   *connect.Client[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
-	pauseConnector       *connect.Client[v1alpha1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      *connect.Client[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        *connect.Client[v1alpha1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      *connect.Client[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      *connect.Client[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
-	getConnectorConfig   *connect.Client[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
-	listConnectorTopics  *connect.Client[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
-	resetConnectorTopics *connect.Client[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters     *connect.Client[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
+	getConnectCluster       *connect.Client[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
+	listConnectors          *connect.Client[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
+	createConnector         *connect.Client[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
+	restartConnector        *connect.Client[v1alpha1.RestartConnectorRequest, emptypb.Empty]
+	getConnector            *connect.Client[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
+	getConnectorStatus      *connect.Client[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
+	pauseConnector          *connect.Client[v1alpha1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector         *connect.Client[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector           *connect.Client[v1alpha1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector         *connect.Client[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector         *connect.Client[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
+	getConnectorConfig      *connect.Client[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
+	listConnectorTopics     *connect.Client[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
+	resetConnectorTopics    *connect.Client[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	getConnectorRemediation *connect.Client[v1alpha1.GetConnectorRemediationRequest, v1alpha1.GetConnectorRemediationResponse]
+	listConnectorOffsets    *connect.Client[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse]
+	alterConnectorOffsets   *connect.Client[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse]
+	resetConnectorOffsets   *connect.Client[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse]
+	listConnectWorkers      *connect.Client[v1alpha1.ListConnectWorkersRequest, v1alpha1.ListConnectWorkersResponse]
+	restartWorkerTasks      *connect.Client[v1alpha1.RestartWorkerTasksRequest, v1alpha1.RestartWorkerTasksResponse]
+	restartFailedTasks      *connect.Client[v1alpha1.RestartFailedTasksRequest, v1alpha1.RestartFailedTasksResponse]
 }
 
 // ListConnectClusters calls
@@ -356,6 +451,45 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, re
 	return c.resetConnectorTopics.CallUnary(ctx, req)
 }
 
+// GetConnectorRemediation calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectorRemediation.
+func (c *kafkaConnectServiceClient) GetConnectorRemediation(ctx context.Context, req *connect.Request[v1alpha1.GetConnectorRemediationRequest]) (*connect.Response[v1alpha1.GetConnectorRemediationResponse], error) {
+	return c.getConnectorRemediation.CallUnary(ctx, req)
+}
+
+// ListConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets.
+func (c *kafkaConnectServiceClient) ListConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	return c.listConnectorOffsets.CallUnary(ctx, req)
+}
+
+// AlterConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets.
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	return c.alterConnectorOffsets.CallUnary(ctx, req)
+}
+
+// ResetConnectorOffsets calls
+// redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets.
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, req *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	return c.resetConnectorOffsets.CallUnary(ctx, req)
+}
+
+// ListConnectWorkers calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectWorkers.
+func (c *kafkaConnectServiceClient) ListConnectWorkers(ctx context.Context, req *connect.Request[v1alpha1.ListConnectWorkersRequest]) (*connect.Response[v1alpha1.ListConnectWorkersResponse], error) {
+	return c.listConnectWorkers.CallUnary(ctx, req)
+}
+
+// RestartWorkerTasks calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartWorkerTasks.
+func (c *kafkaConnectServiceClient) RestartWorkerTasks(ctx context.Context, req *connect.Request[v1alpha1.RestartWorkerTasksRequest]) (*connect.Response[v1alpha1.RestartWorkerTasksResponse], error) {
+	return c.restartWorkerTasks.CallUnary(ctx, req)
+}
+
+// RestartFailedTasks calls redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartFailedTasks.
+func (c *kafkaConnectServiceClient) RestartFailedTasks(ctx context.Context, req *connect.Request[v1alpha1.RestartFailedTasksRequest]) (*connect.Response[v1alpha1.RestartFailedTasksResponse], error) {
+	return c.restartFailedTasks.CallUnary(ctx, req)
+}
+
 // KafkaConnectServiceHandler is an implementation of the
 // redpanda.api.dataplane.v1alpha1.KafkaConnectService service.
 type KafkaConnectServiceHandler interface {
@@ -406,6 +540,26 @@ type KafkaConnectServiceHandler interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetConnectorRemediation returns the auto remediation policy of a connector,
+	// the state of its failed tasks and the history of restarts.
+	GetConnectorRemediation(context.Context, *connect.Request[v1alpha1.GetConnectorRemediationRequest]) (*connect.Response[v1alpha1.GetConnectorRemediationResponse], error)
+	// ListConnectorOffsets implements the list connector offsets method, exposes
+	// a Kafka Connect equivalent REST endpoint (KIP-875).
+	ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error)
+	// AlterConnectorOffsets implements the alter connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error)
+	// ResetConnectorOffsets implements the reset connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error)
+	// ListConnectWorkers lists the workers of a Kafka Connect cluster along with
+	// the connectors and tasks that are assigned to each of them.
+	ListConnectWorkers(context.Context, *connect.Request[v1alpha1.ListConnectWorkersRequest]) (*connect.Response[v1alpha1.ListConnectWorkersResponse], error)
+	// RestartWorkerTasks restarts all tasks that are assigned to a worker.
+	RestartWorkerTasks(context.Context, *connect.Request[v1alpha1.RestartWorkerTasksRequest]) (*connect.Response[v1alpha1.RestartWorkerTasksResponse], error)
+	// RestartFailedTasks restarts all failed tasks of all connectors in a
+	// Kafka Connect cluster.
+	RestartFailedTasks(context.Context, *connect.Request[v1alpha1.RestartFailedTasksRequest]) (*connect.Response[v1alpha1.RestartFailedTasksResponse], error)
 }
 
 // NewKafkaConnectServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -504,6 +658,48 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 		connect.WithSchema(kafkaConnectServiceResetConnectorTopicsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kafkaConnectServiceGetConnectorRemediationHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceGetConnectorRemediationProcedure,
+		svc.GetConnectorRemediation,
+		connect.WithSchema(kafkaConnectServiceGetConnectorRemediationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceListConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceListConnectorOffsetsProcedure,
+		svc.ListConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceListConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceAlterConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceAlterConnectorOffsetsProcedure,
+		svc.AlterConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceAlterConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceResetConnectorOffsetsHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceResetConnectorOffsetsProcedure,
+		svc.ResetConnectorOffsets,
+		connect.WithSchema(kafkaConnectServiceResetConnectorOffsetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceListConnectWorkersHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceListConnectWorkersProcedure,
+		svc.ListConnectWorkers,
+		connect.WithSchema(kafkaConnectServiceListConnectWorkersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceRestartWorkerTasksHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceRestartWorkerTasksProcedure,
+		svc.RestartWorkerTasks,
+		connect.WithSchema(kafkaConnectServiceRestartWorkerTasksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kafkaConnectServiceRestartFailedTasksHandler := connect.NewUnaryHandler(
+		KafkaConnectServiceRestartFailedTasksProcedure,
+		svc.RestartFailedTasks,
+		connect.WithSchema(kafkaConnectServiceRestartFailedTasksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KafkaConnectServiceListConnectClustersProcedure:
@@ -536,6 +732,20 @@ func NewKafkaConnectServiceHandler(svc KafkaConnectServiceHandler, opts ...conne
 			kafkaConnectServiceListConnectorTopicsHandler.ServeHTTP(w, r)
 		case KafkaConnectServiceResetConnectorTopicsProcedure:
 			kafkaConnectServiceResetConnectorTopicsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceGetConnectorRemediationProcedure:
+			kafkaConnectServiceGetConnectorRemediationHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceListConnectorOffsetsProcedure:
+			kafkaConnectServiceListConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceAlterConnectorOffsetsProcedure:
+			kafkaConnectServiceAlterConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceResetConnectorOffsetsProcedure:
+			kafkaConnectServiceResetConnectorOffsetsHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceListConnectWorkersProcedure:
+			kafkaConnectServiceListConnectWorkersHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceRestartWorkerTasksProcedure:
+			kafkaConnectServiceRestartWorkerTasksHandler.ServeHTTP(w, r)
+		case KafkaConnectServiceRestartFailedTasksProcedure:
+			kafkaConnectServiceRestartFailedTasksHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -604,3 +814,31 @@ func (UnimplementedKafkaConnectServiceHandler) ListConnectorTopics(context.Conte
 func (UnimplementedKafkaConnectServiceHandler) ResetConnectorTopics(context.Context, *connect.Request[v1alpha1.ResetConnectorTopicsRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorTopics is not implemented"))
 }
+
+func (UnimplementedKafkaConnectServiceHandler) GetConnectorRemediation(context.Context, *connect.Request[v1alpha1.GetConnectorRemediationRequest]) (*connect.Response[v1alpha1.GetConnectorRemediationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectorRemediation is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ListConnectorOffsets(context.Context, *connect.Request[v1alpha1.ListConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ListConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) AlterConnectorOffsets(context.Context, *connect.Request[v1alpha1.AlterConnectorOffsetsRequest]) (*connect.Response[v1alpha1.AlterConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ResetConnectorOffsets(context.Context, *connect.Request[v1alpha1.ResetConnectorOffsetsRequest]) (*connect.Response[v1alpha1.ResetConnectorOffsetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) ListConnectWorkers(context.Context, *connect.Request[v1alpha1.ListConnectWorkersRequest]) (*connect.Response[v1alpha1.ListConnectWorkersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectWorkers is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) RestartWorkerTasks(context.Context, *connect.Request[v1alpha1.RestartWorkerTasksRequest]) (*connect.Response[v1alpha1.RestartWorkerTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartWorkerTasks is not implemented"))
+}
+
+func (UnimplementedKafkaConnectServiceHandler) RestartFailedTasks(context.Context, *connect.Request[v1alpha1.RestartFailedTasksRequest]) (*connect.Response[v1alpha1.RestartFailedTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartFailedTasks is not implemented"))
+}
@@ -19,42 +19,56 @@ import (
 // service.
 type KafkaConnectServiceGatewayServer struct {
 	v1alpha1.UnimplementedKafkaConnectServiceServer
-	listConnectClusters  connect_gateway.UnaryHandler[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
-	getConnectCluster    connect_gateway.UnaryHandler[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
-	listConnectors       connect_gateway.UnaryHandler[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
-	createConnector      connect_gateway.UnaryHandler[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
-	restartConnector     connect_gateway.UnaryHandler[v1alpha1.RestartConnectorRequest, emptypb.Empty]
-	getConnector         connect_gateway.UnaryHandler[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
-	getConnectorStatus   connect_gateway.UnaryHandler[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
-	pauseConnector       connect_gateway.UnaryHandler[v1alpha1.PauseConnectorRequest, emptypb.Empty]
-	resumeConnector      connect_gateway.UnaryHandler[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
-	stopConnector        connect_gateway.UnaryHandler[v1alpha1.StopConnectorRequest, emptypb.Empty]
-	deleteConnector      connect_gateway.UnaryHandler[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
-	upsertConnector      connect_gateway.UnaryHandler[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
-	getConnectorConfig   connect_gateway.UnaryHandler[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
-	listConnectorTopics  connect_gateway.UnaryHandler[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
-	resetConnectorTopics connect_gateway.UnaryHandler[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	listConnectClusters     connect_gateway.UnaryHandler[v1alpha1.ListConnectClustersRequest, v1alpha1.ListConnectClustersResponse]
+	getConnectCluster       connect_gateway.UnaryHandler[v1alpha1.GetConnectClusterRequest, v1alpha1.GetConnectClusterResponse]
+	listConnectors          connect_gateway.UnaryHandler[v1alpha1.ListConnectorsRequest, v1alpha1.ListConnectorsResponse]
+	createConnector         connect_gateway.UnaryHandler[v1alpha1.CreateConnectorRequest, v1alpha1.CreateConnectorResponse]
+	restartConnector        connect_gateway.UnaryHandler[v1alpha1.RestartConnectorRequest, emptypb.Empty]
+	getConnector            connect_gateway.UnaryHandler[v1alpha1.GetConnectorRequest, v1alpha1.GetConnectorResponse]
+	getConnectorStatus      connect_gateway.UnaryHandler[v1alpha1.GetConnectorStatusRequest, v1alpha1.GetConnectorStatusResponse]
+	pauseConnector          connect_gateway.UnaryHandler[v1alpha1.PauseConnectorRequest, emptypb.Empty]
+	resumeConnector         connect_gateway.UnaryHandler[v1alpha1.ResumeConnectorRequest, emptypb.Empty]
+	stopConnector           connect_gateway.UnaryHandler[v1alpha1.StopConnectorRequest, emptypb.Empty]
+	deleteConnector         connect_gateway.UnaryHandler[v1alpha1.DeleteConnectorRequest, emptypb.Empty]
+	upsertConnector         connect_gateway.UnaryHandler[v1alpha1.UpsertConnectorRequest, v1alpha1.UpsertConnectorResponse]
+	getConnectorConfig      connect_gateway.UnaryHandler[v1alpha1.GetConnectorConfigRequest, v1alpha1.GetConnectorConfigResponse]
+	listConnectorTopics     connect_gateway.UnaryHandler[v1alpha1.ListConnectorTopicsRequest, v1alpha1.ListConnectorTopicsResponse]
+	resetConnectorTopics    connect_gateway.UnaryHandler[v1alpha1.ResetConnectorTopicsRequest, emptypb.Empty]
+	getConnectorRemediation connect_gateway.UnaryHandler[v1alpha1.GetConnectorRemediationRequest, v1alpha1.GetConnectorRemediationResponse]
+	listConnectorOffsets    connect_gateway.UnaryHandler[v1alpha1.ListConnectorOffsetsRequest, v1alpha1.ListConnectorOffsetsResponse]
+	alterConnectorOffsets   connect_gateway.UnaryHandler[v1alpha1.AlterConnectorOffsetsRequest, v1alpha1.AlterConnectorOffsetsResponse]
+	resetConnectorOffsets   connect_gateway.UnaryHandler[v1alpha1.ResetConnectorOffsetsRequest, v1alpha1.ResetConnectorOffsetsResponse]
+	listConnectWorkers      connect_gateway.UnaryHandler[v1alpha1.ListConnectWorkersRequest, v1alpha1.ListConnectWorkersResponse]
+	restartWorkerTasks      connect_gateway.UnaryHandler[v1alpha1.RestartWorkerTasksRequest, v1alpha1.RestartWorkerTasksResponse]
+	restartFailedTasks      connect_gateway.UnaryHandler[v1alpha1.RestartFailedTasksRequest, v1alpha1.RestartFailedTasksResponse]
 }
 
 // NewKafkaConnectServiceGatewayServer constructs a Connect-Gateway gRPC server for the
 // KafkaConnectService service.
 func NewKafkaConnectServiceGatewayServer(svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) *KafkaConnectServiceGatewayServer {
 	return &KafkaConnectServiceGatewayServer{
-		listConnectClusters:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
-		getConnectCluster:    connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
-		listConnectors:       connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
-		createConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
-		restartConnector:     connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
-		getConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
-		getConnectorStatus:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
-		pauseConnector:       connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
-		resumeConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
-		stopConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
-		deleteConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
-		upsertConnector:      connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
-		getConnectorConfig:   connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
-		listConnectorTopics:  connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
-		resetConnectorTopics: connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		listConnectClusters:     connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectClustersProcedure, svc.ListConnectClusters, opts...),
+		getConnectCluster:       connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectClusterProcedure, svc.GetConnectCluster, opts...),
+		listConnectors:          connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorsProcedure, svc.ListConnectors, opts...),
+		createConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceCreateConnectorProcedure, svc.CreateConnector, opts...),
+		restartConnector:        connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartConnectorProcedure, svc.RestartConnector, opts...),
+		getConnector:            connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorProcedure, svc.GetConnector, opts...),
+		getConnectorStatus:      connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorStatusProcedure, svc.GetConnectorStatus, opts...),
+		pauseConnector:          connect_gateway.NewUnaryHandler(KafkaConnectServicePauseConnectorProcedure, svc.PauseConnector, opts...),
+		resumeConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceResumeConnectorProcedure, svc.ResumeConnector, opts...),
+		stopConnector:           connect_gateway.NewUnaryHandler(KafkaConnectServiceStopConnectorProcedure, svc.StopConnector, opts...),
+		deleteConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceDeleteConnectorProcedure, svc.DeleteConnector, opts...),
+		upsertConnector:         connect_gateway.NewUnaryHandler(KafkaConnectServiceUpsertConnectorProcedure, svc.UpsertConnector, opts...),
+		getConnectorConfig:      connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorConfigProcedure, svc.GetConnectorConfig, opts...),
+		listConnectorTopics:     connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorTopicsProcedure, svc.ListConnectorTopics, opts...),
+		resetConnectorTopics:    connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorTopicsProcedure, svc.ResetConnectorTopics, opts...),
+		getConnectorRemediation: connect_gateway.NewUnaryHandler(KafkaConnectServiceGetConnectorRemediationProcedure, svc.GetConnectorRemediation, opts...),
+		listConnectorOffsets:    connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectorOffsetsProcedure, svc.ListConnectorOffsets, opts...),
+		alterConnectorOffsets:   connect_gateway.NewUnaryHandler(KafkaConnectServiceAlterConnectorOffsetsProcedure, svc.AlterConnectorOffsets, opts...),
+		resetConnectorOffsets:   connect_gateway.NewUnaryHandler(KafkaConnectServiceResetConnectorOffsetsProcedure, svc.ResetConnectorOffsets, opts...),
+		listConnectWorkers:      connect_gateway.NewUnaryHandler(KafkaConnectServiceListConnectWorkersProcedure, svc.ListConnectWorkers, opts...),
+		restartWorkerTasks:      connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartWorkerTasksProcedure, svc.RestartWorkerTasks, opts...),
+		restartFailedTasks:      connect_gateway.NewUnaryHandler(KafkaConnectServiceRestartFailedTasksProcedure, svc.RestartFailedTasks, opts...),
 	}
 }
 
@@ -118,6 +132,34 @@ func (s *KafkaConnectServiceGatewayServer) ResetConnectorTopics(ctx context.Cont
 	return s.resetConnectorTopics(ctx, req)
 }
 
+func (s *KafkaConnectServiceGatewayServer) GetConnectorRemediation(ctx context.Context, req *v1alpha1.GetConnectorRemediationRequest) (*v1alpha1.GetConnectorRemediationResponse, error) {
+	return s.getConnectorRemediation(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ListConnectorOffsets(ctx context.Context, req *v1alpha1.ListConnectorOffsetsRequest) (*v1alpha1.ListConnectorOffsetsResponse, error) {
+	return s.listConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) AlterConnectorOffsets(ctx context.Context, req *v1alpha1.AlterConnectorOffsetsRequest) (*v1alpha1.AlterConnectorOffsetsResponse, error) {
+	return s.alterConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ResetConnectorOffsets(ctx context.Context, req *v1alpha1.ResetConnectorOffsetsRequest) (*v1alpha1.ResetConnectorOffsetsResponse, error) {
+	return s.resetConnectorOffsets(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) ListConnectWorkers(ctx context.Context, req *v1alpha1.ListConnectWorkersRequest) (*v1alpha1.ListConnectWorkersResponse, error) {
+	return s.listConnectWorkers(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) RestartWorkerTasks(ctx context.Context, req *v1alpha1.RestartWorkerTasksRequest) (*v1alpha1.RestartWorkerTasksResponse, error) {
+	return s.restartWorkerTasks(ctx, req)
+}
+
+func (s *KafkaConnectServiceGatewayServer) RestartFailedTasks(ctx context.Context, req *v1alpha1.RestartFailedTasksRequest) (*v1alpha1.RestartFailedTasksResponse, error) {
+	return s.restartFailedTasks(ctx, req)
+}
+
 // RegisterKafkaConnectServiceHandlerGatewayServer registers the Connect handlers for the
 // KafkaConnectService "svc" to "mux".
 func RegisterKafkaConnectServiceHandlerGatewayServer(mux *runtime.ServeMux, svc KafkaConnectServiceHandler, opts ...connect_gateway.HandlerOption) {
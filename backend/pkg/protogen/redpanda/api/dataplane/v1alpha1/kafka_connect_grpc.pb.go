@@ -8,7 +8,6 @@ package dataplanev1alpha1
 
 import (
 	context "context"
-
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,21 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	KafkaConnectService_ListConnectClusters_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectClusters"
-	KafkaConnectService_GetConnectCluster_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectCluster"
-	KafkaConnectService_ListConnectors_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectors"
-	KafkaConnectService_CreateConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/CreateConnector"
-	KafkaConnectService_RestartConnector_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartConnector"
-	KafkaConnectService_GetConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnector"
-	KafkaConnectService_GetConnectorStatus_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorStatus"
-	KafkaConnectService_PauseConnector_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/PauseConnector"
-	KafkaConnectService_ResumeConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResumeConnector"
-	KafkaConnectService_StopConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/StopConnector"
-	KafkaConnectService_DeleteConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DeleteConnector"
-	KafkaConnectService_UpsertConnector_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/UpsertConnector"
-	KafkaConnectService_GetConnectorConfig_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorConfig"
-	KafkaConnectService_ListConnectorTopics_FullMethodName  = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorTopics"
-	KafkaConnectService_ResetConnectorTopics_FullMethodName = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_ListConnectClusters_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectClusters"
+	KafkaConnectService_GetConnectCluster_FullMethodName       = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectCluster"
+	KafkaConnectService_ListConnectors_FullMethodName          = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectors"
+	KafkaConnectService_CreateConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/CreateConnector"
+	KafkaConnectService_RestartConnector_FullMethodName        = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartConnector"
+	KafkaConnectService_GetConnector_FullMethodName            = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnector"
+	KafkaConnectService_GetConnectorStatus_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorStatus"
+	KafkaConnectService_PauseConnector_FullMethodName          = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/PauseConnector"
+	KafkaConnectService_ResumeConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResumeConnector"
+	KafkaConnectService_StopConnector_FullMethodName           = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/StopConnector"
+	KafkaConnectService_DeleteConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/DeleteConnector"
+	KafkaConnectService_UpsertConnector_FullMethodName         = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/UpsertConnector"
+	KafkaConnectService_GetConnectorConfig_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorConfig"
+	KafkaConnectService_ListConnectorTopics_FullMethodName     = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorTopics"
+	KafkaConnectService_ResetConnectorTopics_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorTopics"
+	KafkaConnectService_GetConnectorRemediation_FullMethodName = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/GetConnectorRemediation"
+	KafkaConnectService_ListConnectorOffsets_FullMethodName    = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectorOffsets"
+	KafkaConnectService_AlterConnectorOffsets_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/AlterConnectorOffsets"
+	KafkaConnectService_ResetConnectorOffsets_FullMethodName   = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ResetConnectorOffsets"
+	KafkaConnectService_ListConnectWorkers_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/ListConnectWorkers"
+	KafkaConnectService_RestartWorkerTasks_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartWorkerTasks"
+	KafkaConnectService_RestartFailedTasks_FullMethodName      = "/redpanda.api.dataplane.v1alpha1.KafkaConnectService/RestartFailedTasks"
 )
 
 // KafkaConnectServiceClient is the client API for KafkaConnectService service.
@@ -89,6 +95,26 @@ type KafkaConnectServiceClient interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(ctx context.Context, in *ResetConnectorTopicsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetConnectorRemediation returns the auto remediation policy of a connector,
+	// the state of its failed tasks and the history of restarts.
+	GetConnectorRemediation(ctx context.Context, in *GetConnectorRemediationRequest, opts ...grpc.CallOption) (*GetConnectorRemediationResponse, error)
+	// ListConnectorOffsets implements the list connector offsets method, exposes
+	// a Kafka Connect equivalent REST endpoint (KIP-875).
+	ListConnectorOffsets(ctx context.Context, in *ListConnectorOffsetsRequest, opts ...grpc.CallOption) (*ListConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets implements the alter connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*AlterConnectorOffsetsResponse, error)
+	// ResetConnectorOffsets implements the reset connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*ResetConnectorOffsetsResponse, error)
+	// ListConnectWorkers lists the workers of a Kafka Connect cluster along with
+	// the connectors and tasks that are assigned to each of them.
+	ListConnectWorkers(ctx context.Context, in *ListConnectWorkersRequest, opts ...grpc.CallOption) (*ListConnectWorkersResponse, error)
+	// RestartWorkerTasks restarts all tasks that are assigned to a worker.
+	RestartWorkerTasks(ctx context.Context, in *RestartWorkerTasksRequest, opts ...grpc.CallOption) (*RestartWorkerTasksResponse, error)
+	// RestartFailedTasks restarts all failed tasks of all connectors in a
+	// Kafka Connect cluster.
+	RestartFailedTasks(ctx context.Context, in *RestartFailedTasksRequest, opts ...grpc.CallOption) (*RestartFailedTasksResponse, error)
 }
 
 type kafkaConnectServiceClient struct {
@@ -234,6 +260,69 @@ func (c *kafkaConnectServiceClient) ResetConnectorTopics(ctx context.Context, in
 	return out, nil
 }
 
+func (c *kafkaConnectServiceClient) GetConnectorRemediation(ctx context.Context, in *GetConnectorRemediationRequest, opts ...grpc.CallOption) (*GetConnectorRemediationResponse, error) {
+	out := new(GetConnectorRemediationResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_GetConnectorRemediation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ListConnectorOffsets(ctx context.Context, in *ListConnectorOffsetsRequest, opts ...grpc.CallOption) (*ListConnectorOffsetsResponse, error) {
+	out := new(ListConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ListConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) AlterConnectorOffsets(ctx context.Context, in *AlterConnectorOffsetsRequest, opts ...grpc.CallOption) (*AlterConnectorOffsetsResponse, error) {
+	out := new(AlterConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_AlterConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ResetConnectorOffsets(ctx context.Context, in *ResetConnectorOffsetsRequest, opts ...grpc.CallOption) (*ResetConnectorOffsetsResponse, error) {
+	out := new(ResetConnectorOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ResetConnectorOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) ListConnectWorkers(ctx context.Context, in *ListConnectWorkersRequest, opts ...grpc.CallOption) (*ListConnectWorkersResponse, error) {
+	out := new(ListConnectWorkersResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_ListConnectWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) RestartWorkerTasks(ctx context.Context, in *RestartWorkerTasksRequest, opts ...grpc.CallOption) (*RestartWorkerTasksResponse, error) {
+	out := new(RestartWorkerTasksResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_RestartWorkerTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaConnectServiceClient) RestartFailedTasks(ctx context.Context, in *RestartFailedTasksRequest, opts ...grpc.CallOption) (*RestartFailedTasksResponse, error) {
+	out := new(RestartFailedTasksResponse)
+	err := c.cc.Invoke(ctx, KafkaConnectService_RestartFailedTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KafkaConnectServiceServer is the server API for KafkaConnectService service.
 // All implementations must embed UnimplementedKafkaConnectServiceServer
 // for forward compatibility
@@ -285,6 +374,26 @@ type KafkaConnectServiceServer interface {
 	// ResetConnectorTopics implements the reset connector topics method, expose a kafka connect equivalent REST endpoint
 	// the request body is empty.
 	ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error)
+	// GetConnectorRemediation returns the auto remediation policy of a connector,
+	// the state of its failed tasks and the history of restarts.
+	GetConnectorRemediation(context.Context, *GetConnectorRemediationRequest) (*GetConnectorRemediationResponse, error)
+	// ListConnectorOffsets implements the list connector offsets method, exposes
+	// a Kafka Connect equivalent REST endpoint (KIP-875).
+	ListConnectorOffsets(context.Context, *ListConnectorOffsetsRequest) (*ListConnectorOffsetsResponse, error)
+	// AlterConnectorOffsets implements the alter connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*AlterConnectorOffsetsResponse, error)
+	// ResetConnectorOffsets implements the reset connector offsets method,
+	// exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+	ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*ResetConnectorOffsetsResponse, error)
+	// ListConnectWorkers lists the workers of a Kafka Connect cluster along with
+	// the connectors and tasks that are assigned to each of them.
+	ListConnectWorkers(context.Context, *ListConnectWorkersRequest) (*ListConnectWorkersResponse, error)
+	// RestartWorkerTasks restarts all tasks that are assigned to a worker.
+	RestartWorkerTasks(context.Context, *RestartWorkerTasksRequest) (*RestartWorkerTasksResponse, error)
+	// RestartFailedTasks restarts all failed tasks of all connectors in a
+	// Kafka Connect cluster.
+	RestartFailedTasks(context.Context, *RestartFailedTasksRequest) (*RestartFailedTasksResponse, error)
 	mustEmbedUnimplementedKafkaConnectServiceServer()
 }
 
@@ -337,6 +446,27 @@ func (UnimplementedKafkaConnectServiceServer) ListConnectorTopics(context.Contex
 func (UnimplementedKafkaConnectServiceServer) ResetConnectorTopics(context.Context, *ResetConnectorTopicsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorTopics not implemented")
 }
+func (UnimplementedKafkaConnectServiceServer) GetConnectorRemediation(context.Context, *GetConnectorRemediationRequest) (*GetConnectorRemediationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectorRemediation not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ListConnectorOffsets(context.Context, *ListConnectorOffsetsRequest) (*ListConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) AlterConnectorOffsets(context.Context, *AlterConnectorOffsetsRequest) (*AlterConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ResetConnectorOffsets(context.Context, *ResetConnectorOffsetsRequest) (*ResetConnectorOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConnectorOffsets not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) ListConnectWorkers(context.Context, *ListConnectWorkersRequest) (*ListConnectWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectWorkers not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) RestartWorkerTasks(context.Context, *RestartWorkerTasksRequest) (*RestartWorkerTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartWorkerTasks not implemented")
+}
+func (UnimplementedKafkaConnectServiceServer) RestartFailedTasks(context.Context, *RestartFailedTasksRequest) (*RestartFailedTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartFailedTasks not implemented")
+}
 func (UnimplementedKafkaConnectServiceServer) mustEmbedUnimplementedKafkaConnectServiceServer() {}
 
 // UnsafeKafkaConnectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -620,6 +750,132 @@ func _KafkaConnectService_ResetConnectorTopics_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaConnectService_GetConnectorRemediation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectorRemediationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).GetConnectorRemediation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_GetConnectorRemediation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).GetConnectorRemediation(ctx, req.(*GetConnectorRemediationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ListConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ListConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ListConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ListConnectorOffsets(ctx, req.(*ListConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_AlterConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_AlterConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).AlterConnectorOffsets(ctx, req.(*AlterConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ResetConnectorOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetConnectorOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ResetConnectorOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ResetConnectorOffsets(ctx, req.(*ResetConnectorOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_ListConnectWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).ListConnectWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_ListConnectWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).ListConnectWorkers(ctx, req.(*ListConnectWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_RestartWorkerTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartWorkerTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).RestartWorkerTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_RestartWorkerTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).RestartWorkerTasks(ctx, req.(*RestartWorkerTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaConnectService_RestartFailedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartFailedTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaConnectServiceServer).RestartFailedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaConnectService_RestartFailedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaConnectServiceServer).RestartFailedTasks(ctx, req.(*RestartFailedTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KafkaConnectService_ServiceDesc is the grpc.ServiceDesc for KafkaConnectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -687,6 +943,34 @@ var KafkaConnectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetConnectorTopics",
 			Handler:    _KafkaConnectService_ResetConnectorTopics_Handler,
 		},
+		{
+			MethodName: "GetConnectorRemediation",
+			Handler:    _KafkaConnectService_GetConnectorRemediation_Handler,
+		},
+		{
+			MethodName: "ListConnectorOffsets",
+			Handler:    _KafkaConnectService_ListConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "AlterConnectorOffsets",
+			Handler:    _KafkaConnectService_AlterConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "ResetConnectorOffsets",
+			Handler:    _KafkaConnectService_ResetConnectorOffsets_Handler,
+		},
+		{
+			MethodName: "ListConnectWorkers",
+			Handler:    _KafkaConnectService_ListConnectWorkers_Handler,
+		},
+		{
+			MethodName: "RestartWorkerTasks",
+			Handler:    _KafkaConnectService_RestartWorkerTasks_Handler,
+		},
+		{
+			MethodName: "RestartFailedTasks",
+			Handler:    _KafkaConnectService_RestartFailedTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "redpanda/api/dataplane/v1alpha1/kafka_connect.proto",
@@ -0,0 +1,144 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+)
+
+// Decision is the result of evaluating the policy for a single request.
+type Decision struct {
+	Allowed bool `json:"allowed"`
+	// Reason summarizes why the request has been allowed or denied.
+	Reason string `json:"reason"`
+
+	Principal    string       `json:"principal"`
+	Groups       []string     `json:"groups"`
+	ResourceType ResourceType `json:"resourceType"`
+	ResourceName string       `json:"resourceName"`
+	Action       string       `json:"action"`
+
+	// Roles are all roles bound to the principal, along with the evaluation
+	// of their permissions.
+	Roles []RoleEvaluation `json:"roles"`
+}
+
+// RoleEvaluation describes how a role that is bound to the principal has been evaluated.
+type RoleEvaluation struct {
+	Role string `json:"role"`
+	// BoundVia lists the users and groups through which the role is bound, e.g. "user:alice".
+	BoundVia    []string               `json:"boundVia"`
+	Permissions []PermissionEvaluation `json:"permissions"`
+}
+
+// PermissionEvaluation describes whether a permission matched the request.
+type PermissionEvaluation struct {
+	Resource        ResourceType `json:"resource"`
+	Pattern         string       `json:"pattern,omitempty"`
+	Actions         []string     `json:"actions"`
+	MatchesResource bool         `json:"matchesResource"`
+	GrantsAction    bool         `json:"grantsAction"`
+}
+
+// boundRole is a role that is bound to a principal.
+type boundRole struct {
+	role     *Role
+	boundVia []string
+}
+
+// boundRoles returns the roles that are bound to the principal, either by name or by
+// one of its groups, in the order in which they are defined.
+func boundRoles(policy *Policy, principal *auth.Principal) []boundRole {
+	if policy == nil || principal == nil {
+		return nil
+	}
+
+	boundViaByRole := make(map[string][]string)
+	for _, binding := range policy.RoleBindings {
+		if slices.Contains(binding.Users, principal.Name) {
+			boundViaByRole[binding.Role] = append(boundViaByRole[binding.Role], "user:"+principal.Name)
+		}
+		for _, group := range principal.Groups {
+			if slices.Contains(binding.Groups, group) {
+				boundViaByRole[binding.Role] = append(boundViaByRole[binding.Role], "group:"+group)
+			}
+		}
+	}
+
+	roles := make([]boundRole, 0, len(boundViaByRole))
+	for i := range policy.Roles {
+		role := &policy.Roles[i]
+		if boundVia, isBound := boundViaByRole[role.Name]; isBound {
+			roles = append(roles, boundRole{role: role, boundVia: boundVia})
+		}
+	}
+	return roles
+}
+
+func evaluate(policy *Policy, principal *auth.Principal, resourceType ResourceType, resourceName, action string) Decision {
+	decision := Decision{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Action:       action,
+		Groups:       []string{},
+		Roles:        []RoleEvaluation{},
+	}
+	if principal == nil {
+		decision.Reason = "request is not authenticated"
+		return decision
+	}
+	decision.Principal = principal.Name
+	if principal.Groups != nil {
+		decision.Groups = principal.Groups
+	}
+
+	roles := boundRoles(policy, principal)
+	if len(roles) == 0 {
+		decision.Reason = fmt.Sprintf("no role is bound to user %q or any of its groups", principal.Name)
+		return decision
+	}
+
+	roleNames := make([]string, 0, len(roles))
+	for _, bound := range roles {
+		roleNames = append(roleNames, bound.role.Name)
+		evaluation := RoleEvaluation{
+			Role:        bound.role.Name,
+			BoundVia:    bound.boundVia,
+			Permissions: make([]PermissionEvaluation, 0, len(bound.role.Permissions)),
+		}
+		for _, permission := range bound.role.Permissions {
+			permissionEvaluation := PermissionEvaluation{
+				Resource:        permission.Resource,
+				Pattern:         permission.Pattern,
+				Actions:         permission.Actions,
+				MatchesResource: permission.matchesResource(resourceType, resourceName),
+				GrantsAction:    permission.grantsAction(action),
+			}
+			evaluation.Permissions = append(evaluation.Permissions, permissionEvaluation)
+
+			if !decision.Allowed && permissionEvaluation.MatchesResource && permissionEvaluation.GrantsAction {
+				decision.Allowed = true
+				decision.Reason = fmt.Sprintf("role %q bound via %v grants %q on %v %q",
+					bound.role.Name, strings.Join(bound.boundVia, ", "), action, resourceType, resourceName)
+			}
+		}
+		decision.Roles = append(decision.Roles, evaluation)
+	}
+
+	if !decision.Allowed {
+		decision.Reason = fmt.Sprintf("none of the bound roles (%v) grants %q on %v %q",
+			strings.Join(roleNames, ", "), action, resourceType, resourceName)
+	}
+	return decision
+}
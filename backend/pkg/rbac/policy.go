@@ -0,0 +1,203 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+// Package rbac implements role based access control for authenticated users. Roles
+// grant actions on resources whose names match a pattern and are bound to users or
+// identity provider groups in a YAML policy file.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ResourceType is the type of resource a permission applies to.
+type ResourceType string
+
+// Resource types that permissions can be granted on.
+const (
+	ResourceTopic          ResourceType = "topic"
+	ResourceConsumerGroup  ResourceType = "consumerGroup"
+	ResourceConnectCluster ResourceType = "connectCluster"
+	ResourceSubject        ResourceType = "subject"
+	// ResourceCluster covers all cluster-wide operations. Permissions on it have
+	// no pattern.
+	ResourceCluster ResourceType = "cluster"
+)
+
+// ActionAll grants all actions of a resource type.
+const ActionAll = "*"
+
+// Actions that can be granted per resource type. Topic, consumer group and connect
+// cluster actions use the same names as the allowed actions that are returned to
+// the frontend.
+var actionsByResourceType = map[ResourceType][]string{
+	ResourceTopic: {
+		"seeTopic", "viewPartitions", "viewMessages", "useSearchFilter", "viewConsumers", "viewConfig",
+		"deleteTopic", "deleteTopicRecords", "editConfig", "createTopic", "publishRecords",
+	},
+	ResourceConsumerGroup:  {"seeConsumerGroup", "editConsumerGroup", "deleteConsumerGroup"},
+	ResourceConnectCluster: {"viewConnectCluster", "editConnectCluster", "deleteConnectCluster"},
+	ResourceSubject:        {"viewSchemas", "createSchemas", "deleteSchemas"},
+	ResourceCluster: {
+		"listAcls", "createAcls", "deleteAcls", "listQuotas", "patchPartitionReassignments", "patchConfigs",
+		"listKafkaUsers", "createKafkaUsers", "deleteKafkaUsers", "listRedpandaRoles", "createRedpandaRoles",
+		"deleteRedpandaRoles", "manageSchemaRegistry", "debugAuthorization", "viewAuditLog", "manageApiTokens", "approveChanges",
+		"manageConnectClusters", "viewTransforms", "manageTransforms",
+	},
+}
+
+// Actions returns all actions that can be granted on the given resource type.
+func Actions(resourceType ResourceType) []string {
+	return slices.Clone(actionsByResourceType[resourceType])
+}
+
+// Policy is the content of the policy file.
+type Policy struct {
+	Roles        []Role        `yaml:"roles"`
+	RoleBindings []RoleBinding `yaml:"roleBindings"`
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        string       `yaml:"name"`
+	Permissions []Permission `yaml:"permissions"`
+}
+
+// Permission grants actions on all resources of a type whose name matches the pattern.
+type Permission struct {
+	Resource ResourceType `yaml:"resource" json:"resource"`
+	// Pattern is a regular expression that must match the entire resource name.
+	// An empty pattern matches all resources.
+	Pattern string   `yaml:"pattern" json:"pattern,omitempty"`
+	Actions []string `yaml:"actions" json:"actions"`
+
+	regex *regexp.Regexp
+}
+
+// RoleBinding assigns a role to users and groups.
+type RoleBinding struct {
+	Role   string   `yaml:"role"`
+	Users  []string `yaml:"users"`
+	Groups []string `yaml:"groups"`
+}
+
+// parsePolicy decodes and validates the YAML policy.
+func parsePolicy(r io.Reader) (*Policy, error) {
+	var policy Policy
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&policy); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode policy: %w", err)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// validate checks the policy and compiles the patterns of all permissions.
+func (p *Policy) validate() error {
+	roleNames := make(map[string]struct{}, len(p.Roles))
+	for i := range p.Roles {
+		role := &p.Roles[i]
+		if role.Name == "" {
+			return fmt.Errorf("role at index %d has no name", i)
+		}
+		if _, exists := roleNames[role.Name]; exists {
+			return fmt.Errorf("role %q is defined more than once", role.Name)
+		}
+		roleNames[role.Name] = struct{}{}
+
+		for j := range role.Permissions {
+			if err := role.Permissions[j].compile(); err != nil {
+				return fmt.Errorf("role %q: permission at index %d: %w", role.Name, j, err)
+			}
+		}
+	}
+
+	for i, binding := range p.RoleBindings {
+		if _, exists := roleNames[binding.Role]; !exists {
+			return fmt.Errorf("role binding at index %d refers to unknown role %q", i, binding.Role)
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			return fmt.Errorf("role binding at index %d for role %q has neither users nor groups", i, binding.Role)
+		}
+	}
+
+	return nil
+}
+
+func (p *Permission) compile() error {
+	validActions, exists := actionsByResourceType[p.Resource]
+	if !exists {
+		return fmt.Errorf("unknown resource type %q", p.Resource)
+	}
+	if len(p.Actions) == 0 {
+		return errors.New("no actions granted")
+	}
+	for _, action := range p.Actions {
+		if action != ActionAll && !slices.Contains(validActions, action) {
+			return fmt.Errorf("unknown action %q for resource type %q", action, p.Resource)
+		}
+	}
+
+	if p.Resource == ResourceCluster {
+		if p.Pattern != "" {
+			return errors.New("cluster permissions must not have a pattern")
+		}
+		return nil
+	}
+	if p.Pattern == "" {
+		return nil
+	}
+	regex, err := regexp.Compile("^(?:" + p.Pattern + ")$")
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", p.Pattern, err)
+	}
+	p.regex = regex
+
+	return nil
+}
+
+// matchesResource returns true if the permission applies to the given resource.
+func (p *Permission) matchesResource(resourceType ResourceType, resourceName string) bool {
+	if p.Resource != resourceType {
+		return false
+	}
+	return p.regex == nil || p.regex.MatchString(resourceName)
+}
+
+// grantsAction returns true if the permission includes the given action.
+func (p *Permission) grantsAction(action string) bool {
+	return slices.Contains(p.Actions, ActionAll) || slices.Contains(p.Actions, action)
+}
+
+// coversPattern returns true if the permission applies to all resources that match
+// the given pattern. As patterns are regular expressions, a pattern is only
+// covered by permissions without a pattern, with the same pattern, or whose
+// pattern matches it if it is a plain resource name.
+func (p *Permission) coversPattern(pattern string) bool {
+	switch {
+	case p.Resource == ResourceCluster || p.Pattern == "":
+		return true
+	case pattern == "":
+		return false
+	case p.Pattern == pattern:
+		return true
+	default:
+		return regexp.QuoteMeta(pattern) == pattern && p.regex.MatchString(pattern)
+	}
+}
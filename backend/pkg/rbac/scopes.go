@@ -0,0 +1,65 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Scopes are permissions that are granted directly, such as to an API token,
+// rather than via roles that are bound in the policy file.
+type Scopes []Permission
+
+// Validate checks all scopes and compiles their patterns. It must be called
+// before the scopes are evaluated.
+func (s Scopes) Validate() error {
+	if len(s) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	for i := range s {
+		if err := s[i].compile(); err != nil {
+			return fmt.Errorf("scope at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// IsAllowed returns true if one of the scopes grants the action on the resource.
+func (s Scopes) IsAllowed(resourceType ResourceType, resourceName, action string) bool {
+	for i := range s {
+		if s[i].matchesResource(resourceType, resourceName) && s[i].grantsAction(action) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAllowedOnAny returns true if one of the scopes grants the action on at least
+// one resource of the given type, regardless of its name.
+func (s Scopes) IsAllowedOnAny(resourceType ResourceType, action string) bool {
+	for i := range s {
+		if s[i].Resource == resourceType && s[i].grantsAction(action) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedActions returns all actions the scopes grant on the resource.
+func (s Scopes) AllowedActions(resourceType ResourceType, resourceName string) []string {
+	allowed := make([]string, 0)
+	for _, action := range actionsByResourceType[resourceType] {
+		if s.IsAllowed(resourceType, resourceName, action) {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package rbac
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+// Service authorizes principals with the role bindings of the policy file. The
+// policy file is reloaded whenever its content changes.
+type Service struct {
+	cfg    config.AuthRBAC
+	logger *zap.Logger
+
+	mutex          sync.RWMutex
+	policy         *Policy
+	policyChecksum [sha256.Size]byte
+	loadedAt       time.Time
+}
+
+// NewService loads the policy file and creates the RBAC service.
+func NewService(cfg config.AuthRBAC, logger *zap.Logger) (*Service, error) {
+	svc := &Service{
+		cfg:    cfg,
+		logger: logger,
+	}
+	if _, err := svc.Reload(); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
+// Start periodically checks the policy file for changes and reloads it. If the
+// changed policy is invalid, the previous policy remains active.
+func (s *Service) Start() {
+	go func(refreshInterval time.Duration) {
+		// Stop reloading when we receive a signal
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				s.logger.Info("stopped reloading rbac policy", zap.String("reason", "received signal"))
+				return
+			case <-ticker.C:
+				reloaded, err := s.Reload()
+				if err != nil {
+					s.logger.Warn("failed to reload rbac policy, keeping the previous policy", zap.Error(err))
+					break
+				}
+				if reloaded {
+					s.logger.Info("reloaded rbac policy", zap.String("policy_filepath", s.cfg.PolicyFilepath))
+				}
+			}
+		}
+	}(s.cfg.RefreshInterval)
+}
+
+// Reload reads the policy file and activates it if its content has changed. It
+// returns whether a new policy has been activated.
+func (s *Service) Reload() (bool, error) {
+	content, err := os.ReadFile(s.cfg.PolicyFilepath)
+	if err != nil {
+		return false, fmt.Errorf("failed to read rbac policy file: %w", err)
+	}
+	checksum := sha256.Sum256(content)
+
+	s.mutex.RLock()
+	unchanged := s.policy != nil && checksum == s.policyChecksum
+	s.mutex.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	policy, err := parsePolicy(bytes.NewReader(content))
+	if err != nil {
+		return false, fmt.Errorf("failed to load rbac policy file: %w", err)
+	}
+
+	s.mutex.Lock()
+	s.policy = policy
+	s.policyChecksum = checksum
+	s.loadedAt = time.Now()
+	s.mutex.Unlock()
+
+	return true, nil
+}
+
+// IsAllowed returns true if one of the roles bound to the principal grants the
+// action on the resource.
+func (s *Service) IsAllowed(principal *auth.Principal, resourceType ResourceType, resourceName, action string) bool {
+	return s.Explain(principal, resourceType, resourceName, action).Allowed
+}
+
+// IsAllowedOnAny returns true if one of the roles bound to the principal grants the
+// action on at least one resource of the given type, regardless of its name. This is
+// used for checks that are not scoped to a single resource, such as listing schemas.
+func (s *Service) IsAllowedOnAny(principal *auth.Principal, resourceType ResourceType, action string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, bound := range boundRoles(s.policy, principal) {
+		for _, permission := range bound.role.Permissions {
+			if permission.Resource == resourceType && permission.grantsAction(action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Grants returns true if the roles bound to the principal grant every action of
+// the given permission on all resources it matches. It is used to ensure that
+// permissions can only be delegated, e.g. to API tokens, by users who have them.
+func (s *Service) Grants(principal *auth.Principal, permission Permission) bool {
+	actions := permission.Actions
+	if slices.Contains(actions, ActionAll) {
+		actions = actionsByResourceType[permission.Resource]
+	}
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	roles := boundRoles(s.policy, principal)
+	for _, action := range actions {
+		granted := false
+		for _, bound := range roles {
+			for i := range bound.role.Permissions {
+				rolePermission := &bound.role.Permissions[i]
+				if rolePermission.Resource == permission.Resource && rolePermission.grantsAction(action) &&
+					rolePermission.coversPattern(permission.Pattern) {
+					granted = true
+					break
+				}
+			}
+			if granted {
+				break
+			}
+		}
+		if !granted {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowedActions returns all actions the principal is allowed to perform on the resource.
+func (s *Service) AllowedActions(principal *auth.Principal, resourceType ResourceType, resourceName string) []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	allowed := make([]string, 0)
+	for _, action := range actionsByResourceType[resourceType] {
+		if evaluate(s.policy, principal, resourceType, resourceName, action).Allowed {
+			allowed = append(allowed, action)
+		}
+	}
+	return allowed
+}
+
+// Explain evaluates the policy for the given request and returns a decision that
+// details which role bindings and permissions have been considered.
+func (s *Service) Explain(principal *auth.Principal, resourceType ResourceType, resourceName, action string) Decision {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return evaluate(s.policy, principal, resourceType, resourceName, action)
+}
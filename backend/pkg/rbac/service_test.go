@@ -0,0 +1,191 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package rbac
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/auth"
+	"github.com/redpanda-data/console/backend/pkg/config"
+)
+
+const testPolicy = `
+roles:
+  - name: orders-reader
+    permissions:
+      - resource: topic
+        pattern: "orders-.*"
+        actions: ["seeTopic", "viewMessages"]
+      - resource: subject
+        pattern: "orders-.*-value"
+        actions: ["viewSchemas"]
+  - name: admin
+    permissions:
+      - resource: topic
+        actions: ["*"]
+      - resource: cluster
+        actions: ["listAcls"]
+roleBindings:
+  - role: orders-reader
+    groups: ["team-orders"]
+  - role: admin
+    users: ["alice"]
+`
+
+func newTestService(t *testing.T, policy string) (*Service, string) {
+	policyFilepath := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFilepath, []byte(policy), 0o600))
+
+	svc, err := NewService(config.AuthRBAC{Enabled: true, PolicyFilepath: policyFilepath, RefreshInterval: time.Second}, zap.NewNop())
+	require.NoError(t, err)
+	return svc, policyFilepath
+}
+
+func TestIsAllowed(t *testing.T) {
+	svc, _ := newTestService(t, testPolicy)
+	alice := &auth.Principal{Name: "alice"}
+	bob := &auth.Principal{Name: "bob", Groups: []string{"team-orders"}}
+	carol := &auth.Principal{Name: "carol", Groups: []string{"team-payments"}}
+
+	assert.True(t, svc.IsAllowed(alice, ResourceTopic, "payments", "deleteTopic"))
+	assert.True(t, svc.IsAllowed(alice, ResourceCluster, "", "listAcls"))
+	assert.False(t, svc.IsAllowed(alice, ResourceCluster, "", "createAcls"))
+
+	assert.True(t, svc.IsAllowed(bob, ResourceTopic, "orders-v1", "viewMessages"))
+	assert.False(t, svc.IsAllowed(bob, ResourceTopic, "orders-v1", "deleteTopic"))
+	assert.False(t, svc.IsAllowed(bob, ResourceTopic, "payments", "seeTopic"))
+	// Patterns must match the entire name
+	assert.False(t, svc.IsAllowed(bob, ResourceTopic, "legacy-orders-v1", "seeTopic"))
+
+	assert.False(t, svc.IsAllowed(carol, ResourceTopic, "orders-v1", "seeTopic"))
+	assert.False(t, svc.IsAllowed(nil, ResourceTopic, "orders-v1", "seeTopic"))
+
+	assert.True(t, svc.IsAllowedOnAny(bob, ResourceSubject, "viewSchemas"))
+	assert.False(t, svc.IsAllowedOnAny(alice, ResourceSubject, "viewSchemas"))
+
+	assert.Equal(t, []string{"seeTopic", "viewMessages"}, svc.AllowedActions(bob, ResourceTopic, "orders-v1"))
+	assert.Equal(t, Actions(ResourceTopic), svc.AllowedActions(alice, ResourceTopic, "orders-v1"))
+}
+
+func TestGrants(t *testing.T) {
+	svc, _ := newTestService(t, testPolicy)
+	alice := &auth.Principal{Name: "alice"}
+	bob := &auth.Principal{Name: "bob", Groups: []string{"team-orders"}}
+
+	assert.True(t, svc.Grants(alice, Permission{Resource: ResourceTopic, Actions: []string{"*"}}))
+	assert.True(t, svc.Grants(alice, Permission{Resource: ResourceCluster, Actions: []string{"listAcls"}}))
+	assert.False(t, svc.Grants(alice, Permission{Resource: ResourceCluster, Actions: []string{"*"}}))
+
+	assert.True(t, svc.Grants(bob, Permission{Resource: ResourceTopic, Pattern: "orders-.*", Actions: []string{"seeTopic"}}))
+	assert.True(t, svc.Grants(bob, Permission{Resource: ResourceTopic, Pattern: "orders-v1", Actions: []string{"viewMessages"}}))
+	assert.False(t, svc.Grants(bob, Permission{Resource: ResourceTopic, Pattern: "orders-v1", Actions: []string{"deleteTopic"}}))
+	assert.False(t, svc.Grants(bob, Permission{Resource: ResourceTopic, Actions: []string{"seeTopic"}}))
+	// Patterns that are not plain names are only covered by the same pattern
+	assert.False(t, svc.Grants(bob, Permission{Resource: ResourceTopic, Pattern: ".*", Actions: []string{"seeTopic"}}))
+}
+
+func TestExplain(t *testing.T) {
+	svc, _ := newTestService(t, testPolicy)
+	bob := &auth.Principal{Name: "bob", Groups: []string{"team-orders"}}
+
+	decision := svc.Explain(bob, ResourceTopic, "orders-v1", "deleteTopic")
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, `none of the bound roles (orders-reader) grants "deleteTopic" on topic "orders-v1"`, decision.Reason)
+	require.Len(t, decision.Roles, 1)
+	assert.Equal(t, []string{"group:team-orders"}, decision.Roles[0].BoundVia)
+	require.Len(t, decision.Roles[0].Permissions, 2)
+	assert.True(t, decision.Roles[0].Permissions[0].MatchesResource)
+	assert.False(t, decision.Roles[0].Permissions[0].GrantsAction)
+	assert.False(t, decision.Roles[0].Permissions[1].MatchesResource)
+
+	decision = svc.Explain(bob, ResourceTopic, "orders-v1", "seeTopic")
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, `role "orders-reader" bound via group:team-orders grants "seeTopic" on topic "orders-v1"`, decision.Reason)
+
+	decision = svc.Explain(&auth.Principal{Name: "carol"}, ResourceTopic, "orders-v1", "seeTopic")
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, `no role is bound to user "carol" or any of its groups`, decision.Reason)
+	assert.Empty(t, decision.Roles)
+}
+
+func TestReload(t *testing.T) {
+	svc, policyFilepath := newTestService(t, testPolicy)
+	carol := &auth.Principal{Name: "carol"}
+	assert.False(t, svc.IsAllowed(carol, ResourceCluster, "", "listAcls"))
+
+	reloaded, err := svc.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded, "unchanged policy must not be reloaded")
+
+	require.NoError(t, os.WriteFile(policyFilepath, []byte(strings.Replace(testPolicy, `users: ["alice"]`, `users: ["alice", "carol"]`, 1)), 0o600))
+	reloaded, err = svc.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.True(t, svc.IsAllowed(carol, ResourceCluster, "", "listAcls"))
+
+	// An invalid policy keeps the previous policy active
+	require.NoError(t, os.WriteFile(policyFilepath, []byte("roles: [{name: broken, permissions: [{resource: unknown, actions: ['*']}]}]"), 0o600))
+	_, err = svc.Reload()
+	assert.Error(t, err)
+	assert.True(t, svc.IsAllowed(carol, ResourceCluster, "", "listAcls"))
+}
+
+func TestParsePolicyErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		errContains string
+	}{
+		{
+			name:        "unknown action",
+			policy:      "roles: [{name: r, permissions: [{resource: topic, actions: [fly]}]}]",
+			errContains: `unknown action "fly"`,
+		},
+		{
+			name:        "invalid pattern",
+			policy:      "roles: [{name: r, permissions: [{resource: topic, pattern: '(', actions: ['*']}]}]",
+			errContains: "invalid pattern",
+		},
+		{
+			name:        "cluster pattern",
+			policy:      "roles: [{name: r, permissions: [{resource: cluster, pattern: 'x', actions: ['*']}]}]",
+			errContains: "must not have a pattern",
+		},
+		{
+			name:        "duplicate role",
+			policy:      "roles: [{name: r, permissions: []}, {name: r, permissions: []}]",
+			errContains: "defined more than once",
+		},
+		{
+			name:        "unknown role in binding",
+			policy:      "roleBindings: [{role: missing, users: [alice]}]",
+			errContains: `unknown role "missing"`,
+		},
+		{
+			name:        "unknown field",
+			policy:      "rolez: []",
+			errContains: "field rolez not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePolicy(strings.NewReader(tt.policy))
+			assert.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
@@ -126,7 +126,10 @@ func newClient(cfg config.Schema) (*Client, error) {
 //nolint:revive // This is stuttering when calling this with the pkg name, but without that the
 type SchemaResponse struct {
 	Schema     string            `json:"schema"`
+	Type       SchemaType        `json:"schemaType"`
 	References []SchemaReference `json:"references,omitempty"`
+	Metadata   *Metadata         `json:"metadata,omitempty"`
+	RuleSet    *RuleSet          `json:"ruleSet,omitempty"`
 }
 
 // GetSchemaByID returns the schema string identified by the input ID.
@@ -169,6 +172,8 @@ type SchemaVersionedResponse struct {
 	Schema     string            `json:"schema"`
 	Type       SchemaType        `json:"schemaType"`
 	References []SchemaReference `json:"references"`
+	Metadata   *Metadata         `json:"metadata,omitempty"`
+	RuleSet    *RuleSet          `json:"ruleSet,omitempty"`
 }
 
 // GetSchemaBySubject returns the schema for the specified version of this subject. The unescaped schema only is returned.
@@ -0,0 +1,87 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package schema
+
+// Rule types and modes as used by Confluent's data contracts. Only the subset
+// that is relevant for Console is listed here.
+const (
+	// RuleTypeEncrypt encrypts all fields that are tagged with one of the rule's tags.
+	RuleTypeEncrypt = "ENCRYPT"
+	// RuleTypeEncryptPayload encrypts the whole serialized payload that follows
+	// the schema registry wire format header.
+	RuleTypeEncryptPayload = "ENCRYPT_PAYLOAD"
+
+	// RuleModeWrite applies a rule when serializing records.
+	RuleModeWrite = "WRITE"
+	// RuleModeRead applies a rule when deserializing records.
+	RuleModeRead = "READ"
+	// RuleModeWriteRead applies a rule when serializing and deserializing records.
+	RuleModeWriteRead = "WRITEREAD"
+
+	// RuleParamKEKName is the rule parameter that refers to the key encryption key.
+	RuleParamKEKName = "encrypt.kek.name"
+)
+
+// Metadata is the optional metadata that can be attached to a schema. Tags map
+// field paths (e.g. "**.ssn" or "Customer.email") to a list of tags.
+type Metadata struct {
+	Tags       map[string][]string `json:"tags,omitempty"`
+	Properties map[string]string   `json:"properties,omitempty"`
+	Sensitive  []string            `json:"sensitive,omitempty"`
+}
+
+// RuleSet contains the data contract rules of a schema.
+type RuleSet struct {
+	MigrationRules []Rule `json:"migrationRules,omitempty"`
+	DomainRules    []Rule `json:"domainRules,omitempty"`
+}
+
+// Rule is a single data contract rule.
+type Rule struct {
+	Name      string            `json:"name"`
+	Doc       string            `json:"doc,omitempty"`
+	Kind      string            `json:"kind,omitempty"`
+	Mode      string            `json:"mode,omitempty"`
+	Type      string            `json:"type"`
+	Tags      []string          `json:"tags,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	Expr      string            `json:"expr,omitempty"`
+	OnSuccess string            `json:"onSuccess,omitempty"`
+	OnFailure string            `json:"onFailure,omitempty"`
+	Disabled  bool              `json:"disabled,omitempty"`
+}
+
+// AppliesToRead returns true if the rule shall be executed when deserializing records.
+// Rules without a mode default to WRITEREAD.
+func (r Rule) AppliesToRead() bool {
+	return !r.Disabled && (r.Mode == "" || r.Mode == RuleModeRead || r.Mode == RuleModeWriteRead)
+}
+
+// AppliesToWrite returns true if the rule shall be executed when serializing records.
+// Rules without a mode default to WRITEREAD.
+func (r Rule) AppliesToWrite() bool {
+	return !r.Disabled && (r.Mode == "" || r.Mode == RuleModeWrite || r.Mode == RuleModeWriteRead)
+}
+
+// RulesByType returns all domain rules of the given type. It is safe to call
+// this on a nil RuleSet.
+func (r *RuleSet) RulesByType(ruleType string) []Rule {
+	if r == nil {
+		return nil
+	}
+
+	rules := make([]Rule, 0)
+	for _, rule := range r.DomainRules {
+		if rule.Type == ruleType {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
@@ -42,6 +42,7 @@ type Service struct {
 	// schemaBySubjectVersion caches schema response by subject and version. Caching schemas
 	// by subjects is needed to lookup references in avro schemas.
 	schemaBySubjectVersion *cache.Cache[string, *SchemaVersionedResponse]
+	schemaByID             *cache.Cache[uint32, *SchemaResponse]
 	avroSchemaByID         *cache.Cache[uint32, avro.Schema]
 	jsonSchemaByID         *cache.Cache[uint32, *jsonschema.Schema]
 
@@ -65,6 +66,7 @@ func NewService(cfg config.Schema, logger *zap.Logger) (*Service, error) {
 		registryClient:         client,
 		avroSchemaByID:         cache.New[uint32, avro.Schema](cache.MaxAge(5*time.Minute), cache.MaxErrorAge(time.Second)),
 		schemaBySubjectVersion: cache.New[string, *SchemaVersionedResponse](cache.MaxAge(5*time.Minute), cache.MaxErrorAge(time.Second)),
+		schemaByID:             cache.New[uint32, *SchemaResponse](cache.MaxAge(5*time.Minute), cache.MaxErrorAge(time.Second)),
 		jsonSchemaByID:         cache.New[uint32, *jsonschema.Schema](cache.MaxAge(5*time.Minute), cache.MaxErrorAge(time.Second)),
 	}, nil
 }
@@ -378,6 +380,15 @@ func (s *Service) GetSchemaByID(ctx context.Context, id uint32) (*SchemaResponse
 	return s.registryClient.GetSchemaByID(ctx, id)
 }
 
+// GetCachedSchemaByID gets the schema by ID. Schema responses, including their
+// metadata and rule sets, are cached as they are immutable for a given ID.
+func (s *Service) GetCachedSchemaByID(ctx context.Context, id uint32) (*SchemaResponse, error) {
+	schemaRes, err, _ := s.schemaByID.Get(id, func() (*SchemaResponse, error) {
+		return s.registryClient.GetSchemaByID(ctx, id)
+	})
+	return schemaRes, err
+}
+
 // ParseAvroSchemaWithReferences parses an avro schema that potentially has references
 // to other schemas. References will be resolved by requesting and parsing them
 // recursively. If any of the referenced schemas can't be fetched or parsed an
@@ -0,0 +1,121 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var _ Serde = (*BSONSerde)(nil)
+
+// BSONSerde represents the serde for dealing with BSON types. BSON documents are presented
+// as relaxed MongoDB Extended JSON, so that types such as ObjectIDs and dates are retained.
+type BSONSerde struct{}
+
+// Name returns the name of the serde payload encoding.
+func (BSONSerde) Name() PayloadEncoding {
+	return PayloadEncodingBSON
+}
+
+// DeserializePayload deserializes the kafka record to our internal record payload representation.
+func (BSONSerde) DeserializePayload(_ context.Context, record *kgo.Record, payloadType PayloadType) (*RecordPayload, error) {
+	payload := payloadFromRecord(record, payloadType)
+
+	if !hasBSONDocumentFraming(payload) {
+		return &RecordPayload{}, errors.New("first bytes indicate this is not a BSON document")
+	}
+
+	doc := bson.Raw(payload)
+	if err := doc.Validate(); err != nil {
+		return &RecordPayload{}, fmt.Errorf("failed to validate BSON document: %w", err)
+	}
+
+	jsonBytes, err := bson.MarshalExtJSON(doc, false, false)
+	if err != nil {
+		return &RecordPayload{}, fmt.Errorf("failed to serialize BSON payload to json: %w", err)
+	}
+
+	var obj any
+	if err := json.Unmarshal(jsonBytes, &obj); err != nil {
+		return &RecordPayload{}, fmt.Errorf("failed to deserialize BSON payload: %w", err)
+	}
+
+	return &RecordPayload{
+		DeserializedPayload: obj,
+		NormalizedPayload:   jsonBytes,
+		Encoding:            PayloadEncodingBSON,
+	}, nil
+}
+
+// SerializeObject serializes data into binary format ready for writing to Kafka as a record.
+// JSON input may use MongoDB Extended JSON (e.g. {"$oid": "..."}) to specify BSON types.
+func (BSONSerde) SerializeObject(_ context.Context, obj any, _ PayloadType, _ ...SerdeOpt) ([]byte, error) {
+	switch v := obj.(type) {
+	case string:
+		return bsonFromJSON([]byte(v))
+	case []byte:
+		// Binary payloads that are BSON encoded already are written as is. This must be
+		// checked before JSON, because a BSON document of 123 bytes starts with '{' too.
+		if hasBSONDocumentFraming(v) && bson.Raw(v).Validate() == nil {
+			return v, nil
+		}
+
+		trimmed := bytes.TrimLeft(v, " \t\r\n")
+		if len(trimmed) == 0 || trimmed[0] != '{' {
+			return nil, fmt.Errorf("payload is neither JSON nor a valid BSON document: %w", bson.Raw(v).Validate())
+		}
+
+		return bsonFromJSON(trimmed)
+	default:
+		b, err := bson.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize BSON payload: %w", err)
+		}
+		return b, nil
+	}
+}
+
+// hasBSONDocumentFraming returns whether the payload starts with its total size as
+// int32 (little endian) and ends with a null byte, as every BSON document does.
+func hasBSONDocumentFraming(payload []byte) bool {
+	return len(payload) >= 5 && int(binary.LittleEndian.Uint32(payload)) == len(payload) && payload[len(payload)-1] == 0x00
+}
+
+func bsonFromJSON(payload []byte) ([]byte, error) {
+	trimmed := bytes.TrimLeft(payload, " \t\r\n")
+	if len(trimmed) == 0 {
+		return nil, errors.New("string payload is empty")
+	}
+
+	if trimmed[0] != '{' {
+		return nil, errors.New("first byte indicates this it not a valid JSON object, expected curly brackets")
+	}
+
+	// bson.D retains the order of the fields
+	var doc bson.D
+	if err := bson.UnmarshalExtJSON(trimmed, false, &doc); err != nil {
+		return nil, fmt.Errorf("failed to deserialize json to BSON payload: %w", err)
+	}
+
+	b, err := bson.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize BSON payload: %w", err)
+	}
+
+	return b, nil
+}
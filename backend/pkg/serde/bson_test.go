@@ -0,0 +1,170 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBSONSerde_DeserializePayload(t *testing.T) {
+	serde := BSONSerde{}
+
+	objectID, err := primitive.ObjectIDFromHex("65f1a2b3c4d5e6f708192a3b")
+	require.NoError(t, err)
+
+	bsonData, err := bson.Marshal(bson.D{
+		{Key: "_id", Value: objectID},
+		{Key: "name", Value: "order-1"},
+		{Key: "quantity", Value: int32(3)},
+		{Key: "createdAt", Value: primitive.NewDateTimeFromTime(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		record         *kgo.Record
+		payloadType    PayloadType
+		validationFunc func(t *testing.T, payload RecordPayload, err error)
+	}{
+		{
+			name: "Valid BSON document in value",
+			record: &kgo.Record{
+				Value: bsonData,
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, payload RecordPayload, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, payload.Troubleshooting)
+				assert.Nil(t, payload.SchemaID)
+				assert.Equal(t, PayloadEncodingBSON, payload.Encoding)
+
+				assert.Equal(t, `{"_id":{"$oid":"65f1a2b3c4d5e6f708192a3b"},"name":"order-1","quantity":3,"createdAt":{"$date":"2024-03-01T12:00:00Z"}}`, string(payload.NormalizedPayload))
+
+				obj, ok := (payload.DeserializedPayload).(map[string]any)
+				require.Truef(t, ok, "parsed payload is not of type map[string]any")
+				assert.Equal(t, "order-1", obj["name"])
+			},
+		},
+		{
+			name: "Text is not considered BSON",
+			record: &kgo.Record{
+				Value: []byte(`this is no valid BSON`),
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				require.Error(t, err)
+				assert.Equal(t, "first bytes indicate this is not a BSON document", err.Error())
+			},
+		},
+		{
+			name: "Corrupted BSON document",
+			record: &kgo.Record{
+				Value: []byte{0x0c, 0x00, 0x00, 0x00, 0x02, 'a', 0x00, 0xff, 0x00, 0x00, 0x00, 0x00},
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload, err := serde.DeserializePayload(context.Background(), test.record, test.payloadType)
+			test.validationFunc(t, *payload, err)
+		})
+	}
+}
+
+func TestBSONSerde_SerializeObject(t *testing.T) {
+	type Item struct {
+		Foo string `bson:"foo"`
+	}
+
+	serde := BSONSerde{}
+
+	t.Run("string extended json", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), `{"_id":{"$oid":"65f1a2b3c4d5e6f708192a3b"},"foo":"bar","count":3}`, PayloadTypeValue)
+		require.NoError(t, err)
+
+		var decoded bson.D
+		require.NoError(t, bson.Unmarshal(actual, &decoded))
+		objectID, err := primitive.ObjectIDFromHex("65f1a2b3c4d5e6f708192a3b")
+		require.NoError(t, err)
+		assert.Equal(t, bson.D{
+			{Key: "_id", Value: objectID},
+			{Key: "foo", Value: "bar"},
+			{Key: "count", Value: int32(3)},
+		}, decoded)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		input := `{"foo":"bar","nested":{"values":[1,2]}}`
+		actual, err := serde.SerializeObject(context.Background(), []byte(input), PayloadTypeValue)
+		require.NoError(t, err)
+
+		payload, err := serde.DeserializePayload(context.Background(), &kgo.Record{Value: actual}, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, input, string(payload.NormalizedPayload))
+	})
+
+	t.Run("struct type", func(t *testing.T) {
+		expected, err := bson.Marshal(Item{Foo: "bar"})
+		require.NoError(t, err)
+
+		actual, err := serde.SerializeObject(context.Background(), Item{Foo: "bar"}, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("binary bson input", func(t *testing.T) {
+		input, err := bson.Marshal(Item{Foo: "bar"})
+		require.NoError(t, err)
+
+		actual, err := serde.SerializeObject(context.Background(), input, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, input, actual)
+	})
+
+	t.Run("binary bson input starting with a curly bracket", func(t *testing.T) {
+		// The size of the document is 123 bytes, so that its first byte is '{'
+		input, err := bson.Marshal(Item{Foo: strings.Repeat("a", 108)})
+		require.NoError(t, err)
+		require.Len(t, input, 123)
+		require.Equal(t, byte('{'), input[0])
+
+		actual, err := serde.SerializeObject(context.Background(), input, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, input, actual)
+	})
+
+	t.Run("binary input that is neither json nor bson", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), []byte{0x01, 0x02, 0x03}, PayloadTypeValue)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "payload is neither JSON nor a valid BSON document")
+		require.Nil(t, actual)
+	})
+
+	t.Run("json array is not a document", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), `["foo"]`, PayloadTypeValue)
+		require.Error(t, err)
+		assert.Equal(t, "first byte indicates this it not a valid JSON object, expected curly brackets", err.Error())
+		require.Nil(t, actual)
+	})
+}
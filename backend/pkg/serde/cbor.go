@@ -0,0 +1,130 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+var _ Serde = (*CBORSerde)(nil)
+
+var (
+	// cborDecMode decodes CBOR maps into map[string]any, so that the
+	// deserialized payload can be serialized to JSON. Duplicate map keys and
+	// invalid UTF-8 strings are rejected to reduce false positives when
+	// detecting the encoding.
+	cborDecMode, _ = cbor.DecOptions{
+		DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+		DupMapKey:      cbor.DupMapKeyEnforcedAPF,
+		UTF8:           cbor.UTF8RejectInvalid,
+	}.DecMode()
+
+	// cborEncMode produces deterministic output with sorted map keys.
+	cborEncMode, _ = cbor.CoreDetEncOptions().EncMode()
+)
+
+// CBORSerde represents the serde for dealing with CBOR types.
+type CBORSerde struct{}
+
+// Name returns the name of the serde payload encoding.
+func (CBORSerde) Name() PayloadEncoding {
+	return PayloadEncodingCBOR
+}
+
+// DeserializePayload deserializes the kafka record to our internal record payload representation.
+func (CBORSerde) DeserializePayload(_ context.Context, record *kgo.Record, payloadType PayloadType) (*RecordPayload, error) {
+	payload := payloadFromRecord(record, payloadType)
+
+	if len(payload) == 0 {
+		return &RecordPayload{}, errors.New("payload is empty")
+	}
+
+	// Almost any byte sequence starts with a valid CBOR data item. To avoid false positives
+	// we only consider payloads whose top level item is an array, a map or which use the
+	// self-described CBOR tag (0xd9d9f7).
+	majorType := payload[0] >> 5
+	isSelfDescribed := bytes.HasPrefix(payload, []byte{0xd9, 0xd9, 0xf7})
+	if majorType != 4 && majorType != 5 && !isSelfDescribed {
+		return &RecordPayload{}, errors.New("first byte indicates this is not a CBOR array or map")
+	}
+	// A single byte is an empty array or map, which is indistinguishable from
+	// arbitrary binary data.
+	if len(payload) == 1 {
+		return &RecordPayload{}, errors.New("payload is too short to be CBOR")
+	}
+
+	// The payload is only considered CBOR if it consists of exactly one data item,
+	// that is decoded completely. Binary payloads often start with something that
+	// looks like a valid data item followed by more bytes.
+	var obj any
+	rest, err := cborDecMode.UnmarshalFirst(payload, &obj)
+	if err != nil {
+		return &RecordPayload{}, fmt.Errorf("failed to decode CBOR payload: %w", err)
+	}
+	if len(rest) > 0 {
+		return &RecordPayload{}, fmt.Errorf("failed to decode CBOR payload: %d trailing bytes after the first data item", len(rest))
+	}
+
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return &RecordPayload{}, fmt.Errorf("failed to serialize CBOR payload to json: %w", err)
+	}
+
+	return &RecordPayload{
+		DeserializedPayload: obj,
+		NormalizedPayload:   jsonBytes,
+		Encoding:            PayloadEncodingCBOR,
+	}, nil
+}
+
+// SerializeObject serializes data into binary format ready for writing to Kafka as a record.
+func (CBORSerde) SerializeObject(_ context.Context, obj any, _ PayloadType, _ ...SerdeOpt) ([]byte, error) {
+	var nativeObj any
+	switch v := obj.(type) {
+	case string:
+		native, err := jsonInputToNative([]byte(v))
+		if err != nil {
+			return nil, err
+		}
+		nativeObj = native
+	case []byte:
+		trimmed := bytes.TrimLeft(v, " \t\r\n")
+		if len(trimmed) == 0 || (trimmed[0] != '[' && trimmed[0] != '{') {
+			// Binary payloads are expected to be CBOR encoded already
+			if err := cbor.Wellformed(v); err != nil {
+				return nil, fmt.Errorf("payload is neither JSON nor valid CBOR: %w", err)
+			}
+			return v, nil
+		}
+
+		native, err := jsonInputToNative(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		nativeObj = native
+	default:
+		nativeObj = v
+	}
+
+	b, err := cborEncMode.Marshal(nativeObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize CBOR payload: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestCBORSerde_DeserializePayload(t *testing.T) {
+	serde := CBORSerde{}
+
+	cborData, err := cbor.Marshal(map[string]any{"deviceId": "sensor-1", "temperature": 21.5, "tags": []string{"a", "b"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		record         *kgo.Record
+		payloadType    PayloadType
+		validationFunc func(t *testing.T, payload RecordPayload, err error)
+	}{
+		{
+			name: "Valid CBOR Object in value",
+			record: &kgo.Record{
+				Value: cborData,
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, payload RecordPayload, err error) {
+				require.NoError(t, err)
+				assert.Nil(t, payload.Troubleshooting)
+				assert.Nil(t, payload.SchemaID)
+				assert.Equal(t, PayloadEncodingCBOR, payload.Encoding)
+
+				assert.JSONEq(t, `{"deviceId":"sensor-1","temperature":21.5,"tags":["a","b"]}`, string(payload.NormalizedPayload))
+
+				obj, ok := (payload.DeserializedPayload).(map[string]any)
+				require.Truef(t, ok, "parsed payload is not of type map[string]any")
+				assert.Equal(t, "sensor-1", obj["deviceId"])
+			},
+		},
+		{
+			name: "Valid CBOR Object in key",
+			record: &kgo.Record{
+				Key: cborData,
+			},
+			payloadType: PayloadTypeKey,
+			validationFunc: func(t *testing.T, payload RecordPayload, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, PayloadEncodingCBOR, payload.Encoding)
+			},
+		},
+		{
+			name: "Text is not considered CBOR",
+			record: &kgo.Record{
+				Value: []byte(`this is no valid CBOR`),
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				require.Error(t, err)
+				assert.Equal(t, "first byte indicates this is not a CBOR array or map", err.Error())
+			},
+		},
+		{
+			name: "Truncated CBOR",
+			record: &kgo.Record{
+				Value: cborData[:len(cborData)-2],
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "CBOR followed by trailing bytes",
+			record: &kgo.Record{
+				Value: append(append([]byte{}, cborData...), 0x00, 0x01, 0x02),
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "trailing bytes")
+			},
+		},
+		{
+			name: "Binary starting with an empty array",
+			record: &kgo.Record{
+				Value: []byte{0x80, 0x13, 0x37, 0xff, 0x00},
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Single byte",
+			record: &kgo.Record{
+				Value: []byte{0xa0},
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name: "Duplicate map keys",
+			record: &kgo.Record{
+				// {"a": 1, "a": 2}
+				Value: []byte{0xa2, 0x61, 'a', 0x01, 0x61, 'a', 0x02},
+			},
+			payloadType: PayloadTypeValue,
+			validationFunc: func(t *testing.T, _ RecordPayload, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			payload, err := serde.DeserializePayload(context.Background(), test.record, test.payloadType)
+			test.validationFunc(t, *payload, err)
+		})
+	}
+}
+
+func TestCBORSerde_SerializeObject(t *testing.T) {
+	type Item struct {
+		Foo string `cbor:"foo"`
+	}
+
+	serde := CBORSerde{}
+
+	t.Run("string json", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), `{"foo":"bar","count":3,"ratio":0.5}`, PayloadTypeValue)
+		require.NoError(t, err)
+
+		var decoded map[string]any
+		require.NoError(t, cbor.Unmarshal(actual, &decoded))
+		assert.Equal(t, map[string]any{"foo": "bar", "count": uint64(3), "ratio": 0.5}, decoded)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), []byte(`[{"foo":"bar"},-5]`), PayloadTypeValue)
+		require.NoError(t, err)
+
+		payload, err := serde.DeserializePayload(context.Background(), &kgo.Record{Value: actual}, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, `[{"foo":"bar"},-5]`, string(payload.NormalizedPayload))
+	})
+
+	t.Run("struct type", func(t *testing.T) {
+		expected, err := cbor.Marshal(Item{Foo: "bar"})
+		require.NoError(t, err)
+
+		actual, err := serde.SerializeObject(context.Background(), Item{Foo: "bar"}, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("binary cbor input", func(t *testing.T) {
+		input, err := cbor.Marshal([]int{1, 2, 3})
+		require.NoError(t, err)
+
+		actual, err := serde.SerializeObject(context.Background(), input, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, input, actual)
+	})
+
+	t.Run("string invalid json", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), `foo`, PayloadTypeValue)
+		require.Error(t, err)
+		assert.Equal(t, "first byte indicates this it not valid JSON, expected brackets", err.Error())
+		require.Nil(t, actual)
+	})
+}
@@ -0,0 +1,292 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/encryption"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// encryptionSerdeName is the name that is used in troubleshooting reports
+// for errors that occurred in the encryption stage.
+const encryptionSerdeName = "encryption"
+
+// encryptionStage applies the ENCRYPT and ENCRYPT_PAYLOAD rules of schema
+// registry rule sets. Payload rules encrypt the whole payload that follows
+// the wire format header, whereas field rules encrypt all fields that are
+// tagged with one of the rule's tags.
+type encryptionStage struct {
+	schemaSvc     *schema.Service
+	encryptionSvc *encryption.Service
+}
+
+// encryptionRules are the encryption rules that apply to a single schema.
+type encryptionRules struct {
+	payloadRules []schema.Rule
+	fieldRules   []fieldEncryptionRule
+}
+
+type fieldEncryptionRule struct {
+	kekName string
+	fields  *taggedFieldPaths
+}
+
+func (r *encryptionRules) isEmpty() bool {
+	return r == nil || (len(r.payloadRules) == 0 && len(r.fieldRules) == 0)
+}
+
+// rulesForSchema returns the encryption rules of the given schema that apply to
+// the requested direction.
+func (e *encryptionStage) rulesForSchema(ctx context.Context, schemaID uint32, forWrite bool) (*encryptionRules, error) {
+	schemaRes, err := e.schemaSvc.GetCachedSchemaByID(ctx, schemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema %d: %w", schemaID, err)
+	}
+
+	applies := func(rule schema.Rule) bool {
+		if forWrite {
+			return rule.AppliesToWrite()
+		}
+		return rule.AppliesToRead()
+	}
+
+	rules := &encryptionRules{}
+	for _, rule := range schemaRes.RuleSet.RulesByType(schema.RuleTypeEncryptPayload) {
+		if !applies(rule) {
+			continue
+		}
+		if rule.Params[schema.RuleParamKEKName] == "" {
+			return nil, fmt.Errorf("rule %q has no %q param", rule.Name, schema.RuleParamKEKName)
+		}
+		rules.payloadRules = append(rules.payloadRules, rule)
+	}
+
+	for _, rule := range schemaRes.RuleSet.RulesByType(schema.RuleTypeEncrypt) {
+		if !applies(rule) {
+			continue
+		}
+		kekName := rule.Params[schema.RuleParamKEKName]
+		if kekName == "" {
+			return nil, fmt.Errorf("rule %q has no %q param", rule.Name, schema.RuleParamKEKName)
+		}
+		fields := taggedFields(schemaRes, rule.Tags)
+		if fields.isEmpty() {
+			continue
+		}
+		rules.fieldRules = append(rules.fieldRules, fieldEncryptionRule{kekName: kekName, fields: fields})
+	}
+
+	return rules, nil
+}
+
+// decryptPayload decrypts the payload that follows the wire format header if the
+// schema has an ENCRYPT_PAYLOAD rule. It returns the record with the decrypted
+// payload alongside the rules that need to be applied after deserialization.
+// The returned record is a copy if the payload has been decrypted.
+func (e *encryptionStage) decryptPayload(ctx context.Context, record *kgo.Record, payloadType PayloadType) (*kgo.Record, *encryptionRules, error) {
+	payload := payloadFromRecord(record, payloadType)
+	schemaID, ok := wireFormatSchemaID(payload)
+	if !ok {
+		return record, nil, nil
+	}
+
+	// Failing to look up the schema is not an encryption error. The payload may
+	// not use the wire format at all, and the SerDes report lookup errors themselves.
+	rules, err := e.rulesForSchema(ctx, schemaID, false)
+	if err != nil || rules.isEmpty() {
+		return record, nil, nil //nolint:nilerr // see above
+	}
+
+	if len(rules.payloadRules) == 0 {
+		return record, rules, nil
+	}
+
+	body := payload[5:]
+	// Payload rules are applied in reverse order of how they were applied on write.
+	for i := len(rules.payloadRules) - 1; i >= 0; i-- {
+		rule := rules.payloadRules[i]
+		body, err = e.encryptionSvc.Decrypt(ctx, rule.Params[schema.RuleParamKEKName], body)
+		if err != nil {
+			return record, rules, fmt.Errorf("failed to apply rule %q: %w", rule.Name, err)
+		}
+	}
+
+	decrypted := make([]byte, 0, 5+len(body))
+	decrypted = append(decrypted, payload[:5]...)
+	decrypted = append(decrypted, body...)
+
+	recordCopy := *record
+	if payloadType == PayloadTypeValue {
+		recordCopy.Value = decrypted
+	} else {
+		recordCopy.Key = decrypted
+	}
+
+	return &recordCopy, rules, nil
+}
+
+// decryptFields decrypts all tagged fields of a deserialized payload in place.
+func (e *encryptionStage) decryptFields(ctx context.Context, rules *encryptionRules, rp *RecordPayload) error {
+	if rules == nil || len(rules.fieldRules) == 0 {
+		return nil
+	}
+
+	obj := rp.DeserializedPayload
+	for _, rule := range rules.fieldRules {
+		var err error
+		obj, err = rule.fields.transform(obj, nil, func(v any) (any, error) {
+			return e.decryptValue(ctx, rule.kekName, v)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	jsonBytes, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to serialize decrypted payload: %w", err)
+	}
+
+	rp.DeserializedPayload = obj
+	rp.NormalizedPayload = jsonBytes
+	return nil
+}
+
+// encryptFields encrypts all tagged fields of the given serialization input. The
+// input must be a JSON object, either as string, bytes or already unmarshalled.
+// The returned input has the same type as the given input.
+func (e *encryptionStage) encryptFields(ctx context.Context, rules *encryptionRules, input any) (any, error) {
+	if rules == nil || len(rules.fieldRules) == 0 {
+		return input, nil
+	}
+
+	var obj any
+	switch v := input.(type) {
+	case string, []byte:
+		raw, _ := v.([]byte)
+		if s, ok := v.(string); ok {
+			raw = []byte(s)
+		}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		if err := dec.Decode(&obj); err != nil {
+			return nil, fmt.Errorf("field level encryption requires a JSON payload: %w", err)
+		}
+	case map[string]any:
+		obj = v
+	default:
+		return nil, fmt.Errorf("field level encryption is not supported for payloads of type %T", input)
+	}
+
+	for _, rule := range rules.fieldRules {
+		var err error
+		obj, err = rule.fields.transform(obj, nil, func(v any) (any, error) {
+			return e.encryptValue(ctx, rule.kekName, v)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch input.(type) {
+	case string:
+		b, err := json.Marshal(obj)
+		return string(b), err
+	case []byte:
+		return json.Marshal(obj)
+	default:
+		return obj, nil
+	}
+}
+
+// encryptPayload encrypts the serialized payload following the wire format
+// header with all ENCRYPT_PAYLOAD rules.
+func (e *encryptionStage) encryptPayload(ctx context.Context, rules *encryptionRules, payload []byte) ([]byte, error) {
+	if rules == nil || len(rules.payloadRules) == 0 {
+		return payload, nil
+	}
+	if _, ok := wireFormatSchemaID(payload); !ok {
+		return nil, errors.New("payload encryption requires a payload in the schema registry wire format")
+	}
+
+	body := payload[5:]
+	for _, rule := range rules.payloadRules {
+		var err error
+		body, err = e.encryptionSvc.Encrypt(ctx, rule.Params[schema.RuleParamKEKName], body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply rule %q: %w", rule.Name, err)
+		}
+	}
+
+	encrypted := make([]byte, 0, 5+len(body))
+	encrypted = append(encrypted, payload[:5]...)
+	return append(encrypted, body...), nil
+}
+
+// decryptValue decrypts a single field value. String values are expected to
+// be base64 encoded envelopes.
+func (e *encryptionStage) decryptValue(ctx context.Context, kekName string, v any) (any, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return e.encryptionSvc.Decrypt(ctx, kekName, val)
+	case string:
+		envelope, err := base64.StdEncoding.DecodeString(val)
+		if err != nil {
+			return nil, fmt.Errorf("encrypted field is not base64 encoded: %w", err)
+		}
+		plaintext, err := e.encryptionSvc.Decrypt(ctx, kekName, envelope)
+		if err != nil {
+			return nil, err
+		}
+		return string(plaintext), nil
+	default:
+		return nil, fmt.Errorf("encrypted field must be of type string or bytes, but got %T", v)
+	}
+}
+
+// encryptValue encrypts a single field value. String values are encrypted
+// into base64 encoded envelopes.
+func (e *encryptionStage) encryptValue(ctx context.Context, kekName string, v any) (any, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return e.encryptionSvc.Encrypt(ctx, kekName, val)
+	case string:
+		envelope, err := e.encryptionSvc.Encrypt(ctx, kekName, []byte(val))
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(envelope), nil
+	default:
+		return nil, fmt.Errorf("encrypted field must be of type string or bytes, but got %T", v)
+	}
+}
+
+// wireFormatSchemaID returns the schema ID of a payload that uses the schema
+// registry wire format.
+func wireFormatSchemaID(payload []byte) (uint32, bool) {
+	if len(payload) <= 5 || payload[0] != byte(0) {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(payload[1:5]), true
+}
@@ -0,0 +1,418 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+// taggedFieldPaths are the paths of all fields that carry one of the tags of
+// an encryption rule. A path is the sequence of field names from the root of
+// the payload to the field. Array items share the path of the array, the
+// values of maps use the path segment "*". Patterns taken from the schema
+// metadata may contain the wildcards "*" (exactly one segment) and "**" (any
+// number of segments).
+type taggedFieldPaths struct {
+	patterns [][]string
+	// unionBranches are the type names of Avro union branches. Union values are
+	// wrapped into single entry maps keyed by the branch name, these maps are
+	// not part of the field path.
+	unionBranches map[string]struct{}
+}
+
+func (t *taggedFieldPaths) isEmpty() bool {
+	return t == nil || len(t.patterns) == 0
+}
+
+// matches returns true if the field at the given path is tagged.
+func (t *taggedFieldPaths) matches(path []string) bool {
+	for _, pattern := range t.patterns {
+		if matchFieldPath(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// unionValue returns the branch name and the value of an Avro union value.
+func (t *taggedFieldPaths) unionValue(m map[string]any) (string, any, bool) {
+	if len(m) != 1 {
+		return "", nil, false
+	}
+	for branch, val := range m {
+		if _, ok := t.unionBranches[branch]; ok {
+			return branch, val, true
+		}
+	}
+	return "", nil, false
+}
+
+// transform walks the given object and replaces the value of every tagged
+// field with the result of fn.
+func (t *taggedFieldPaths) transform(obj any, path []string, fn func(any) (any, error)) (any, error) {
+	switch v := obj.(type) {
+	case map[string]any:
+		if branch, val, ok := t.unionValue(v); ok {
+			transformed, err := t.transform(val, path, fn)
+			if err != nil {
+				return nil, err
+			}
+			v[branch] = transformed
+			return v, nil
+		}
+
+		for key, val := range v {
+			fieldPath := append(slices.Clip(path), key)
+			if !t.matches(fieldPath) {
+				transformed, err := t.transform(val, fieldPath, fn)
+				if err != nil {
+					return nil, err
+				}
+				v[key] = transformed
+				continue
+			}
+
+			if union, ok := val.(map[string]any); ok {
+				if branch, unionVal, ok := t.unionValue(union); ok {
+					transformed, err := fn(unionVal)
+					if err != nil {
+						return nil, fmt.Errorf("field %q: %w", strings.Join(fieldPath, "."), err)
+					}
+					union[branch] = transformed
+					continue
+				}
+			}
+
+			transformed, err := fn(val)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", strings.Join(fieldPath, "."), err)
+			}
+			v[key] = transformed
+		}
+		return v, nil
+	case []any:
+		for i, val := range v {
+			transformed, err := t.transform(val, path, fn)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = transformed
+		}
+		return v, nil
+	default:
+		return obj, nil
+	}
+}
+
+// matchFieldPath returns true if the path matches the pattern. The segment "*"
+// matches exactly one segment and "**" matches any number of segments.
+func matchFieldPath(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	switch pattern[0] {
+	case "**":
+		for i := 0; i <= len(path); i++ {
+			if matchFieldPath(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(path) > 0 && matchFieldPath(pattern[1:], path[1:])
+	default:
+		return len(path) > 0 && pattern[0] == path[0] && matchFieldPath(pattern[1:], path[1:])
+	}
+}
+
+// taggedFields returns the paths of all fields that carry at least one of the
+// given tags. Tags are read from the schema metadata (e.g. "**.ssn": ["PII"])
+// and from inline "confluent:tags" annotations in Avro and JSON schemas.
+// Metadata paths of Avro schemas may start with the name of a record, e.g.
+// "Customer.address.street", which is resolved to the paths the record is
+// used at.
+func taggedFields(schemaRes *schema.SchemaResponse, tags []string) *taggedFieldPaths {
+	hasTag := func(fieldTags []string) bool {
+		for _, tag := range fieldTags {
+			if slices.Contains(tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+
+	fields := &taggedFieldPaths{unionBranches: make(map[string]struct{})}
+	var recordPaths map[string][][]string
+
+	var parsed any
+	if schemaRes.Type != schema.TypeProtobuf && json.Unmarshal([]byte(schemaRes.Schema), &parsed) == nil {
+		switch schemaRes.Type {
+		case schema.TypeAvro:
+			walker := &avroFieldWalker{
+				hasTag:      hasTag,
+				fields:      fields,
+				named:       make(map[string]any),
+				visiting:    make(map[string]bool),
+				recordPaths: make(map[string][][]string),
+			}
+			walker.walk(parsed, "", nil)
+			recordPaths = walker.recordPaths
+		case schema.TypeJSON:
+			walker := &jsonSchemaFieldWalker{
+				hasTag:   hasTag,
+				fields:   fields,
+				root:     parsed,
+				visiting: make(map[string]bool),
+			}
+			walker.walk(parsed, nil)
+		}
+	}
+
+	if schemaRes.Metadata != nil {
+		for path, fieldTags := range schemaRes.Metadata.Tags {
+			if !hasTag(fieldTags) || path == "" {
+				continue
+			}
+			fields.patterns = append(fields.patterns, metadataFieldPatterns(path, recordPaths)...)
+		}
+	}
+
+	return fields
+}
+
+// metadataFieldPatterns converts a metadata tag path into patterns of field
+// paths. If the path starts with the name of a record, the remainder of the
+// path is relative to each path the record is used at.
+func metadataFieldPatterns(path string, recordPaths map[string][][]string) [][]string {
+	longestName := ""
+	for name := range recordPaths {
+		if strings.HasPrefix(path, name+".") && len(name) > len(longestName) {
+			longestName = name
+		}
+	}
+	if longestName == "" {
+		return [][]string{strings.Split(path, ".")}
+	}
+
+	suffix := strings.Split(path[len(longestName)+1:], ".")
+	patterns := make([][]string, 0, len(recordPaths[longestName]))
+	for _, prefix := range recordPaths[longestName] {
+		patterns = append(patterns, append(slices.Clone(prefix), suffix...))
+	}
+	return patterns
+}
+
+func inlineFieldTags(v any) []string {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	raw, _ := m["confluent:tags"].([]any)
+	tags := make([]string, 0, len(raw))
+	for _, t := range raw {
+		if s, ok := t.(string); ok {
+			tags = append(tags, s)
+		}
+	}
+	return tags
+}
+
+// avroFieldWalker collects the paths of tagged fields, the union branch names
+// and the paths of all records of an Avro schema.
+type avroFieldWalker struct {
+	hasTag func([]string) bool
+	fields *taggedFieldPaths
+	// named are the named types by their full name.
+	named map[string]any
+	// visiting guards against recursive records.
+	visiting map[string]bool
+	// recordPaths are the paths records are used at by their full and short name.
+	recordPaths map[string][][]string
+}
+
+func (w *avroFieldWalker) walk(node any, namespace string, path []string) {
+	switch n := node.(type) {
+	case string:
+		if named, ok := w.named[avroFullName(n, namespace)]; ok {
+			w.walk(named, namespace, path)
+		}
+	case []any:
+		for _, member := range n {
+			for _, branch := range w.branchNames(member, namespace) {
+				w.fields.unionBranches[branch] = struct{}{}
+			}
+			w.walk(member, namespace, path)
+		}
+	case map[string]any:
+		switch typ := n["type"]; typ {
+		case "record", "error":
+			name, _ := n["name"].(string)
+			if ns, ok := n["namespace"].(string); ok && !strings.Contains(name, ".") {
+				name = ns + "." + name
+			}
+			fullName := avroFullName(name, namespace)
+			w.named[fullName] = n
+			w.recordPaths[fullName] = append(w.recordPaths[fullName], slices.Clone(path))
+			if shortName := fullName[strings.LastIndex(fullName, ".")+1:]; shortName != fullName {
+				w.recordPaths[shortName] = append(w.recordPaths[shortName], slices.Clone(path))
+			}
+			if w.visiting[fullName] {
+				return
+			}
+			w.visiting[fullName] = true
+			defer delete(w.visiting, fullName)
+
+			recordNamespace := ""
+			if i := strings.LastIndex(fullName, "."); i >= 0 {
+				recordNamespace = fullName[:i]
+			}
+			fields, _ := n["fields"].([]any)
+			for _, f := range fields {
+				field, ok := f.(map[string]any)
+				if !ok {
+					continue
+				}
+				fieldName, _ := field["name"].(string)
+				fieldPath := append(slices.Clip(path), fieldName)
+				if w.hasTag(inlineFieldTags(field)) {
+					w.fields.patterns = append(w.fields.patterns, fieldPath)
+				}
+				w.walk(field["type"], recordNamespace, fieldPath)
+			}
+		case "enum", "fixed":
+			name, _ := n["name"].(string)
+			if ns, ok := n["namespace"].(string); ok && !strings.Contains(name, ".") {
+				name = ns + "." + name
+			}
+			w.named[avroFullName(name, namespace)] = n
+		case "array":
+			w.walk(n["items"], namespace, path)
+		case "map":
+			w.walk(n["values"], namespace, append(slices.Clip(path), "*"))
+		default:
+			w.walk(typ, namespace, path)
+		}
+	}
+}
+
+// branchNames returns the names a union branch is referred to by in union
+// values, which is the full name for named types and the type name otherwise.
+func (w *avroFieldWalker) branchNames(member any, namespace string) []string {
+	switch m := member.(type) {
+	case string:
+		fullName := avroFullName(m, namespace)
+		if _, ok := w.named[fullName]; ok {
+			return []string{fullName, m}
+		}
+		return []string{m}
+	case map[string]any:
+		typ, _ := m["type"].(string)
+		switch typ {
+		case "record", "error", "enum", "fixed":
+			name, _ := m["name"].(string)
+			if ns, ok := m["namespace"].(string); ok && !strings.Contains(name, ".") {
+				name = ns + "." + name
+			}
+			fullName := avroFullName(name, namespace)
+			return []string{fullName, fullName[strings.LastIndex(fullName, ".")+1:]}
+		case "":
+			return nil
+		default:
+			return []string{typ}
+		}
+	default:
+		return nil
+	}
+}
+
+// avroFullName returns the full name of a named Avro type.
+func avroFullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// jsonSchemaFieldWalker collects the paths of tagged properties of a JSON
+// schema. Local references ("#/...") are resolved.
+type jsonSchemaFieldWalker struct {
+	hasTag   func([]string) bool
+	fields   *taggedFieldPaths
+	root     any
+	visiting map[string]bool
+}
+
+func (w *jsonSchemaFieldWalker) walk(node any, path []string) {
+	n, ok := node.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if ref, ok := n["$ref"].(string); ok && strings.HasPrefix(ref, "#") && !w.visiting[ref] {
+		w.visiting[ref] = true
+		w.walk(w.resolve(ref), path)
+		delete(w.visiting, ref)
+	}
+
+	if props, ok := n["properties"].(map[string]any); ok {
+		for name, prop := range props {
+			propPath := append(slices.Clip(path), name)
+			if w.hasTag(inlineFieldTags(prop)) {
+				w.fields.patterns = append(w.fields.patterns, propPath)
+			}
+			w.walk(prop, propPath)
+		}
+	}
+	if patternProps, ok := n["patternProperties"].(map[string]any); ok {
+		for _, prop := range patternProps {
+			w.walk(prop, append(slices.Clip(path), "*"))
+		}
+	}
+	if additional, ok := n["additionalProperties"].(map[string]any); ok {
+		w.walk(additional, append(slices.Clip(path), "*"))
+	}
+
+	switch items := n["items"].(type) {
+	case map[string]any:
+		w.walk(items, path)
+	case []any:
+		for _, item := range items {
+			w.walk(item, path)
+		}
+	}
+	for _, keyword := range []string{"prefixItems", "allOf", "anyOf", "oneOf"} {
+		subSchemas, _ := n[keyword].([]any)
+		for _, subSchema := range subSchemas {
+			w.walk(subSchema, path)
+		}
+	}
+}
+
+// resolve returns the sub schema a local JSON pointer reference points to.
+func (w *jsonSchemaFieldWalker) resolve(ref string) any {
+	node := w.root
+	for _, token := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+		if token == "" {
+			continue
+		}
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil
+		}
+		node = m[token]
+	}
+	return node
+}
@@ -0,0 +1,286 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/encryption"
+	"github.com/redpanda-data/console/backend/pkg/schema"
+)
+
+func newEncryptionTestService(t *testing.T) *Service {
+	t.Helper()
+
+	customerSchema := `{
+		"type": "record",
+		"name": "Customer",
+		"fields": [
+			{"name": "id", "type": "int"},
+			{"name": "ssn", "type": "string", "confluent:tags": ["PII"]},
+			{"name": "email", "type": "string"}
+		]
+	}`
+	untaggedSchema := `{
+		"type": "record",
+		"name": "Customer",
+		"fields": [
+			{"name": "id", "type": "int"},
+			{"name": "ssn", "type": "string"},
+			{"name": "email", "type": "string"}
+		]
+	}`
+	encryptRule := schema.Rule{
+		Name:   "encrypt-pii",
+		Kind:   "TRANSFORM",
+		Mode:   schema.RuleModeWriteRead,
+		Type:   schema.RuleTypeEncrypt,
+		Tags:   []string{"PII"},
+		Params: map[string]string{schema.RuleParamKEKName: "test-kek"},
+	}
+	nestedSchema := `{
+		"type": "record",
+		"name": "Customer",
+		"namespace": "com.example",
+		"fields": [
+			{"name": "id", "type": "int"},
+			{"name": "ssn", "type": "string", "confluent:tags": ["PII"]},
+			{"name": "contact", "type": {
+				"type": "record",
+				"name": "Contact",
+				"fields": [
+					{"name": "ssn", "type": "string"},
+					{"name": "email", "type": "string"}
+				]
+			}}
+		]
+	}`
+	// Rules without a mode apply on write and on read
+	defaultModeRule := schema.Rule{
+		Name:   "encrypt-pii-default-mode",
+		Kind:   "TRANSFORM",
+		Type:   schema.RuleTypeEncrypt,
+		Tags:   []string{"PII"},
+		Params: map[string]string{schema.RuleParamKEKName: "test-kek"},
+	}
+	payloadRule := schema.Rule{
+		Name:   "encrypt-payload",
+		Kind:   "TRANSFORM",
+		Mode:   schema.RuleModeWriteRead,
+		Type:   schema.RuleTypeEncryptPayload,
+		Params: map[string]string{schema.RuleParamKEKName: "test-kek"},
+	}
+
+	schemasByID := map[string]schema.SchemaResponse{
+		// Field level encryption via inline tags
+		"1": {Schema: customerSchema, RuleSet: &schema.RuleSet{DomainRules: []schema.Rule{encryptRule}}},
+		// Field level encryption via metadata tags
+		"2": {
+			Schema:   untaggedSchema,
+			Metadata: &schema.Metadata{Tags: map[string][]string{"Customer.email": {"PII"}}},
+			RuleSet:  &schema.RuleSet{DomainRules: []schema.Rule{encryptRule}},
+		},
+		// Payload encryption
+		"3": {Schema: customerSchema, RuleSet: &schema.RuleSet{DomainRules: []schema.Rule{payloadRule}}},
+		// Nested field with the same name as a tagged field
+		"4": {Schema: nestedSchema, RuleSet: &schema.RuleSet{DomainRules: []schema.Rule{defaultModeRule}}},
+		// Metadata tags that refer to a nested record
+		"5": {
+			Schema:   nestedSchema,
+			Metadata: &schema.Metadata{Tags: map[string][]string{"com.example.Contact.email": {"PII"}}},
+			RuleSet:  &schema.RuleSet{DomainRules: []schema.Rule{defaultModeRule}},
+		},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/schemas/ids/"):]
+		res, ok := schemasByID[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(srv.Close)
+
+	schemaSvc, err := schema.NewService(config.Schema{Enabled: true, URLs: []string{srv.URL}}, zap.NewNop())
+	require.NoError(t, err)
+
+	kekProvider := encryption.NewLocalKEKProviderFromKeys(map[string][]byte{
+		"test-kek": bytes.Repeat([]byte{0x07}, 32),
+	})
+	encryptionSvc := encryption.NewServiceWithProvider(config.Encryption{DEKCacheTTL: time.Minute}, zap.NewNop(), kekProvider)
+
+	return NewService(schemaSvc, nil, nil, encryptionSvc)
+}
+
+func TestService_FieldLevelEncryption(t *testing.T) {
+	svc := newEncryptionTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		schemaID       uint32
+		encryptedField string
+		plainField     string
+	}{
+		{name: "inline tags", schemaID: 1, encryptedField: "123-45-6789", plainField: "jane@example.com"},
+		{name: "metadata tags", schemaID: 2, encryptedField: "jane@example.com", plainField: "123-45-6789"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := svc.SerializeRecord(ctx, SerializeInput{
+				Topic: "customers",
+				Key:   RecordPayloadInput{Encoding: PayloadEncodingNull},
+				Value: RecordPayloadInput{
+					Payload:  `{"id": 1, "ssn": "123-45-6789", "email": "jane@example.com"}`,
+					Encoding: PayloadEncodingAvro,
+					Options:  []SerdeOpt{WithSchemaID(test.schemaID)},
+				},
+			})
+			require.NoError(t, err)
+			assert.NotContains(t, string(out.Value.Payload), test.encryptedField)
+			assert.Contains(t, string(out.Value.Payload), test.plainField)
+
+			record := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "customers", Value: out.Value.Payload}, DeserializationOptions{})
+			require.Equal(t, PayloadEncodingAvro, record.Value.Encoding)
+			assert.Empty(t, record.Value.Troubleshooting)
+			assert.JSONEq(t,
+				`{"id": 1, "ssn": "123-45-6789", "email": "jane@example.com"}`,
+				string(record.Value.NormalizedPayload))
+		})
+	}
+}
+
+func TestService_NestedFieldLevelEncryption(t *testing.T) {
+	svc := newEncryptionTestService(t)
+	ctx := context.Background()
+
+	input := `{"id": 1, "ssn": "123-45-6789", "contact": {"ssn": "987-65-4321", "email": "jane@example.com"}}`
+
+	tests := []struct {
+		name            string
+		schemaID        uint32
+		encryptedFields []string
+		plainFields     []string
+	}{
+		{
+			name:            "inline tag on top level field",
+			schemaID:        4,
+			encryptedFields: []string{"123-45-6789"},
+			plainFields:     []string{"987-65-4321", "jane@example.com"},
+		},
+		{
+			name:            "metadata tag on nested record",
+			schemaID:        5,
+			encryptedFields: []string{"123-45-6789", "jane@example.com"},
+			plainFields:     []string{"987-65-4321"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out, err := svc.SerializeRecord(ctx, SerializeInput{
+				Topic: "customers",
+				Key:   RecordPayloadInput{Encoding: PayloadEncodingNull},
+				Value: RecordPayloadInput{
+					Payload:  input,
+					Encoding: PayloadEncodingAvro,
+					Options:  []SerdeOpt{WithSchemaID(test.schemaID)},
+				},
+			})
+			require.NoError(t, err)
+			for _, field := range test.encryptedFields {
+				assert.NotContains(t, string(out.Value.Payload), field)
+			}
+			for _, field := range test.plainFields {
+				assert.Contains(t, string(out.Value.Payload), field)
+			}
+
+			record := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "customers", Value: out.Value.Payload}, DeserializationOptions{})
+			require.Equal(t, PayloadEncodingAvro, record.Value.Encoding)
+			assert.Empty(t, record.Value.Troubleshooting)
+			assert.JSONEq(t, input, string(record.Value.NormalizedPayload))
+		})
+	}
+}
+
+func TestMatchFieldPath(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "ssn", path: "ssn", want: true},
+		{pattern: "ssn", path: "contact.ssn", want: false},
+		{pattern: "**.ssn", path: "ssn", want: true},
+		{pattern: "**.ssn", path: "contact.address.ssn", want: true},
+		{pattern: "*.ssn", path: "contact.ssn", want: true},
+		{pattern: "*.ssn", path: "ssn", want: false},
+		{pattern: "contact.**", path: "contact.address.street", want: true},
+		{pattern: "contact.email", path: "contact.ssn", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+"/"+test.path, func(t *testing.T) {
+			assert.Equal(t, test.want, matchFieldPath(strings.Split(test.pattern, "."), strings.Split(test.path, ".")))
+		})
+	}
+}
+
+func TestService_PayloadEncryption(t *testing.T) {
+	svc := newEncryptionTestService(t)
+	ctx := context.Background()
+
+	out, err := svc.SerializeRecord(ctx, SerializeInput{
+		Topic: "customers",
+		Key:   RecordPayloadInput{Encoding: PayloadEncodingNull},
+		Value: RecordPayloadInput{
+			Payload:  `{"id": 1, "ssn": "123-45-6789", "email": "jane@example.com"}`,
+			Encoding: PayloadEncodingAvro,
+			Options:  []SerdeOpt{WithSchemaID(3)},
+		},
+	})
+	require.NoError(t, err)
+
+	payload := out.Value.Payload
+	assert.Equal(t, []byte{0, 0, 0, 0, 3}, payload[:5])
+	assert.True(t, encryption.IsEnvelope(payload[5:]))
+	assert.NotContains(t, string(payload), "jane@example.com")
+
+	record := svc.DeserializeRecord(ctx, &kgo.Record{Topic: "customers", Value: payload}, DeserializationOptions{})
+	require.Equal(t, PayloadEncodingAvro, record.Value.Encoding)
+	assert.JSONEq(t,
+		`{"id": 1, "ssn": "123-45-6789", "email": "jane@example.com"}`,
+		string(record.Value.NormalizedPayload))
+
+	// Without the KEK the payload can't be decrypted and the reason is reported
+	noKeySvc := NewService(svc.encryption.schemaSvc, nil, nil, encryption.NewServiceWithProvider(
+		config.Encryption{}, zap.NewNop(), encryption.NewLocalKEKProviderFromKeys(nil)))
+	record = noKeySvc.DeserializeRecord(ctx, &kgo.Record{Topic: "customers", Value: payload}, DeserializationOptions{})
+	assert.NotEqual(t, PayloadEncodingAvro, record.Value.Encoding)
+	require.NotEmpty(t, record.Value.Troubleshooting)
+	assert.Equal(t, encryptionSerdeName, record.Value.Troubleshooting[0].SerdeName)
+}
@@ -10,10 +10,15 @@
 package serde
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sort"
 	"unicode/utf8"
 
 	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
 )
 
 // recordHeaders deserialize Kafka record headers.
@@ -40,3 +45,92 @@ func recordHeaders(record *kgo.Record) []RecordHeader {
 
 	return headers
 }
+
+// deserializeHeaders deserializes the Kafka record headers and decodes the values of
+// all headers that match one of the configured header rules.
+func (s *Service) deserializeHeaders(ctx context.Context, record *kgo.Record, opts *DeserializationOptions) []RecordHeader {
+	headers := recordHeaders(record)
+	if len(opts.HeaderRules) == 0 {
+		return headers
+	}
+
+	for i := range headers {
+		if headers[i].Value == nil {
+			continue
+		}
+
+		rule := matchingHeaderRule(opts.HeaderRules, record.Topic, headers[i].Key)
+		if rule == nil {
+			continue
+		}
+
+		headers[i].DecodedValue = s.deserializeHeaderValue(ctx, record.Topic, headers[i].Value, rule, opts)
+	}
+
+	return headers
+}
+
+// deserializeHeaderValue decodes a header value with the encoding of the given rule. If the
+// value can not be decoded, it is returned as binary payload along with the troubleshooting
+// information.
+func (s *Service) deserializeHeaderValue(ctx context.Context, topicName string, value []byte, rule *config.KafkaHeaderRule, opts *DeserializationOptions) *RecordPayload {
+	// Header values are processed like record values, so that we can use the same SerDes.
+	headerRecord := &kgo.Record{Topic: topicName, Value: value}
+
+	rp, err := s.deserializeHeaderPayload(ctx, headerRecord, rule)
+	var troubleshooting []TroubleshootingReport
+	if err != nil {
+		troubleshooting = append(troubleshooting, TroubleshootingReport{
+			SerdeName: rule.Encoding,
+			Message:   err.Error(),
+		})
+		rp, _ = BinarySerde{}.DeserializePayload(ctx, headerRecord, PayloadTypeValue)
+	}
+
+	rp.PayloadSizeBytes = len(value)
+
+	if !opts.IgnoreMaxSizeLimit && len(value) > opts.MaxPayloadSize {
+		rp.IsPayloadTooLarge = true
+		rp.NormalizedPayload = nil
+	}
+
+	if opts.Troubleshoot || err != nil {
+		rp.Troubleshooting = troubleshooting
+	}
+
+	return rp
+}
+
+func (s *Service) deserializeHeaderPayload(ctx context.Context, headerRecord *kgo.Record, rule *config.KafkaHeaderRule) (*RecordPayload, error) {
+	// Protobuf SerDes resolve the proto type via the topic mappings, which are defined
+	// for record keys and values only. Headers specify the proto type in the rule instead.
+	if PayloadEncoding(rule.Encoding) == PayloadEncodingProtobuf {
+		if s.protoSvc == nil {
+			return nil, errors.New("no protobuf file registry configured")
+		}
+		md, err := s.protoSvc.GetMessageDescriptorByType(rule.ProtoType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get message descriptor for header: %w", err)
+		}
+		return ProtobufSerde{ProtoSvc: s.protoSvc}.deserializeMessage(headerRecord.Value, md)
+	}
+
+	for _, serde := range s.SerDes {
+		if serde.Name() == PayloadEncoding(rule.Encoding) {
+			return serde.DeserializePayload(ctx, headerRecord, PayloadTypeValue)
+		}
+	}
+
+	return nil, fmt.Errorf("no serde registered for encoding %q", rule.Encoding)
+}
+
+// matchingHeaderRule returns the first rule that applies to the given header key.
+func matchingHeaderRule(rules []config.KafkaHeaderRule, topicName, headerKey string) *config.KafkaHeaderRule {
+	for i := range rules {
+		if rules[i].Matches(topicName, headerKey) {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,15 @@
 package serde
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/redpanda-data/console/backend/pkg/config"
 )
 
 func TestJsonSchemaSerde_recordHeaders(t *testing.T) {
@@ -163,3 +168,108 @@ func TestJsonSchemaSerde_recordHeaders(t *testing.T) {
 		})
 	}
 }
+
+func TestService_deserializeHeaders(t *testing.T) {
+	headerRule := func(topicName, key, encoding string) config.KafkaHeaderRule {
+		rule := config.KafkaHeaderRule{
+			Key:      config.Regexp{Regexp: regexp.MustCompile(key)},
+			Encoding: encoding,
+		}
+		if topicName != "" {
+			rule.TopicName = config.Regexp{Regexp: regexp.MustCompile(topicName)}
+		}
+		return rule
+	}
+
+	rules := []config.KafkaHeaderRule{
+		headerRule("", "^retry-count$", "uint"),
+		headerRule("^orders$", "^trace-.*$", "json"),
+		headerRule("", "^routing$", "avro"),
+	}
+
+	tests := []struct {
+		name   string
+		record *kgo.Record
+		test   func(t *testing.T, headers []RecordHeader)
+	}{
+		{
+			name: "big endian integer",
+			record: &kgo.Record{
+				Topic:   "orders",
+				Headers: []kgo.RecordHeader{{Key: "retry-count", Value: []byte{0x00, 0x00, 0x00, 0x2a}}},
+			},
+			test: func(t *testing.T, headers []RecordHeader) {
+				require.Len(t, headers, 1)
+				require.NotNil(t, headers[0].DecodedValue)
+				assert.Equal(t, PayloadEncodingUint, headers[0].DecodedValue.Encoding)
+				assert.Equal(t, uint32(42), headers[0].DecodedValue.DeserializedPayload)
+				assert.Equal(t, "42", string(headers[0].DecodedValue.NormalizedPayload))
+				assert.Equal(t, 4, headers[0].DecodedValue.PayloadSizeBytes)
+			},
+		},
+		{
+			name: "json for matching topic",
+			record: &kgo.Record{
+				Topic:   "orders",
+				Headers: []kgo.RecordHeader{{Key: "trace-context", Value: []byte(`{"traceId":"abc"}`)}},
+			},
+			test: func(t *testing.T, headers []RecordHeader) {
+				require.Len(t, headers, 1)
+				require.NotNil(t, headers[0].DecodedValue)
+				assert.Equal(t, PayloadEncodingJSON, headers[0].DecodedValue.Encoding)
+				assert.Equal(t, map[string]any{"traceId": "abc"}, headers[0].DecodedValue.DeserializedPayload)
+				assert.Empty(t, headers[0].DecodedValue.Troubleshooting)
+			},
+		},
+		{
+			name: "rule restricted to other topic",
+			record: &kgo.Record{
+				Topic:   "payments",
+				Headers: []kgo.RecordHeader{{Key: "trace-context", Value: []byte(`{"traceId":"abc"}`)}},
+			},
+			test: func(t *testing.T, headers []RecordHeader) {
+				require.Len(t, headers, 1)
+				assert.Nil(t, headers[0].DecodedValue)
+				assert.Equal(t, HeaderEncodingUTF8, headers[0].Encoding)
+			},
+		},
+		{
+			name: "failed decoding falls back to binary",
+			record: &kgo.Record{
+				Topic:   "orders",
+				Headers: []kgo.RecordHeader{{Key: "routing", Value: []byte{0x00, 0x00, 0x00, 0x00, 0x01, 0x02}}},
+			},
+			test: func(t *testing.T, headers []RecordHeader) {
+				require.Len(t, headers, 1)
+				require.NotNil(t, headers[0].DecodedValue)
+				assert.Equal(t, PayloadEncodingBinary, headers[0].DecodedValue.Encoding)
+				require.Len(t, headers[0].DecodedValue.Troubleshooting, 1)
+				assert.Equal(t, "avro", headers[0].DecodedValue.Troubleshooting[0].SerdeName)
+			},
+		},
+		{
+			name: "null value is not decoded",
+			record: &kgo.Record{
+				Topic:   "orders",
+				Headers: []kgo.RecordHeader{{Key: "retry-count"}},
+			},
+			test: func(t *testing.T, headers []RecordHeader) {
+				require.Len(t, headers, 1)
+				assert.Nil(t, headers[0].DecodedValue)
+			},
+		},
+	}
+
+	svc := NewService(nil, nil, nil, nil)
+	opts := &DeserializationOptions{
+		MaxPayloadSize: config.DefaultMaxDeserializationPayloadSize,
+		HeaderRules:    rules,
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			headers := svc.deserializeHeaders(context.Background(), test.record, opts)
+			test.test(t, headers)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/twmb/franz-go/pkg/kgo"
 )
@@ -102,3 +103,64 @@ func jsonDeserializePayload(payload []byte) (any, error) {
 
 	return obj, nil
 }
+
+// jsonInputToNative parses a JSON object or array that has been provided as input for
+// the serialization into a binary format.
+func jsonInputToNative(payload []byte) (any, error) {
+	trimmed := bytes.TrimLeft(payload, " \t\r\n")
+	if len(trimmed) == 0 {
+		return nil, errors.New("string payload is empty")
+	}
+
+	if trimmed[0] != '[' && trimmed[0] != '{' {
+		return nil, fmt.Errorf("first byte indicates this it not valid JSON, expected brackets")
+	}
+
+	native, err := jsonToNative(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON payload: %w", err)
+	}
+
+	return native, nil
+}
+
+// jsonToNative parses a JSON document into native Go types. In contrast to
+// json.Unmarshal, integral numbers are returned as int64 rather than float64,
+// so that binary formats such as Smile, CBOR or BSON can encode them as integers.
+func jsonToNative(payload []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+
+	var obj any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON document")
+	}
+
+	return convertJSONNumbers(obj), nil
+}
+
+func convertJSONNumbers(obj any) any {
+	switch v := obj.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, val := range v {
+			v[key] = convertJSONNumbers(val)
+		}
+		return v
+	case []any:
+		for i, val := range v {
+			v[i] = convertJSONNumbers(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
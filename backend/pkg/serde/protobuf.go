@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	v1proto "github.com/golang/protobuf/proto" //nolint:staticcheck // intentional import of old module
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/dynamic"
 	"github.com/twmb/franz-go/pkg/kgo"
 	v2proto "google.golang.org/protobuf/proto"
@@ -54,8 +55,13 @@ func (d ProtobufSerde) DeserializePayload(_ context.Context, record *kgo.Record,
 
 	payload := payloadFromRecord(record, payloadType)
 
+	return d.deserializeMessage(payload, messageDescriptor)
+}
+
+// deserializeMessage deserializes the protobuf encoded payload using the given message descriptor.
+func (d ProtobufSerde) deserializeMessage(payload []byte, messageDescriptor *desc.MessageDescriptor) (*RecordPayload, error) {
 	msg := dynamic.NewMessage(messageDescriptor)
-	err = msg.Unmarshal(payload)
+	err := msg.Unmarshal(payload)
 	if err != nil {
 		return &RecordPayload{}, fmt.Errorf("failed to unmarshal payload into protobuf message: %w", err)
 	}
@@ -93,6 +93,10 @@ type RecordHeader struct {
 
 	// Encoding is the encoding that has been recognized for the value.
 	Encoding HeaderEncoding `json:"encoding"`
+
+	// DecodedValue is the header value deserialized with the encoding of the
+	// matching header rule. It is nil if no header rule applies to this header.
+	DecodedValue *RecordPayload `json:"decodedValue,omitempty"`
 }
 
 // TroubleshootingReport contains troubleshooting information why a Serde has failed
@@ -16,6 +16,7 @@ import (
 	"github.com/twmb/franz-go/pkg/kgo"
 
 	"github.com/redpanda-data/console/backend/pkg/config"
+	"github.com/redpanda-data/console/backend/pkg/encryption"
 	"github.com/redpanda-data/console/backend/pkg/msgpack"
 	"github.com/redpanda-data/console/backend/pkg/proto"
 	"github.com/redpanda-data/console/backend/pkg/schema"
@@ -25,11 +26,28 @@ import (
 // a record.
 type Service struct {
 	SerDes []Serde
+
+	// encryption decrypts and encrypts payloads according to the schema
+	// registry rule sets. It is nil if encryption is not configured.
+	encryption *encryptionStage
+
+	// protoSvc is used to look up the proto types that are configured
+	// for record headers.
+	protoSvc *proto.Service
 }
 
-// NewService creates the new serde service.
-func NewService(schemaService *schema.Service, protoSvc *proto.Service, msgPackSvc *msgpack.Service) *Service {
+// NewService creates the new serde service. The encryption service is optional
+// and enables the decryption and encryption of payloads and fields based on the
+// encryption rules in the schema registry.
+func NewService(schemaService *schema.Service, protoSvc *proto.Service, msgPackSvc *msgpack.Service, encryptionSvc *encryption.Service) *Service {
+	var encStage *encryptionStage
+	if encryptionSvc != nil && schemaService != nil {
+		encStage = &encryptionStage{schemaSvc: schemaService, encryptionSvc: encryptionSvc}
+	}
+
 	return &Service{
+		encryption: encStage,
+		protoSvc:   protoSvc,
 		SerDes: []Serde{
 			NullSerde{},
 			JSONSerde{},
@@ -40,6 +58,8 @@ func NewService(schemaService *schema.Service, protoSvc *proto.Service, msgPackS
 			ProtobufSchemaSerde{ProtoSvc: protoSvc},
 			MsgPackSerde{MsgPackService: msgPackSvc},
 			SmileSerde{},
+			CBORSerde{},
+			BSONSerde{},
 			UTF8Serde{},
 			TextSerde{},
 			UintSerde{},
@@ -67,7 +87,7 @@ func (s *Service) DeserializeRecord(ctx context.Context, record *kgo.Record, opt
 	// 2. Deserialize key & value separately
 	key := s.deserializePayload(ctx, record, PayloadTypeKey, &opts)
 	val := s.deserializePayload(ctx, record, PayloadTypeValue, &opts)
-	headers := recordHeaders(record)
+	headers := s.deserializeHeaders(ctx, record, &opts)
 
 	return &Record{
 		Key:     key,
@@ -83,6 +103,22 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 
 	troubleshooting := make([]TroubleshootingReport, 0, len(s.SerDes))
 
+	// Payloads that are encrypted as a whole must be decrypted before we can
+	// try any of the SerDes. Field level encryption rules are applied afterwards.
+	var encRules *encryptionRules
+	encryptionFailed := false
+	if s.encryption != nil {
+		var err error
+		record, encRules, err = s.encryption.decryptPayload(ctx, record, payloadType)
+		if err != nil {
+			encryptionFailed = true
+			troubleshooting = append(troubleshooting, TroubleshootingReport{
+				SerdeName: encryptionSerdeName,
+				Message:   err.Error(),
+			})
+		}
+	}
+
 	serdeEncoding := opts.KeyEncoding
 	if payloadType == PayloadTypeValue {
 		serdeEncoding = opts.ValueEncoding
@@ -91,6 +127,13 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 	// When deserializing, clients can optionally specify the desired encoding
 	doSpecificEncoding := serdeEncoding != PayloadEncodingUnspecified && serdeEncoding != ""
 
+	// An encrypted payload that we failed to decrypt can only be presented as binary,
+	// other SerDes could otherwise misinterpret the ciphertext.
+	if encryptionFailed {
+		doSpecificEncoding = true
+		serdeEncoding = PayloadEncodingBinary
+	}
+
 	// Try all registered SerDes in the order they were registered
 	var rp *RecordPayload
 	for _, serde := range s.SerDes {
@@ -104,6 +147,13 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 		rp, err = serde.DeserializePayload(ctx, record, payloadType)
 		if err == nil {
 			// found the matching serde
+			if err := s.encryption.decryptFields(ctx, encRules, rp); err != nil {
+				encryptionFailed = true
+				troubleshooting = append(troubleshooting, TroubleshootingReport{
+					SerdeName: encryptionSerdeName,
+					Message:   err.Error(),
+				})
+			}
 			break
 		}
 
@@ -125,7 +175,7 @@ func (s *Service) deserializePayload(ctx context.Context, record *kgo.Record, pa
 		rp.NormalizedPayload = nil
 	}
 
-	if opts.Troubleshoot || rp.Encoding == PayloadEncodingBinary {
+	if opts.Troubleshoot || encryptionFailed || rp.Encoding == PayloadEncodingBinary {
 		rp.Troubleshooting = troubleshooting
 	}
 
@@ -163,6 +213,10 @@ type DeserializationOptions struct {
 
 	// IgnoreMaxSizeLimit can be used to force returning deserialized payloads even if too large.
 	IgnoreMaxSizeLimit bool
+
+	// HeaderRules define the encodings that shall be used to deserialize record
+	// header values. Header values without a matching rule are not deserialized.
+	HeaderRules []config.KafkaHeaderRule
 }
 
 // SerializeRecord will serialize the input.
@@ -189,7 +243,7 @@ func (s *Service) SerializeRecord(ctx context.Context, input SerializeInput) (*S
 		}
 
 		found = true
-		bytes, err = serde.SerializeObject(ctx, input.Key.Payload, PayloadTypeKey, input.Key.Options...)
+		bytes, err = s.serializeObject(ctx, serde, input.Key.Payload, PayloadTypeKey, input.Key.Options...)
 		if err != nil {
 			keyTS = append(keyTS, TroubleshootingReport{
 				SerdeName: string(serde.Name()),
@@ -227,7 +281,7 @@ func (s *Service) SerializeRecord(ctx context.Context, input SerializeInput) (*S
 		}
 
 		found = true
-		bytes, err = serde.SerializeObject(ctx, input.Value.Payload, PayloadTypeValue, input.Value.Options...)
+		bytes, err = s.serializeObject(ctx, serde, input.Value.Payload, PayloadTypeValue, input.Value.Options...)
 		if err != nil {
 			valueTS = append(valueTS, TroubleshootingReport{
 				SerdeName: string(serde.Name()),
@@ -250,6 +304,37 @@ func (s *Service) SerializeRecord(ctx context.Context, input SerializeInput) (*S
 	return &sr, err
 }
 
+// serializeObject serializes the object with the given serde. If encryption is
+// configured and the referenced schema has encryption rules, tagged fields are
+// encrypted before and the payload is encrypted after serialization.
+func (s *Service) serializeObject(ctx context.Context, serde Serde, obj any, payloadType PayloadType, opts ...SerdeOpt) ([]byte, error) {
+	so := serdeCfg{}
+	for _, o := range opts {
+		o.apply(&so)
+	}
+
+	if s.encryption == nil || so.schemaID == 0 {
+		return serde.SerializeObject(ctx, obj, payloadType, opts...)
+	}
+
+	rules, err := s.encryption.rulesForSchema(ctx, so.schemaID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err = s.encryption.encryptFields(ctx, rules, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt fields: %w", err)
+	}
+
+	b, err := serde.SerializeObject(ctx, obj, payloadType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.encryption.encryptPayload(ctx, rules, b)
+}
+
 func payloadFromRecord(record *kgo.Record, payloadType PayloadType) []byte {
 	if payloadType == PayloadTypeValue {
 		return record.Value
@@ -62,9 +62,38 @@ func (SmileSerde) DeserializePayload(_ context.Context, record *kgo.Record, payl
 }
 
 // SerializeObject serializes data into binary format ready for writing to Kafka as a record.
-// This is not supported for this type for now.
-func (SmileSerde) SerializeObject(_ context.Context, _ any, _ PayloadType, _ ...SerdeOpt) ([]byte, error) {
-	// go-smile does not support encoding yet
-	// https://github.com/zencoder/go-smile
-	return nil, errors.New("serializing for smile data format is not implemented")
+func (SmileSerde) SerializeObject(_ context.Context, obj any, _ PayloadType, _ ...SerdeOpt) ([]byte, error) {
+	var nativeObj any
+	switch v := obj.(type) {
+	case string:
+		native, err := jsonInputToNative([]byte(v))
+		if err != nil {
+			return nil, err
+		}
+		nativeObj = native
+	case []byte:
+		trimmed := bytes.TrimLeft(v, " \t\r\n")
+		if len(trimmed) == 0 || (trimmed[0] != '[' && trimmed[0] != '{') {
+			// Binary payloads are expected to be Smile encoded already
+			if !bytes.HasPrefix(v, smileHeader[:3]) {
+				return nil, errors.New("payload is neither JSON nor Smile encoded")
+			}
+			return v, nil
+		}
+
+		native, err := jsonInputToNative(trimmed)
+		if err != nil {
+			return nil, err
+		}
+		nativeObj = native
+	default:
+		nativeObj = v
+	}
+
+	b, err := encodeSmile(nativeObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize Smile payload: %w", err)
+	}
+
+	return b, nil
 }
@@ -0,0 +1,302 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.md
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0
+
+package serde
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"unicode/utf8"
+)
+
+// Smile tokens as defined in the format specification:
+// https://github.com/FasterXML/smile-format-specification
+const (
+	smileTokenEmptyString byte = 0x20
+	smileTokenNull        byte = 0x21
+	smileTokenFalse       byte = 0x22
+	smileTokenTrue        byte = 0x23
+	smileTokenInt32       byte = 0x24
+	smileTokenInt64       byte = 0x25
+	smileTokenBigInteger  byte = 0x26
+	smileTokenFloat64     byte = 0x29
+	smileTokenTinyASCII   byte = 0x40
+	smileTokenShortASCII  byte = 0x60
+	smileTokenTinyUTF8    byte = 0x80
+	smileTokenShortUTF8   byte = 0xA0
+	smileTokenSmallInt    byte = 0xC0
+	smileTokenLongASCII   byte = 0xE0
+	smileTokenLongUTF8    byte = 0xE4
+	smileTokenBinary7Bit  byte = 0xE8
+	smileTokenStartArray  byte = 0xF8
+	smileTokenEndArray    byte = 0xF9
+	smileTokenStartObject byte = 0xFA
+	smileTokenEndObject   byte = 0xFB
+	smileTokenStringEnd   byte = 0xFC
+
+	smileKeyTokenLongUTF8   byte = 0x34
+	smileKeyTokenShortASCII byte = 0x80
+	smileKeyTokenShortUTF8  byte = 0xC0
+)
+
+// smileHeader is the header that is written before the encoded value. The
+// fourth byte contains the version and feature flags. We neither use shared
+// property names nor shared string values, hence all flags are unset.
+var smileHeader = []byte{':', ')', '\n', 0x00}
+
+// smileEncoder encodes native Go values into the Smile binary JSON format.
+// go-smile, which we use for decoding, does not support encoding.
+type smileEncoder struct {
+	buf []byte
+}
+
+// encodeSmile encodes the given value including the Smile header.
+func encodeSmile(v any) ([]byte, error) {
+	enc := &smileEncoder{buf: append([]byte{}, smileHeader...)}
+	if err := enc.encodeValue(v); err != nil {
+		return nil, err
+	}
+	return enc.buf, nil
+}
+
+//nolint:cyclop // one case per supported type
+func (e *smileEncoder) encodeValue(v any) error {
+	switch val := v.(type) {
+	case nil:
+		e.buf = append(e.buf, smileTokenNull)
+	case bool:
+		if val {
+			e.buf = append(e.buf, smileTokenTrue)
+		} else {
+			e.buf = append(e.buf, smileTokenFalse)
+		}
+	case string:
+		e.encodeString(val)
+	case int:
+		e.encodeInt(int64(val))
+	case int8:
+		e.encodeInt(int64(val))
+	case int16:
+		e.encodeInt(int64(val))
+	case int32:
+		e.encodeInt(int64(val))
+	case int64:
+		e.encodeInt(val)
+	case uint:
+		e.encodeUint(uint64(val))
+	case uint8:
+		e.encodeInt(int64(val))
+	case uint16:
+		e.encodeInt(int64(val))
+	case uint32:
+		e.encodeInt(int64(val))
+	case uint64:
+		e.encodeUint(val)
+	case float32:
+		e.encodeFloat(float64(val))
+	case float64:
+		e.encodeFloat(val)
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			e.encodeInt(i)
+			return nil
+		}
+		f, err := val.Float64()
+		if err != nil {
+			return fmt.Errorf("failed to encode number %q: %w", val.String(), err)
+		}
+		e.encodeFloat(f)
+	case []byte:
+		e.buf = append(e.buf, smileTokenBinary7Bit)
+		e.buf = appendSmileVInt(e.buf, uint64(len(val)))
+		e.buf = appendSmile7BitEncoded(e.buf, val)
+	case []any:
+		e.buf = append(e.buf, smileTokenStartArray)
+		for _, item := range val {
+			if err := e.encodeValue(item); err != nil {
+				return err
+			}
+		}
+		e.buf = append(e.buf, smileTokenEndArray)
+	case map[string]any:
+		// Sort keys so that the output is deterministic, just like encoding/json does.
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		e.buf = append(e.buf, smileTokenStartObject)
+		for _, key := range keys {
+			e.encodeKey(key)
+			if err := e.encodeValue(val[key]); err != nil {
+				return err
+			}
+		}
+		e.buf = append(e.buf, smileTokenEndObject)
+	default:
+		// Other types (e.g. structs or typed maps) are converted using their
+		// JSON representation.
+		jsonBytes, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Errorf("failed to encode type %T: %w", val, err)
+		}
+		native, err := jsonToNative(jsonBytes)
+		if err != nil {
+			return fmt.Errorf("failed to encode type %T: %w", val, err)
+		}
+		return e.encodeValue(native)
+	}
+
+	return nil
+}
+
+func (e *smileEncoder) encodeInt(v int64) {
+	zigzag := uint64((v << 1) ^ (v >> 63))
+	switch {
+	case v >= -16 && v <= 15:
+		e.buf = append(e.buf, smileTokenSmallInt|byte(zigzag))
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		e.buf = append(e.buf, smileTokenInt32)
+		e.buf = appendSmileVInt(e.buf, zigzag)
+	default:
+		e.buf = append(e.buf, smileTokenInt64)
+		e.buf = appendSmileVInt(e.buf, zigzag)
+	}
+}
+
+func (e *smileEncoder) encodeUint(v uint64) {
+	if v <= math.MaxInt64 {
+		e.encodeInt(int64(v))
+		return
+	}
+
+	// Big integers are encoded as two's complement big-endian byte array. A leading
+	// zero byte is required because the most significant bit is set.
+	b := make([]byte, 9)
+	for i := 8; i > 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	e.buf = append(e.buf, smileTokenBigInteger)
+	e.buf = appendSmileVInt(e.buf, uint64(len(b)))
+	e.buf = appendSmile7BitEncoded(e.buf, b)
+}
+
+func (e *smileEncoder) encodeFloat(v float64) {
+	bits := math.Float64bits(v)
+	e.buf = append(e.buf, smileTokenFloat64)
+	// 64 bits are stored in ten 7-bit bytes, most significant bits first.
+	for i := 9; i >= 0; i-- {
+		e.buf = append(e.buf, byte(bits>>(7*uint(i)))&0x7F)
+	}
+}
+
+func (e *smileEncoder) encodeString(s string) {
+	n := len(s)
+	ascii := isASCII(s)
+
+	switch {
+	case n == 0:
+		e.buf = append(e.buf, smileTokenEmptyString)
+		return
+	case ascii && n <= 32:
+		e.buf = append(e.buf, smileTokenTinyASCII+byte(n-1))
+	case ascii && n <= 64:
+		e.buf = append(e.buf, smileTokenShortASCII+byte(n-33))
+	case !ascii && n <= 33:
+		e.buf = append(e.buf, smileTokenTinyUTF8+byte(n-2))
+	case !ascii && n <= 65:
+		e.buf = append(e.buf, smileTokenShortUTF8+byte(n-34))
+	case ascii:
+		e.buf = append(e.buf, smileTokenLongASCII)
+		e.buf = append(e.buf, s...)
+		e.buf = append(e.buf, smileTokenStringEnd)
+		return
+	default:
+		e.buf = append(e.buf, smileTokenLongUTF8)
+		e.buf = append(e.buf, s...)
+		e.buf = append(e.buf, smileTokenStringEnd)
+		return
+	}
+
+	e.buf = append(e.buf, s...)
+}
+
+func (e *smileEncoder) encodeKey(key string) {
+	n := len(key)
+	ascii := isASCII(key)
+
+	switch {
+	case n == 0:
+		e.buf = append(e.buf, smileTokenEmptyString)
+		return
+	case ascii && n <= 64:
+		e.buf = append(e.buf, smileKeyTokenShortASCII+byte(n-1))
+	case !ascii && n <= 57:
+		e.buf = append(e.buf, smileKeyTokenShortUTF8+byte(n-2))
+	default:
+		e.buf = append(e.buf, smileKeyTokenLongUTF8)
+		e.buf = append(e.buf, key...)
+		e.buf = append(e.buf, smileTokenStringEnd)
+		return
+	}
+
+	e.buf = append(e.buf, key...)
+}
+
+// appendSmileVInt appends an unsigned variable length integer. All bytes but the
+// last one carry 7 bits, the last byte has the most significant bit set and carries 6 bits.
+func appendSmileVInt(buf []byte, v uint64) []byte {
+	last := byte(v&0x3F) | 0x80
+	v >>= 6
+
+	var groups []byte
+	for v > 0 {
+		groups = append(groups, byte(v&0x7F))
+		v >>= 7
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		buf = append(buf, groups[i])
+	}
+
+	return append(buf, last)
+}
+
+// appendSmile7BitEncoded appends the data so that each byte carries 7 bits. The
+// remaining bits of the last byte are stored right-aligned.
+func appendSmile7BitEncoded(buf, data []byte) []byte {
+	var acc uint16
+	var bits uint
+	for _, b := range data {
+		acc = acc<<8 | uint16(b)
+		bits += 8
+		for bits >= 7 {
+			bits -= 7
+			buf = append(buf, byte(acc>>bits)&0x7F)
+		}
+		acc &= 1<<bits - 1
+	}
+	if bits > 0 {
+		buf = append(buf, byte(acc))
+	}
+
+	return buf
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -13,6 +13,7 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -75,3 +76,79 @@ func TestSmileSerde_DeserializePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestSmileSerde_SerializeObject(t *testing.T) {
+	type Item struct {
+		Foo string `json:"foo"`
+		Bar int    `json:"bar"`
+	}
+
+	serde := SmileSerde{}
+
+	roundTrip := func(t *testing.T, obj any) any {
+		t.Helper()
+
+		b, err := serde.SerializeObject(context.Background(), obj, PayloadTypeValue)
+		require.NoError(t, err)
+
+		payload, err := serde.DeserializePayload(context.Background(), &kgo.Record{Value: b}, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, PayloadEncodingSmile, payload.Encoding)
+
+		return payload.DeserializedPayload
+	}
+
+	t.Run("string json", func(t *testing.T) {
+		actual := roundTrip(t, `{"name":"sensor-1","active":true,"reading":21.5,"count":42,"offset":-1234567,"missing":null}`)
+		assert.Equal(t, map[string]any{
+			"name":    "sensor-1",
+			"active":  true,
+			"reading": 21.5,
+			"count":   42,
+			"offset":  -1234567,
+			"missing": nil,
+		}, actual)
+	})
+
+	t.Run("string json array", func(t *testing.T) {
+		actual := roundTrip(t, `["foo","bär",7]`)
+		assert.Equal(t, []any{"foo", "bär", 7}, actual)
+	})
+
+	t.Run("nested objects and long strings", func(t *testing.T) {
+		long := strings.Repeat("a", 100)
+		actual := roundTrip(t, map[string]any{
+			"nested": map[string]any{"values": []any{int64(1), int64(2)}},
+			"long":   long,
+			"empty":  "",
+			"big":    int64(1) << 40,
+		})
+		assert.Equal(t, map[string]any{
+			"nested": map[string]any{"values": []any{1, 2}},
+			"long":   long,
+			"empty":  "",
+			"big":    1 << 40,
+		}, actual)
+	})
+
+	t.Run("struct type", func(t *testing.T) {
+		actual := roundTrip(t, Item{Foo: "bar", Bar: 3})
+		assert.Equal(t, map[string]any{"foo": "bar", "bar": 3}, actual)
+	})
+
+	t.Run("binary smile input", func(t *testing.T) {
+		smileData, err := os.ReadFile("testdata/test2.smile")
+		require.NoError(t, err)
+
+		actual, err := serde.SerializeObject(context.Background(), smileData, PayloadTypeValue)
+		require.NoError(t, err)
+		assert.Equal(t, smileData, actual)
+	})
+
+	t.Run("string invalid json", func(t *testing.T) {
+		actual, err := serde.SerializeObject(context.Background(), `foo`, PayloadTypeValue)
+		require.Error(t, err)
+		assert.Equal(t, "first byte indicates this it not valid JSON, expected brackets", err.Error())
+		require.Nil(t, actual)
+	})
+}
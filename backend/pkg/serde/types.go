@@ -43,6 +43,10 @@ const (
 	PayloadEncodingSmile PayloadEncoding = "smile"
 	// PayloadEncodingUint is the enum of Uint types.
 	PayloadEncodingUint PayloadEncoding = "uint"
+	// PayloadEncodingCBOR is the enum of CBOR types.
+	PayloadEncodingCBOR PayloadEncoding = "cbor"
+	// PayloadEncodingBSON is the enum of BSON types.
+	PayloadEncodingBSON PayloadEncoding = "bson"
 )
 
 // HeaderEncoding is an enum for different header encoding types.
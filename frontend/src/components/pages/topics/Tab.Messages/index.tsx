@@ -134,6 +134,8 @@ const payloadEncodingPairs = [
     { value: PayloadEncoding.UTF8, label: 'UTF-8' },
     { value: PayloadEncoding.MESSAGE_PACK, label: 'Message Pack' },
     { value: PayloadEncoding.SMILE, label: 'Smile' },
+    { value: PayloadEncoding.CBOR, label: 'CBOR' },
+    { value: PayloadEncoding.BSON, label: 'BSON' },
     { value: PayloadEncoding.BINARY, label: 'Binary' },
     { value: PayloadEncoding.UINT, label: 'Unsigned Int' },
     { value: PayloadEncoding.CONSUMER_OFFSETS, label: 'Consumer Offsets' },
@@ -32,6 +32,10 @@ const encodingOptions: EncodingOption[] = [
     // specify an index that points to the type within the proto schema.
     // {value: PayloadEncoding.PROTOBUF, label: 'Protobuf', tooltip: 'The given JSON will be serialized using the selected schema'},
 
+    { value: PayloadEncoding.SMILE, label: 'Smile', tooltip: 'The given JSON will be serialized to Smile' },
+    { value: PayloadEncoding.CBOR, label: 'CBOR', tooltip: 'The given JSON will be serialized to CBOR' },
+    { value: PayloadEncoding.BSON, label: 'BSON', tooltip: 'The given JSON object will be serialized to BSON. MongoDB Extended JSON, such as {"$oid": "..."}, is supported' },
+
     { value: PayloadEncoding.BINARY, label: 'Binary (Base64)', tooltip: 'Message value is binary, represented as a base64 string in the editor' },
 ];
 
@@ -43,6 +47,9 @@ const protoBufInfoElement = <Text>
 function encodingToLanguage(encoding: PayloadEncoding) {
     if (encoding == PayloadEncoding.AVRO) return 'json';
     if (encoding == PayloadEncoding.JSON) return 'json';
+    if (encoding == PayloadEncoding.SMILE) return 'json';
+    if (encoding == PayloadEncoding.CBOR) return 'json';
+    if (encoding == PayloadEncoding.BSON) return 'json';
     if (encoding == PayloadEncoding.PROTOBUF) return 'protobuf';
     if (encoding == PayloadEncoding.BINARY) return 'plaintext';
     return undefined;
@@ -0,0 +1,75 @@
+// @generated by protoc-gen-connect-es v1.2.0 with parameter "target=ts,import_extension="
+// @generated from file redpanda/api/console/v1alpha1/approval.proto (package redpanda.api.console.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ApproveChangeRequestRequest, ApproveChangeRequestResponse, CreateChangeRequestRequest, CreateChangeRequestResponse, GetChangeRequestRequest, GetChangeRequestResponse, ListChangeRequestsRequest, ListChangeRequestsResponse, RejectChangeRequestRequest, RejectChangeRequestResponse } from "./approval_pb";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service redpanda.api.console.v1alpha1.ApprovalService
+ */
+export const ApprovalService = {
+  typeName: "redpanda.api.console.v1alpha1.ApprovalService",
+  methods: {
+    /**
+     * ListChangeRequests lists change requests. Users without the approveChanges
+     * permission only see their own change requests.
+     *
+     * @generated from rpc redpanda.api.console.v1alpha1.ApprovalService.ListChangeRequests
+     */
+    listChangeRequests: {
+      name: "ListChangeRequests",
+      I: ListChangeRequestsRequest,
+      O: ListChangeRequestsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetChangeRequest retrieves the specific change request.
+     *
+     * @generated from rpc redpanda.api.console.v1alpha1.ApprovalService.GetChangeRequest
+     */
+    getChangeRequest: {
+      name: "GetChangeRequest",
+      I: GetChangeRequestRequest,
+      O: GetChangeRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateChangeRequest requests an operation that requires an approval.
+     *
+     * @generated from rpc redpanda.api.console.v1alpha1.ApprovalService.CreateChangeRequest
+     */
+    createChangeRequest: {
+      name: "CreateChangeRequest",
+      I: CreateChangeRequestRequest,
+      O: CreateChangeRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ApproveChangeRequest approves a pending change request of another user and
+     * executes its operation with the permissions of the approver.
+     *
+     * @generated from rpc redpanda.api.console.v1alpha1.ApprovalService.ApproveChangeRequest
+     */
+    approveChangeRequest: {
+      name: "ApproveChangeRequest",
+      I: ApproveChangeRequestRequest,
+      O: ApproveChangeRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RejectChangeRequest rejects a pending change request. Requesters can
+     * reject their own change requests to withdraw them.
+     *
+     * @generated from rpc redpanda.api.console.v1alpha1.ApprovalService.RejectChangeRequest
+     */
+    rejectChangeRequest: {
+      name: "RejectChangeRequest",
+      I: RejectChangeRequestRequest,
+      O: RejectChangeRequestResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
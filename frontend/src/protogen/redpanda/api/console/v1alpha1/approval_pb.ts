@@ -0,0 +1,742 @@
+// @generated by protoc-gen-es v1.6.0 with parameter "target=ts,import_extension="
+// @generated from file redpanda/api/console/v1alpha1/approval.proto (package redpanda.api.console.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * ChangeRequestStatus is the state of a change request.
+ *
+ * @generated from enum redpanda.api.console.v1alpha1.ChangeRequestStatus
+ */
+export enum ChangeRequestStatus {
+  /**
+   * @generated from enum value: CHANGE_REQUEST_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The change request waits for an approval.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The change request has been approved and is being executed.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_APPROVED = 2;
+   */
+  APPROVED = 2,
+
+  /**
+   * The approved operation has been executed successfully.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_EXECUTED = 3;
+   */
+  EXECUTED = 3,
+
+  /**
+   * The execution of the approved operation failed.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+
+  /**
+   * The change request has been rejected or withdrawn.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_REJECTED = 5;
+   */
+  REJECTED = 5,
+
+  /**
+   * The change request has not been reviewed before its expiry.
+   *
+   * @generated from enum value: CHANGE_REQUEST_STATUS_EXPIRED = 6;
+   */
+  EXPIRED = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ChangeRequestStatus)
+proto3.util.setEnumType(ChangeRequestStatus, "redpanda.api.console.v1alpha1.ChangeRequestStatus", [
+  { no: 0, name: "CHANGE_REQUEST_STATUS_UNSPECIFIED" },
+  { no: 1, name: "CHANGE_REQUEST_STATUS_PENDING" },
+  { no: 2, name: "CHANGE_REQUEST_STATUS_APPROVED" },
+  { no: 3, name: "CHANGE_REQUEST_STATUS_EXECUTED" },
+  { no: 4, name: "CHANGE_REQUEST_STATUS_FAILED" },
+  { no: 5, name: "CHANGE_REQUEST_STATUS_REJECTED" },
+  { no: 6, name: "CHANGE_REQUEST_STATUS_EXPIRED" },
+]);
+
+/**
+ * ChangeRequestEvent is an entry in the history of a change request.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ChangeRequestEvent
+ */
+export class ChangeRequestEvent extends Message<ChangeRequestEvent> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * The user who performed the action, empty for system actions.
+   *
+   * @generated from field: string actor = 2;
+   */
+  actor = "";
+
+  /**
+   * The action, e.g. `requested`, `approved` or `executed`.
+   *
+   * @generated from field: string action = 3;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string comment = 4;
+   */
+  comment = "";
+
+  constructor(data?: PartialMessage<ChangeRequestEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ChangeRequestEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangeRequestEvent {
+    return new ChangeRequestEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangeRequestEvent {
+    return new ChangeRequestEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangeRequestEvent {
+    return new ChangeRequestEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangeRequestEvent | PlainMessage<ChangeRequestEvent> | undefined, b: ChangeRequestEvent | PlainMessage<ChangeRequestEvent> | undefined): boolean {
+    return proto3.util.equals(ChangeRequestEvent, a, b);
+  }
+}
+
+/**
+ * ChangeRequest is a dangerous operation that has to be approved by a second
+ * user before it is executed.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ChangeRequest
+ */
+export class ChangeRequest extends Message<ChangeRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The operation, e.g. `deleteTopic`.
+   *
+   * @generated from field: string operation = 2;
+   */
+  operation = "";
+
+  /**
+   * Names of the resources the operation is invoked on, e.g. {"topicName": "orders"}.
+   *
+   * @generated from field: map<string, string> target = 3;
+   */
+  target: { [key: string]: string } = {};
+
+  /**
+   * JSON encoded input the operation is executed with once approved.
+   *
+   * @generated from field: string payload = 4;
+   */
+  payload = "";
+
+  /**
+   * Justification of the requester.
+   *
+   * @generated from field: string comment = 5;
+   */
+  comment = "";
+
+  /**
+   * @generated from field: string requested_by = 6;
+   */
+  requestedBy = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp requested_at = 7;
+   */
+  requestedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequestStatus status = 9;
+   */
+  status = ChangeRequestStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: string reviewed_by = 10;
+   */
+  reviewedBy = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp reviewed_at = 11;
+   */
+  reviewedAt?: Timestamp;
+
+  /**
+   * @generated from field: string review_comment = 12;
+   */
+  reviewComment = "";
+
+  /**
+   * JSON encoded response of the executed operation.
+   *
+   * @generated from field: string result = 13;
+   */
+  result = "";
+
+  /**
+   * Error of the failed execution.
+   *
+   * @generated from field: string error = 14;
+   */
+  error = "";
+
+  /**
+   * Full history of the change request.
+   *
+   * @generated from field: repeated redpanda.api.console.v1alpha1.ChangeRequestEvent events = 15;
+   */
+  events: ChangeRequestEvent[] = [];
+
+  constructor(data?: PartialMessage<ChangeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ChangeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "operation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "target", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "requested_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "requested_at", kind: "message", T: Timestamp },
+    { no: 8, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 9, name: "status", kind: "enum", T: proto3.getEnumType(ChangeRequestStatus) },
+    { no: 10, name: "reviewed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "reviewed_at", kind: "message", T: Timestamp },
+    { no: 12, name: "review_comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "events", kind: "message", T: ChangeRequestEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangeRequest {
+    return new ChangeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangeRequest {
+    return new ChangeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangeRequest {
+    return new ChangeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangeRequest | PlainMessage<ChangeRequest> | undefined, b: ChangeRequest | PlainMessage<ChangeRequest> | undefined): boolean {
+    return proto3.util.equals(ChangeRequest, a, b);
+  }
+}
+
+/**
+ * ListChangeRequestsRequest is the request for ListChangeRequests.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ListChangeRequestsRequest
+ */
+export class ListChangeRequestsRequest extends Message<ListChangeRequestsRequest> {
+  /**
+   * Optional filter.
+   *
+   * @generated from field: optional redpanda.api.console.v1alpha1.ListChangeRequestsRequest.Filter filter = 1;
+   */
+  filter?: ListChangeRequestsRequest_Filter;
+
+  constructor(data?: PartialMessage<ListChangeRequestsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ListChangeRequestsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "filter", kind: "message", T: ListChangeRequestsRequest_Filter, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListChangeRequestsRequest {
+    return new ListChangeRequestsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListChangeRequestsRequest {
+    return new ListChangeRequestsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListChangeRequestsRequest {
+    return new ListChangeRequestsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListChangeRequestsRequest | PlainMessage<ListChangeRequestsRequest> | undefined, b: ListChangeRequestsRequest | PlainMessage<ListChangeRequestsRequest> | undefined): boolean {
+    return proto3.util.equals(ListChangeRequestsRequest, a, b);
+  }
+}
+
+/**
+ * Filter options.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ListChangeRequestsRequest.Filter
+ */
+export class ListChangeRequestsRequest_Filter extends Message<ListChangeRequestsRequest_Filter> {
+  /**
+   * Return only change requests with this status.
+   *
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequestStatus status = 1;
+   */
+  status = ChangeRequestStatus.UNSPECIFIED;
+
+  /**
+   * Return only change requests of this user.
+   *
+   * @generated from field: string requested_by = 2;
+   */
+  requestedBy = "";
+
+  constructor(data?: PartialMessage<ListChangeRequestsRequest_Filter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ListChangeRequestsRequest.Filter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(ChangeRequestStatus) },
+    { no: 2, name: "requested_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListChangeRequestsRequest_Filter {
+    return new ListChangeRequestsRequest_Filter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListChangeRequestsRequest_Filter {
+    return new ListChangeRequestsRequest_Filter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListChangeRequestsRequest_Filter {
+    return new ListChangeRequestsRequest_Filter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListChangeRequestsRequest_Filter | PlainMessage<ListChangeRequestsRequest_Filter> | undefined, b: ListChangeRequestsRequest_Filter | PlainMessage<ListChangeRequestsRequest_Filter> | undefined): boolean {
+    return proto3.util.equals(ListChangeRequestsRequest_Filter, a, b);
+  }
+}
+
+/**
+ * ListChangeRequestsResponse is the response for ListChangeRequests.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ListChangeRequestsResponse
+ */
+export class ListChangeRequestsResponse extends Message<ListChangeRequestsResponse> {
+  /**
+   * @generated from field: repeated redpanda.api.console.v1alpha1.ChangeRequest change_requests = 1;
+   */
+  changeRequests: ChangeRequest[] = [];
+
+  constructor(data?: PartialMessage<ListChangeRequestsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ListChangeRequestsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "change_requests", kind: "message", T: ChangeRequest, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListChangeRequestsResponse {
+    return new ListChangeRequestsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListChangeRequestsResponse {
+    return new ListChangeRequestsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListChangeRequestsResponse {
+    return new ListChangeRequestsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListChangeRequestsResponse | PlainMessage<ListChangeRequestsResponse> | undefined, b: ListChangeRequestsResponse | PlainMessage<ListChangeRequestsResponse> | undefined): boolean {
+    return proto3.util.equals(ListChangeRequestsResponse, a, b);
+  }
+}
+
+/**
+ * GetChangeRequestRequest is the request for GetChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.GetChangeRequestRequest
+ */
+export class GetChangeRequestRequest extends Message<GetChangeRequestRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetChangeRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.GetChangeRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChangeRequestRequest {
+    return new GetChangeRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChangeRequestRequest {
+    return new GetChangeRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChangeRequestRequest {
+    return new GetChangeRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChangeRequestRequest | PlainMessage<GetChangeRequestRequest> | undefined, b: GetChangeRequestRequest | PlainMessage<GetChangeRequestRequest> | undefined): boolean {
+    return proto3.util.equals(GetChangeRequestRequest, a, b);
+  }
+}
+
+/**
+ * GetChangeRequestResponse is the response for GetChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.GetChangeRequestResponse
+ */
+export class GetChangeRequestResponse extends Message<GetChangeRequestResponse> {
+  /**
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequest change_request = 1;
+   */
+  changeRequest?: ChangeRequest;
+
+  constructor(data?: PartialMessage<GetChangeRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.GetChangeRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "change_request", kind: "message", T: ChangeRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChangeRequestResponse {
+    return new GetChangeRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChangeRequestResponse {
+    return new GetChangeRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChangeRequestResponse {
+    return new GetChangeRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetChangeRequestResponse | PlainMessage<GetChangeRequestResponse> | undefined, b: GetChangeRequestResponse | PlainMessage<GetChangeRequestResponse> | undefined): boolean {
+    return proto3.util.equals(GetChangeRequestResponse, a, b);
+  }
+}
+
+/**
+ * CreateChangeRequestRequest is the request for CreateChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.CreateChangeRequestRequest
+ */
+export class CreateChangeRequestRequest extends Message<CreateChangeRequestRequest> {
+  /**
+   * The operation, e.g. `deleteTopic`.
+   *
+   * @generated from field: string operation = 1;
+   */
+  operation = "";
+
+  /**
+   * JSON encoded input of the operation, e.g. {"topicName": "orders"}.
+   *
+   * @generated from field: string payload = 2;
+   */
+  payload = "";
+
+  /**
+   * Justification of the requester.
+   *
+   * @generated from field: string comment = 3;
+   */
+  comment = "";
+
+  constructor(data?: PartialMessage<CreateChangeRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.CreateChangeRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChangeRequestRequest {
+    return new CreateChangeRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChangeRequestRequest {
+    return new CreateChangeRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChangeRequestRequest {
+    return new CreateChangeRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChangeRequestRequest | PlainMessage<CreateChangeRequestRequest> | undefined, b: CreateChangeRequestRequest | PlainMessage<CreateChangeRequestRequest> | undefined): boolean {
+    return proto3.util.equals(CreateChangeRequestRequest, a, b);
+  }
+}
+
+/**
+ * CreateChangeRequestResponse is the response for CreateChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.CreateChangeRequestResponse
+ */
+export class CreateChangeRequestResponse extends Message<CreateChangeRequestResponse> {
+  /**
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequest change_request = 1;
+   */
+  changeRequest?: ChangeRequest;
+
+  constructor(data?: PartialMessage<CreateChangeRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.CreateChangeRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "change_request", kind: "message", T: ChangeRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChangeRequestResponse {
+    return new CreateChangeRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChangeRequestResponse {
+    return new CreateChangeRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChangeRequestResponse {
+    return new CreateChangeRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChangeRequestResponse | PlainMessage<CreateChangeRequestResponse> | undefined, b: CreateChangeRequestResponse | PlainMessage<CreateChangeRequestResponse> | undefined): boolean {
+    return proto3.util.equals(CreateChangeRequestResponse, a, b);
+  }
+}
+
+/**
+ * ApproveChangeRequestRequest is the request for ApproveChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ApproveChangeRequestRequest
+ */
+export class ApproveChangeRequestRequest extends Message<ApproveChangeRequestRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string comment = 2;
+   */
+  comment = "";
+
+  constructor(data?: PartialMessage<ApproveChangeRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ApproveChangeRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveChangeRequestRequest {
+    return new ApproveChangeRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveChangeRequestRequest {
+    return new ApproveChangeRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveChangeRequestRequest {
+    return new ApproveChangeRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveChangeRequestRequest | PlainMessage<ApproveChangeRequestRequest> | undefined, b: ApproveChangeRequestRequest | PlainMessage<ApproveChangeRequestRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveChangeRequestRequest, a, b);
+  }
+}
+
+/**
+ * ApproveChangeRequestResponse is the response for ApproveChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.ApproveChangeRequestResponse
+ */
+export class ApproveChangeRequestResponse extends Message<ApproveChangeRequestResponse> {
+  /**
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequest change_request = 1;
+   */
+  changeRequest?: ChangeRequest;
+
+  constructor(data?: PartialMessage<ApproveChangeRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.ApproveChangeRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "change_request", kind: "message", T: ChangeRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveChangeRequestResponse {
+    return new ApproveChangeRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveChangeRequestResponse {
+    return new ApproveChangeRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveChangeRequestResponse {
+    return new ApproveChangeRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveChangeRequestResponse | PlainMessage<ApproveChangeRequestResponse> | undefined, b: ApproveChangeRequestResponse | PlainMessage<ApproveChangeRequestResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveChangeRequestResponse, a, b);
+  }
+}
+
+/**
+ * RejectChangeRequestRequest is the request for RejectChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.RejectChangeRequestRequest
+ */
+export class RejectChangeRequestRequest extends Message<RejectChangeRequestRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string comment = 2;
+   */
+  comment = "";
+
+  constructor(data?: PartialMessage<RejectChangeRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.RejectChangeRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "comment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectChangeRequestRequest {
+    return new RejectChangeRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectChangeRequestRequest {
+    return new RejectChangeRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectChangeRequestRequest {
+    return new RejectChangeRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectChangeRequestRequest | PlainMessage<RejectChangeRequestRequest> | undefined, b: RejectChangeRequestRequest | PlainMessage<RejectChangeRequestRequest> | undefined): boolean {
+    return proto3.util.equals(RejectChangeRequestRequest, a, b);
+  }
+}
+
+/**
+ * RejectChangeRequestResponse is the response for RejectChangeRequest.
+ *
+ * @generated from message redpanda.api.console.v1alpha1.RejectChangeRequestResponse
+ */
+export class RejectChangeRequestResponse extends Message<RejectChangeRequestResponse> {
+  /**
+   * @generated from field: redpanda.api.console.v1alpha1.ChangeRequest change_request = 1;
+   */
+  changeRequest?: ChangeRequest;
+
+  constructor(data?: PartialMessage<RejectChangeRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.console.v1alpha1.RejectChangeRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "change_request", kind: "message", T: ChangeRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectChangeRequestResponse {
+    return new RejectChangeRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectChangeRequestResponse {
+    return new RejectChangeRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectChangeRequestResponse {
+    return new RejectChangeRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectChangeRequestResponse | PlainMessage<RejectChangeRequestResponse> | undefined, b: RejectChangeRequestResponse | PlainMessage<RejectChangeRequestResponse> | undefined): boolean {
+    return proto3.util.equals(RejectChangeRequestResponse, a, b);
+  }
+}
+
@@ -128,6 +128,16 @@ export enum PayloadEncoding {
    * @generated from enum value: PAYLOAD_ENCODING_CONSUMER_OFFSETS = 14;
    */
   CONSUMER_OFFSETS = 14,
+
+  /**
+   * @generated from enum value: PAYLOAD_ENCODING_CBOR = 15;
+   */
+  CBOR = 15,
+
+  /**
+   * @generated from enum value: PAYLOAD_ENCODING_BSON = 16;
+   */
+  BSON = 16,
 }
 // Retrieve enum metadata with: proto3.getEnumType(PayloadEncoding)
 proto3.util.setEnumType(PayloadEncoding, "redpanda.api.console.v1alpha1.PayloadEncoding", [
@@ -146,6 +156,8 @@ proto3.util.setEnumType(PayloadEncoding, "redpanda.api.console.v1alpha1.PayloadE
   { no: 12, name: "PAYLOAD_ENCODING_BINARY" },
   { no: 13, name: "PAYLOAD_ENCODING_UINT" },
   { no: 14, name: "PAYLOAD_ENCODING_CONSUMER_OFFSETS" },
+  { no: 15, name: "PAYLOAD_ENCODING_CBOR" },
+  { no: 16, name: "PAYLOAD_ENCODING_BSON" },
 ]);
 
 /**
@@ -168,6 +180,34 @@ export class KafkaRecordHeader extends Message<KafkaRecordHeader> {
    */
   value = new Uint8Array(0);
 
+  /**
+   * Normalized user friendly representation of the header value, if a header rule applies.
+   *
+   * @generated from field: optional bytes decoded_value = 3;
+   */
+  decodedValue?: Uint8Array;
+
+  /**
+   * Encoding that has been used to decode the header value.
+   *
+   * @generated from field: redpanda.api.console.v1alpha1.PayloadEncoding decoded_encoding = 4;
+   */
+  decodedEncoding = PayloadEncoding.UNSPECIFIED;
+
+  /**
+   * Optionally, the schema ID used to decode the header value.
+   *
+   * @generated from field: optional int32 schema_id = 5;
+   */
+  schemaId?: number;
+
+  /**
+   * Troubleshooting information if the header value could not be decoded.
+   *
+   * @generated from field: repeated redpanda.api.console.v1alpha1.TroubleshootReport troubleshoot_report = 6;
+   */
+  troubleshootReport: TroubleshootReport[] = [];
+
   constructor(data?: PartialMessage<KafkaRecordHeader>) {
     super();
     proto3.util.initPartial(data, this);
@@ -178,6 +218,10 @@ export class KafkaRecordHeader extends Message<KafkaRecordHeader> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "decoded_value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 4, name: "decoded_encoding", kind: "enum", T: proto3.getEnumType(PayloadEncoding) },
+    { no: 5, name: "schema_id", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 6, name: "troubleshoot_report", kind: "message", T: TroubleshootReport, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaRecordHeader {
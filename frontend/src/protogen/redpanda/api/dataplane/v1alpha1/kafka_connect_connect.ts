@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateConnectorRequest, CreateConnectorResponse, DeleteConnectorRequest, GetConnectClusterRequest, GetConnectClusterResponse, GetConnectorConfigRequest, GetConnectorConfigResponse, GetConnectorRequest, GetConnectorResponse, GetConnectorStatusRequest, GetConnectorStatusResponse, ListConnectClustersRequest, ListConnectClustersResponse, ListConnectorsRequest, ListConnectorsResponse, ListConnectorTopicsRequest, ListConnectorTopicsResponse, PauseConnectorRequest, ResetConnectorTopicsRequest, RestartConnectorRequest, ResumeConnectorRequest, StopConnectorRequest, UpsertConnectorRequest, UpsertConnectorResponse } from "./kafka_connect_pb";
+import { AlterConnectorOffsetsRequest, AlterConnectorOffsetsResponse, CreateConnectorRequest, CreateConnectorResponse, DeleteConnectorRequest, GetConnectClusterRequest, GetConnectClusterResponse, GetConnectorConfigRequest, GetConnectorConfigResponse, GetConnectorRemediationRequest, GetConnectorRemediationResponse, GetConnectorRequest, GetConnectorResponse, GetConnectorStatusRequest, GetConnectorStatusResponse, ListConnectClustersRequest, ListConnectClustersResponse, ListConnectorOffsetsRequest, ListConnectorOffsetsResponse, ListConnectorsRequest, ListConnectorsResponse, ListConnectorTopicsRequest, ListConnectorTopicsResponse, ListConnectWorkersRequest, ListConnectWorkersResponse, PauseConnectorRequest, ResetConnectorOffsetsRequest, ResetConnectorOffsetsResponse, ResetConnectorTopicsRequest, RestartConnectorRequest, RestartFailedTasksRequest, RestartFailedTasksResponse, RestartWorkerTasksRequest, RestartWorkerTasksResponse, ResumeConnectorRequest, StopConnectorRequest, UpsertConnectorRequest, UpsertConnectorResponse } from "./kafka_connect_pb";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -200,6 +200,89 @@ export const KafkaConnectService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetConnectorRemediation returns the auto remediation policy of a connector,
+     * the state of its failed tasks and the history of restarts.
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.GetConnectorRemediation
+     */
+    getConnectorRemediation: {
+      name: "GetConnectorRemediation",
+      I: GetConnectorRemediationRequest,
+      O: GetConnectorRemediationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListConnectorOffsets implements the list connector offsets method, exposes
+     * a Kafka Connect equivalent REST endpoint (KIP-875).
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectorOffsets
+     */
+    listConnectorOffsets: {
+      name: "ListConnectorOffsets",
+      I: ListConnectorOffsetsRequest,
+      O: ListConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AlterConnectorOffsets implements the alter connector offsets method,
+     * exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.AlterConnectorOffsets
+     */
+    alterConnectorOffsets: {
+      name: "AlterConnectorOffsets",
+      I: AlterConnectorOffsetsRequest,
+      O: AlterConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResetConnectorOffsets implements the reset connector offsets method,
+     * exposes a Kafka Connect equivalent REST endpoint (KIP-875).
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ResetConnectorOffsets
+     */
+    resetConnectorOffsets: {
+      name: "ResetConnectorOffsets",
+      I: ResetConnectorOffsetsRequest,
+      O: ResetConnectorOffsetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListConnectWorkers lists the workers of a Kafka Connect cluster along with
+     * the connectors and tasks that are assigned to each of them.
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.ListConnectWorkers
+     */
+    listConnectWorkers: {
+      name: "ListConnectWorkers",
+      I: ListConnectWorkersRequest,
+      O: ListConnectWorkersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestartWorkerTasks restarts all tasks that are assigned to a worker.
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartWorkerTasks
+     */
+    restartWorkerTasks: {
+      name: "RestartWorkerTasks",
+      I: RestartWorkerTasksRequest,
+      O: RestartWorkerTasksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestartFailedTasks restarts all failed tasks of all connectors in a
+     * Kafka Connect cluster.
+     *
+     * @generated from rpc redpanda.api.dataplane.v1alpha1.KafkaConnectService.RestartFailedTasks
+     */
+    restartFailedTasks: {
+      name: "RestartFailedTasks",
+      I: RestartFailedTasksRequest,
+      O: RestartFailedTasksResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
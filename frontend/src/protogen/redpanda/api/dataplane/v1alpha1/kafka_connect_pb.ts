@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Duration, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * State of a connector or one of its tasks, as described in the [Kafka Connect documentation](https://kafka.apache.org/documentation.html#connect_administration). Takes into account not just the state of the connector instance itself, but also the tasks within the connector.
@@ -1760,3 +1760,1190 @@ export class ResetConnectorTopicsRequest extends Message<ResetConnectorTopicsReq
   }
 }
 
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectorRemediationRequest
+ */
+export class GetConnectorRemediationRequest extends Message<GetConnectorRemediationRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<GetConnectorRemediationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectorRemediationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectorRemediationRequest {
+    return new GetConnectorRemediationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectorRemediationRequest {
+    return new GetConnectorRemediationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectorRemediationRequest {
+    return new GetConnectorRemediationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectorRemediationRequest | PlainMessage<GetConnectorRemediationRequest> | undefined, b: GetConnectorRemediationRequest | PlainMessage<GetConnectorRemediationRequest> | undefined): boolean {
+    return proto3.util.equals(GetConnectorRemediationRequest, a, b);
+  }
+}
+
+/**
+ * ConnectorRemediationPolicy defines how failed tasks of a connector are restarted
+ * by the auto remediation supervisor.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorRemediationPolicy
+ */
+export class ConnectorRemediationPolicy extends Message<ConnectorRemediationPolicy> {
+  /**
+   * Whether failed tasks of this connector are restarted automatically.
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * Number of restarts for a failed task before the supervisor gives up.
+   *
+   * @generated from field: int32 max_attempts = 2;
+   */
+  maxAttempts = 0;
+
+  /**
+   * Wait time after the first restart attempt.
+   *
+   * @generated from field: google.protobuf.Duration base_interval = 3;
+   */
+  baseInterval?: Duration;
+
+  /**
+   * Maximum wait time between two restart attempts.
+   *
+   * @generated from field: google.protobuf.Duration max_interval = 4;
+   */
+  maxInterval?: Duration;
+
+  /**
+   * Factor by which the wait time increases with each attempt.
+   *
+   * @generated from field: double multiplier = 5;
+   */
+  multiplier = 0;
+
+  constructor(data?: PartialMessage<ConnectorRemediationPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorRemediationPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "max_attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "base_interval", kind: "message", T: Duration },
+    { no: 4, name: "max_interval", kind: "message", T: Duration },
+    { no: 5, name: "multiplier", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorRemediationPolicy {
+    return new ConnectorRemediationPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorRemediationPolicy {
+    return new ConnectorRemediationPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorRemediationPolicy {
+    return new ConnectorRemediationPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorRemediationPolicy | PlainMessage<ConnectorRemediationPolicy> | undefined, b: ConnectorRemediationPolicy | PlainMessage<ConnectorRemediationPolicy> | undefined): boolean {
+    return proto3.util.equals(ConnectorRemediationPolicy, a, b);
+  }
+}
+
+/**
+ * ConnectorRemediationTaskState is the remediation state of a task that has failed.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorRemediationTaskState
+ */
+export class ConnectorRemediationTaskState extends Message<ConnectorRemediationTaskState> {
+  /**
+   * The connector task ID.
+   *
+   * @generated from field: int32 task_id = 1;
+   */
+  taskId = 0;
+
+  /**
+   * Number of restarts since the task has failed.
+   *
+   * @generated from field: int32 attempts = 2;
+   */
+  attempts = 0;
+
+  /**
+   * Whether all restart attempts have been exhausted.
+   *
+   * @generated from field: bool gave_up = 3;
+   */
+  gaveUp = false;
+
+  /**
+   * Earliest time for the next restart, if the task is still failed.
+   *
+   * @generated from field: google.protobuf.Timestamp next_attempt_time = 4;
+   */
+  nextAttemptTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ConnectorRemediationTaskState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorRemediationTaskState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "task_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "gave_up", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "next_attempt_time", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorRemediationTaskState {
+    return new ConnectorRemediationTaskState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorRemediationTaskState {
+    return new ConnectorRemediationTaskState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorRemediationTaskState {
+    return new ConnectorRemediationTaskState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorRemediationTaskState | PlainMessage<ConnectorRemediationTaskState> | undefined, b: ConnectorRemediationTaskState | PlainMessage<ConnectorRemediationTaskState> | undefined): boolean {
+    return proto3.util.equals(ConnectorRemediationTaskState, a, b);
+  }
+}
+
+/**
+ * ConnectorRemediationEvent is an entry in the remediation history of a connector.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent
+ */
+export class ConnectorRemediationEvent extends Message<ConnectorRemediationEvent> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp;
+
+  /**
+   * The connector task ID.
+   *
+   * @generated from field: int32 task_id = 2;
+   */
+  taskId = 0;
+
+  /**
+   * ID of worker that the task was assigned to.
+   *
+   * @generated from field: string worker_id = 3;
+   */
+  workerId = "";
+
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent.Action action = 4;
+   */
+  action = ConnectorRemediationEvent_Action.UNSPECIFIED;
+
+  /**
+   * Restart attempt, starting at 1.
+   *
+   * @generated from field: int32 attempt = 5;
+   */
+  attempt = 0;
+
+  /**
+   * Stack trace of the failed task.
+   *
+   * @generated from field: string trace = 6;
+   */
+  trace = "";
+
+  /**
+   * Error of the restart request, if it has failed.
+   *
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ConnectorRemediationEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "message", T: Timestamp },
+    { no: 2, name: "task_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "enum", T: proto3.getEnumType(ConnectorRemediationEvent_Action) },
+    { no: 5, name: "attempt", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "trace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorRemediationEvent {
+    return new ConnectorRemediationEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorRemediationEvent {
+    return new ConnectorRemediationEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorRemediationEvent {
+    return new ConnectorRemediationEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorRemediationEvent | PlainMessage<ConnectorRemediationEvent> | undefined, b: ConnectorRemediationEvent | PlainMessage<ConnectorRemediationEvent> | undefined): boolean {
+    return proto3.util.equals(ConnectorRemediationEvent, a, b);
+  }
+}
+
+/**
+ * @generated from enum redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent.Action
+ */
+export enum ConnectorRemediationEvent_Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The failed task has been restarted.
+   *
+   * @generated from enum value: ACTION_RESTARTED = 1;
+   */
+  RESTARTED = 1,
+
+  /**
+   * The restart request for the failed task has failed.
+   *
+   * @generated from enum value: ACTION_RESTART_FAILED = 2;
+   */
+  RESTART_FAILED = 2,
+
+  /**
+   * All restart attempts have been exhausted.
+   *
+   * @generated from enum value: ACTION_GAVE_UP = 3;
+   */
+  GAVE_UP = 3,
+
+  /**
+   * The task has been running again, its attempts have been reset.
+   *
+   * @generated from enum value: ACTION_RECOVERED = 4;
+   */
+  RECOVERED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConnectorRemediationEvent_Action)
+proto3.util.setEnumType(ConnectorRemediationEvent_Action, "redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent.Action", [
+  { no: 0, name: "ACTION_UNSPECIFIED" },
+  { no: 1, name: "ACTION_RESTARTED" },
+  { no: 2, name: "ACTION_RESTART_FAILED" },
+  { no: 3, name: "ACTION_GAVE_UP" },
+  { no: 4, name: "ACTION_RECOVERED" },
+]);
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.GetConnectorRemediationResponse
+ */
+export class GetConnectorRemediationResponse extends Message<GetConnectorRemediationResponse> {
+  /**
+   * @generated from field: redpanda.api.dataplane.v1alpha1.ConnectorRemediationPolicy policy = 1;
+   */
+  policy?: ConnectorRemediationPolicy;
+
+  /**
+   * Remediation state of tasks that have failed.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorRemediationTaskState tasks = 2;
+   */
+  tasks: ConnectorRemediationTaskState[] = [];
+
+  /**
+   * Remediation events, newest first.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorRemediationEvent history = 3;
+   */
+  history: ConnectorRemediationEvent[] = [];
+
+  constructor(data?: PartialMessage<GetConnectorRemediationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.GetConnectorRemediationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: ConnectorRemediationPolicy },
+    { no: 2, name: "tasks", kind: "message", T: ConnectorRemediationTaskState, repeated: true },
+    { no: 3, name: "history", kind: "message", T: ConnectorRemediationEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConnectorRemediationResponse {
+    return new GetConnectorRemediationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConnectorRemediationResponse {
+    return new GetConnectorRemediationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConnectorRemediationResponse {
+    return new GetConnectorRemediationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConnectorRemediationResponse | PlainMessage<GetConnectorRemediationResponse> | undefined, b: GetConnectorRemediationResponse | PlainMessage<GetConnectorRemediationResponse> | undefined): boolean {
+    return proto3.util.equals(GetConnectorRemediationResponse, a, b);
+  }
+}
+
+/**
+ * ConnectorOffset is the offset of a single partition that a connector has
+ * consumed (sink connectors) or produced (source connectors) up to.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectorOffset
+ */
+export class ConnectorOffset extends Message<ConnectorOffset> {
+  /**
+   * Connector-defined partition. For sink connectors, the partition is
+   * `{"kafka_topic": "<topic>", "kafka_partition": <partition>}`.
+   *
+   * @generated from field: google.protobuf.Struct partition = 1;
+   */
+  partition?: Struct;
+
+  /**
+   * Connector-defined offset. For sink connectors, the offset is
+   * `{"kafka_offset": <offset>}`. When altering offsets, an unset offset
+   * resets the offset of the partition.
+   *
+   * @generated from field: google.protobuf.Struct offset = 2;
+   */
+  offset?: Struct;
+
+  constructor(data?: PartialMessage<ConnectorOffset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectorOffset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "partition", kind: "message", T: Struct },
+    { no: 2, name: "offset", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectorOffset {
+    return new ConnectorOffset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectorOffset | PlainMessage<ConnectorOffset> | undefined, b: ConnectorOffset | PlainMessage<ConnectorOffset> | undefined): boolean {
+    return proto3.util.equals(ConnectorOffset, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsRequest
+ */
+export class ListConnectorOffsetsRequest extends Message<ListConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ListConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorOffsetsRequest {
+    return new ListConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorOffsetsRequest | PlainMessage<ListConnectorOffsetsRequest> | undefined, b: ListConnectorOffsetsRequest | PlainMessage<ListConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsResponse
+ */
+export class ListConnectorOffsetsResponse extends Message<ListConnectorOffsetsResponse> {
+  /**
+   * Type of connector, either `source` or `sink`.
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorOffset offsets = 2;
+   */
+  offsets: ConnectorOffset[] = [];
+
+  constructor(data?: PartialMessage<ListConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "offsets", kind: "message", T: ConnectorOffset, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectorOffsetsResponse {
+    return new ListConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectorOffsetsResponse | PlainMessage<ListConnectorOffsetsResponse> | undefined, b: ListConnectorOffsetsResponse | PlainMessage<ListConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectorOffsetsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsRequest
+ */
+export class AlterConnectorOffsetsRequest extends Message<AlterConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Offsets to write. Partitions that are not listed keep their offsets.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectorOffset offsets = 3;
+   */
+  offsets: ConnectorOffset[] = [];
+
+  /**
+   * Stop the connector first if it is not stopped yet. Offsets can only be
+   * altered while the connector is stopped.
+   *
+   * @generated from field: bool stop_connector = 4;
+   */
+  stopConnector = false;
+
+  constructor(data?: PartialMessage<AlterConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "offsets", kind: "message", T: ConnectorOffset, repeated: true },
+    { no: 4, name: "stop_connector", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsRequest {
+    return new AlterConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlterConnectorOffsetsRequest | PlainMessage<AlterConnectorOffsetsRequest> | undefined, b: AlterConnectorOffsetsRequest | PlainMessage<AlterConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(AlterConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsResponse
+ */
+export class AlterConnectorOffsetsResponse extends Message<AlterConnectorOffsetsResponse> {
+  /**
+   * Message returned by Kafka Connect.
+   *
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<AlterConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.AlterConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlterConnectorOffsetsResponse {
+    return new AlterConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlterConnectorOffsetsResponse | PlainMessage<AlterConnectorOffsetsResponse> | undefined, b: AlterConnectorOffsetsResponse | PlainMessage<AlterConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(AlterConnectorOffsetsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsRequest
+ */
+export class ResetConnectorOffsetsRequest extends Message<ResetConnectorOffsetsRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * Name of connector.
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Stop the connector first if it is not stopped yet. Offsets can only be
+   * reset while the connector is stopped.
+   *
+   * @generated from field: bool stop_connector = 3;
+   */
+  stopConnector = false;
+
+  constructor(data?: PartialMessage<ResetConnectorOffsetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "stop_connector", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsRequest {
+    return new ResetConnectorOffsetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetConnectorOffsetsRequest | PlainMessage<ResetConnectorOffsetsRequest> | undefined, b: ResetConnectorOffsetsRequest | PlainMessage<ResetConnectorOffsetsRequest> | undefined): boolean {
+    return proto3.util.equals(ResetConnectorOffsetsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsResponse
+ */
+export class ResetConnectorOffsetsResponse extends Message<ResetConnectorOffsetsResponse> {
+  /**
+   * Message returned by Kafka Connect.
+   *
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ResetConnectorOffsetsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ResetConnectorOffsetsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResetConnectorOffsetsResponse {
+    return new ResetConnectorOffsetsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResetConnectorOffsetsResponse | PlainMessage<ResetConnectorOffsetsResponse> | undefined, b: ResetConnectorOffsetsResponse | PlainMessage<ResetConnectorOffsetsResponse> | undefined): boolean {
+    return proto3.util.equals(ResetConnectorOffsetsResponse, a, b);
+  }
+}
+
+/**
+ * ConnectWorkerTask is a connector task that is assigned to a worker.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectWorkerTask
+ */
+export class ConnectWorkerTask extends Message<ConnectWorkerTask> {
+  /**
+   * Name of the connector that the task belongs to.
+   *
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * The connector task ID.
+   *
+   * @generated from field: int32 task_id = 2;
+   */
+  taskId = 0;
+
+  /**
+   * State of the task, e.g. `RUNNING` or `FAILED`.
+   *
+   * @generated from field: string state = 3;
+   */
+  state = "";
+
+  constructor(data?: PartialMessage<ConnectWorkerTask>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectWorkerTask";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "task_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectWorkerTask {
+    return new ConnectWorkerTask().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectWorkerTask {
+    return new ConnectWorkerTask().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectWorkerTask {
+    return new ConnectWorkerTask().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectWorkerTask | PlainMessage<ConnectWorkerTask> | undefined, b: ConnectWorkerTask | PlainMessage<ConnectWorkerTask> | undefined): boolean {
+    return proto3.util.equals(ConnectWorkerTask, a, b);
+  }
+}
+
+/**
+ * ConnectWorkerConnector is a connector instance that is assigned to a worker.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectWorkerConnector
+ */
+export class ConnectWorkerConnector extends Message<ConnectWorkerConnector> {
+  /**
+   * Name of the connector.
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * State of the connector instance, e.g. `RUNNING` or `FAILED`.
+   *
+   * @generated from field: string state = 2;
+   */
+  state = "";
+
+  constructor(data?: PartialMessage<ConnectWorkerConnector>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectWorkerConnector";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectWorkerConnector {
+    return new ConnectWorkerConnector().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectWorkerConnector {
+    return new ConnectWorkerConnector().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectWorkerConnector {
+    return new ConnectWorkerConnector().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectWorkerConnector | PlainMessage<ConnectWorkerConnector> | undefined, b: ConnectWorkerConnector | PlainMessage<ConnectWorkerConnector> | undefined): boolean {
+    return proto3.util.equals(ConnectWorkerConnector, a, b);
+  }
+}
+
+/**
+ * ConnectWorker is a Kafka Connect worker along with the connectors and tasks
+ * that are assigned to it.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectWorker
+ */
+export class ConnectWorker extends Message<ConnectWorker> {
+  /**
+   * ID of the worker, usually its advertised host and port.
+   *
+   * @generated from field: string worker_id = 1;
+   */
+  workerId = "";
+
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorkerConnector connectors = 2;
+   */
+  connectors: ConnectWorkerConnector[] = [];
+
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorkerTask tasks = 3;
+   */
+  tasks: ConnectWorkerTask[] = [];
+
+  /**
+   * Number of tasks in state `RUNNING`.
+   *
+   * @generated from field: int32 running_tasks = 4;
+   */
+  runningTasks = 0;
+
+  /**
+   * Number of tasks in state `FAILED`.
+   *
+   * @generated from field: int32 failed_tasks = 5;
+   */
+  failedTasks = 0;
+
+  constructor(data?: PartialMessage<ConnectWorker>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectWorker";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connectors", kind: "message", T: ConnectWorkerConnector, repeated: true },
+    { no: 3, name: "tasks", kind: "message", T: ConnectWorkerTask, repeated: true },
+    { no: 4, name: "running_tasks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "failed_tasks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectWorker {
+    return new ConnectWorker().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectWorker {
+    return new ConnectWorker().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectWorker {
+    return new ConnectWorker().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectWorker | PlainMessage<ConnectWorker> | undefined, b: ConnectWorker | PlainMessage<ConnectWorker> | undefined): boolean {
+    return proto3.util.equals(ConnectWorker, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectWorkersRequest
+ */
+export class ListConnectWorkersRequest extends Message<ListConnectWorkersRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  constructor(data?: PartialMessage<ListConnectWorkersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectWorkersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectWorkersRequest {
+    return new ListConnectWorkersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectWorkersRequest {
+    return new ListConnectWorkersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectWorkersRequest {
+    return new ListConnectWorkersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectWorkersRequest | PlainMessage<ListConnectWorkersRequest> | undefined, b: ListConnectWorkersRequest | PlainMessage<ListConnectWorkersRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectWorkersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.ListConnectWorkersResponse
+ */
+export class ListConnectWorkersResponse extends Message<ListConnectWorkersResponse> {
+  /**
+   * Workers that have at least one connector or task assigned, sorted by ID.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorker workers = 1;
+   */
+  workers: ConnectWorker[] = [];
+
+  /**
+   * Tasks that are not assigned to any worker.
+   *
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorkerTask unassigned_tasks = 2;
+   */
+  unassignedTasks: ConnectWorkerTask[] = [];
+
+  /**
+   * Ratio of the task count of the busiest worker to the average task count
+   * per worker. 1 means that tasks are evenly spread, 0 that there are no tasks.
+   *
+   * @generated from field: double task_skew = 3;
+   */
+  taskSkew = 0;
+
+  constructor(data?: PartialMessage<ListConnectWorkersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ListConnectWorkersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workers", kind: "message", T: ConnectWorker, repeated: true },
+    { no: 2, name: "unassigned_tasks", kind: "message", T: ConnectWorkerTask, repeated: true },
+    { no: 3, name: "task_skew", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectWorkersResponse {
+    return new ListConnectWorkersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectWorkersResponse {
+    return new ListConnectWorkersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectWorkersResponse {
+    return new ListConnectWorkersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectWorkersResponse | PlainMessage<ListConnectWorkersResponse> | undefined, b: ListConnectWorkersResponse | PlainMessage<ListConnectWorkersResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectWorkersResponse, a, b);
+  }
+}
+
+/**
+ * ConnectWorkerTaskRestart is the result of restarting a single task.
+ *
+ * @generated from message redpanda.api.dataplane.v1alpha1.ConnectWorkerTaskRestart
+ */
+export class ConnectWorkerTaskRestart extends Message<ConnectWorkerTaskRestart> {
+  /**
+   * Name of the connector that the task belongs to.
+   *
+   * @generated from field: string connector = 1;
+   */
+  connector = "";
+
+  /**
+   * The connector task ID.
+   *
+   * @generated from field: int32 task_id = 2;
+   */
+  taskId = 0;
+
+  /**
+   * Error of the restart request, if it has failed.
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ConnectWorkerTaskRestart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.ConnectWorkerTaskRestart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "task_id", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectWorkerTaskRestart {
+    return new ConnectWorkerTaskRestart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectWorkerTaskRestart {
+    return new ConnectWorkerTaskRestart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectWorkerTaskRestart {
+    return new ConnectWorkerTaskRestart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectWorkerTaskRestart | PlainMessage<ConnectWorkerTaskRestart> | undefined, b: ConnectWorkerTaskRestart | PlainMessage<ConnectWorkerTaskRestart> | undefined): boolean {
+    return proto3.util.equals(ConnectWorkerTaskRestart, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RestartWorkerTasksRequest
+ */
+export class RestartWorkerTasksRequest extends Message<RestartWorkerTasksRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  /**
+   * ID of the worker whose tasks shall be restarted.
+   *
+   * @generated from field: string worker_id = 2;
+   */
+  workerId = "";
+
+  constructor(data?: PartialMessage<RestartWorkerTasksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RestartWorkerTasksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "worker_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartWorkerTasksRequest {
+    return new RestartWorkerTasksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartWorkerTasksRequest {
+    return new RestartWorkerTasksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartWorkerTasksRequest {
+    return new RestartWorkerTasksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartWorkerTasksRequest | PlainMessage<RestartWorkerTasksRequest> | undefined, b: RestartWorkerTasksRequest | PlainMessage<RestartWorkerTasksRequest> | undefined): boolean {
+    return proto3.util.equals(RestartWorkerTasksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RestartWorkerTasksResponse
+ */
+export class RestartWorkerTasksResponse extends Message<RestartWorkerTasksResponse> {
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorkerTaskRestart restarts = 1;
+   */
+  restarts: ConnectWorkerTaskRestart[] = [];
+
+  constructor(data?: PartialMessage<RestartWorkerTasksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RestartWorkerTasksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "restarts", kind: "message", T: ConnectWorkerTaskRestart, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartWorkerTasksResponse {
+    return new RestartWorkerTasksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartWorkerTasksResponse {
+    return new RestartWorkerTasksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartWorkerTasksResponse {
+    return new RestartWorkerTasksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartWorkerTasksResponse | PlainMessage<RestartWorkerTasksResponse> | undefined, b: RestartWorkerTasksResponse | PlainMessage<RestartWorkerTasksResponse> | undefined): boolean {
+    return proto3.util.equals(RestartWorkerTasksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RestartFailedTasksRequest
+ */
+export class RestartFailedTasksRequest extends Message<RestartFailedTasksRequest> {
+  /**
+   * @generated from field: string cluster_name = 1;
+   */
+  clusterName = "";
+
+  constructor(data?: PartialMessage<RestartFailedTasksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RestartFailedTasksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartFailedTasksRequest {
+    return new RestartFailedTasksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartFailedTasksRequest {
+    return new RestartFailedTasksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartFailedTasksRequest {
+    return new RestartFailedTasksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartFailedTasksRequest | PlainMessage<RestartFailedTasksRequest> | undefined, b: RestartFailedTasksRequest | PlainMessage<RestartFailedTasksRequest> | undefined): boolean {
+    return proto3.util.equals(RestartFailedTasksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message redpanda.api.dataplane.v1alpha1.RestartFailedTasksResponse
+ */
+export class RestartFailedTasksResponse extends Message<RestartFailedTasksResponse> {
+  /**
+   * @generated from field: repeated redpanda.api.dataplane.v1alpha1.ConnectWorkerTaskRestart restarts = 1;
+   */
+  restarts: ConnectWorkerTaskRestart[] = [];
+
+  constructor(data?: PartialMessage<RestartFailedTasksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "redpanda.api.dataplane.v1alpha1.RestartFailedTasksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "restarts", kind: "message", T: ConnectWorkerTaskRestart, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestartFailedTasksResponse {
+    return new RestartFailedTasksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestartFailedTasksResponse {
+    return new RestartFailedTasksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestartFailedTasksResponse {
+    return new RestartFailedTasksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestartFailedTasksResponse | PlainMessage<RestartFailedTasksResponse> | undefined, b: RestartFailedTasksResponse | PlainMessage<RestartFailedTasksResponse> | undefined): boolean {
+    return proto3.util.equals(RestartFailedTasksResponse, a, b);
+  }
+}
+
@@ -1934,6 +1934,12 @@ export function createMessageSearch() {
                                     case PayloadEncoding.SMILE:
                                         m.key.encoding = 'smile';
                                         break;
+                                    case PayloadEncoding.CBOR:
+                                        m.key.encoding = 'cbor';
+                                        break;
+                                    case PayloadEncoding.BSON:
+                                        m.key.encoding = 'bson';
+                                        break;
                                     case PayloadEncoding.CONSUMER_OFFSETS:
                                         m.key.encoding = 'consumerOffsets';
                                         break;
@@ -2004,6 +2010,12 @@ export function createMessageSearch() {
                                     case PayloadEncoding.SMILE:
                                         m.value.encoding = 'smile';
                                         break;
+                                    case PayloadEncoding.CBOR:
+                                        m.value.encoding = 'cbor';
+                                        break;
+                                    case PayloadEncoding.BSON:
+                                        m.value.encoding = 'bson';
+                                        break;
                                     case PayloadEncoding.CONSUMER_OFFSETS:
                                         m.value.encoding = 'consumerOffsets';
                                         break;